@@ -15,6 +15,7 @@ import (
 	"bytes"
 	"encoding/xml"
 	"io"
+	"sort"
 	"strconv"
 	"strings"
 	"unicode"
@@ -30,34 +31,88 @@ const (
 )
 
 // adjustHelperFunc defines functions to adjust helper.
-var adjustHelperFunc = [9]func(*File, *xlsxWorksheet, string, adjustDirection, int, int, int) error{
-	func(f *File, ws *xlsxWorksheet, sheet string, dir adjustDirection, num, offset, sheetID int) error {
-		return f.adjustConditionalFormats(ws, sheet, dir, num, offset, sheetID)
+var adjustHelperFunc = [10]func(*File, *xlsxWorksheet, string, adjustDirection, adjustNums, int) error{
+	func(f *File, ws *xlsxWorksheet, sheet string, dir adjustDirection, a adjustNums, sheetID int) error {
+		return f.adjustConditionalFormats(ws, sheet, dir, a, sheetID)
 	},
-	func(f *File, ws *xlsxWorksheet, sheet string, dir adjustDirection, num, offset, sheetID int) error {
-		return f.adjustDataValidations(ws, sheet, dir, num, offset, sheetID)
+	func(f *File, ws *xlsxWorksheet, sheet string, dir adjustDirection, a adjustNums, sheetID int) error {
+		return f.adjustDataValidations(ws, sheet, dir, a, sheetID)
 	},
-	func(f *File, ws *xlsxWorksheet, sheet string, dir adjustDirection, num, offset, sheetID int) error {
-		return f.adjustDefinedNames(ws, sheet, dir, num, offset, sheetID)
+	func(f *File, ws *xlsxWorksheet, sheet string, dir adjustDirection, a adjustNums, sheetID int) error {
+		return f.adjustDefinedNames(ws, sheet, dir, a, sheetID)
 	},
-	func(f *File, ws *xlsxWorksheet, sheet string, dir adjustDirection, num, offset, sheetID int) error {
-		return f.adjustDrawings(ws, sheet, dir, num, offset, sheetID)
+	func(f *File, ws *xlsxWorksheet, sheet string, dir adjustDirection, a adjustNums, sheetID int) error {
+		return f.adjustDrawings(ws, sheet, dir, a, sheetID)
 	},
-	func(f *File, ws *xlsxWorksheet, sheet string, dir adjustDirection, num, offset, sheetID int) error {
-		return f.adjustMergeCells(ws, sheet, dir, num, offset, sheetID)
+	func(f *File, ws *xlsxWorksheet, sheet string, dir adjustDirection, a adjustNums, sheetID int) error {
+		return f.adjustMergeCells(ws, sheet, dir, a, sheetID)
 	},
-	func(f *File, ws *xlsxWorksheet, sheet string, dir adjustDirection, num, offset, sheetID int) error {
-		return f.adjustAutoFilter(ws, sheet, dir, num, offset, sheetID)
+	func(f *File, ws *xlsxWorksheet, sheet string, dir adjustDirection, a adjustNums, sheetID int) error {
+		return f.adjustAutoFilter(ws, sheet, dir, a, sheetID)
 	},
-	func(f *File, ws *xlsxWorksheet, sheet string, dir adjustDirection, num, offset, sheetID int) error {
-		return f.adjustCalcChain(ws, sheet, dir, num, offset, sheetID)
+	func(f *File, ws *xlsxWorksheet, sheet string, dir adjustDirection, a adjustNums, sheetID int) error {
+		return f.adjustCalcChain(ws, sheet, dir, a, sheetID)
 	},
-	func(f *File, ws *xlsxWorksheet, sheet string, dir adjustDirection, num, offset, sheetID int) error {
-		return f.adjustTable(ws, sheet, dir, num, offset, sheetID)
+	func(f *File, ws *xlsxWorksheet, sheet string, dir adjustDirection, a adjustNums, sheetID int) error {
+		return f.adjustTable(ws, sheet, dir, a, sheetID)
 	},
-	func(f *File, ws *xlsxWorksheet, sheet string, dir adjustDirection, num, offset, sheetID int) error {
-		return f.adjustVolatileDeps(ws, sheet, dir, num, offset, sheetID)
+	func(f *File, ws *xlsxWorksheet, sheet string, dir adjustDirection, a adjustNums, sheetID int) error {
+		return f.adjustVolatileDeps(ws, sheet, dir, a, sheetID)
 	},
+	func(f *File, ws *xlsxWorksheet, sheet string, dir adjustDirection, a adjustNums, sheetID int) error {
+		return f.adjustIgnoredErrors(ws, sheet, dir, a, sheetID)
+	},
+}
+
+// adjustNums defines the mapping of the column or row numbers before and
+// after inserting or deleting rows or columns, the deleted field is the sorted
+// and unique column or row numbers which have been deleted.
+type adjustNums struct {
+	adjust  adjustNumFunc
+	deleted []int
+}
+
+// newAdjustNums returns the column or row numbers mapping by given the base
+// number of column or row, and offset, negative offset indicates deletion.
+func newAdjustNums(num, offset int) adjustNums {
+	a := adjustNums{adjust: newAdjustNumFunc(num, offset)}
+	for n := num; n < num-offset; n++ {
+		a.deleted = append(a.deleted, n)
+	}
+	return a
+}
+
+// newDeletedNums returns the column or row numbers mapping by given the sorted
+// and unique column or row numbers which will be deleted.
+func newDeletedNums(deleted []int) adjustNums {
+	return adjustNums{
+		adjust: func(n int) int {
+			return n - sort.SearchInts(deleted, n+1)
+		},
+		deleted: deleted,
+	}
+}
+
+// isDeleted returns whether the given column or row number has been deleted.
+func (a adjustNums) isDeleted(n int) bool {
+	idx := sort.SearchInts(a.deleted, n)
+	return idx < len(a.deleted) && a.deleted[idx] == n
+}
+
+// adjustRange returns the adjusted first and last column or row number of the
+// given range, and returns false if all columns or rows in the range have
+// been deleted.
+func (a adjustNums) adjustRange(p1, p2 int) (int, int, bool) {
+	if p2 < p1 {
+		p1, p2 = p2, p1
+	}
+	if a.isDeleted(p1) {
+		p1 -= sort.SearchInts(a.deleted, p1)
+	} else {
+		p1 = a.adjust(p1)
+	}
+	p2 = a.adjust(p2)
+	return p1, p2, p1 <= p2
 }
 
 // adjustHelper provides a function to adjust rows and columns dimensions,
@@ -75,20 +130,28 @@ func (f *File) adjustHelper(sheet string, dir adjustDirection, num, offset int)
 	if err != nil {
 		return err
 	}
-	sheetID := f.getSheetID(sheet)
+	a := newAdjustNums(num, offset)
 	if dir == rows {
-		err = f.adjustRowDimensions(sheet, ws, num, offset)
+		err = f.adjustRowDimensions(sheet, ws, a)
 	} else {
 		err = f.adjustColDimensions(sheet, ws, num, offset)
 	}
 	if err != nil {
 		return err
 	}
-	f.adjustHyperlinks(ws, sheet, dir, num, offset)
+	return f.adjustReferences(ws, sheet, dir, a)
+}
+
+// adjustReferences provides a function to adjust hyperlinks, merged cells,
+// auto filter and other references in the worksheet by given column or row
+// numbers mapping after the column or row dimensions have been adjusted.
+func (f *File) adjustReferences(ws *xlsxWorksheet, sheet string, dir adjustDirection, a adjustNums) error {
+	sheetID := f.getSheetID(sheet)
+	f.adjustHyperlinks(ws, sheet, dir, a)
 	ws.checkSheet()
 	_ = ws.checkRow()
 	for _, fn := range adjustHelperFunc {
-		if err := fn(f, ws, sheet, dir, num, offset, sheetID); err != nil {
+		if err := fn(f, ws, sheet, dir, a, sheetID); err != nil {
 			return err
 		}
 	}
@@ -150,6 +213,7 @@ func (f *File) adjustColDimensions(sheet string, ws *xlsxWorksheet, col, offset
 			}
 		}
 	}
+	a := newAdjustNums(col, offset)
 	for _, sheetN := range f.GetSheetList() {
 		worksheet, err := f.workSheetReader(sheetN)
 		if err != nil {
@@ -165,7 +229,7 @@ func (f *File) adjustColDimensions(sheet string, ws *xlsxWorksheet, col, offset
 						worksheet.SheetData.Row[rowIdx].C[colIdx].R, _ = CoordinatesToCellName(newCol, cellRow)
 					}
 				}
-				if err := f.adjustFormula(sheet, sheetN, &worksheet.SheetData.Row[rowIdx].C[colIdx], columns, col, offset, false); err != nil {
+				if err := f.adjustFormula(sheet, sheetN, &worksheet.SheetData.Row[rowIdx].C[colIdx], columns, a, false); err != nil {
 					return err
 				}
 			}
@@ -176,7 +240,7 @@ func (f *File) adjustColDimensions(sheet string, ws *xlsxWorksheet, col, offset
 
 // adjustRowDimensions provides a function to update row dimensions when
 // inserting or deleting rows or columns.
-func (f *File) adjustRowDimensions(sheet string, ws *xlsxWorksheet, row, offset int) error {
+func (f *File) adjustRowDimensions(sheet string, ws *xlsxWorksheet, a adjustNums) error {
 	for _, sheetN := range f.GetSheetList() {
 		if sheetN == sheet {
 			continue
@@ -191,7 +255,7 @@ func (f *File) adjustRowDimensions(sheet string, ws *xlsxWorksheet, row, offset
 		numOfRows := len(worksheet.SheetData.Row)
 		for i := 0; i < numOfRows; i++ {
 			r := &worksheet.SheetData.Row[i]
-			if err = f.adjustSingleRowFormulas(sheet, sheetN, r, row, offset, false); err != nil {
+			if err = f.adjustSingleRowFormulas(sheet, sheetN, r, a, false); err != nil {
 				return err
 			}
 		}
@@ -201,16 +265,16 @@ func (f *File) adjustRowDimensions(sheet string, ws *xlsxWorksheet, row, offset
 		return nil
 	}
 	lastRow := &ws.SheetData.Row[totalRows-1]
-	if newRow := lastRow.R + offset; lastRow.R >= row && newRow > 0 && newRow > TotalRows {
+	if a.adjust(lastRow.R) > TotalRows {
 		return ErrMaxRows
 	}
 	numOfRows := len(ws.SheetData.Row)
 	for i := 0; i < numOfRows; i++ {
 		r := &ws.SheetData.Row[i]
-		if newRow := r.R + offset; r.R >= row && newRow > 0 {
-			r.adjustSingleRowDimensions(offset)
+		if newRow := a.adjust(r.R); newRow != r.R && newRow > 0 {
+			r.adjustSingleRowDimensions(newRow - r.R)
 		}
-		if err := f.adjustSingleRowFormulas(sheet, sheet, r, row, offset, false); err != nil {
+		if err := f.adjustSingleRowFormulas(sheet, sheet, r, a, false); err != nil {
 			return err
 		}
 	}
@@ -227,9 +291,9 @@ func (r *xlsxRow) adjustSingleRowDimensions(offset int) {
 }
 
 // adjustSingleRowFormulas provides a function to adjust single row formulas.
-func (f *File) adjustSingleRowFormulas(sheet, sheetN string, r *xlsxRow, num, offset int, si bool) error {
+func (f *File) adjustSingleRowFormulas(sheet, sheetN string, r *xlsxRow, a adjustNums, si bool) error {
 	for i := 0; i < len(r.C); i++ {
-		if err := f.adjustFormula(sheet, sheetN, &r.C[i], rows, num, offset, si); err != nil {
+		if err := f.adjustFormula(sheet, sheetN, &r.C[i], rows, a, si); err != nil {
 			return err
 		}
 	}
@@ -237,20 +301,15 @@ func (f *File) adjustSingleRowFormulas(sheet, sheetN string, r *xlsxRow, num, of
 }
 
 // adjustCellRef provides a function to adjust cell reference.
-func (f *File) adjustCellRef(cellRef string, dir adjustDirection, num, offset int) (string, error) {
+func (f *File) adjustCellRef(cellRef string, dir adjustDirection, a adjustNums) (string, error) {
 	var SQRef []string
-	applyOffset := func(coordinates []int, idx1, idx2, maxVal int) []int {
-		if coordinates[idx1] >= num {
-			coordinates[idx1] += offset
-		}
-		if coordinates[idx2] >= num {
-			if coordinates[idx2] += offset; coordinates[idx2] > maxVal {
-				coordinates[idx2] = maxVal
+	for _, ref := range strings.Split(cellRef, " ") {
+		if entireRef, ok := adjustEntireRangeRef(ref, dir, a); ok {
+			if entireRef != "" {
+				SQRef = append(SQRef, entireRef)
 			}
+			continue
 		}
-		return coordinates
-	}
-	for _, ref := range strings.Split(cellRef, " ") {
 		if !strings.Contains(ref, ":") {
 			ref += ":" + ref
 		}
@@ -258,16 +317,16 @@ func (f *File) adjustCellRef(cellRef string, dir adjustDirection, num, offset in
 		if err != nil {
 			return "", err
 		}
+		idx1, idx2, maxVal := 1, 3, TotalRows
 		if dir == columns {
-			if offset < 0 && coordinates[0] == coordinates[2] {
-				continue
-			}
-			coordinates = applyOffset(coordinates, 0, 2, MaxColumns)
-		} else {
-			if offset < 0 && coordinates[1] == coordinates[3] {
-				continue
-			}
-			coordinates = applyOffset(coordinates, 1, 3, TotalRows)
+			idx1, idx2, maxVal = 0, 2, MaxColumns
+		}
+		var ok bool
+		if coordinates[idx1], coordinates[idx2], ok = a.adjustRange(coordinates[idx1], coordinates[idx2]); !ok {
+			continue
+		}
+		if coordinates[idx2] > maxVal {
+			coordinates[idx2] = maxVal
 		}
 		if ref, err = coordinatesToRangeRef(coordinates); err != nil {
 			return "", err
@@ -277,12 +336,52 @@ func (f *File) adjustCellRef(cellRef string, dir adjustDirection, num, offset in
 	return strings.Join(SQRef, " "), nil
 }
 
+// adjustEntireRangeRef provides a function to adjust the entire columns range
+// reference (e.g. A:B) or entire rows range reference (e.g. 1:2) when
+// inserting or deleting rows or columns, returns false if the given reference
+// isn't an entire columns or rows range reference. The returned reference will
+// be empty if the range has been deleted.
+func adjustEntireRangeRef(ref string, dir adjustDirection, a adjustNums) (string, bool) {
+	entireRef, _, ok := parseEntireRangeRef(ref)
+	if !ok {
+		return "", false
+	}
+	refs := strings.Split(entireRef, ":")
+	isCol := !strings.ContainsAny(refs[0], "0123456789")
+	if isCol != (dir == columns) {
+		return entireRef, true
+	}
+	maxVal, fromTo := TotalRows, [2]int{}
+	for i, ref := range refs {
+		if isCol {
+			maxVal = MaxColumns
+			fromTo[i], _ = ColumnNameToNumber(ref)
+			continue
+		}
+		fromTo[i], _ = strconv.Atoi(ref)
+	}
+	if fromTo[0], fromTo[1], ok = a.adjustRange(fromTo[0], fromTo[1]); !ok {
+		return "", true
+	}
+	for i := range fromTo {
+		if fromTo[i] > maxVal {
+			fromTo[i] = maxVal
+		}
+	}
+	if isCol {
+		from, _ := ColumnNumberToName(fromTo[0])
+		to, _ := ColumnNumberToName(fromTo[1])
+		return from + ":" + to, true
+	}
+	return strconv.Itoa(fromTo[0]) + ":" + strconv.Itoa(fromTo[1]), true
+}
+
 // adjustFormula provides a function to adjust formula reference and shared
 // formula reference.
-func (f *File) adjustFormula(sheet, sheetN string, cell *xlsxC, dir adjustDirection, num, offset int, si bool) error {
+func (f *File) adjustFormula(sheet, sheetN string, cell *xlsxC, dir adjustDirection, a adjustNums, si bool) error {
 	var err error
 	if cell.f != "" {
-		if cell.f, err = f.adjustFormulaRef(sheet, sheetN, cell.f, false, dir, num, offset); err != nil {
+		if cell.f, err = f.adjustFormulaRef(sheet, sheetN, cell.f, false, dir, a.adjust); err != nil {
 			return err
 		}
 	}
@@ -290,7 +389,7 @@ func (f *File) adjustFormula(sheet, sheetN string, cell *xlsxC, dir adjustDirect
 		return nil
 	}
 	if cell.F.Ref != "" && sheet == sheetN {
-		if cell.F.Ref, err = f.adjustCellRef(cell.F.Ref, dir, num, offset); err != nil {
+		if cell.F.Ref, err = f.adjustCellRef(cell.F.Ref, dir, a); err != nil {
 			return err
 		}
 		if si && cell.F.Si != nil {
@@ -298,7 +397,7 @@ func (f *File) adjustFormula(sheet, sheetN string, cell *xlsxC, dir adjustDirect
 		}
 	}
 	if cell.F.Content != "" {
-		if cell.F.Content, err = f.adjustFormulaRef(sheet, sheetN, cell.F.Content, false, dir, num, offset); err != nil {
+		if cell.F.Content, err = f.adjustFormulaRef(sheet, sheetN, cell.F.Content, false, dir, a.adjust); err != nil {
 			return err
 		}
 	}
@@ -316,8 +415,23 @@ func escapeSheetName(name string) string {
 	return name
 }
 
+// adjustNumFunc defines the function to return the adjusted column or row
+// number by given column or row number.
+type adjustNumFunc func(int) int
+
+// newAdjustNumFunc returns the function to adjust the column or row number
+// which not less than the given number by the given offset.
+func newAdjustNumFunc(num, offset int) adjustNumFunc {
+	return func(n int) int {
+		if n >= num {
+			return n + offset
+		}
+		return n
+	}
+}
+
 // adjustFormulaColumnName adjust column name in the formula reference.
-func adjustFormulaColumnName(name, operand string, abs, keepRelative bool, dir adjustDirection, num, offset int) (string, string, bool, error) {
+func adjustFormulaColumnName(name, operand string, abs, keepRelative bool, dir adjustDirection, adjust adjustNumFunc) (string, string, bool, error) {
 	if name == "" || (!abs && keepRelative) {
 		return "", operand + name, abs, nil
 	}
@@ -325,8 +439,8 @@ func adjustFormulaColumnName(name, operand string, abs, keepRelative bool, dir a
 	if err != nil {
 		return "", operand, false, err
 	}
-	if dir == columns && col >= num {
-		if col += offset; col < 1 {
+	if newCol := adjust(col); dir == columns && newCol != col {
+		if col = newCol; col < 1 {
 			col = 1
 		}
 		colName, err := ColumnNumberToName(col)
@@ -336,13 +450,13 @@ func adjustFormulaColumnName(name, operand string, abs, keepRelative bool, dir a
 }
 
 // adjustFormulaRowNumber adjust row number in the formula reference.
-func adjustFormulaRowNumber(name, operand string, abs, keepRelative bool, dir adjustDirection, num, offset int) (string, string, bool, error) {
+func adjustFormulaRowNumber(name, operand string, abs, keepRelative bool, dir adjustDirection, adjust adjustNumFunc) (string, string, bool, error) {
 	if name == "" || (!abs && keepRelative) {
 		return "", operand + name, abs, nil
 	}
 	row, _ := strconv.Atoi(name)
-	if dir == rows && row >= num {
-		if row += offset; row < 1 {
+	if newRow := adjust(row); dir == rows && newRow != row {
+		if row = newRow; row < 1 {
 			row = 1
 		}
 		if row > TotalRows {
@@ -354,18 +468,18 @@ func adjustFormulaRowNumber(name, operand string, abs, keepRelative bool, dir ad
 }
 
 // adjustFormulaOperandRef adjust cell reference in the operand tokens for the formula.
-func adjustFormulaOperandRef(row, col, operand string, abs, keepRelative bool, dir adjustDirection, num int, offset int) (string, string, string, bool, error) {
+func adjustFormulaOperandRef(row, col, operand string, abs, keepRelative bool, dir adjustDirection, adjust adjustNumFunc) (string, string, string, bool, error) {
 	var err error
-	col, operand, abs, err = adjustFormulaColumnName(col, operand, abs, keepRelative, dir, num, offset)
+	col, operand, abs, err = adjustFormulaColumnName(col, operand, abs, keepRelative, dir, adjust)
 	if err != nil {
 		return row, col, operand, abs, err
 	}
-	row, operand, abs, err = adjustFormulaRowNumber(row, operand, abs, keepRelative, dir, num, offset)
+	row, operand, abs, err = adjustFormulaRowNumber(row, operand, abs, keepRelative, dir, adjust)
 	return row, col, operand, abs, err
 }
 
 // adjustFormulaOperand adjust range operand tokens for the formula.
-func (f *File) adjustFormulaOperand(sheet, sheetN string, keepRelative bool, token efp.Token, dir adjustDirection, num int, offset int) (string, error) {
+func (f *File) adjustFormulaOperand(sheet, sheetN string, keepRelative bool, token efp.Token, dir adjustDirection, adjust adjustNumFunc) (string, error) {
 	var (
 		err                          error
 		abs                          bool
@@ -385,7 +499,7 @@ func (f *File) adjustFormulaOperand(sheet, sheetN string, keepRelative bool, tok
 	}
 	for _, r := range cell {
 		if r == '$' {
-			if col, operand, _, err = adjustFormulaColumnName(col, operand, abs, keepRelative, dir, num, offset); err != nil {
+			if col, operand, _, err = adjustFormulaColumnName(col, operand, abs, keepRelative, dir, adjust); err != nil {
 				return operand, err
 			}
 			abs = true
@@ -398,24 +512,24 @@ func (f *File) adjustFormulaOperand(sheet, sheetN string, keepRelative bool, tok
 		}
 		if '0' <= r && r <= '9' {
 			row += string(r)
-			col, operand, abs, err = adjustFormulaColumnName(col, operand, abs, keepRelative, dir, num, offset)
+			col, operand, abs, err = adjustFormulaColumnName(col, operand, abs, keepRelative, dir, adjust)
 			if err != nil {
 				return operand, err
 			}
 			continue
 		}
-		if row, col, operand, abs, err = adjustFormulaOperandRef(row, col, operand, abs, keepRelative, dir, num, offset); err != nil {
+		if row, col, operand, abs, err = adjustFormulaOperandRef(row, col, operand, abs, keepRelative, dir, adjust); err != nil {
 			return operand, err
 		}
 		operand += string(r)
 	}
-	_, _, operand, _, err = adjustFormulaOperandRef(row, col, operand, abs, keepRelative, dir, num, offset)
+	_, _, operand, _, err = adjustFormulaOperandRef(row, col, operand, abs, keepRelative, dir, adjust)
 	return operand, err
 }
 
 // adjustFormulaRef returns adjusted formula by giving adjusting direction and
-// the base number of column or row, and offset.
-func (f *File) adjustFormulaRef(sheet, sheetN, formula string, keepRelative bool, dir adjustDirection, num, offset int) (string, error) {
+// the function to adjust the column or row number.
+func (f *File) adjustFormulaRef(sheet, sheetN, formula string, keepRelative bool, dir adjustDirection, adjust adjustNumFunc) (string, error) {
 	var (
 		val          string
 		definedNames []string
@@ -440,7 +554,7 @@ func (f *File) adjustFormulaRef(sheet, sheetN, formula string, keepRelative bool
 				val += token.TValue
 				continue
 			}
-			operand, err := f.adjustFormulaOperand(sheet, sheetN, keepRelative, token, dir, num, offset)
+			operand, err := f.adjustFormulaOperand(sheet, sheetN, keepRelative, token, dir, adjust)
 			if err != nil {
 				return val, err
 			}
@@ -606,27 +720,24 @@ func getArrayFormulaTokens(sheet, formula string, definedNames []DefinedName) ([
 
 // adjustHyperlinks provides a function to update hyperlinks when inserting or
 // deleting rows or columns.
-func (f *File) adjustHyperlinks(ws *xlsxWorksheet, sheet string, dir adjustDirection, num, offset int) {
+func (f *File) adjustHyperlinks(ws *xlsxWorksheet, sheet string, dir adjustDirection, a adjustNums) {
 	// short path
 	if ws.Hyperlinks == nil || len(ws.Hyperlinks.Hyperlink) == 0 {
 		return
 	}
 
-	// order is important
-	if offset < 0 {
-		for i := len(ws.Hyperlinks.Hyperlink) - 1; i >= 0; i-- {
-			linkData := ws.Hyperlinks.Hyperlink[i]
+	if len(a.deleted) > 0 {
+		links := ws.Hyperlinks.Hyperlink[:0]
+		for _, linkData := range ws.Hyperlinks.Hyperlink {
 			colNum, rowNum, _ := CellNameToCoordinates(linkData.Ref)
-
-			if (dir == rows && num == rowNum) || (dir == columns && num == colNum) {
+			if (dir == rows && a.isDeleted(rowNum)) || (dir == columns && a.isDeleted(colNum)) {
 				f.deleteSheetRelationships(sheet, linkData.RID)
-				if len(ws.Hyperlinks.Hyperlink) > 1 {
-					ws.Hyperlinks.Hyperlink = append(ws.Hyperlinks.Hyperlink[:i],
-						ws.Hyperlinks.Hyperlink[i+1:]...)
-				} else {
-					ws.Hyperlinks = nil
-				}
+				continue
 			}
+			links = append(links, linkData)
+		}
+		if ws.Hyperlinks.Hyperlink = links; len(links) == 0 {
+			ws.Hyperlinks = nil
 		}
 	}
 	if ws.Hyperlinks == nil {
@@ -634,13 +745,13 @@ func (f *File) adjustHyperlinks(ws *xlsxWorksheet, sheet string, dir adjustDirec
 	}
 	for i := range ws.Hyperlinks.Hyperlink {
 		link := &ws.Hyperlinks.Hyperlink[i] // get reference
-		link.Ref, _ = f.adjustFormulaRef(sheet, sheet, link.Ref, false, dir, num, offset)
+		link.Ref, _ = f.adjustFormulaRef(sheet, sheet, link.Ref, false, dir, a.adjust)
 	}
 }
 
 // adjustTable provides a function to update the table when inserting or
 // deleting rows or columns.
-func (f *File) adjustTable(ws *xlsxWorksheet, sheet string, dir adjustDirection, num, offset, sheetID int) error {
+func (f *File) adjustTable(ws *xlsxWorksheet, sheet string, dir adjustDirection, a adjustNums, sheetID int) error {
 	if ws.TableParts == nil || len(ws.TableParts.TableParts) == 0 {
 		return nil
 	}
@@ -662,13 +773,13 @@ func (f *File) adjustTable(ws *xlsxWorksheet, sheet string, dir adjustDirection,
 			return err
 		}
 		// Remove the table when deleting the header row of the table
-		if dir == rows && num == coordinates[0] && offset == -1 {
+		if dir == rows && a.isDeleted(coordinates[1]) {
 			ws.TableParts.TableParts = append(ws.TableParts.TableParts[:idx], ws.TableParts.TableParts[idx+1:]...)
 			ws.TableParts.Count = len(ws.TableParts.TableParts)
 			idx--
 			continue
 		}
-		coordinates = f.adjustAutoFilterHelper(dir, coordinates, num, offset)
+		coordinates = f.adjustAutoFilterHelper(dir, coordinates, a)
 		x1, y1, x2, y2 := coordinates[0], coordinates[1], coordinates[2], coordinates[3]
 		if y2-y1 < 1 || x2-x1 < 0 {
 			ws.TableParts.TableParts = append(ws.TableParts.TableParts[:idx], ws.TableParts.TableParts[idx+1:]...)
@@ -691,7 +802,7 @@ func (f *File) adjustTable(ws *xlsxWorksheet, sheet string, dir adjustDirection,
 
 // adjustAutoFilter provides a function to update the auto filter when
 // inserting or deleting rows or columns.
-func (f *File) adjustAutoFilter(ws *xlsxWorksheet, sheet string, dir adjustDirection, num, offset, sheetID int) error {
+func (f *File) adjustAutoFilter(ws *xlsxWorksheet, sheet string, dir adjustDirection, a adjustNums, sheetID int) error {
 	if ws.AutoFilter == nil {
 		return nil
 	}
@@ -702,18 +813,22 @@ func (f *File) adjustAutoFilter(ws *xlsxWorksheet, sheet string, dir adjustDirec
 	}
 	x1, y1, x2, y2 := coordinates[0], coordinates[1], coordinates[2], coordinates[3]
 
-	if (dir == rows && y1 == num && offset < 0) || (dir == columns && x1 == num && x2 == num) {
+	if (dir == rows && a.isDeleted(y1)) || (dir == columns && x1 == x2 && a.isDeleted(x1)) {
 		ws.AutoFilter = nil
+		// Unhide the rows below the header row of the auto filter
+		if y1++; dir == rows && y1 <= y2 {
+			y1, y2, _ = a.adjustRange(y1, y2)
+		}
 		for rowIdx := range ws.SheetData.Row {
 			rowData := &ws.SheetData.Row[rowIdx]
-			if rowData.R > y1 && rowData.R <= y2 {
+			if rowData.R >= y1 && rowData.R <= y2 {
 				rowData.Hidden = false
 			}
 		}
 		return err
 	}
 
-	coordinates = f.adjustAutoFilterHelper(dir, coordinates, num, offset)
+	coordinates = f.adjustAutoFilterHelper(dir, coordinates, a)
 	x1, y1, x2, y2 = coordinates[0], coordinates[1], coordinates[2], coordinates[3]
 
 	ws.AutoFilter.Ref, err = coordinatesToRangeRef([]int{x1, y1, x2, y2})
@@ -721,30 +836,20 @@ func (f *File) adjustAutoFilter(ws *xlsxWorksheet, sheet string, dir adjustDirec
 }
 
 // adjustAutoFilterHelper provides a function for adjusting auto filter to
-// compare and calculate cell reference by the giving adjusting direction,
-// operation reference and offset.
-func (f *File) adjustAutoFilterHelper(dir adjustDirection, coordinates []int, num, offset int) []int {
+// compare and calculate cell reference by the giving adjusting direction and
+// the column or row numbers mapping.
+func (f *File) adjustAutoFilterHelper(dir adjustDirection, coordinates []int, a adjustNums) []int {
 	if dir == rows {
-		if coordinates[1] >= num {
-			coordinates[1] += offset
-		}
-		if coordinates[3] >= num {
-			coordinates[3] += offset
-		}
+		coordinates[1], coordinates[3] = a.adjust(coordinates[1]), a.adjust(coordinates[3])
 		return coordinates
 	}
-	if coordinates[0] >= num {
-		coordinates[0] += offset
-	}
-	if coordinates[2] >= num {
-		coordinates[2] += offset
-	}
+	coordinates[0], coordinates[2] = a.adjust(coordinates[0]), a.adjust(coordinates[2])
 	return coordinates
 }
 
 // adjustMergeCells provides a function to update merged cells when inserting
 // or deleting rows or columns.
-func (f *File) adjustMergeCells(ws *xlsxWorksheet, sheet string, dir adjustDirection, num, offset, sheetID int) error {
+func (f *File) adjustMergeCells(ws *xlsxWorksheet, sheet string, dir adjustDirection, a adjustNums, sheetID int) error {
 	if ws.MergeCells == nil {
 		return nil
 	}
@@ -760,24 +865,13 @@ func (f *File) adjustMergeCells(ws *xlsxWorksheet, sheet string, dir adjustDirec
 			return err
 		}
 		x1, y1, x2, y2 := coordinates[0], coordinates[1], coordinates[2], coordinates[3]
+		var ok bool
 		if dir == rows {
-			if y1 == num && y2 == num && offset < 0 {
-				f.deleteMergeCell(ws, i)
-				i--
-				continue
-			}
-
-			y1, y2 = f.adjustMergeCellsHelper(y1, y2, num, offset)
+			y1, y2, ok = a.adjustRange(y1, y2)
 		} else {
-			if x1 == num && x2 == num && offset < 0 {
-				f.deleteMergeCell(ws, i)
-				i--
-				continue
-			}
-
-			x1, x2 = f.adjustMergeCellsHelper(x1, x2, num, offset)
+			x1, x2, ok = a.adjustRange(x1, x2)
 		}
-		if x1 == x2 && y1 == y2 {
+		if !ok || (x1 == x2 && y1 == y2) {
 			f.deleteMergeCell(ws, i)
 			i--
 			continue
@@ -790,32 +884,6 @@ func (f *File) adjustMergeCells(ws *xlsxWorksheet, sheet string, dir adjustDirec
 	return nil
 }
 
-// adjustMergeCellsHelper provides a function for adjusting merge cells to
-// compare and calculate cell reference by the given pivot, operation reference
-// and offset.
-func (f *File) adjustMergeCellsHelper(p1, p2, num, offset int) (int, int) {
-	if p2 < p1 {
-		p1, p2 = p2, p1
-	}
-
-	if offset >= 0 {
-		if num <= p1 {
-			p1 += offset
-			p2 += offset
-		} else if num <= p2 {
-			p2 += offset
-		}
-		return p1, p2
-	}
-	if num < p1 || (num == p1 && num == p2) {
-		p1 += offset
-		p2 += offset
-	} else if num <= p2 {
-		p2 += offset
-	}
-	return p1, p2
-}
-
 // deleteMergeCell provides a function to delete merged cell by given index.
 func (f *File) deleteMergeCell(ws *xlsxWorksheet, idx int) {
 	if idx < 0 {
@@ -828,106 +896,92 @@ func (f *File) deleteMergeCell(ws *xlsxWorksheet, idx int) {
 }
 
 // adjustCellName returns updated cell name by giving column/row number and
-// offset on inserting or deleting rows or columns.
-func adjustCellName(cell string, dir adjustDirection, c, r, offset int) (string, error) {
+// the column or row numbers mapping on inserting or deleting rows or columns,
+// returns false if the cell has been deleted.
+func adjustCellName(cell string, dir adjustDirection, a adjustNums) (string, bool, error) {
+	colNum, rowNum, err := CellNameToCoordinates(cell)
+	if err != nil {
+		return cell, false, err
+	}
 	if dir == rows {
-		if rn := r + offset; rn > 0 {
-			return CoordinatesToCellName(c, rn)
+		if a.isDeleted(rowNum) {
+			return cell, false, err
+		}
+		rowNum = a.adjust(rowNum)
+	} else {
+		if a.isDeleted(colNum) {
+			return cell, false, err
 		}
+		colNum = a.adjust(colNum)
 	}
-	return CoordinatesToCellName(c+offset, r)
+	cell, err = CoordinatesToCellName(colNum, rowNum)
+	return cell, true, err
 }
 
 // adjustCalcChain provides a function to update the calculation chain when
 // inserting or deleting rows or columns.
-func (f *File) adjustCalcChain(ws *xlsxWorksheet, sheet string, dir adjustDirection, num, offset, sheetID int) error {
+func (f *File) adjustCalcChain(ws *xlsxWorksheet, sheet string, dir adjustDirection, a adjustNums, sheetID int) error {
 	if f.CalcChain == nil {
 		return nil
 	}
 	// If sheet ID is omitted, it is assumed to be the same as the i value of
 	// the previous cell.
-	var prevSheetID int
-	for i := 0; i < len(f.CalcChain.C); i++ {
-		c := f.CalcChain.C[i]
-		if c.I == 0 {
-			c.I = prevSheetID
-		}
-		prevSheetID = c.I
-		if c.I != sheetID {
-			continue
-		}
-		colNum, rowNum, err := CellNameToCoordinates(c.R)
-		if err != nil {
-			return err
-		}
-		if dir == rows && num <= rowNum {
-			if num == rowNum && offset == -1 {
-				_ = f.deleteCalcChain(c.I, c.R)
-				i--
-				continue
+	var prevSheetID, prevKeptSheetID int
+	cells := f.CalcChain.C[:0]
+	for _, c := range f.CalcChain.C {
+		i := c.I
+		if i == 0 {
+			i = prevSheetID
+		}
+		prevSheetID = i
+		if i == sheetID {
+			cell, ok, err := adjustCellName(c.R, dir, a)
+			if err != nil {
+				return err
 			}
-			f.CalcChain.C[i].R, _ = adjustCellName(c.R, dir, colNum, rowNum, offset)
-		}
-		if dir == columns && num <= colNum {
-			if num == colNum && offset == -1 {
-				_ = f.deleteCalcChain(c.I, c.R)
-				i--
+			if !ok {
 				continue
 			}
-			f.CalcChain.C[i].R, _ = adjustCellName(c.R, dir, colNum, rowNum, offset)
+			c.R = cell
 		}
-	}
-	return nil
-}
-
-// adjustVolatileDepsTopic updates the volatile dependencies topic when
-// inserting or deleting rows or columns.
-func (vt *xlsxVolTypes) adjustVolatileDepsTopic(cell string, dir adjustDirection, indexes []int) (int, error) {
-	num, offset, i1, i2, i3, i4 := indexes[0], indexes[1], indexes[2], indexes[3], indexes[4], indexes[5]
-	colNum, rowNum, err := CellNameToCoordinates(cell)
-	if err != nil {
-		return i4, err
-	}
-	if dir == rows && num <= rowNum {
-		if num == rowNum && offset == -1 {
-			vt.deleteVolTopicRef(i1, i2, i3, i4)
-			i4--
-			return i4, err
+		if c.I == 0 && i != prevKeptSheetID {
+			c.I = i
 		}
-		vt.VolType[i1].Main[i2].Tp[i3].Tr[i4].R, _ = adjustCellName(cell, dir, colNum, rowNum, offset)
+		prevKeptSheetID = i
+		cells = append(cells, c)
 	}
-	if dir == columns && num <= colNum {
-		if num == colNum && offset == -1 {
-			vt.deleteVolTopicRef(i1, i2, i3, i4)
-			i4--
-			return i4, err
-		}
-		if name, _ := adjustCellName(cell, dir, colNum, rowNum, offset); name != "" {
-			vt.VolType[i1].Main[i2].Tp[i3].Tr[i4].R, _ = adjustCellName(cell, dir, colNum, rowNum, offset)
-		}
+	if f.CalcChain.C = cells; len(cells) == 0 {
+		return f.deleteCalcChainPart()
 	}
-	return i4, err
+	return nil
 }
 
 // adjustVolatileDeps updates the volatile dependencies when inserting or
 // deleting rows or columns.
-func (f *File) adjustVolatileDeps(ws *xlsxWorksheet, sheet string, dir adjustDirection, num, offset, sheetID int) error {
+func (f *File) adjustVolatileDeps(ws *xlsxWorksheet, sheet string, dir adjustDirection, a adjustNums, sheetID int) error {
 	volTypes, err := f.volatileDepsReader()
 	if err != nil || volTypes == nil {
 		return err
 	}
-	for i1 := 0; i1 < len(volTypes.VolType); i1++ {
-		for i2 := 0; i2 < len(volTypes.VolType[i1].Main); i2++ {
-			for i3 := 0; i3 < len(volTypes.VolType[i1].Main[i2].Tp); i3++ {
-				for i4 := 0; i4 < len(volTypes.VolType[i1].Main[i2].Tp[i3].Tr); i4++ {
-					ref := volTypes.VolType[i1].Main[i2].Tp[i3].Tr[i4]
-					if ref.S != sheetID {
-						continue
-					}
-					if i4, err = volTypes.adjustVolatileDepsTopic(ref.R, dir, []int{num, offset, i1, i2, i3, i4}); err != nil {
-						return err
+	for i1 := range volTypes.VolType {
+		for i2 := range volTypes.VolType[i1].Main {
+			for i3 := range volTypes.VolType[i1].Main[i2].Tp {
+				tp := &volTypes.VolType[i1].Main[i2].Tp[i3]
+				refs := tp.Tr[:0]
+				for _, ref := range tp.Tr {
+					if ref.S == sheetID {
+						cell, ok, err := adjustCellName(ref.R, dir, a)
+						if err != nil {
+							return err
+						}
+						if !ok {
+							continue
+						}
+						ref.R = cell
 					}
+					refs = append(refs, ref)
 				}
+				tp.Tr = refs
 			}
 		}
 	}
@@ -936,13 +990,13 @@ func (f *File) adjustVolatileDeps(ws *xlsxWorksheet, sheet string, dir adjustDir
 
 // adjustConditionalFormats updates the cell reference of the worksheet
 // conditional formatting when inserting or deleting rows or columns.
-func (f *File) adjustConditionalFormats(ws *xlsxWorksheet, sheet string, dir adjustDirection, num, offset, sheetID int) error {
+func (f *File) adjustConditionalFormats(ws *xlsxWorksheet, sheet string, dir adjustDirection, a adjustNums, sheetID int) error {
 	for i := 0; i < len(ws.ConditionalFormatting); i++ {
 		cf := ws.ConditionalFormatting[i]
 		if cf == nil {
 			continue
 		}
-		ref, err := f.adjustCellRef(cf.SQRef, dir, num, offset)
+		ref, err := f.adjustCellRef(cf.SQRef, dir, a)
 		if err != nil {
 			return err
 		}
@@ -957,9 +1011,40 @@ func (f *File) adjustConditionalFormats(ws *xlsxWorksheet, sheet string, dir adj
 	return nil
 }
 
+// adjustIgnoredErrors updates the range references of the ignored errors for
+// the worksheet when inserting or deleting rows or columns.
+func (f *File) adjustIgnoredErrors(ws *xlsxWorksheet, sheet string, dir adjustDirection, a adjustNums, sheetID int) error {
+	if ws.IgnoredErrors == nil {
+		return nil
+	}
+	if ws.ignoredErrors == nil {
+		ws.loadIgnoredErrors()
+	}
+	for item, refs := range ws.ignoredErrors {
+		adjusted := make(map[string]struct{}, len(refs))
+		for ref := range refs {
+			newRef, err := f.adjustCellRef(ref, dir, a)
+			if err != nil {
+				return err
+			}
+			if newRef == "" {
+				continue
+			}
+			// Keep the single cell reference for deleting it on overwritten
+			if !strings.Contains(ref, ":") {
+				newRef = strings.Split(newRef, ":")[0]
+			}
+			adjusted[newRef] = struct{}{}
+		}
+		ws.ignoredErrors[item] = adjusted
+	}
+	ws.mergeIgnoredErrors()
+	return nil
+}
+
 // adjustDataValidations updates the range of data validations for the worksheet
 // when inserting or deleting rows or columns.
-func (f *File) adjustDataValidations(ws *xlsxWorksheet, sheet string, dir adjustDirection, num, offset, sheetID int) error {
+func (f *File) adjustDataValidations(ws *xlsxWorksheet, sheet string, dir adjustDirection, a adjustNums, sheetID int) error {
 	for _, sheetN := range f.GetSheetList() {
 		worksheet, err := f.workSheetReader(sheetN)
 		if err != nil {
@@ -969,7 +1054,7 @@ func (f *File) adjustDataValidations(ws *xlsxWorksheet, sheet string, dir adjust
 			return err
 		}
 		if worksheet.DataValidations == nil {
-			return nil
+			continue
 		}
 		for i := 0; i < len(worksheet.DataValidations.DataValidation); i++ {
 			dv := worksheet.DataValidations.DataValidation[i]
@@ -977,7 +1062,7 @@ func (f *File) adjustDataValidations(ws *xlsxWorksheet, sheet string, dir adjust
 				continue
 			}
 			if sheet == sheetN {
-				ref, err := f.adjustCellRef(dv.Sqref, dir, num, offset)
+				ref, err := f.adjustCellRef(dv.Sqref, dir, a)
 				if err != nil {
 					return err
 				}
@@ -991,14 +1076,14 @@ func (f *File) adjustDataValidations(ws *xlsxWorksheet, sheet string, dir adjust
 			}
 			if worksheet.DataValidations.DataValidation[i].Formula1.isFormula() {
 				formula := formulaUnescaper.Replace(worksheet.DataValidations.DataValidation[i].Formula1.Content)
-				if formula, err = f.adjustFormulaRef(sheet, sheetN, formula, false, dir, num, offset); err != nil {
+				if formula, err = f.adjustFormulaRef(sheet, sheetN, formula, false, dir, a.adjust); err != nil {
 					return err
 				}
 				worksheet.DataValidations.DataValidation[i].Formula1 = &xlsxInnerXML{Content: formulaEscaper.Replace(formula)}
 			}
 			if worksheet.DataValidations.DataValidation[i].Formula2.isFormula() {
 				formula := formulaUnescaper.Replace(worksheet.DataValidations.DataValidation[i].Formula2.Content)
-				if formula, err = f.adjustFormulaRef(sheet, sheetN, formula, false, dir, num, offset); err != nil {
+				if formula, err = f.adjustFormulaRef(sheet, sheetN, formula, false, dir, a.adjust); err != nil {
 					return err
 				}
 				worksheet.DataValidations.DataValidation[i].Formula2 = &xlsxInnerXML{Content: formulaEscaper.Replace(formula)}
@@ -1011,22 +1096,32 @@ func (f *File) adjustDataValidations(ws *xlsxWorksheet, sheet string, dir adjust
 	return nil
 }
 
+// adjustDrawingNum returns the adjusted zero-based column or row number of
+// the drawing anchor, and returns false if the number wasn't changed.
+func adjustDrawingNum(n int, a adjustNums) (int, bool) {
+	newNum := a.adjust(n+1) - 1
+	if newNum < 0 {
+		newNum = 0
+	}
+	return newNum, newNum != n
+}
+
 // adjustDrawings updates the starting anchor of the two cell anchor pictures
 // and charts object when inserting or deleting rows or columns.
-func (from *xlsxFrom) adjustDrawings(dir adjustDirection, num, offset int, editAs string) (bool, error) {
+func (from *xlsxFrom) adjustDrawings(dir adjustDirection, a adjustNums, editAs string) (bool, error) {
 	var ok bool
-	if dir == columns && from.Col+1 >= num && from.Col+offset >= 0 {
-		if from.Col+offset >= MaxColumns {
+	if col, changed := adjustDrawingNum(from.Col, a); dir == columns && changed {
+		if col >= MaxColumns {
 			return false, ErrColumnNumber
 		}
-		from.Col += offset
+		from.Col = col
 		ok = editAs == "oneCell"
 	}
-	if dir == rows && from.Row+1 >= num && from.Row+offset >= 0 {
-		if from.Row+offset >= TotalRows {
+	if row, changed := adjustDrawingNum(from.Row, a); dir == rows && changed {
+		if row >= TotalRows {
 			return false, ErrMaxRows
 		}
-		from.Row += offset
+		from.Row = row
 		ok = editAs == "oneCell"
 	}
 	return ok, nil
@@ -1034,52 +1129,52 @@ func (from *xlsxFrom) adjustDrawings(dir adjustDirection, num, offset int, editA
 
 // adjustDrawings updates the ending anchor of the two cell anchor pictures
 // and charts object when inserting or deleting rows or columns.
-func (to *xlsxTo) adjustDrawings(dir adjustDirection, num, offset int, editAs string, ok bool) error {
-	if dir == columns && to.Col+1 >= num && to.Col+offset >= 0 && ok {
-		if to.Col+offset >= MaxColumns {
+func (to *xlsxTo) adjustDrawings(dir adjustDirection, a adjustNums, editAs string, ok bool) error {
+	if col, changed := adjustDrawingNum(to.Col, a); dir == columns && changed && ok {
+		if col >= MaxColumns {
 			return ErrColumnNumber
 		}
-		to.Col += offset
+		to.Col = col
 	}
-	if dir == rows && to.Row+1 >= num && to.Row+offset >= 0 && ok {
-		if to.Row+offset >= TotalRows {
+	if row, changed := adjustDrawingNum(to.Row, a); dir == rows && changed && ok {
+		if row >= TotalRows {
 			return ErrMaxRows
 		}
-		to.Row += offset
+		to.Row = row
 	}
 	return nil
 }
 
 // adjustDrawings updates the two cell anchor pictures and charts object when
 // inserting or deleting rows or columns.
-func (a *xdrCellAnchor) adjustDrawings(dir adjustDirection, num, offset int) error {
+func (a *xdrCellAnchor) adjustDrawings(dir adjustDirection, nums adjustNums) error {
 	editAs := a.EditAs
 	if a.From == nil || a.To == nil || editAs == "absolute" {
 		return nil
 	}
-	ok, err := a.From.adjustDrawings(dir, num, offset, editAs)
+	ok, err := a.From.adjustDrawings(dir, nums, editAs)
 	if err != nil {
 		return err
 	}
-	return a.To.adjustDrawings(dir, num, offset, editAs, ok || editAs == "")
+	return a.To.adjustDrawings(dir, nums, editAs, ok || editAs == "")
 }
 
 // adjustDrawings updates the existing two cell anchor pictures and charts
 // object when inserting or deleting rows or columns.
-func (a *xlsxCellAnchorPos) adjustDrawings(dir adjustDirection, num, offset int, editAs string) error {
+func (a *xlsxCellAnchorPos) adjustDrawings(dir adjustDirection, nums adjustNums, editAs string) error {
 	if a.From == nil || a.To == nil || editAs == "absolute" {
 		return nil
 	}
-	ok, err := a.From.adjustDrawings(dir, num, offset, editAs)
+	ok, err := a.From.adjustDrawings(dir, nums, editAs)
 	if err != nil {
 		return err
 	}
-	return a.To.adjustDrawings(dir, num, offset, editAs, ok || editAs == "")
+	return a.To.adjustDrawings(dir, nums, editAs, ok || editAs == "")
 }
 
 // adjustDrawings updates the pictures and charts object when inserting or
 // deleting rows or columns.
-func (f *File) adjustDrawings(ws *xlsxWorksheet, sheet string, dir adjustDirection, num, offset, sheetID int) error {
+func (f *File) adjustDrawings(ws *xlsxWorksheet, sheet string, dir adjustDirection, nums adjustNums, sheetID int) error {
 	if ws.Drawing == nil {
 		return nil
 	}
@@ -1094,7 +1189,7 @@ func (f *File) adjustDrawings(ws *xlsxWorksheet, sheet string, dir adjustDirecti
 	}
 	anchorCb := func(a *xdrCellAnchor) error {
 		if a.GraphicFrame == "" {
-			return a.adjustDrawings(dir, num, offset)
+			return a.adjustDrawings(dir, nums)
 		}
 		deCellAnchor := decodeCellAnchor{}
 		deCellAnchorPos := decodeCellAnchorPos{}
@@ -1116,7 +1211,7 @@ func (f *File) adjustDrawings(ws *xlsxWorksheet, sheet string, dir adjustDirecti
 				Row: deCellAnchor.To.Row, RowOff: deCellAnchor.To.RowOff,
 			}
 		}
-		if err = xlsxCellAnchorPos.adjustDrawings(dir, num, offset, a.EditAs); err != nil {
+		if err = xlsxCellAnchorPos.adjustDrawings(dir, nums, a.EditAs); err != nil {
 			return err
 		}
 		cellAnchor, _ := xml.Marshal(xlsxCellAnchorPos)
@@ -1133,7 +1228,7 @@ func (f *File) adjustDrawings(ws *xlsxWorksheet, sheet string, dir adjustDirecti
 
 // adjustDefinedNames updates the cell reference of the defined names when
 // inserting or deleting rows or columns.
-func (f *File) adjustDefinedNames(ws *xlsxWorksheet, sheet string, dir adjustDirection, num, offset, sheetID int) error {
+func (f *File) adjustDefinedNames(ws *xlsxWorksheet, sheet string, dir adjustDirection, a adjustNums, sheetID int) error {
 	wb, err := f.workbookReader()
 	if err != nil {
 		return err
@@ -1141,7 +1236,7 @@ func (f *File) adjustDefinedNames(ws *xlsxWorksheet, sheet string, dir adjustDir
 	if wb.DefinedNames != nil {
 		for i := 0; i < len(wb.DefinedNames.DefinedName); i++ {
 			data := wb.DefinedNames.DefinedName[i].Data
-			if data, err = f.adjustFormulaRef(sheet, "", data, true, dir, num, offset); err == nil {
+			if data, err = f.adjustFormulaRef(sheet, "", data, true, dir, a.adjust); err == nil {
 				wb.DefinedNames.DefinedName[i].Data = data
 			}
 		}
@@ -23,7 +23,7 @@ func TestAdjustMergeCells(t *testing.T) {
 				},
 			},
 		},
-	}, "Sheet1", rows, 0, 0, 1), newCellNameToCoordinatesError("A", newInvalidCellNameError("A")))
+	}, "Sheet1", rows, newAdjustNums(0, 0), 1), newCellNameToCoordinatesError("A", newInvalidCellNameError("A")))
 	assert.Equal(t, f.adjustMergeCells(&xlsxWorksheet{
 		MergeCells: &xlsxMergeCells{
 			Cells: []*xlsxMergeCell{
@@ -32,7 +32,7 @@ func TestAdjustMergeCells(t *testing.T) {
 				},
 			},
 		},
-	}, "Sheet1", rows, 0, 0, 1), newCellNameToCoordinatesError("B", newInvalidCellNameError("B")))
+	}, "Sheet1", rows, newAdjustNums(0, 0), 1), newCellNameToCoordinatesError("B", newInvalidCellNameError("B")))
 	assert.NoError(t, f.adjustMergeCells(&xlsxWorksheet{
 		MergeCells: &xlsxMergeCells{
 			Cells: []*xlsxMergeCell{
@@ -41,7 +41,7 @@ func TestAdjustMergeCells(t *testing.T) {
 				},
 			},
 		},
-	}, "Sheet1", rows, 1, -1, 1))
+	}, "Sheet1", rows, newAdjustNums(1, -1), 1))
 	assert.NoError(t, f.adjustMergeCells(&xlsxWorksheet{
 		MergeCells: &xlsxMergeCells{
 			Cells: []*xlsxMergeCell{
@@ -50,7 +50,7 @@ func TestAdjustMergeCells(t *testing.T) {
 				},
 			},
 		},
-	}, "Sheet1", columns, 1, -1, 1))
+	}, "Sheet1", columns, newAdjustNums(1, -1), 1))
 	assert.NoError(t, f.adjustMergeCells(&xlsxWorksheet{
 		MergeCells: &xlsxMergeCells{
 			Cells: []*xlsxMergeCell{
@@ -59,7 +59,7 @@ func TestAdjustMergeCells(t *testing.T) {
 				},
 			},
 		},
-	}, "Sheet1", columns, 1, -1, 1))
+	}, "Sheet1", columns, newAdjustNums(1, -1), 1))
 
 	// Test adjust merge cells
 	var cases []struct {
@@ -138,7 +138,7 @@ func TestAdjustMergeCells(t *testing.T) {
 		},
 	}
 	for _, c := range cases {
-		assert.NoError(t, f.adjustMergeCells(c.ws, "Sheet1", c.dir, c.num, 1, 1))
+		assert.NoError(t, f.adjustMergeCells(c.ws, "Sheet1", c.dir, newAdjustNums(c.num, 1), 1))
 		assert.Equal(t, c.expect, c.ws.MergeCells.Cells[0].Ref, c.label)
 		assert.Equal(t, c.expectRect, c.ws.MergeCells.Cells[0].rect, c.label)
 	}
@@ -227,7 +227,7 @@ func TestAdjustMergeCells(t *testing.T) {
 		},
 	}
 	for _, c := range cases {
-		assert.NoError(t, f.adjustMergeCells(c.ws, "Sheet1", c.dir, c.num, -1, 1))
+		assert.NoError(t, f.adjustMergeCells(c.ws, "Sheet1", c.dir, newAdjustNums(c.num, -1), 1))
 		assert.Equal(t, c.expect, c.ws.MergeCells.Cells[0].Ref, c.label)
 	}
 
@@ -275,12 +275,12 @@ func TestAdjustMergeCells(t *testing.T) {
 		},
 	}
 	for _, c := range cases {
-		assert.NoError(t, f.adjustMergeCells(c.ws, "Sheet1", c.dir, c.num, -1, 1))
+		assert.NoError(t, f.adjustMergeCells(c.ws, "Sheet1", c.dir, newAdjustNums(c.num, -1), 1))
 		assert.Len(t, c.ws.MergeCells.Cells, 0, c.label)
 	}
 
 	f = NewFile()
-	p1, p2 := f.adjustMergeCellsHelper(2, 1, 0, 0)
+	p1, p2, _ := newAdjustNums(0, 0).adjustRange(2, 1)
 	assert.Equal(t, 1, p1)
 	assert.Equal(t, 2, p2)
 	f.deleteMergeCell(nil, -1)
@@ -295,18 +295,18 @@ func TestAdjustAutoFilter(t *testing.T) {
 		AutoFilter: &xlsxAutoFilter{
 			Ref: "A1:A3",
 		},
-	}, "Sheet1", rows, 1, -1, 1))
+	}, "Sheet1", rows, newAdjustNums(1, -1), 1))
 	// Test adjustAutoFilter with illegal cell reference
 	assert.Equal(t, f.adjustAutoFilter(&xlsxWorksheet{
 		AutoFilter: &xlsxAutoFilter{
 			Ref: "A:B1",
 		},
-	}, "Sheet1", rows, 0, 0, 1), newCellNameToCoordinatesError("A", newInvalidCellNameError("A")))
+	}, "Sheet1", rows, newAdjustNums(0, 0), 1), newCellNameToCoordinatesError("A", newInvalidCellNameError("A")))
 	assert.Equal(t, f.adjustAutoFilter(&xlsxWorksheet{
 		AutoFilter: &xlsxAutoFilter{
 			Ref: "A1:B",
 		},
-	}, "Sheet1", rows, 0, 0, 1), newCellNameToCoordinatesError("B", newInvalidCellNameError("B")))
+	}, "Sheet1", rows, newAdjustNums(0, 0), 1), newCellNameToCoordinatesError("B", newInvalidCellNameError("B")))
 }
 
 func TestAdjustTable(t *testing.T) {
@@ -493,13 +493,13 @@ func TestAdjustRowDimensions(t *testing.T) {
 	ws, err := f.workSheetReader("Sheet1")
 	assert.NoError(t, err)
 	assert.NoError(t, f.SetCellFormula("Sheet1", "C3", "A1+B1"))
-	assert.Equal(t, ErrMaxRows, f.adjustRowDimensions("Sheet1", ws, 1, TotalRows))
+	assert.Equal(t, ErrMaxRows, f.adjustRowDimensions("Sheet1", ws, newAdjustNums(1, TotalRows)))
 
 	_, err = f.NewSheet("Sheet2")
 	assert.NoError(t, err)
 	f.Sheet.Delete("xl/worksheets/sheet2.xml")
 	f.Pkg.Store("xl/worksheets/sheet2.xml", MacintoshCyrillicCharset)
-	assert.EqualError(t, f.adjustRowDimensions("Sheet1", ws, 2, 1), "XML syntax error on line 1: invalid UTF-8")
+	assert.EqualError(t, f.adjustRowDimensions("Sheet1", ws, newAdjustNums(2, 1)), "XML syntax error on line 1: invalid UTF-8")
 
 	f = NewFile()
 	_, err = f.NewSheet("Sheet2")
@@ -507,7 +507,7 @@ func TestAdjustRowDimensions(t *testing.T) {
 	ws, err = f.workSheetReader("Sheet1")
 	assert.NoError(t, err)
 	assert.NoError(t, f.SetCellFormula("Sheet1", "B2", fmt.Sprintf("Sheet2!A%d", TotalRows)))
-	assert.Equal(t, ErrMaxRows, f.adjustRowDimensions("Sheet2", ws, 1, TotalRows))
+	assert.Equal(t, ErrMaxRows, f.adjustRowDimensions("Sheet2", ws, newAdjustNums(1, TotalRows)))
 }
 
 func TestAdjustHyperlinks(t *testing.T) {
@@ -515,7 +515,7 @@ func TestAdjustHyperlinks(t *testing.T) {
 	ws, err := f.workSheetReader("Sheet1")
 	assert.NoError(t, err)
 	assert.NoError(t, f.SetCellFormula("Sheet1", "C3", "A1+B1"))
-	f.adjustHyperlinks(ws, "Sheet1", rows, 3, -1)
+	f.adjustHyperlinks(ws, "Sheet1", rows, newAdjustNums(3, -1))
 
 	// Test adjust hyperlinks location with positive offset
 	assert.NoError(t, f.SetCellHyperLink("Sheet1", "F5", "Sheet1!A1", "Location"))
@@ -566,13 +566,13 @@ func TestAdjustFormula(t *testing.T) {
 	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestAdjustFormula.xlsx")))
 	assert.NoError(t, f.Close())
 
-	assert.NoError(t, f.adjustFormula("Sheet1", "Sheet1", &xlsxC{}, rows, 0, 0, false))
-	assert.Equal(t, newCellNameToCoordinatesError("-", newInvalidCellNameError("-")), f.adjustFormula("Sheet1", "Sheet1", &xlsxC{F: &xlsxF{Ref: "-"}}, rows, 0, 0, false))
-	assert.Equal(t, ErrColumnNumber, f.adjustFormula("Sheet1", "Sheet1", &xlsxC{F: &xlsxF{Ref: "XFD1:XFD1"}}, columns, 0, 1, false))
+	assert.NoError(t, f.adjustFormula("Sheet1", "Sheet1", &xlsxC{}, rows, newAdjustNums(0, 0), false))
+	assert.Equal(t, newCellNameToCoordinatesError("-", newInvalidCellNameError("-")), f.adjustFormula("Sheet1", "Sheet1", &xlsxC{F: &xlsxF{Ref: "-"}}, rows, newAdjustNums(0, 0), false))
+	assert.Equal(t, ErrColumnNumber, f.adjustFormula("Sheet1", "Sheet1", &xlsxC{F: &xlsxF{Ref: "XFD1:XFD1"}}, columns, newAdjustNums(0, 1), false))
 
-	_, err := f.adjustFormulaRef("Sheet1", "Sheet1", "XFE1", false, columns, 0, 1)
+	_, err := f.adjustFormulaRef("Sheet1", "Sheet1", "XFE1", false, columns, newAdjustNumFunc(0, 1))
 	assert.Equal(t, ErrColumnNumber, err)
-	_, err = f.adjustFormulaRef("Sheet1", "Sheet1", "XFD1", false, columns, 0, 1)
+	_, err = f.adjustFormulaRef("Sheet1", "Sheet1", "XFD1", false, columns, newAdjustNumFunc(0, 1))
 	assert.Equal(t, ErrColumnNumber, err)
 
 	f = NewFile()
@@ -991,6 +991,27 @@ func TestAdjustVolatileDeps(t *testing.T) {
 	f.volatileDepsWriter()
 }
 
+func TestAdjustIgnoredErrors(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetIgnoredErrors("Sheet1", "B2:C3", IgnoredErrorOptions{EvalError: true}))
+	assert.NoError(t, f.InsertCols("Sheet1", "A", 1))
+	opts, err := f.GetIgnoredErrors("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, []IgnoredErrorOptions{{SQRef: "C2:D3", EvalError: true}}, opts)
+	assert.NoError(t, f.RemoveCol("Sheet1", "C"))
+	assert.NoError(t, f.RemoveCol("Sheet1", "C"))
+	opts, err = f.GetIgnoredErrors("Sheet1")
+	assert.NoError(t, err)
+	assert.Empty(t, opts)
+	// Test adjust ignored errors with invalid range reference
+	f = NewFile()
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	ws.(*xlsxWorksheet).IgnoredErrors = &xlsxIgnoredErrors{IgnoredError: []xlsxIgnoredError{{Sqref: "A", EvalError: true}}}
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), f.RemoveRow("Sheet1", 1))
+	assert.NoError(t, f.Close())
+}
+
 func TestAdjustConditionalFormats(t *testing.T) {
 	f := NewFile()
 	assert.NoError(t, f.SetSheetRow("Sheet1", "B1", &[]interface{}{1, nil, 1, 1}))
@@ -1011,7 +1032,9 @@ func TestAdjustConditionalFormats(t *testing.T) {
 	opts, err := f.GetConditionalFormats("Sheet1")
 	assert.NoError(t, err)
 	assert.Len(t, format, 1)
-	assert.Equal(t, format, opts["C1:D1"])
+	expected := format[0]
+	expected.Priority = 2
+	assert.Equal(t, []ConditionalFormatOptions{expected}, opts["C1:D1"])
 
 	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
 	assert.True(t, ok)
@@ -1020,6 +1043,28 @@ func TestAdjustConditionalFormats(t *testing.T) {
 
 	ws.(*xlsxWorksheet).ConditionalFormatting[0] = nil
 	assert.NoError(t, f.RemoveCol("Sheet1", "B"))
+
+	// Test adjust conditional formats with entire columns or rows range reference
+	f = NewFile()
+	for _, ref := range []string{"B:D", "C:C", "2:3"} {
+		assert.NoError(t, f.SetConditionalFormat("Sheet1", ref, format))
+	}
+	assert.NoError(t, f.InsertCols("Sheet1", "B", 2))
+	assert.NoError(t, f.InsertRows("Sheet1", 1, 1))
+	ws, ok = f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	var refs []string
+	for _, cf := range ws.(*xlsxWorksheet).ConditionalFormatting {
+		refs = append(refs, cf.SQRef)
+	}
+	assert.Equal(t, []string{"D:F", "E:E", "3:4"}, refs)
+	assert.NoError(t, f.RemoveCol("Sheet1", "E"))
+	assert.NoError(t, f.RemoveRow("Sheet1", 3))
+	refs = nil
+	for _, cf := range ws.(*xlsxWorksheet).ConditionalFormatting {
+		refs = append(refs, cf.SQRef)
+	}
+	assert.Equal(t, []string{"D:E", "3:3"}, refs)
 }
 
 func TestAdjustDataValidations(t *testing.T) {
@@ -1099,7 +1144,7 @@ func TestAdjustDataValidations(t *testing.T) {
 
 	f.Sheet.Delete("xl/worksheets/sheet1.xml")
 	f.Pkg.Store("xl/worksheets/sheet1.xml", MacintoshCyrillicCharset)
-	assert.EqualError(t, f.adjustDataValidations(nil, "Sheet1", columns, 0, 0, 1), "XML syntax error on line 1: invalid UTF-8")
+	assert.EqualError(t, f.adjustDataValidations(nil, "Sheet1", columns, newAdjustNums(0, 0), 1), "XML syntax error on line 1: invalid UTF-8")
 
 	t.Run("for_escaped_data_validation_rules_formula", func(t *testing.T) {
 		f := NewFile()
@@ -1189,9 +1234,9 @@ func TestAdjustDrawings(t *testing.T) {
 	}
 
 	a := xdrCellAnchor{}
-	assert.NoError(t, a.adjustDrawings(columns, 0, 0))
+	assert.NoError(t, a.adjustDrawings(columns, newAdjustNums(0, 0)))
 	p := xlsxCellAnchorPos{}
-	assert.NoError(t, p.adjustDrawings(columns, 0, 0, ""))
+	assert.NoError(t, p.adjustDrawings(columns, newAdjustNums(0, 0), ""))
 
 	f, err = OpenFile(wb)
 	assert.NoError(t, err)
@@ -1260,5 +1305,119 @@ func TestAdjustDefinedNames(t *testing.T) {
 	f = NewFile()
 	f.WorkBook = nil
 	f.Pkg.Store(defaultXMLPathWorkbook, MacintoshCyrillicCharset)
-	assert.EqualError(t, f.adjustDefinedNames(nil, "Sheet1", columns, 0, 0, 1), "XML syntax error on line 1: invalid UTF-8")
+	assert.EqualError(t, f.adjustDefinedNames(nil, "Sheet1", columns, newAdjustNums(0, 0), 1), "XML syntax error on line 1: invalid UTF-8")
+}
+
+func TestAdjustDeletedNums(t *testing.T) {
+	a := newDeletedNums([]int{2, 3, 5})
+	assert.True(t, a.isDeleted(3))
+	assert.False(t, a.isDeleted(4))
+	for row, expected := range map[int]int{1: 1, 2: 1, 3: 1, 4: 2, 5: 2, 6: 3} {
+		assert.Equal(t, expected, a.adjust(row))
+	}
+	f := NewFile()
+	for _, c := range []struct {
+		ref, expected string
+	}{
+		{"A1:B6", "A1:B3"},
+		{"A2:B3", ""},
+		{"A3:B4", "A2:B2"},
+		{"A4 C2 D6:D4", "A2:A2 D2:D3"},
+		{"2:3 4:6 A:B", "2:3 A:B"},
+	} {
+		ref, err := f.adjustCellRef(c.ref, rows, a)
+		assert.NoError(t, err)
+		assert.Equal(t, c.expected, ref, c.ref)
+	}
+	_, err := f.adjustCellRef("A", rows, a)
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), err)
+	assert.NoError(t, f.Close())
+
+	f = NewFile()
+	// Test remove rows with conditional formats, auto filter, calculation
+	// chain and volatile dependencies
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	ws.(*xlsxWorksheet).ConditionalFormatting = []*xlsxConditionalFormatting{
+		nil, {SQRef: "A2:B3"}, {SQRef: "A4:B6 D1"},
+	}
+	ws.(*xlsxWorksheet).AutoFilter = &xlsxAutoFilter{Ref: "A1:B6"}
+	f.CalcChain = &xlsxCalcChain{C: []xlsxCalcChainC{{R: "A2", I: 1}, {R: "A4"}, {R: "B2", I: 2}, {R: "A6", I: 1}}}
+	f.Pkg.Store(defaultXMLPathVolatileDeps, []byte(fmt.Sprintf(`<volTypes xmlns="%s"><volType><main><tp><tr r="C2" s="2"/><tr r="C2" s="1"/><tr r="D6" s="1"/></tp></main></volType></volTypes>`, NameSpaceSpreadSheet.Value)))
+	assert.NoError(t, f.RemoveRows("Sheet1", []int{5, 3, 2, 3}))
+	assert.Len(t, ws.(*xlsxWorksheet).ConditionalFormatting, 2)
+	assert.Equal(t, "A2:B3 D1:D1", ws.(*xlsxWorksheet).ConditionalFormatting[1].SQRef)
+	assert.Equal(t, "A1:B3", ws.(*xlsxWorksheet).AutoFilter.Ref)
+	assert.Equal(t, []xlsxCalcChainC{{R: "A2", I: 1}, {R: "B2", I: 2}, {R: "A3", I: 1}}, f.CalcChain.C)
+	assert.Equal(t, []xlsxVolTopicRef{{R: "C2", S: 2}, {R: "D3", S: 1}}, f.VolatileDeps.VolType[0].Main[0].Tp[0].Tr)
+	// Test remove rows with the header row of the auto filter
+	ws.(*xlsxWorksheet).SheetData.Row = []xlsxRow{{R: 1}, {R: 2, Hidden: true}, {R: 3, Hidden: true}}
+	assert.NoError(t, f.RemoveRows("Sheet1", []int{1}))
+	assert.Nil(t, ws.(*xlsxWorksheet).AutoFilter)
+	assert.False(t, ws.(*xlsxWorksheet).SheetData.Row[0].Hidden)
+	assert.False(t, ws.(*xlsxWorksheet).SheetData.Row[1].Hidden)
+	assert.Equal(t, []xlsxCalcChainC{{R: "A1", I: 1}, {R: "B2", I: 2}, {R: "A2", I: 1}}, f.CalcChain.C)
+	// Test remove all cells of the calculation chain
+	f.CalcChain = &xlsxCalcChain{C: []xlsxCalcChainC{{R: "A1", I: 1}, {R: "A2"}}}
+	assert.NoError(t, f.RemoveRows("Sheet1", []int{1, 2}))
+	assert.Nil(t, f.CalcChain)
+	// Test remove rows with invalid references
+	for _, ws := range []*xlsxWorksheet{
+		{ConditionalFormatting: []*xlsxConditionalFormatting{{SQRef: "A"}}},
+		{MergeCells: &xlsxMergeCells{Cells: []*xlsxMergeCell{{Ref: "A:B1"}}}},
+		{AutoFilter: &xlsxAutoFilter{Ref: "A:B1"}},
+		{SheetData: xlsxSheetData{Row: []xlsxRow{{R: 2, C: []xlsxC{{R: "A2", F: &xlsxF{Ref: "A", T: STCellFormulaTypeShared}}}}}}},
+	} {
+		f.Sheet.Store("xl/worksheets/sheet1.xml", ws)
+		assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), f.RemoveRows("Sheet1", []int{1}))
+	}
+	f.Sheet.Store("xl/worksheets/sheet1.xml", &xlsxWorksheet{})
+	f.CalcChain = &xlsxCalcChain{C: []xlsxCalcChainC{{R: "A", I: 1}}}
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), f.RemoveRows("Sheet1", []int{1}))
+	f.CalcChain = nil
+	f.VolatileDeps.VolType[0].Main[0].Tp[0].Tr = []xlsxVolTopicRef{{R: "A", S: 1}}
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), f.RemoveRows("Sheet1", []int{1}))
+	f.VolatileDeps = nil
+	f.Pkg.Store(defaultXMLPathVolatileDeps, MacintoshCyrillicCharset)
+	assert.EqualError(t, f.RemoveRows("Sheet1", []int{1}), "XML syntax error on line 1: invalid UTF-8")
+	f.Pkg.Delete(defaultXMLPathVolatileDeps)
+	f.VolatileDeps = nil
+	assert.NoError(t, f.Close())
+
+	// Test remove rows with tables, pictures and data validations
+	f = NewFile()
+	assert.NoError(t, f.AddTable("Sheet1", &Table{Range: "A1:B8", Name: "Table1"}))
+	assert.NoError(t, f.AddTable("Sheet1", &Table{Range: "D3:E5", Name: "Table2"}))
+	assert.NoError(t, f.AddTable("Sheet1", &Table{Range: "G6:H8", Name: "Table3"}))
+	assert.NoError(t, f.AddPicture("Sheet1", "J6", filepath.Join("test", "images", "excel.jpg"), nil))
+	assert.NoError(t, f.AddPicture("Sheet1", "L6", filepath.Join("test", "images", "excel.jpg"), &GraphicOptions{Positioning: "oneCell"}))
+	assert.NoError(t, f.AddPicture("Sheet1", "N6", filepath.Join("test", "images", "excel.jpg"), &GraphicOptions{Positioning: "absolute"}))
+	_, err = f.NewSheet("Sheet2")
+	assert.NoError(t, err)
+	dv := NewDataValidation(true)
+	dv.Sqref = "A1:A2"
+	dv.SetSqrefDropList("Sheet1!$A$4:$A$8")
+	assert.NoError(t, f.AddDataValidation("Sheet2", dv))
+	assert.NoError(t, f.RemoveRows("Sheet1", []int{3, 4, 7}))
+	tables, err := f.GetTables("Sheet1")
+	assert.NoError(t, err)
+	assert.Len(t, tables, 2)
+	assert.Equal(t, "A1:B5", tables[0].Range)
+	assert.Equal(t, "G4:H5", tables[1].Range)
+	cells, err := f.GetPictureCells("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"J4", "L4", "N6"}, cells)
+	dvs, err := f.GetDataValidations("Sheet2")
+	assert.NoError(t, err)
+	assert.Equal(t, "Sheet1!$A$2:$A$5", dvs[0].Formula1)
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestAdjustRemovedRows.xlsx")))
+	// Test remove rows with unsupported charset drawing
+	f.Pkg.Store("xl/drawings/drawing1.xml", MacintoshCyrillicCharset)
+	f.Drawings.Delete("xl/drawings/drawing1.xml")
+	assert.EqualError(t, f.RemoveRows("Sheet1", []int{1}), "XML syntax error on line 1: invalid UTF-8")
+	// Test remove rows with unsupported charset worksheet
+	f.Sheet.Delete("xl/worksheets/sheet2.xml")
+	f.Pkg.Store("xl/worksheets/sheet2.xml", MacintoshCyrillicCharset)
+	assert.EqualError(t, f.adjustDataValidations(nil, "Sheet1", rows, newDeletedNums([]int{1}), 1), "XML syntax error on line 1: invalid UTF-8")
+	assert.NoError(t, f.Close())
 }
@@ -59,6 +59,7 @@ const (
 	criteriaG
 	criteriaErr
 	criteriaRegexp
+	criteriaNotRegexp
 
 	categoryWeightAndMass
 	categoryDistance
@@ -218,6 +219,7 @@ type calcContext struct {
 	mu                sync.Mutex
 	entry             string
 	maxCalcIterations uint
+	date1904          bool
 	iterations        map[string]uint
 	iterationsCache   map[string]formulaArg
 }
@@ -351,6 +353,7 @@ type formulaFuncs struct {
 	f           *File
 	ctx         *calcContext
 	sheet, cell string
+	date1904    bool
 }
 
 // CalcCellValue provides a function to get calculated cell value. This feature
@@ -770,6 +773,7 @@ type formulaFuncs struct {
 //	TEXTAFTER
 //	TEXTBEFORE
 //	TEXTJOIN
+//	TEXTSPLIT
 //	TIME
 //	TIMEVALUE
 //	TINV
@@ -818,10 +822,15 @@ func (f *File) CalcCellValue(sheet, cell string, opts ...Options) (result string
 		rawCellValue = options.RawCellValue
 		styleIdx     int
 		token        formulaArg
+		date1904     bool
 	)
+	if date1904, err = f.isDate1904(); err != nil {
+		return
+	}
 	if token, err = f.calcCellValue(&calcContext{
 		entry:             fmt.Sprintf("%s!%s", sheet, cell),
 		maxCalcIterations: options.MaxCalcIterations,
+		date1904:          date1904,
 		iterations:        make(map[string]uint),
 		iterationsCache:   make(map[string]formulaArg),
 	}, sheet, cell); err != nil {
@@ -862,6 +871,269 @@ func (f *File) calcCellValue(ctx *calcContext, sheet, cell string) (result formu
 	return
 }
 
+// CalcSheet provides a function to calculate all formula cells in the
+// worksheet by given worksheet name, and set the calculated results as the
+// cached values of the formula cells. The formula cells will be calculated
+// once in the order of their dependencies, so the cost of the recalculation
+// for the whole worksheet is much lower than calling CalcCellValue for each
+// formula cell. If there are circular references in the worksheet, the other
+// formula cells will still be calculated, and an error includes the cells in
+// the circular references will be returned. For example, recalculate all
+// formula cells in the worksheet named Sheet1:
+//
+//	err := f.CalcSheet("Sheet1")
+func (f *File) CalcSheet(sheet string, opts ...Options) error {
+	cells, err := f.getFormulaCells(sheet)
+	if err != nil {
+		return err
+	}
+	date1904, err := f.isDate1904()
+	if err != nil {
+		return err
+	}
+	ctx := &calcContext{
+		maxCalcIterations: f.getOptions(opts...).MaxCalcIterations,
+		date1904:          date1904,
+		iterations:        make(map[string]uint),
+		iterationsCache:   make(map[string]formulaArg),
+	}
+	order, circular := f.sortFormulaCells(sheet, cells)
+	results := make(map[string]formulaArg, len(cells))
+	for _, cell := range order {
+		ref := fmt.Sprintf("%s!%s", sheet, cell)
+		ctx.entry = ref
+		result, err := f.calcCellValue(ctx, sheet, cell)
+		if err != nil && result.Type != ArgError {
+			result = newErrorFormulaArg(formulaErrorVALUE, err.Error())
+			if strings.HasPrefix(err.Error(), "#") {
+				result.String = err.Error()
+			}
+		}
+		ctx.mu.Lock()
+		ctx.iterations[ref] = f.options.MaxCalcIterations + 1
+		ctx.iterationsCache[ref] = result
+		ctx.mu.Unlock()
+		results[cell] = result
+	}
+	if err = f.setFormulaCellsResult(sheet, results); err != nil {
+		return err
+	}
+	if len(circular) > 0 {
+		return newCircularReferenceError(sheet, circular)
+	}
+	return nil
+}
+
+// getFormulaCells returns the references of all formula cells in the
+// worksheet by given worksheet name, in the order of rows and columns.
+func (f *File) getFormulaCells(sheet string) ([]string, error) {
+	f.mu.Lock()
+	ws, err := f.workSheetReader(sheet)
+	f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if !f.formulaChecked {
+		if err = f.setArrayFormulaCells(); err != nil {
+			return nil, err
+		}
+		f.formulaChecked = true
+	}
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	var cells []string
+	for _, row := range ws.SheetData.Row {
+		for _, c := range row.C {
+			if c.F != nil || c.f != "" {
+				cells = append(cells, c.R)
+			}
+		}
+	}
+	return cells, nil
+}
+
+// formulaDependencies returns the referenced cell ranges in the given
+// worksheet of the formula by given worksheet name and formula cell
+// reference.
+func (f *File) formulaDependencies(sheet, cell string) []cellRange {
+	var ranges []cellRange
+	formula, err := f.getCellFormula(sheet, cell, true)
+	if err != nil {
+		return ranges
+	}
+	ps := efp.ExcelParser()
+	for _, token := range ps.Parse(formula) {
+		if token.TType != efp.TokenTypeOperand || token.TSubType != efp.TokenSubTypeRange {
+			continue
+		}
+		if refTo := f.getDefinedNameRefTo(token.TValue, sheet); refTo != "" {
+			token.TValue = refTo
+		}
+		var cr cellRange
+		for i, ref := range strings.Split(strings.ReplaceAll(token.TValue, "$", ""), ":") {
+			cellRef, col, row, err := parseRef(ref)
+			if err != nil {
+				cr.From.Sheet = ""
+				break
+			}
+			if cellRef.Sheet = strings.Trim(cellRef.Sheet, "'"); cellRef.Sheet == "" {
+				cellRef.Sheet = sheet
+			}
+			if i == 0 {
+				from := cellRef
+				if col {
+					cellRef.Row, from.Row = TotalRows, 1
+				}
+				if row {
+					cellRef.Col, from.Col = MaxColumns, 1
+				}
+				cr.From, cr.To = from, cellRef
+				continue
+			}
+			if err = cr.prepareCellRange(col, row, cellRef); err != nil {
+				cr.From.Sheet = ""
+				break
+			}
+		}
+		if strings.EqualFold(cr.From.Sheet, sheet) {
+			ranges = append(ranges, cr)
+		}
+	}
+	return ranges
+}
+
+// sortFormulaCells sort the formula cells in the order of their dependencies
+// by given worksheet name and formula cells, the formula cells which depend on
+// the circular references will be placed at the end. The formula cells in the
+// circular references will also be returned separately.
+func (f *File) sortFormulaCells(sheet string, cells []string) ([]string, []string) {
+	var (
+		order, circular []string
+		coordinates     = make(map[[2]int]int, len(cells))
+		dependents      = make([][]int, len(cells))
+		precedents      = make([]int, len(cells))
+	)
+	for i, cell := range cells {
+		col, row, _ := CellNameToCoordinates(cell)
+		coordinates[[2]int{col, row}] = i
+	}
+	for i, cell := range cells {
+		deps := map[int]struct{}{}
+		for _, cr := range f.formulaDependencies(sheet, cell) {
+			if (cr.To.Col-cr.From.Col+1)*(cr.To.Row-cr.From.Row+1) > len(cells) {
+				for coordinate, j := range coordinates {
+					if coordinate[0] >= cr.From.Col && coordinate[0] <= cr.To.Col &&
+						coordinate[1] >= cr.From.Row && coordinate[1] <= cr.To.Row {
+						deps[j] = struct{}{}
+					}
+				}
+				continue
+			}
+			for col := cr.From.Col; col <= cr.To.Col; col++ {
+				for row := cr.From.Row; row <= cr.To.Row; row++ {
+					if j, ok := coordinates[[2]int{col, row}]; ok {
+						deps[j] = struct{}{}
+					}
+				}
+			}
+		}
+		for j := range deps {
+			dependents[j] = append(dependents[j], i)
+			precedents[i]++
+		}
+	}
+	var queue []int
+	for i := range cells {
+		if precedents[i] == 0 {
+			queue = append(queue, i)
+		}
+	}
+	for len(queue) > 0 {
+		i := queue[0]
+		queue = queue[1:]
+		order = append(order, cells[i])
+		for _, j := range dependents[i] {
+			if precedents[j]--; precedents[j] == 0 {
+				queue = append(queue, j)
+			}
+		}
+	}
+	remaining := map[int]struct{}{}
+	for i, cell := range cells {
+		if precedents[i] > 0 {
+			order = append(order, cell)
+			remaining[i] = struct{}{}
+		}
+	}
+	// exclude the formula cells which only depend on the circular references
+	for trimmed := true; trimmed; {
+		trimmed = false
+		for i := range remaining {
+			var referenced bool
+			for _, j := range dependents[i] {
+				if _, referenced = remaining[j]; referenced {
+					break
+				}
+			}
+			if !referenced {
+				delete(remaining, i)
+				trimmed = true
+			}
+		}
+	}
+	for i, cell := range cells {
+		if _, ok := remaining[i]; ok {
+			circular = append(circular, cell)
+		}
+	}
+	return order, circular
+}
+
+// setFormulaCellsResult set the calculated results as the cached values of
+// the formula cells by given worksheet name and results.
+func (f *File) setFormulaCellsResult(sheet string, results map[string]formulaArg) error {
+	f.mu.Lock()
+	ws, err := f.workSheetReader(sheet)
+	f.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	for rowIdx := range ws.SheetData.Row {
+		for colIdx := range ws.SheetData.Row[rowIdx].C {
+			c := &ws.SheetData.Row[rowIdx].C[colIdx]
+			result, ok := results[c.R]
+			if !ok {
+				continue
+			}
+			if result.Type == ArgMatrix {
+				if args := result.ToList(); len(args) > 0 {
+					result = args[0]
+				}
+			}
+			c.IS = nil
+			switch result.Type {
+			case ArgNumber:
+				if result.Boolean {
+					c.T, c.V = setCellBool(result.Number == 1)
+					break
+				}
+				c.T, c.V = f.formatCellFloat(result.Number, -1, 64)
+			case ArgString:
+				if err = f.setCellFormulaResult(c, result.String); err != nil {
+					return err
+				}
+			case ArgError:
+				c.T, c.V = "e", result.String
+			default:
+				c.T, c.V = "", ""
+			}
+		}
+	}
+	return nil
+}
+
 // getPriority calculate arithmetic operator priority.
 func getPriority(token efp.Token) (pri int) {
 	pri = tokenPriority[token.TValue]
@@ -1074,7 +1346,7 @@ func (f *File) evalInfixExpFunc(ctx *calcContext, sheet, cell string, token, nex
 	}
 	prepareEvalInfixExp(opfStack, opftStack, opfdStack, argsStack)
 	// call formula function to evaluate
-	arg := callFuncByName(&formulaFuncs{f: f, sheet: sheet, cell: cell, ctx: ctx}, strings.NewReplacer(
+	arg := callFuncByName(&formulaFuncs{f: f, sheet: sheet, cell: cell, ctx: ctx, date1904: ctx.date1904}, strings.NewReplacer(
 		"_xlfn.", "", ".", "dot").Replace(opfStack.Peek().(efp.Token).TValue),
 		[]reflect.Value{reflect.ValueOf(argsStack.Peek().(*list.List))})
 	if arg.Type == ArgError && opfStack.Len() == 1 {
@@ -1759,6 +2031,7 @@ func formulaCriteriaParser(exp formulaArg) *formulaCriteria {
 	}
 	fc, val := &formulaCriteria{}, exp.Value()
 	if val == "" {
+		fc.Type, fc.Condition = criteriaEq, newStringFormulaArg(val)
 		return fc
 	}
 	for i, re := range formulaFormats {
@@ -1768,22 +2041,59 @@ func formulaCriteriaParser(exp formulaArg) *formulaCriteria {
 				fc.Condition = newNumberFormulaArg(num)
 			}
 			fc.Type = formulaCriterias[i]
+			if fc.Condition.Type == ArgString && (fc.Type == criteriaEq || fc.Type == criteriaNe) {
+				if pattern, ok := wildcardToRegexp(match[1]); ok {
+					fc.Type, fc.Condition = criteriaRegexp, newStringFormulaArg(pattern)
+					if formulaCriterias[i] == criteriaNe {
+						fc.Type = criteriaNotRegexp
+					}
+				}
+			}
 			return fc
 		}
 	}
-	if strings.Contains(val, "?") {
-		val = strings.ReplaceAll(val, "?", ".")
-	}
-	if strings.Contains(val, "*") {
-		val = strings.ReplaceAll(val, "*", ".*")
+	if pattern, ok := wildcardToRegexp(val); ok {
+		fc.Type, fc.Condition = criteriaRegexp, newStringFormulaArg(pattern)
+		return fc
 	}
-	fc.Type, fc.Condition = criteriaRegexp, newStringFormulaArg(val)
-	if num := fc.Condition.ToNumber(); num.Type == ArgNumber {
-		fc.Condition = num
+	fc.Type, fc.Condition = criteriaEq, newStringFormulaArg(val)
+	if num, err := prepareValue(val); err == nil {
+		fc.Condition = newNumberFormulaArg(num)
 	}
 	return fc
 }
 
+// wildcardToRegexp converts the criteria with the wildcard characters question
+// mark (?) and asterisk (*) to a case-insensitive regular expression which
+// matches the entire value. A tilde (~) followed by the wildcard characters or
+// tilde will be treated as literal character. The second returned value
+// indicates whether the criteria contains wildcard or escape characters.
+func wildcardToRegexp(criteria string) (string, bool) {
+	if !strings.ContainsAny(criteria, "*?~") {
+		return criteria, false
+	}
+	var pattern strings.Builder
+	pattern.WriteString("(?i)^")
+	runes := []rune(criteria)
+	for i := 0; i < len(runes); i++ {
+		switch r := runes[i]; r {
+		case '~':
+			if i+1 < len(runes) && strings.ContainsRune("*?~", runes[i+1]) {
+				i++
+			}
+			pattern.WriteString(regexp.QuoteMeta(string(runes[i])))
+		case '*':
+			pattern.WriteString(".*")
+		case '?':
+			pattern.WriteString(".")
+		default:
+			pattern.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	pattern.WriteString("$")
+	return pattern.String(), true
+}
+
 // formulaCriteriaEval evaluate formula criteria expression.
 func formulaCriteriaEval(val formulaArg, criteria *formulaCriteria) (result bool, err error) {
 	s := NewStack()
@@ -1796,14 +2106,22 @@ func formulaCriteriaEval(val formulaArg, criteria *formulaCriteria) (result bool
 		criteriaGe: calcGe,
 	}
 	switch criteria.Type {
-	case criteriaEq, criteriaLe, criteriaGe, criteriaNe, criteriaL, criteriaG:
+	case criteriaEq, criteriaNe:
+		if criteria.Condition.Type == ArgString {
+			return strings.EqualFold(criteria.Condition.Value(), val.Value()) == (criteria.Type == criteriaEq), err
+		}
+		fallthrough
+	case criteriaLe, criteriaGe, criteriaL, criteriaG:
 		if fn, ok := tokenCalcFunc[criteria.Type]; ok {
 			if _ = fn(criteria.Condition, val, s); s.Len() > 0 {
 				return s.Pop().(formulaArg).Number == 1, err
 			}
 		}
-	case criteriaRegexp:
-		return regexp.MatchString(criteria.Condition.Value(), val.Value())
+	case criteriaRegexp, criteriaNotRegexp:
+		if result, err = regexp.MatchString(criteria.Condition.Value(), val.Value()); err != nil {
+			return
+		}
+		return result == (criteria.Type == criteriaRegexp), err
 	}
 	return
 }
@@ -12081,12 +12399,12 @@ func (fn *formulaFuncs) DATE(argsList *list.List) formulaArg {
 		return newErrorFormulaArg(formulaErrorVALUE, "DATE requires 3 number arguments")
 	}
 	d := makeDate(int(year.Number), time.Month(month.Number), int(day.Number))
-	return newNumberFormulaArg(daysBetween(excelMinTime1900.Unix(), d) + 1)
+	return newNumberFormulaArg(excelDateSerial(d, fn.date1904))
 }
 
 // calcDateDif is an implementation of the formula function DATEDIF,
 // calculation difference between two dates.
-func calcDateDif(unit string, diff float64, seq []int, startArg, endArg formulaArg) float64 {
+func calcDateDif(unit string, diff float64, seq []int, startArg, endArg formulaArg, date1904 bool) float64 {
 	ey, sy, em, sm, ed, sd := seq[0], seq[1], seq[2], seq[3], seq[4], seq[5]
 	switch unit {
 	case "d":
@@ -12096,7 +12414,7 @@ func calcDateDif(unit string, diff float64, seq []int, startArg, endArg formulaA
 		if ed < sd {
 			smMD--
 		}
-		diff = endArg.Number - daysBetween(excelMinTime1900.Unix(), makeDate(ey, time.Month(smMD), sd)) - 1
+		diff = endArg.Number - excelDateSerial(makeDate(ey, time.Month(smMD), sd), date1904)
 	case "ym":
 		diff = float64(em - sm)
 		if ed < sd {
@@ -12136,7 +12454,7 @@ func (fn *formulaFuncs) DATEDIF(argsList *list.List) formulaArg {
 		return newNumberFormulaArg(0)
 	}
 	unit := strings.ToLower(argsList.Back().Value.(formulaArg).Value())
-	startDate, endDate := timeFromExcelTime(startArg.Number, false), timeFromExcelTime(endArg.Number, false)
+	startDate, endDate := timeFromExcelTime(startArg.Number, fn.date1904), timeFromExcelTime(endArg.Number, fn.date1904)
 	sy, smm, sd := startDate.Date()
 	ey, emm, ed := endDate.Date()
 	sm, em, diff := int(smm), int(emm), 0.0
@@ -12158,7 +12476,7 @@ func (fn *formulaFuncs) DATEDIF(argsList *list.List) formulaArg {
 		}
 		diff = float64(yDiff*12 + mDiff)
 	case "d", "md", "ym", "yd":
-		diff = calcDateDif(unit, diff, []int{ey, sy, em, sm, ed, sd}, startArg, endArg)
+		diff = calcDateDif(unit, diff, []int{ey, sy, em, sm, ed, sd}, startArg, endArg, fn.date1904)
 	default:
 		return newErrorFormulaArg(formulaErrorVALUE, "DATEDIF has invalid unit")
 	}
@@ -12415,7 +12733,7 @@ func (fn *formulaFuncs) DATEVALUE(argsList *list.List) formulaArg {
 	if err.Type == ArgError {
 		return err
 	}
-	return newNumberFormulaArg(daysBetween(excelMinTime1900.Unix(), makeDate(y, time.Month(m), d)) + 1)
+	return newNumberFormulaArg(excelDateSerial(makeDate(y, time.Month(m), d), fn.date1904))
 }
 
 // DAY function returns the day of a date, represented by a serial number. The
@@ -12445,10 +12763,10 @@ func (fn *formulaFuncs) DAY(argsList *list.List) formulaArg {
 	if num.Number < 0 {
 		return newErrorFormulaArg(formulaErrorNUM, "DAY only accepts positive argument")
 	}
-	if num.Number <= 60 {
+	if !fn.date1904 && num.Number <= 60 {
 		return newNumberFormulaArg(math.Mod(num.Number, 31.0))
 	}
-	return newNumberFormulaArg(float64(timeFromExcelTime(num.Number, false).Day()))
+	return newNumberFormulaArg(float64(timeFromExcelTime(num.Number, fn.date1904).Day()))
 }
 
 // DAYS function returns the number of days between two supplied dates. The
@@ -12478,15 +12796,15 @@ func (fn *formulaFuncs) DAYS360(argsList *list.List) formulaArg {
 	if argsList.Len() > 3 {
 		return newErrorFormulaArg(formulaErrorVALUE, "DAYS360 requires at most 3 arguments")
 	}
-	startDate := toExcelDateArg(argsList.Front().Value.(formulaArg))
+	startDate := toExcelDateArg(argsList.Front().Value.(formulaArg), fn.date1904)
 	if startDate.Type != ArgNumber {
 		return startDate
 	}
-	endDate := toExcelDateArg(argsList.Front().Next().Value.(formulaArg))
+	endDate := toExcelDateArg(argsList.Front().Next().Value.(formulaArg), fn.date1904)
 	if endDate.Type != ArgNumber {
 		return endDate
 	}
-	start, end := timeFromExcelTime(startDate.Number, false), timeFromExcelTime(endDate.Number, false)
+	start, end := timeFromExcelTime(startDate.Number, fn.date1904), timeFromExcelTime(endDate.Number, fn.date1904)
 	sy, sm, sd, ey, em, ed := start.Year(), int(start.Month()), start.Day(), end.Year(), int(end.Month()), end.Day()
 	method := newBoolFormulaArg(false)
 	if argsList.Len() > 2 {
@@ -12544,110 +12862,128 @@ func (fn *formulaFuncs) ISOWEEKNUM(argsList *list.List) formulaArg {
 		if num.Number < 0 {
 			return newErrorFormulaArg(formulaErrorNUM, formulaErrorNUM)
 		}
-		_, weekNum = timeFromExcelTime(num.Number, false).ISOWeek()
+		_, weekNum = timeFromExcelTime(num.Number, fn.date1904).ISOWeek()
 	}
 	return newNumberFormulaArg(float64(weekNum))
 }
 
-// EDATE function returns a date that is a specified number of months before or
-// after a supplied start date. The syntax of function is:
-//
-//	EDATE(start_date,months)
-func (fn *formulaFuncs) EDATE(argsList *list.List) formulaArg {
-	if argsList.Len() != 2 {
-		return newErrorFormulaArg(formulaErrorVALUE, "EDATE requires 2 arguments")
+// serialToDate returns the year, month and day of the given Excel date serial
+// number. The serial number 0 will be treated as the 0th January 1900, and 60
+// will be treated as the 29th February 1900 to stay compatible with the leap
+// year bug in the 1900 date system.
+func serialToDate(serial float64, date1904 bool) (int, int, int) {
+	days := int(serial)
+	if date1904 {
+		t := excel1904Epoc.AddDate(0, 0, days)
+		return t.Year(), int(t.Month()), t.Day()
 	}
-	date := argsList.Front().Value.(formulaArg)
-	num := date.ToNumber()
-	var dateTime time.Time
-	if num.Type != ArgNumber {
-		dateString := strings.ToLower(date.Value())
-		if !isDateOnlyFmt(dateString) {
-			if _, _, _, _, _, err := strToTime(dateString); err.Type == ArgError {
-				return err
-			}
-		}
-		y, m, d, _, err := strToDate(dateString)
-		if err.Type == ArgError {
-			return err
-		}
-		dateTime = time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.Now().Location())
-	} else {
-		if num.Number < 0 {
-			return newErrorFormulaArg(formulaErrorNUM, formulaErrorNUM)
-		}
-		dateTime = timeFromExcelTime(num.Number, false)
+	if days == 0 {
+		return 1900, 1, 0
 	}
-	month := argsList.Back().Value.(formulaArg).ToNumber()
-	if month.Type != ArgNumber {
-		return month
+	if days == 60 {
+		return 1900, 2, 29
 	}
-	y, d := dateTime.Year(), dateTime.Day()
-	m := int(dateTime.Month()) + int(month.Number)
-	if month.Number < 0 {
-		y -= int(math.Ceil(-1 * float64(m) / 12))
+	if days < 60 {
+		days++
 	}
-	if month.Number > 11 {
-		y += int(math.Floor(float64(m) / 12))
+	t := excel1900Epoc.AddDate(0, 0, days)
+	return t.Year(), int(t.Month()), t.Day()
+}
+
+// dateToSerial returns the Excel date serial number by given year, month and
+// day, the 29th February 1900 which doesn't exist will be treated as a valid
+// date.
+func dateToSerial(y, m, d int, date1904 bool) float64 {
+	if date1904 {
+		return math.Round(time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC).Sub(excel1904Epoc).Hours() / 24)
 	}
-	if m = m % 12; m < 0 {
-		m += 12
+	if y == 1900 && m == 2 && d == 29 {
+		return 60
 	}
-	if d > 28 {
-		if days := getDaysInMonth(y, m); d > days {
-			d = days
-		}
+	serial := math.Round(time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC).Sub(excel1900Epoc).Hours() / 24)
+	if serial < 61 {
+		serial--
 	}
-	result, _ := timeToExcelTime(time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC), false)
-	return newNumberFormulaArg(result)
+	return serial
 }
 
-// EOMONTH function returns the last day of the month, that is a specified
-// number of months before or after an initial supplied start date. The syntax
-// of the function is:
-//
-//	EOMONTH(start_date,months)
-func (fn *formulaFuncs) EOMONTH(argsList *list.List) formulaArg {
+// daysInExcelMonth returns the number of days in the given month of year, the
+// February 1900 has 29 days in the 1900 date system.
+func daysInExcelMonth(y, m int) int {
+	if y == 1900 && m == 2 {
+		return 29
+	}
+	return getDaysInMonth(y, m)
+}
+
+// prepareEdateArgs checks and prepare arguments for the formula functions
+// EDATE and EOMONTH, returns the day of the start date and the total number
+// of months since year 0 of the shifted date.
+func prepareEdateArgs(name string, argsList *list.List, date1904 bool) (int, formulaArg) {
 	if argsList.Len() != 2 {
-		return newErrorFormulaArg(formulaErrorVALUE, "EOMONTH requires 2 arguments")
+		return 0, newErrorFormulaArg(formulaErrorVALUE, fmt.Sprintf("%s requires 2 arguments", name))
 	}
 	date := argsList.Front().Value.(formulaArg)
 	num := date.ToNumber()
-	var dateTime time.Time
+	var y, m, d int
 	if num.Type != ArgNumber {
 		dateString := strings.ToLower(date.Value())
 		if !isDateOnlyFmt(dateString) {
 			if _, _, _, _, _, err := strToTime(dateString); err.Type == ArgError {
-				return err
+				return 0, err
 			}
 		}
-		y, m, d, _, err := strToDate(dateString)
-		if err.Type == ArgError {
-			return err
+		var err formulaArg
+		if y, m, d, _, err = strToDate(dateString); err.Type == ArgError {
+			return 0, err
 		}
-		dateTime = time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.Now().Location())
 	} else {
 		if num.Number < 0 {
-			return newErrorFormulaArg(formulaErrorNUM, formulaErrorNUM)
+			return 0, newErrorFormulaArg(formulaErrorNUM, formulaErrorNUM)
 		}
-		dateTime = timeFromExcelTime(num.Number, false)
+		y, m, d = serialToDate(num.Number, date1904)
 	}
 	months := argsList.Back().Value.(formulaArg).ToNumber()
 	if months.Type != ArgNumber {
-		return months
+		return 0, months
 	}
-	y, m := dateTime.Year(), int(dateTime.Month())+int(months.Number)-1
-	if m < 0 {
-		y -= int(math.Ceil(-1 * float64(m) / 12))
+	return d, newNumberFormulaArg(float64(y*12 + m - 1 + int(months.Number)))
+}
+
+// EDATE function returns a date that is a specified number of months before or
+// after a supplied start date. The syntax of function is:
+//
+//	EDATE(start_date,months)
+func (fn *formulaFuncs) EDATE(argsList *list.List) formulaArg {
+	d, totalMonths := prepareEdateArgs("EDATE", argsList, fn.date1904)
+	if totalMonths.Type != ArgNumber {
+		return totalMonths
 	}
-	if m > 11 {
-		y += int(math.Floor(float64(m) / 12))
+	y, m := int(totalMonths.Number)/12, int(totalMonths.Number)%12+1
+	if y < 1900 || fn.date1904 && y < 1904 {
+		return newErrorFormulaArg(formulaErrorNUM, formulaErrorNUM)
 	}
-	if m = m % 12; m < 0 {
-		m += 12
+	if days := daysInExcelMonth(y, m); d > days {
+		d = days
 	}
-	result, _ := timeToExcelTime(time.Date(y, time.Month(m+1), getDaysInMonth(y, m+1), 0, 0, 0, 0, time.UTC), false)
-	return newNumberFormulaArg(result)
+	return newNumberFormulaArg(dateToSerial(y, m, d, fn.date1904))
+}
+
+// EOMONTH function returns the last day of the month, that is a specified
+// number of months before or after an initial supplied start date. The syntax
+// of the function is:
+//
+//	EOMONTH(start_date,months)
+func (fn *formulaFuncs) EOMONTH(argsList *list.List) formulaArg {
+	_, totalMonths := prepareEdateArgs("EOMONTH", argsList, fn.date1904)
+	if totalMonths.Type != ArgNumber {
+		return totalMonths
+	}
+	y, m := int(totalMonths.Number)/12, int(totalMonths.Number)%12+1
+	if y < 1900 || fn.date1904 && y < 1904 {
+		return newErrorFormulaArg(formulaErrorNUM, formulaErrorNUM)
+	}
+	return newNumberFormulaArg(dateToSerial(y, m, daysInExcelMonth(y, m), fn.date1904))
 }
 
 // HOUR function returns an integer representing the hour component of a
@@ -12680,7 +13016,7 @@ func (fn *formulaFuncs) HOUR(argsList *list.List) formulaArg {
 	if num.Number < 0 {
 		return newErrorFormulaArg(formulaErrorNUM, "HOUR only accepts positive argument")
 	}
-	return newNumberFormulaArg(float64(timeFromExcelTime(num.Number, false).Hour()))
+	return newNumberFormulaArg(float64(timeFromExcelTime(num.Number, fn.date1904).Hour()))
 }
 
 // MINUTE function returns an integer representing the minute component of a
@@ -12710,7 +13046,7 @@ func (fn *formulaFuncs) MINUTE(argsList *list.List) formulaArg {
 	if num.Number < 0 {
 		return newErrorFormulaArg(formulaErrorNUM, "MINUTE only accepts positive argument")
 	}
-	return newNumberFormulaArg(float64(timeFromExcelTime(num.Number, false).Minute()))
+	return newNumberFormulaArg(float64(timeFromExcelTime(num.Number, fn.date1904).Minute()))
 }
 
 // MONTH function returns the month of a date represented by a serial number.
@@ -12740,7 +13076,7 @@ func (fn *formulaFuncs) MONTH(argsList *list.List) formulaArg {
 	if num.Number < 0 {
 		return newErrorFormulaArg(formulaErrorNUM, "MONTH only accepts positive argument")
 	}
-	return newNumberFormulaArg(float64(timeFromExcelTime(num.Number, false).Month()))
+	return newNumberFormulaArg(float64(timeFromExcelTime(num.Number, fn.date1904).Month()))
 }
 
 // genWeekendMask generate weekend mask of a series of seven 0's and 1's which
@@ -12760,8 +13096,8 @@ func genWeekendMask(weekend int) []byte {
 }
 
 // isWorkday check if the date is workday.
-func isWorkday(weekendMask []byte, date float64) bool {
-	dateTime := timeFromExcelTime(date, false)
+func isWorkday(weekendMask []byte, date float64, date1904 bool) bool {
+	dateTime := timeFromExcelTime(date, date1904)
 	weekday := dateTime.Weekday()
 	if weekday == time.Sunday {
 		weekday = 7
@@ -12799,7 +13135,7 @@ func prepareWorkday(weekend formulaArg) ([]byte, int) {
 
 // toExcelDateArg function converts a text representation of a time, into an
 // Excel date time number formula argument.
-func toExcelDateArg(arg formulaArg) formulaArg {
+func toExcelDateArg(arg formulaArg, date1904 bool) formulaArg {
 	num := arg.ToNumber()
 	if num.Type != ArgNumber {
 		dateString := strings.ToLower(arg.Value())
@@ -12812,7 +13148,7 @@ func toExcelDateArg(arg formulaArg) formulaArg {
 		if err.Type == ArgError {
 			return err
 		}
-		num.Number, _ = timeToExcelTime(time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC), false)
+		num.Number, _ = timeToExcelTime(time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC), date1904)
 		return newNumberFormulaArg(num.Number)
 	}
 	if arg.Number < 0 {
@@ -12823,10 +13159,10 @@ func toExcelDateArg(arg formulaArg) formulaArg {
 
 // prepareHolidays function converts array type formula arguments to into an
 // Excel date time number formula arguments list.
-func prepareHolidays(args formulaArg) []int {
+func prepareHolidays(args formulaArg, date1904 bool) []int {
 	var holidays []int
 	for _, arg := range args.ToList() {
-		num := toExcelDateArg(arg)
+		num := toExcelDateArg(arg, date1904)
 		if num.Type != ArgNumber {
 			continue
 		}
@@ -12836,7 +13172,7 @@ func prepareHolidays(args formulaArg) []int {
 }
 
 // workdayIntl is an implementation of the formula function WORKDAY.INTL.
-func workdayIntl(endDate, sign int, holidays []int, weekendMask []byte, startDate float64) int {
+func workdayIntl(endDate, sign int, holidays []int, weekendMask []byte, startDate float64, date1904 bool) int {
 	for i := 0; i < len(holidays); i++ {
 		holiday := holidays[i]
 		if sign > 0 {
@@ -12850,18 +13186,18 @@ func workdayIntl(endDate, sign int, holidays []int, weekendMask []byte, startDat
 		}
 		if sign > 0 {
 			if holiday > int(math.Ceil(startDate)) {
-				if isWorkday(weekendMask, float64(holiday)) {
+				if isWorkday(weekendMask, float64(holiday), date1904) {
 					endDate += sign
-					for !isWorkday(weekendMask, float64(endDate)) {
+					for !isWorkday(weekendMask, float64(endDate), date1904) {
 						endDate += sign
 					}
 				}
 			}
 		} else {
 			if holiday < int(math.Ceil(startDate)) {
-				if isWorkday(weekendMask, float64(holiday)) {
+				if isWorkday(weekendMask, float64(holiday), date1904) {
 					endDate += sign
-					for !isWorkday(weekendMask, float64(endDate)) {
+					for !isWorkday(weekendMask, float64(endDate), date1904) {
 						endDate += sign
 					}
 				}
@@ -12907,11 +13243,11 @@ func (fn *formulaFuncs) NETWORKDAYSdotINTL(argsList *list.List) formulaArg {
 	if argsList.Len() > 4 {
 		return newErrorFormulaArg(formulaErrorVALUE, "NETWORKDAYS.INTL requires at most 4 arguments")
 	}
-	startDate := toExcelDateArg(argsList.Front().Value.(formulaArg))
+	startDate := toExcelDateArg(argsList.Front().Value.(formulaArg), fn.date1904)
 	if startDate.Type != ArgNumber {
 		return startDate
 	}
-	endDate := toExcelDateArg(argsList.Front().Next().Value.(formulaArg))
+	endDate := toExcelDateArg(argsList.Front().Next().Value.(formulaArg), fn.date1904)
 	if endDate.Type != ArgNumber {
 		return endDate
 	}
@@ -12921,7 +13257,7 @@ func (fn *formulaFuncs) NETWORKDAYSdotINTL(argsList *list.List) formulaArg {
 	}
 	var holidays []int
 	if argsList.Len() == 4 {
-		holidays = prepareHolidays(argsList.Back().Value.(formulaArg))
+		holidays = prepareHolidays(argsList.Back().Value.(formulaArg), fn.date1904)
 		sort.Ints(holidays)
 	}
 	weekendMask, workdaysPerWeek := prepareWorkday(weekend)
@@ -12939,14 +13275,14 @@ func (fn *formulaFuncs) NETWORKDAYSdotINTL(argsList *list.List) formulaArg {
 	count := int(math.Floor(offset/7) * float64(workdaysPerWeek))
 	daysMod := int(offset) % 7
 	for daysMod >= 0 {
-		if isWorkday(weekendMask, endDate.Number-float64(daysMod)) {
+		if isWorkday(weekendMask, endDate.Number-float64(daysMod), fn.date1904) {
 			count++
 		}
 		daysMod--
 	}
 	for i := 0; i < len(holidays); i++ {
 		holiday := float64(holidays[i])
-		if isWorkday(weekendMask, holiday) && holiday >= startDate.Number && holiday <= endDate.Number {
+		if isWorkday(weekendMask, holiday, fn.date1904) && holiday >= startDate.Number && holiday <= endDate.Number {
 			count--
 		}
 	}
@@ -12988,7 +13324,7 @@ func (fn *formulaFuncs) WORKDAYdotINTL(argsList *list.List) formulaArg {
 	if argsList.Len() > 4 {
 		return newErrorFormulaArg(formulaErrorVALUE, "WORKDAY.INTL requires at most 4 arguments")
 	}
-	startDate := toExcelDateArg(argsList.Front().Value.(formulaArg))
+	startDate := toExcelDateArg(argsList.Front().Value.(formulaArg), fn.date1904)
 	if startDate.Type != ArgNumber {
 		return startDate
 	}
@@ -13002,7 +13338,7 @@ func (fn *formulaFuncs) WORKDAYdotINTL(argsList *list.List) formulaArg {
 	}
 	var holidays []int
 	if argsList.Len() == 4 {
-		holidays = prepareHolidays(argsList.Back().Value.(formulaArg))
+		holidays = prepareHolidays(argsList.Back().Value.(formulaArg), fn.date1904)
 		sort.Ints(holidays)
 	}
 	if days.Number == 0 {
@@ -13020,13 +13356,13 @@ func (fn *formulaFuncs) WORKDAYdotINTL(argsList *list.List) formulaArg {
 	daysMod := int(days.Number) % workdaysPerWeek
 	endDate := int(math.Ceil(startDate.Number)) + offset*7
 	if daysMod == 0 {
-		for !isWorkday(weekendMask, float64(endDate)) {
+		for !isWorkday(weekendMask, float64(endDate), fn.date1904) {
 			endDate -= sign
 		}
 	} else {
 		for daysMod != 0 {
 			endDate += sign
-			if isWorkday(weekendMask, float64(endDate)) {
+			if isWorkday(weekendMask, float64(endDate), fn.date1904) {
 				if daysMod < 0 {
 					daysMod++
 					continue
@@ -13035,7 +13371,7 @@ func (fn *formulaFuncs) WORKDAYdotINTL(argsList *list.List) formulaArg {
 			}
 		}
 	}
-	return newNumberFormulaArg(float64(workdayIntl(endDate, sign, holidays, weekendMask, startDate.Number)))
+	return newNumberFormulaArg(float64(workdayIntl(endDate, sign, holidays, weekendMask, startDate.Number, fn.date1904)))
 }
 
 // YEAR function returns an integer representing the year of a supplied date.
@@ -13064,7 +13400,7 @@ func (fn *formulaFuncs) YEAR(argsList *list.List) formulaArg {
 	if num.Number < 0 {
 		return newErrorFormulaArg(formulaErrorNUM, "YEAR only accepts positive argument")
 	}
-	return newNumberFormulaArg(float64(timeFromExcelTime(num.Number, false).Year()))
+	return newNumberFormulaArg(float64(timeFromExcelTime(num.Number, fn.date1904).Year()))
 }
 
 // yearFracBasisCond is an implementation of the yearFracBasis1.
@@ -13074,8 +13410,8 @@ func yearFracBasisCond(sy, sm, sd, ey, em, ed int) bool {
 
 // yearFracBasis0 function returns the fraction of a year that between two
 // supplied dates in US (NASD) 30/360 type of day.
-func yearFracBasis0(startDate, endDate float64) (dayDiff, daysInYear float64) {
-	startTime, endTime := timeFromExcelTime(startDate, false), timeFromExcelTime(endDate, false)
+func yearFracBasis0(startDate, endDate float64, date1904 bool) (dayDiff, daysInYear float64) {
+	startTime, endTime := timeFromExcelTime(startDate, date1904), timeFromExcelTime(endDate, date1904)
 	sy, smM, sd := startTime.Date()
 	ey, emM, ed := endTime.Date()
 	sm, em := int(smM), int(emM)
@@ -13097,8 +13433,8 @@ func yearFracBasis0(startDate, endDate float64) (dayDiff, daysInYear float64) {
 
 // yearFracBasis1 function returns the fraction of a year that between two
 // supplied dates in actual type of day.
-func yearFracBasis1(startDate, endDate float64) (dayDiff, daysInYear float64) {
-	startTime, endTime := timeFromExcelTime(startDate, false), timeFromExcelTime(endDate, false)
+func yearFracBasis1(startDate, endDate float64, date1904 bool) (dayDiff, daysInYear float64) {
+	startTime, endTime := timeFromExcelTime(startDate, date1904), timeFromExcelTime(endDate, date1904)
 	sy, smM, sd := startTime.Date()
 	ey, emM, ed := endTime.Date()
 	sm, em := int(smM), int(emM)
@@ -13126,8 +13462,8 @@ func yearFracBasis1(startDate, endDate float64) (dayDiff, daysInYear float64) {
 
 // yearFracBasis4 function returns the fraction of a year that between two
 // supplied dates in European 30/360 type of day.
-func yearFracBasis4(startDate, endDate float64) (dayDiff, daysInYear float64) {
-	startTime, endTime := timeFromExcelTime(startDate, false), timeFromExcelTime(endDate, false)
+func yearFracBasis4(startDate, endDate float64, date1904 bool) (dayDiff, daysInYear float64) {
+	startTime, endTime := timeFromExcelTime(startDate, date1904), timeFromExcelTime(endDate, date1904)
 	sy, smM, sd := startTime.Date()
 	ey, emM, ed := endTime.Date()
 	sm, em := int(smM), int(emM)
@@ -13143,17 +13479,17 @@ func yearFracBasis4(startDate, endDate float64) (dayDiff, daysInYear float64) {
 }
 
 // yearFrac is an implementation of the formula function YEARFRAC.
-func yearFrac(startDate, endDate float64, basis int) formulaArg {
-	startTime, endTime := timeFromExcelTime(startDate, false), timeFromExcelTime(endDate, false)
+func yearFrac(startDate, endDate float64, basis int, date1904 bool) formulaArg {
+	startTime, endTime := timeFromExcelTime(startDate, date1904), timeFromExcelTime(endDate, date1904)
 	if startTime == endTime {
 		return newNumberFormulaArg(0)
 	}
 	var dayDiff, daysInYear float64
 	switch basis {
 	case 0:
-		dayDiff, daysInYear = yearFracBasis0(startDate, endDate)
+		dayDiff, daysInYear = yearFracBasis0(startDate, endDate, date1904)
 	case 1:
-		dayDiff, daysInYear = yearFracBasis1(startDate, endDate)
+		dayDiff, daysInYear = yearFracBasis1(startDate, endDate, date1904)
 	case 2:
 		dayDiff = endDate - startDate
 		daysInYear = 360
@@ -13161,7 +13497,7 @@ func yearFrac(startDate, endDate float64, basis int) formulaArg {
 		dayDiff = endDate - startDate
 		daysInYear = 365
 	case 4:
-		dayDiff, daysInYear = yearFracBasis4(startDate, endDate)
+		dayDiff, daysInYear = yearFracBasis4(startDate, endDate, date1904)
 	default:
 		return newErrorFormulaArg(formulaErrorNUM, "invalid basis")
 	}
@@ -13204,7 +13540,7 @@ func (fn *formulaFuncs) YEARFRAC(argsList *list.List) formulaArg {
 			return basis
 		}
 	}
-	return yearFrac(start.Number, end.Number, int(basis.Number))
+	return yearFrac(start.Number, end.Number, int(basis.Number), fn.date1904)
 }
 
 // NOW function returns the current date and time. The function receives no
@@ -13217,7 +13553,7 @@ func (fn *formulaFuncs) NOW(argsList *list.List) formulaArg {
 	}
 	now := time.Now()
 	_, offset := now.Zone()
-	return newNumberFormulaArg(25569.0 + float64(now.Unix()+int64(offset))/86400)
+	return newNumberFormulaArg(excelDateSerial(0, fn.date1904) + float64(now.Unix()+int64(offset))/86400)
 }
 
 // SECOND function returns an integer representing the second component of a
@@ -13247,7 +13583,7 @@ func (fn *formulaFuncs) SECOND(argsList *list.List) formulaArg {
 	if num.Number < 0 {
 		return newErrorFormulaArg(formulaErrorNUM, "SECOND only accepts positive argument")
 	}
-	return newNumberFormulaArg(float64(timeFromExcelTime(num.Number, false).Second()))
+	return newNumberFormulaArg(float64(timeFromExcelTime(num.Number, fn.date1904).Second()))
 }
 
 // TIME function accepts three integer arguments representing hours, minutes
@@ -13313,7 +13649,7 @@ func (fn *formulaFuncs) TODAY(argsList *list.List) formulaArg {
 	}
 	now := time.Now()
 	_, offset := now.Zone()
-	return newNumberFormulaArg(daysBetween(excelMinTime1900.Unix(), now.Unix()+int64(offset)) + 1)
+	return newNumberFormulaArg(excelDateSerial(now.Unix()+int64(offset), fn.date1904))
 }
 
 // makeDate return date as a Unix time, the number of seconds elapsed since
@@ -13332,6 +13668,15 @@ func daysBetween(startDate, endDate int64) float64 {
 	return float64(int(0.5 + float64((endDate-startDate)/86400)))
 }
 
+// excelDateSerial returns the serial number of the date by given Unix time in
+// the 1900 or 1904 date system.
+func excelDateSerial(date int64, date1904 bool) float64 {
+	if date1904 {
+		return daysBetween(excel1904Epoc.Unix(), date)
+	}
+	return daysBetween(excelMinTime1900.Unix(), date) + 1
+}
+
 // WEEKDAY function returns an integer representing the day of the week for a
 // supplied date. The syntax of the function is:
 //
@@ -13362,7 +13707,7 @@ func (fn *formulaFuncs) WEEKDAY(argsList *list.List) formulaArg {
 		if num.Number < 0 {
 			return newErrorFormulaArg(formulaErrorNUM, formulaErrorNUM)
 		}
-		weekday = int(timeFromExcelTime(num.Number, false).Weekday())
+		weekday = int(timeFromExcelTime(num.Number, fn.date1904).Weekday())
 	}
 	if argsList.Len() == 2 {
 		returnTypeArg := argsList.Back().Value.(formulaArg).ToNumber()
@@ -13454,7 +13799,7 @@ func (fn *formulaFuncs) WEEKNUM(argsList *list.List) formulaArg {
 		if num.Number < 0 {
 			return newErrorFormulaArg(formulaErrorNUM, formulaErrorNUM)
 		}
-		snTime = timeFromExcelTime(num.Number, false)
+		snTime = timeFromExcelTime(num.Number, fn.date1904)
 	}
 	if argsList.Len() == 2 {
 		returnTypeArg := argsList.Back().Value.(formulaArg).ToNumber()
@@ -14175,7 +14520,7 @@ func (fn *formulaFuncs) TEXT(argsList *list.List) formulaArg {
 	if num := value.ToNumber(); num.Type != ArgNumber {
 		cellType = CellTypeSharedString
 	}
-	return newStringFormulaArg(format(value.Value(), fmtText.Value(), false, cellType, nil))
+	return newStringFormulaArg(format(value.Value(), fmtText.Value(), fn.date1904, cellType, nil))
 }
 
 // prepareTextAfterBefore checking and prepare arguments for the formula
@@ -14337,7 +14682,8 @@ func (fn *formulaFuncs) TEXTBEFORE(argsList *list.List) formulaArg {
 
 // TEXTJOIN function joins together a series of supplied text strings into one
 // combined text string. The user can specify a delimiter to add between the
-// individual text items, if required. The syntax of the function is:
+// individual text items, if required. If the delimiter is a range or an
+// array, the delimiters will be used in turn. The syntax of the function is:
 //
 //	TEXTJOIN([delimiter],[ignore_empty],text1,[text2],...)
 func (fn *formulaFuncs) TEXTJOIN(argsList *list.List) formulaArg {
@@ -14347,51 +14693,185 @@ func (fn *formulaFuncs) TEXTJOIN(argsList *list.List) formulaArg {
 	if argsList.Len() > 252 {
 		return newErrorFormulaArg(formulaErrorVALUE, "TEXTJOIN accepts at most 252 arguments")
 	}
-	delimiter := argsList.Front().Value.(formulaArg)
+	delimiters, errArg := textDelimiters(argsList.Front().Value.(formulaArg))
+	if errArg.Type == ArgError {
+		return errArg
+	}
 	ignoreEmpty := argsList.Front().Next().Value.(formulaArg)
-	if ignoreEmpty.Type != ArgNumber || !ignoreEmpty.Boolean {
+	if ignoreEmpty.Type != ArgNumber {
 		return newErrorFormulaArg(formulaErrorVALUE, formulaErrorVALUE)
 	}
 	args, ok := textJoin(argsList.Front().Next().Next(), []string{}, ignoreEmpty.Number != 0)
 	if ok.Type != ArgNumber {
 		return ok
 	}
-	result := strings.Join(args, delimiter.Value())
+	var buf strings.Builder
+	for i, arg := range args {
+		if i > 0 && len(delimiters) > 0 {
+			buf.WriteString(delimiters[(i-1)%len(delimiters)])
+		}
+		buf.WriteString(arg)
+	}
+	result := buf.String()
 	if len(result) > TotalCellChars {
 		return newErrorFormulaArg(formulaErrorVALUE, fmt.Sprintf("TEXTJOIN function exceeds %d characters", TotalCellChars))
 	}
 	return newStringFormulaArg(result)
 }
 
+// textDelimiters returns the delimiters by given delimiter argument for the
+// formula functions TEXTJOIN and TEXTSPLIT.
+func textDelimiters(arg formulaArg) ([]string, formulaArg) {
+	var delimiters []string
+	cells := []formulaArg{arg}
+	if arg.Type == ArgMatrix || arg.Type == ArgList {
+		cells = arg.ToList()
+	}
+	for _, cell := range cells {
+		if cell.Type == ArgError {
+			return delimiters, cell
+		}
+		delimiters = append(delimiters, cell.Value())
+	}
+	return delimiters, newBoolFormulaArg(true)
+}
+
 // textJoin is an implementation of the formula function TEXTJOIN.
 func textJoin(arg *list.Element, arr []string, ignoreEmpty bool) ([]string, formulaArg) {
-	for arg.Next(); arg != nil; arg = arg.Next() {
-		switch arg.Value.(formulaArg).Type {
-		case ArgError:
-			return arr, arg.Value.(formulaArg)
-		case ArgString, ArgEmpty:
-			val := arg.Value.(formulaArg).Value()
-			if val != "" || !ignoreEmpty {
-				arr = append(arr, val)
-			}
-		case ArgNumber:
-			arr = append(arr, arg.Value.(formulaArg).Value())
-		case ArgMatrix:
-			for _, row := range arg.Value.(formulaArg).Matrix {
-				argList := list.New().Init()
-				for _, ele := range row {
-					argList.PushBack(ele)
-				}
-				if argList.Len() > 0 {
-					args, _ := textJoin(argList.Front(), []string{}, ignoreEmpty)
-					arr = append(arr, args...)
+	for ; arg != nil; arg = arg.Next() {
+		value := arg.Value.(formulaArg)
+		cells := []formulaArg{value}
+		if value.Type == ArgMatrix || value.Type == ArgList {
+			cells = value.ToList()
+		}
+		for _, cell := range cells {
+			switch cell.Type {
+			case ArgError:
+				return arr, cell
+			case ArgString, ArgEmpty:
+				if val := cell.Value(); val != "" || !ignoreEmpty {
+					arr = append(arr, val)
 				}
+			case ArgNumber:
+				arr = append(arr, cell.Value())
 			}
 		}
 	}
 	return arr, newBoolFormulaArg(true)
 }
 
+// textSplit splits the text by given delimiters, the longest delimiter will
+// be used if there are multiple delimiters matched at the same position.
+func textSplit(text string, delimiters []string, ignoreEmpty, caseInsensitive bool) []string {
+	var parts []string
+	start := 0
+	for i := 0; i < len(text); {
+		matched := 0
+		for _, delimiter := range delimiters {
+			if delimiter == "" || len(delimiter) <= matched || len(text)-i < len(delimiter) {
+				continue
+			}
+			if text[i:i+len(delimiter)] == delimiter || (caseInsensitive && strings.EqualFold(text[i:i+len(delimiter)], delimiter)) {
+				matched = len(delimiter)
+			}
+		}
+		if matched == 0 {
+			i++
+			continue
+		}
+		parts = append(parts, text[start:i])
+		i += matched
+		start = i
+	}
+	parts = append(parts, text[start:])
+	if !ignoreEmpty {
+		return parts
+	}
+	var result []string
+	for _, part := range parts {
+		if part != "" {
+			result = append(result, part)
+		}
+	}
+	return result
+}
+
+// TEXTSPLIT function splits text strings by using column and row delimiters.
+// The delimiters can be a text or an array of texts, and the missing values
+// will be padded with the pad_with argument, by default is #N/A. The syntax
+// of the function is:
+//
+//	TEXTSPLIT(text,col_delimiter,[row_delimiter],[ignore_empty],[match_mode],[pad_with])
+func (fn *formulaFuncs) TEXTSPLIT(argsList *list.List) formulaArg {
+	if argsList.Len() < 2 {
+		return newErrorFormulaArg(formulaErrorVALUE, "TEXTSPLIT requires at least 2 arguments")
+	}
+	if argsList.Len() > 6 {
+		return newErrorFormulaArg(formulaErrorVALUE, "TEXTSPLIT allows at most 6 arguments")
+	}
+	var args []formulaArg
+	for arg := argsList.Front(); arg != nil; arg = arg.Next() {
+		args = append(args, arg.Value.(formulaArg))
+	}
+	text := args[0]
+	if text.Type == ArgError {
+		return text
+	}
+	colDelimiters, errArg := textDelimiters(args[1])
+	if errArg.Type == ArgError {
+		return errArg
+	}
+	var rowDelimiters []string
+	if len(args) > 2 {
+		if rowDelimiters, errArg = textDelimiters(args[2]); errArg.Type == ArgError {
+			return errArg
+		}
+	}
+	ignoreEmpty, matchMode, padWith := newBoolFormulaArg(false), newNumberFormulaArg(0), newErrorFormulaArg(formulaErrorNA, formulaErrorNA)
+	if len(args) > 3 && args[3].Type != ArgEmpty {
+		if ignoreEmpty = args[3].ToBool(); ignoreEmpty.Type == ArgError {
+			return ignoreEmpty
+		}
+	}
+	if len(args) > 4 && args[4].Type != ArgEmpty {
+		if matchMode = args[4].ToNumber(); matchMode.Type == ArgError {
+			return matchMode
+		}
+		if matchMode.Number != 0 && matchMode.Number != 1 {
+			return newErrorFormulaArg(formulaErrorVALUE, formulaErrorVALUE)
+		}
+	}
+	if len(args) > 5 {
+		padWith = args[5]
+	}
+	if strings.Join(colDelimiters, "") == "" && strings.Join(rowDelimiters, "") == "" {
+		return newErrorFormulaArg(formulaErrorVALUE, formulaErrorVALUE)
+	}
+	var (
+		mtx     [][]formulaArg
+		maxCols int
+	)
+	for _, row := range textSplit(text.Value(), rowDelimiters, ignoreEmpty.Number == 1, matchMode.Number == 1) {
+		var cols []formulaArg
+		for _, col := range textSplit(row, colDelimiters, ignoreEmpty.Number == 1, matchMode.Number == 1) {
+			cols = append(cols, newStringFormulaArg(col))
+		}
+		if len(cols) > maxCols {
+			maxCols = len(cols)
+		}
+		mtx = append(mtx, cols)
+	}
+	if maxCols == 0 {
+		return newErrorFormulaArg(formulaErrorCALC, formulaErrorCALC)
+	}
+	for i := range mtx {
+		for len(mtx[i]) < maxCols {
+			mtx[i] = append(mtx[i], padWith)
+		}
+	}
+	return newMatrixFormulaArg(mtx)
+}
+
 // TRIM removes extra spaces (i.e. all spaces except for single spaces between
 // words or characters) from a supplied text string. The syntax of the
 // function is:
@@ -14470,7 +14950,7 @@ func (fn *formulaFuncs) VALUE(argsList *list.List) formulaArg {
 	y, m, d, _, err := strToDate(text)
 	errDate = err.Type == ArgError
 	if !errDate {
-		dateValue = daysBetween(excelMinTime1900.Unix(), makeDate(y, time.Month(m), d)) + 1
+		dateValue = excelDateSerial(makeDate(y, time.Month(m), d), fn.date1904)
 	}
 	if errTime && errDate {
 		return newErrorFormulaArg(formulaErrorVALUE, formulaErrorVALUE)
@@ -15346,6 +15826,113 @@ func (fn *formulaFuncs) prepareXlookupArgs(argsList *list.List) formulaArg {
 	return newListFormulaArg([]formulaArg{lookupValue, lookupArray, returnArray, ifNotFond, matchMode, searchMode})
 }
 
+// xlookupCompare compares the lookup array cell and the lookup value for the
+// formula function XLOOKUP with approximate match. The numbers are less than
+// the texts, and the texts are less than the logical values, the texts are
+// compared case-insensitive. The criteriaErr will be returned if the cell is
+// empty or an error.
+func xlookupCompare(cell, lookupValue formulaArg) byte {
+	rank := func(arg formulaArg) int {
+		switch arg.Type {
+		case ArgNumber:
+			if arg.Boolean {
+				return 2
+			}
+			return 0
+		case ArgString:
+			if arg.Value() == "" {
+				return -1
+			}
+			if lookupValue.Type == ArgNumber && !lookupValue.Boolean && arg.ToNumber().Type == ArgNumber {
+				return 0
+			}
+			return 1
+		}
+		return -1
+	}
+	cellRank, lookupRank := rank(cell), rank(lookupValue)
+	if cellRank == -1 || lookupRank == -1 {
+		return criteriaErr
+	}
+	if cellRank != lookupRank {
+		if cellRank < lookupRank {
+			return criteriaL
+		}
+		return criteriaG
+	}
+	if cellRank == 1 {
+		return compareFormulaArg(newStringFormulaArg(cell.Value()), newStringFormulaArg(lookupValue.Value()), newNumberFormulaArg(matchModeExact), false)
+	}
+	return compareFormulaArg(cell.ToNumber(), lookupValue.ToNumber(), newNumberFormulaArg(matchModeExact), false)
+}
+
+// xlookupApproximateSearch returns the index of the exact match item, or the
+// next smaller or the next larger item in the lookup array by given match
+// mode and search mode for the formula function XLOOKUP. The -1 will be
+// returned if no matched item.
+func xlookupApproximateSearch(vertical bool, lookupValue, lookupArray, matchMode, searchMode formulaArg) int {
+	var tableArray []formulaArg
+	if vertical {
+		for _, row := range lookupArray.Matrix {
+			tableArray = append(tableArray, row[0])
+		}
+	} else {
+		tableArray = lookupArray.Matrix[0]
+	}
+	if searchMode.Number == searchModeAscBinary || searchMode.Number == searchModeDescBinary {
+		desc, low, high, lessIdx, greaterIdx := searchMode.Number == searchModeDescBinary, 0, len(tableArray)-1, -1, -1
+		for low <= high {
+			mid := low + (high-low)/2
+			result := xlookupCompare(tableArray[mid], lookupValue)
+			if result == criteriaEq {
+				return mid
+			}
+			if result == criteriaErr {
+				return -1
+			}
+			if result == criteriaL {
+				lessIdx = mid
+			} else {
+				greaterIdx = mid
+			}
+			if (result == criteriaL) != desc {
+				low = mid + 1
+				continue
+			}
+			high = mid - 1
+		}
+		if matchMode.Number == matchModeMaxLess {
+			return lessIdx
+		}
+		return greaterIdx
+	}
+	matchIdx := -1
+	for i := range tableArray {
+		idx := i
+		if searchMode.Number == searchModeReverseLinear {
+			idx = len(tableArray) - 1 - i
+		}
+		result := xlookupCompare(tableArray[idx], lookupValue)
+		if result == criteriaEq {
+			return idx
+		}
+		if (matchMode.Number == matchModeMaxLess && result != criteriaL) ||
+			(matchMode.Number == matchModeMinGreater && result != criteriaG) {
+			continue
+		}
+		if matchIdx == -1 {
+			matchIdx = idx
+			continue
+		}
+		// Find the largest smaller item or the smallest larger item
+		if better := xlookupCompare(tableArray[idx], tableArray[matchIdx]); (matchMode.Number == matchModeMaxLess && better == criteriaG) ||
+			(matchMode.Number == matchModeMinGreater && better == criteriaL) {
+			matchIdx = idx
+		}
+	}
+	return matchIdx
+}
+
 // xlookup is an implementation of the formula function XLOOKUP.
 func (fn *formulaFuncs) xlookup(lookupRows, lookupCols, returnArrayRows, returnArrayCols, matchIdx int,
 	condition1, condition2, condition3, condition4 bool, returnArray formulaArg,
@@ -15395,8 +15982,13 @@ func (fn *formulaFuncs) xlookup(lookupRows, lookupCols, returnArrayRows, returnA
 
 // XLOOKUP function searches a range or an array, and then returns the item
 // corresponding to the first match it finds. If no match exists, then
-// XLOOKUP can return the closest (approximate) match. The syntax of the
-// function is:
+// XLOOKUP can return the closest (approximate) match. The match_mode can be
+// 0 (exact match), -1 (exact match or next smaller item), 1 (exact match or
+// next larger item) or 2 (wildcard match), and the search_mode can be 1
+// (search first to last), -1 (search last to first), 2 (binary search in
+// ascending order) or -2 (binary search in descending order). If the result
+// is an array, the first item of the array will be returned for the cell.
+// The syntax of the function is:
 //
 //	XLOOKUP(lookup_value,lookup_array,return_array,[if_not_found],[match_mode],[search_mode])
 func (fn *formulaFuncs) XLOOKUP(argsList *list.List) formulaArg {
@@ -15414,8 +16006,10 @@ func (fn *formulaFuncs) XLOOKUP(argsList *list.List) formulaArg {
 	}
 	verticalLookup := lookupRows >= lookupCols
 	var matchIdx int
-	switch searchMode.Number {
-	case searchModeLinear, searchModeReverseLinear:
+	switch {
+	case matchMode.Number == matchModeMinGreater || matchMode.Number == matchModeMaxLess:
+		matchIdx = xlookupApproximateSearch(verticalLookup, lookupValue, lookupArray, matchMode, searchMode)
+	case searchMode.Number == searchModeLinear || searchMode.Number == searchModeReverseLinear:
 		matchIdx, _ = lookupLinearSearch(verticalLookup, lookupValue, lookupArray, matchMode, searchMode)
 	default:
 		matchIdx, _ = lookupBinarySearch(verticalLookup, lookupValue, lookupArray, matchMode, searchMode)
@@ -15677,7 +16271,7 @@ func (fn *formulaFuncs) ACCRINT(argsList *list.List) formulaArg {
 			return newErrorFormulaArg(formulaErrorVALUE, formulaErrorVALUE)
 		}
 	}
-	frac1 := yearFrac(issue.Number, settlement.Number, int(basis.Number))
+	frac1 := yearFrac(issue.Number, settlement.Number, int(basis.Number), fn.date1904)
 	if frac1.Type != ArgNumber {
 		return frac1
 	}
@@ -15714,7 +16308,7 @@ func (fn *formulaFuncs) ACCRINTM(argsList *list.List) formulaArg {
 			return newErrorFormulaArg(formulaErrorNUM, formulaErrorNUM)
 		}
 	}
-	frac := yearFrac(issue.Number, settlement.Number, int(basis.Number))
+	frac := yearFrac(issue.Number, settlement.Number, int(basis.Number), fn.date1904)
 	if frac.Type != ArgNumber {
 		return frac
 	}
@@ -15796,7 +16390,7 @@ func (fn *formulaFuncs) AMORDEGRC(argsList *list.List) formulaArg {
 		amorCoeff = 2
 	}
 	rate.Number *= amorCoeff
-	frac := yearFrac(datePurchased.Number, firstPeriod.Number, int(basis.Number))
+	frac := yearFrac(datePurchased.Number, firstPeriod.Number, int(basis.Number), fn.date1904)
 	if frac.Type != ArgNumber {
 		return frac
 	}
@@ -15834,7 +16428,7 @@ func (fn *formulaFuncs) AMORLINC(argsList *list.List) formulaArg {
 		return args
 	}
 	cost, datePurchased, firstPeriod, salvage, period, rate, basis := args.List[0], args.List[1], args.List[2], args.List[3], args.List[4], args.List[5], args.List[6]
-	frac := yearFrac(datePurchased.Number, firstPeriod.Number, int(basis.Number))
+	frac := yearFrac(datePurchased.Number, firstPeriod.Number, int(basis.Number), fn.date1904)
 	if frac.Type != ArgNumber {
 		return frac
 	}
@@ -15959,8 +16553,8 @@ func (fn *formulaFuncs) COUPDAYBS(argsList *list.List) formulaArg {
 	if args.Type != ArgList {
 		return args
 	}
-	settlement := timeFromExcelTime(args.List[0].Number, false)
-	pcd := timeFromExcelTime(fn.COUPPCD(argsList).Number, false)
+	settlement := timeFromExcelTime(args.List[0].Number, fn.date1904)
+	pcd := timeFromExcelTime(fn.COUPPCD(argsList).Number, fn.date1904)
 	return newNumberFormulaArg(coupdays(pcd, settlement, int(args.List[3].Number)))
 }
 
@@ -15976,7 +16570,7 @@ func (fn *formulaFuncs) COUPDAYS(argsList *list.List) formulaArg {
 	freq := args.List[2].Number
 	basis := int(args.List[3].Number)
 	if basis == 1 {
-		pcd := timeFromExcelTime(fn.COUPPCD(argsList).Number, false)
+		pcd := timeFromExcelTime(fn.COUPPCD(argsList).Number, fn.date1904)
 		next := pcd.AddDate(0, 12/int(freq), 0)
 		return newNumberFormulaArg(coupdays(pcd, next, basis))
 	}
@@ -15992,16 +16586,16 @@ func (fn *formulaFuncs) COUPDAYSNC(argsList *list.List) formulaArg {
 	if args.Type != ArgList {
 		return args
 	}
-	settlement := timeFromExcelTime(args.List[0].Number, false)
+	settlement := timeFromExcelTime(args.List[0].Number, fn.date1904)
 	basis := int(args.List[3].Number)
-	ncd := timeFromExcelTime(fn.COUPNCD(argsList).Number, false)
+	ncd := timeFromExcelTime(fn.COUPNCD(argsList).Number, fn.date1904)
 	return newNumberFormulaArg(coupdays(settlement, ncd, basis))
 }
 
 // coupons is an implementation of the formula functions COUPNCD and COUPPCD.
 func (fn *formulaFuncs) coupons(name string, arg formulaArg) formulaArg {
-	settlement := timeFromExcelTime(arg.List[0].Number, false)
-	maturity := timeFromExcelTime(arg.List[1].Number, false)
+	settlement := timeFromExcelTime(arg.List[0].Number, fn.date1904)
+	maturity := timeFromExcelTime(arg.List[1].Number, fn.date1904)
 	maturityDays := (maturity.Year()-settlement.Year())*12 + (int(maturity.Month()) - int(settlement.Month()))
 	coupon := 12 / int(arg.List[2].Number)
 	mod := maturityDays % coupon
@@ -16029,7 +16623,7 @@ func (fn *formulaFuncs) coupons(name string, arg formulaArg) formulaArg {
 	} else if day > 27 && day > days {
 		day = days
 	}
-	return newNumberFormulaArg(daysBetween(excelMinTime1900.Unix(), makeDate(year, time.Month(month), day)) + 1)
+	return newNumberFormulaArg(excelDateSerial(makeDate(year, time.Month(month), day), fn.date1904))
 }
 
 // COUPNCD function calculates the number of coupons payable, between a
@@ -16055,7 +16649,7 @@ func (fn *formulaFuncs) COUPNUM(argsList *list.List) formulaArg {
 	if args.Type != ArgList {
 		return args
 	}
-	frac := yearFrac(args.List[0].Number, args.List[1].Number, 0)
+	frac := yearFrac(args.List[0].Number, args.List[1].Number, 0, fn.date1904)
 	return newNumberFormulaArg(math.Ceil(frac.Number * args.List[2].Number))
 }
 
@@ -16323,7 +16917,7 @@ func (fn *formulaFuncs) discIntrate(name string, argsList *list.List) formulaArg
 			return newErrorFormulaArg(formulaErrorNUM, formulaErrorNUM)
 		}
 	}
-	frac := yearFrac(settlement.Number, maturity.Number, int(basis.Number))
+	frac := yearFrac(settlement.Number, maturity.Number, int(basis.Number), fn.date1904)
 	if frac.Type != ArgNumber {
 		return frac
 	}
@@ -16434,7 +17028,7 @@ func (fn *formulaFuncs) prepareDurationArgs(name string, argsList *list.List) fo
 
 // duration is an implementation of the formula function DURATION.
 func (fn *formulaFuncs) duration(settlement, maturity, coupon, yld, frequency, basis formulaArg) formulaArg {
-	frac := yearFrac(settlement.Number, maturity.Number, int(basis.Number))
+	frac := yearFrac(settlement.Number, maturity.Number, int(basis.Number), fn.date1904)
 	if frac.Type != ArgNumber {
 		return frac
 	}
@@ -17052,8 +17646,8 @@ func datesAggregate(startDate, endDate time.Time, numMonths float64, f func(pcd,
 }
 
 // coupNumber is a part of implementation of the formula function ODDFPRICE.
-func coupNumber(maturity, settlement, numMonths float64) float64 {
-	maturityTime, settlementTime := timeFromExcelTime(maturity, false), timeFromExcelTime(settlement, false)
+func coupNumber(maturity, settlement, numMonths float64, date1904 bool) float64 {
+	maturityTime, settlementTime := timeFromExcelTime(maturity, date1904), timeFromExcelTime(settlement, date1904)
 	my, mm, md := maturityTime.Year(), maturityTime.Month(), maturityTime.Day()
 	sy, sm, sd := settlementTime.Year(), settlementTime.Month(), settlementTime.Day()
 	couponsTemp, endOfMonthTemp := 0.0, getDaysInMonth(my, int(mm)) == md
@@ -17157,10 +17751,10 @@ func (fn *formulaFuncs) ODDFPRICE(argsList *list.List) formulaArg {
 	if basisArg.Number < 0 || basisArg.Number > 4 {
 		return newErrorFormulaArg(formulaErrorNUM, "invalid basis")
 	}
-	issueTime := timeFromExcelTime(issue.Number, false)
-	settlementTime := timeFromExcelTime(settlement.Number, false)
-	maturityTime := timeFromExcelTime(maturity.Number, false)
-	firstCouponTime := timeFromExcelTime(firstCoupon.Number, false)
+	issueTime := timeFromExcelTime(issue.Number, fn.date1904)
+	settlementTime := timeFromExcelTime(settlement.Number, fn.date1904)
+	maturityTime := timeFromExcelTime(maturity.Number, fn.date1904)
+	firstCouponTime := timeFromExcelTime(firstCoupon.Number, fn.date1904)
 	basis := int(basisArg.Number)
 	monthDays := getDaysInMonth(maturityTime.Year(), int(maturityTime.Month()))
 	returnLastMonth := monthDays == maturityTime.Day()
@@ -17206,9 +17800,9 @@ func (fn *formulaFuncs) ODDFPRICE(argsList *list.List) formulaArg {
 	nc := fn.COUPNUM(fnArgs)
 	lastCoupon := firstCoupon.Number
 	aggrFunc := func(acc []float64, index float64) []float64 {
-		lastCouponTime := timeFromExcelTime(lastCoupon, false)
-		earlyCoupon := daysBetween(excelMinTime1900.Unix(), makeDate(lastCouponTime.Year(), time.Month(float64(lastCouponTime.Month())+numMonthsNeg), lastCouponTime.Day())) + 1
-		earlyCouponTime := timeFromExcelTime(earlyCoupon, false)
+		lastCouponTime := timeFromExcelTime(lastCoupon, fn.date1904)
+		earlyCoupon := excelDateSerial(makeDate(lastCouponTime.Year(), time.Month(float64(lastCouponTime.Month())+numMonthsNeg), lastCouponTime.Day()), fn.date1904)
+		earlyCouponTime := timeFromExcelTime(earlyCoupon, fn.date1904)
 		nl := e.Number
 		if basis == 1 {
 			nl = coupdays(earlyCouponTime, lastCouponTime, basis)
@@ -17225,8 +17819,8 @@ func (fn *formulaFuncs) ODDFPRICE(argsList *list.List) formulaArg {
 		if settlement.Number < lastCoupon {
 			endDate = settlement.Number
 		}
-		startDateTime := timeFromExcelTime(startDate, false)
-		endDateTime := timeFromExcelTime(endDate, false)
+		startDateTime := timeFromExcelTime(startDate, fn.date1904)
+		endDateTime := timeFromExcelTime(endDate, fn.date1904)
 		a := coupdays(startDateTime, endDateTime, basis)
 		lastCoupon = earlyCoupon
 		dcnl := acc[0]
@@ -17241,14 +17835,14 @@ func (fn *formulaFuncs) ODDFPRICE(argsList *list.List) formulaArg {
 	fnArgs.PushBack(firstCoupon)
 	fnArgs.PushBack(frequency)
 	if basis == 2 || basis == 3 {
-		d := timeFromExcelTime(fn.COUPNCD(fnArgs).Number, false)
+		d := timeFromExcelTime(fn.COUPNCD(fnArgs).Number, fn.date1904)
 		dsc = coupdays(settlementTime, d, basis)
 	} else {
-		d := timeFromExcelTime(fn.COUPPCD(fnArgs).Number, false)
+		d := timeFromExcelTime(fn.COUPPCD(fnArgs).Number, fn.date1904)
 		a := coupdays(d, settlementTime, basis)
 		dsc = e.Number - a
 	}
-	nq := coupNumber(firstCoupon.Number, settlement.Number, numMonths)
+	nq := coupNumber(firstCoupon.Number, settlement.Number, numMonths, fn.date1904)
 	fnArgs.Init()
 	fnArgs.PushBack(firstCoupon)
 	fnArgs.PushBack(maturity)
@@ -17301,8 +17895,8 @@ func (fn *formulaFuncs) ODDFYIELD(argsList *list.List) formulaArg {
 	if basisArg.Number < 0 || basisArg.Number > 4 {
 		return newErrorFormulaArg(formulaErrorNUM, "invalid basis")
 	}
-	settlementTime := timeFromExcelTime(settlement.Number, false)
-	maturityTime := timeFromExcelTime(maturity.Number, false)
+	settlementTime := timeFromExcelTime(settlement.Number, fn.date1904)
+	maturityTime := timeFromExcelTime(maturity.Number, fn.date1904)
 	years := coupdays(settlementTime, maturityTime, int(basisArg.Number))
 	px := pr.Number - 100
 	num := rate.Number*years*100 - px
@@ -17388,8 +17982,8 @@ func (fn *formulaFuncs) oddl(name string, argsList *list.List) formulaArg {
 	if basisArg.Number < 0 || basisArg.Number > 4 {
 		return newErrorFormulaArg(formulaErrorNUM, "invalid basis")
 	}
-	settlementTime := timeFromExcelTime(settlement.Number, false)
-	maturityTime := timeFromExcelTime(maturity.Number, false)
+	settlementTime := timeFromExcelTime(settlement.Number, fn.date1904)
+	maturityTime := timeFromExcelTime(maturity.Number, fn.date1904)
 	basis := int(basisArg.Number)
 	numMonths := 12 / frequency.Number
 	fnArgs := list.New().Init()
@@ -17400,9 +17994,9 @@ func (fn *formulaFuncs) oddl(name string, argsList *list.List) formulaArg {
 	nc := fn.COUPNUM(fnArgs)
 	earlyCoupon := lastInterest.Number
 	aggrFunc := func(acc []float64, index float64) []float64 {
-		earlyCouponTime := timeFromExcelTime(earlyCoupon, false)
+		earlyCouponTime := timeFromExcelTime(earlyCoupon, fn.date1904)
 		lateCouponTime := changeMonth(earlyCouponTime, numMonths, false)
-		lateCoupon, _ := timeToExcelTime(lateCouponTime, false)
+		lateCoupon, _ := timeToExcelTime(lateCouponTime, fn.date1904)
 		nl := coupdays(earlyCouponTime, lateCouponTime, basis)
 		dci := coupdays(earlyCouponTime, maturityTime, basis)
 		if index < nc.Number {
@@ -17422,8 +18016,8 @@ func (fn *formulaFuncs) oddl(name string, argsList *list.List) formulaArg {
 		if maturity.Number < lateCoupon {
 			endDate = maturity.Number
 		}
-		startDateTime := timeFromExcelTime(startDate, false)
-		endDateTime := timeFromExcelTime(endDate, false)
+		startDateTime := timeFromExcelTime(startDate, fn.date1904)
+		endDateTime := timeFromExcelTime(endDate, fn.date1904)
 		dsc := coupdays(startDateTime, endDateTime, basis)
 		earlyCoupon = lateCoupon
 		dcnl := acc[0]
@@ -17688,7 +18282,7 @@ func (fn *formulaFuncs) PRICEDISC(argsList *list.List) formulaArg {
 			return newErrorFormulaArg(formulaErrorNUM, formulaErrorNUM)
 		}
 	}
-	frac := yearFrac(settlement.Number, maturity.Number, int(basis.Number))
+	frac := yearFrac(settlement.Number, maturity.Number, int(basis.Number), fn.date1904)
 	if frac.Type != ArgNumber {
 		return frac
 	}
@@ -17734,12 +18328,12 @@ func (fn *formulaFuncs) PRICEMAT(argsList *list.List) formulaArg {
 			return newErrorFormulaArg(formulaErrorNUM, formulaErrorNUM)
 		}
 	}
-	dsm := yearFrac(settlement.Number, maturity.Number, int(basis.Number))
+	dsm := yearFrac(settlement.Number, maturity.Number, int(basis.Number), fn.date1904)
 	if dsm.Type != ArgNumber {
 		return dsm
 	}
-	dis := yearFrac(issue.Number, settlement.Number, int(basis.Number))
-	dim := yearFrac(issue.Number, maturity.Number, int(basis.Number))
+	dis := yearFrac(issue.Number, settlement.Number, int(basis.Number), fn.date1904)
+	dim := yearFrac(issue.Number, maturity.Number, int(basis.Number), fn.date1904)
 	return newNumberFormulaArg(((1+dim.Number*rate.Number)/(1+dsm.Number*yld.Number) - dis.Number*rate.Number) * 100)
 }
 
@@ -17891,7 +18485,7 @@ func (fn *formulaFuncs) RECEIVED(argsList *list.List) formulaArg {
 			return newErrorFormulaArg(formulaErrorNUM, formulaErrorNUM)
 		}
 	}
-	frac := yearFrac(settlement.Number, maturity.Number, int(basis.Number))
+	frac := yearFrac(settlement.Number, maturity.Number, int(basis.Number), fn.date1904)
 	if frac.Type != ArgNumber {
 		return frac
 	}
@@ -18417,7 +19011,7 @@ func (fn *formulaFuncs) YIELDDISC(argsList *list.List) formulaArg {
 			return newErrorFormulaArg(formulaErrorNUM, formulaErrorNUM)
 		}
 	}
-	frac := yearFrac(settlement.Number, maturity.Number, int(basis.Number))
+	frac := yearFrac(settlement.Number, maturity.Number, int(basis.Number), fn.date1904)
 	if frac.Type != ArgNumber {
 		return frac
 	}
@@ -18469,12 +19063,12 @@ func (fn *formulaFuncs) YIELDMAT(argsList *list.List) formulaArg {
 			return newErrorFormulaArg(formulaErrorNUM, formulaErrorNUM)
 		}
 	}
-	dim := yearFrac(issue.Number, maturity.Number, int(basis.Number))
+	dim := yearFrac(issue.Number, maturity.Number, int(basis.Number), fn.date1904)
 	if dim.Type != ArgNumber {
 		return dim
 	}
-	dis := yearFrac(issue.Number, settlement.Number, int(basis.Number))
-	dsm := yearFrac(settlement.Number, maturity.Number, int(basis.Number))
+	dis := yearFrac(issue.Number, settlement.Number, int(basis.Number), fn.date1904)
+	dsm := yearFrac(settlement.Number, maturity.Number, int(basis.Number), fn.date1904)
 	f1 := dim.Number * rate.Number
 	result := 1 + math.Nextafter(f1, f1)
 	result /= pr.Number/100 + dis.Number*rate.Number
@@ -18554,6 +19148,11 @@ func (db *calcDatabase) criteriaEval() bool {
 			if criteriaExp.Value() == "" {
 				continue
 			}
+			if val := criteriaExp.Value(); criteriaExp.Type == ArgString && !strings.ContainsAny(val[:1], "=<>") &&
+				criteriaExp.ToNumber().Type != ArgNumber {
+				// The text criteria of the database matches the values begin with the text
+				criteriaExp = newStringFormulaArg(val + "*")
+			}
 			criteria := formulaCriteriaParser(criteriaExp)
 			cell := db.database[db.row][db.indexMap[j]]
 			matched, _ = formulaCriteriaEval(cell, criteria)
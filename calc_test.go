@@ -1598,10 +1598,20 @@ func TestCalcCellValue(t *testing.T) {
 		"=EDATE(\"01/31/2020\",1)":  "43890",
 		"=EDATE(\"01/29/2020\",12)": "44225",
 		"=EDATE(\"6/12/2021\",-14)": "43933",
+		"=EDATE(0,1)":               "31",
+		"=EDATE(31,1)":              "60",
+		"=EDATE(60,1)":              "89",
+		"=EDATE(89,-1)":             "60",
+		"=EDATE(60,12)":             "425",
 		// EOMONTH
 		"=EOMONTH(\"01/01/2021\",-1)":  "44196",
 		"=EOMONTH(\"01/29/2020\",12)":  "44227",
 		"=EOMONTH(\"01/12/2021\",-18)": "43677",
+		"=EOMONTH(0,0)":                "31",
+		"=EOMONTH(1,1)":                "60",
+		"=EOMONTH(45,0)":               "60",
+		"=EOMONTH(61,-1)":              "60",
+		"=EOMONTH(70,-2)":              "31",
 		// HOUR
 		"=HOUR(1)":                    "0",
 		"=HOUR(43543.5032060185)":     "12",
@@ -1893,11 +1903,27 @@ func TestCalcCellValue(t *testing.T) {
 		"=TEXTBEFORE(\"ABX-123-Red-XYZ\",\"-\",4,0,1)":                        "ABX-123-Red-XYZ",
 		"=TEXTBEFORE(\"ABX-112-Red-Y\",\"A\")":                                "",
 		// TEXTJOIN
-		"=TEXTJOIN(\"-\",TRUE,1,2,3,4)":  "1-2-3-4",
-		"=TEXTJOIN(A4,TRUE,A1:B2)":       "1040205",
-		"=TEXTJOIN(\",\",FALSE,A1:C2)":   "1,4,,2,5,",
-		"=TEXTJOIN(\",\",TRUE,A1:C2)":    "1,4,2,5",
-		"=TEXTJOIN(\",\",TRUE,MUNIT(2))": "1,0,0,1",
+		"=TEXTJOIN(\"-\",TRUE,1,2,3,4)":         "1-2-3-4",
+		"=TEXTJOIN(A4,TRUE,A1:B2)":              "1040205",
+		"=TEXTJOIN(\",\",FALSE,A1:C2)":          "1,4,,2,5,",
+		"=TEXTJOIN(\",\",TRUE,A1:C2)":           "1,4,2,5",
+		"=TEXTJOIN(\"-\",TRUE,A1:D2)":           "1-4-Month-2-5-Jan",
+		"=TEXTJOIN({\"-\";\"+\"},FALSE,A1:D1)":  "1-4+-Month",
+		"=TEXTJOIN(\",\",TRUE,MUNIT(2))":        "1,0,0,1",
+		"=TEXTJOIN({\"-\",\"+\"},TRUE,1,2,3,4)": "1-2+3-4",
+		"=TEXTJOIN(\"\",1,\"a\",\"\",\"b\")":    "ab",
+		"=TEXTJOIN(\",\",0,\"a\",\"\",\"b\")":   "a,,b",
+		// TEXTSPLIT
+		"=TEXTSPLIT(\"a,b,c\",\",\")":                                "a",
+		"=INDEX(TEXTSPLIT(\"a,b,c\",\",\"),1,3)":                     "c",
+		"=INDEX(TEXTSPLIT(\"a,b;c,d\",\",\",\";\"),2,2)":             "d",
+		"=INDEX(TEXTSPLIT(\"a,b;c\",\",\",\";\",FALSE,0,\"-\"),2,2)": "-",
+		"=INDEX(TEXTSPLIT(\"a,,b\",\",\",\"\",TRUE),1,2)":            "b",
+		"=INDEX(TEXTSPLIT(\"a,,b\",\",\"),1,2)":                      "",
+		"=INDEX(TEXTSPLIT(\"a-b+c\",{\"-\",\"+\"}),1,3)":             "c",
+		"=INDEX(TEXTSPLIT(\"1x2X3\",\"x\",\"\",FALSE,1),1,3)":        "3",
+		"=INDEX(TEXTSPLIT(\"1x2X3\",\"x\",\"\",FALSE,0),1,2)":        "2X3",
+		"=INDEX(TEXTSPLIT(\"a;b\",\"\",\";\"),2,1)":                  "b",
 		// TRIM
 		"=TRIM(\" trim text \")": "trim text",
 		"=TRIM(0)":               "0",
@@ -3747,12 +3773,14 @@ func TestCalcCellValue(t *testing.T) {
 		"=EDATE(-1,0)":                  {"#NUM!", "#NUM!"},
 		"=EDATE(\"\",0)":                {"#VALUE!", "#VALUE!"},
 		"=EDATE(\"January 25, 100\",0)": {"#VALUE!", "#VALUE!"},
+		"=EDATE(1,-1)":                  {"#NUM!", "#NUM!"},
 		// EOMONTH
 		"=EOMONTH()":                      {"#VALUE!", "EOMONTH requires 2 arguments"},
 		"=EOMONTH(0,\"\")":                {"#VALUE!", "strconv.ParseFloat: parsing \"\": invalid syntax"},
 		"=EOMONTH(-1,0)":                  {"#NUM!", "#NUM!"},
 		"=EOMONTH(\"\",0)":                {"#VALUE!", "#VALUE!"},
 		"=EOMONTH(\"January 25, 100\",0)": {"#VALUE!", "#VALUE!"},
+		"=EOMONTH(1,-1)":                  {"#NUM!", "#NUM!"},
 		// HOUR
 		"=HOUR()":             {"#VALUE!", "HOUR requires exactly 1 argument"},
 		"=HOUR(-1)":           {"#NUM!", "HOUR only accepts positive argument"},
@@ -3954,6 +3982,19 @@ func TestCalcCellValue(t *testing.T) {
 		"=TEXTJOIN()":               {"#VALUE!", "TEXTJOIN requires at least 3 arguments"},
 		"=TEXTJOIN(\"\",\"\",1)":    {"#VALUE!", "#VALUE!"},
 		"=TEXTJOIN(\"\",TRUE,NA())": {"#N/A", "#N/A"},
+		"=TEXTJOIN(NA(),TRUE,1)":    {"#N/A", "#N/A"},
+		// TEXTSPLIT
+		"=TEXTSPLIT()": {"#VALUE!", "TEXTSPLIT requires at least 2 arguments"},
+		"=TEXTSPLIT(\"\",\"\",\"\",\"\",\"\",\"\",\"\")":           {"#VALUE!", "TEXTSPLIT allows at most 6 arguments"},
+		"=TEXTSPLIT(NA(),\",\")":                                   {"#N/A", "#N/A"},
+		"=TEXTSPLIT(\"a\",NA())":                                   {"#N/A", "#N/A"},
+		"=TEXTSPLIT(\"a\",\",\",NA())":                             {"#N/A", "#N/A"},
+		"=TEXTSPLIT(\"a\",\",\",\"\",\"x\")":                       {"#VALUE!", "strconv.ParseBool: parsing \"x\": invalid syntax"},
+		"=TEXTSPLIT(\"a\",\",\",\"\",FALSE,\"x\")":                 {"#VALUE!", "strconv.ParseFloat: parsing \"x\": invalid syntax"},
+		"=TEXTSPLIT(\"a\",\",\",\"\",FALSE,2)":                     {"#VALUE!", "#VALUE!"},
+		"=TEXTSPLIT(\"a\",\"\")":                                   {"#VALUE!", "#VALUE!"},
+		"=TEXTSPLIT(\",\",\",\",\"\",TRUE)":                        {"#CALC!", "#CALC!"},
+		"=INDEX(TEXTSPLIT(\"a,b;c\",\",\",\";\"),2,2)":             {"#N/A", "#N/A"},
 		"=TEXTJOIN(\"\",TRUE," + strings.Repeat("0,", 250) + ",0)": {"#VALUE!", "TEXTJOIN accepts at most 252 arguments"},
 		"=TEXTJOIN(\",\",FALSE,REPT(\"*\",32768))":                 {"#VALUE!", "TEXTJOIN function exceeds 32767 characters"},
 		// TRIM
@@ -4708,6 +4749,73 @@ func TestCalcCellValue(t *testing.T) {
 	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestCalcCellValue.xlsx")))
 }
 
+func TestCalcSheet(t *testing.T) {
+	f := prepareCalcData([][]interface{}{{1, "Text"}})
+	assert.NoError(t, f.SetDefinedName(&DefinedName{Name: "Total", RefersTo: "Sheet1!$B$3", Scope: "Workbook"}))
+	for cell, formula := range map[string]string{
+		"A2": "A1+1",
+		"A3": "A2*2",
+		"B2": "SUM(A1:A3)",
+		"B3": "SUM('Sheet1'!A1:A3)",
+		"C1": "Total&B1",
+		"C2": "A1>0",
+		"C3": "1/0",
+		"C4": "Sheet1!$A$3+$A$2",
+		"C5": "\"\"",
+		"C6": "Sheet2!A1",
+		"C7": "FOO(1)",
+		"C8": "COUNTA(2:2)",
+	} {
+		assert.NoError(t, f.SetCellFormula("Sheet1", cell, formula))
+	}
+	assert.NoError(t, f.CalcSheet("Sheet1"))
+	for cell, expected := range map[string]string{
+		"A2": "2", "A3": "4", "B2": "7", "B3": "7", "C1": "7Text",
+		"C2": "TRUE", "C3": "#DIV/0!", "C4": "6", "C5": "", "C6": "#NAME?", "C7": "#VALUE!", "C8": "3",
+	} {
+		value, err := f.GetCellValue("Sheet1", cell)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, value, cell)
+	}
+	cellType, err := f.GetCellType("Sheet1", "C2")
+	assert.NoError(t, err)
+	assert.Equal(t, CellTypeBool, cellType)
+	cellType, err = f.GetCellType("Sheet1", "C3")
+	assert.NoError(t, err)
+	assert.Equal(t, CellTypeError, cellType)
+	// Test calculate worksheet with circular references
+	assert.NoError(t, f.SetCellFormula("Sheet1", "D1", "D2+1"))
+	assert.NoError(t, f.SetCellFormula("Sheet1", "D2", "D1+1"))
+	assert.NoError(t, f.SetCellFormula("Sheet1", "D3", "D2+A3"))
+	assert.NoError(t, f.SetCellFormula("Sheet1", "D4", "D4"))
+	assert.EqualError(t, f.CalcSheet("Sheet1"), "circular reference found in worksheet Sheet1: D1, D2, D4")
+	value, err := f.GetCellValue("Sheet1", "A3")
+	assert.NoError(t, err)
+	assert.Equal(t, "4", value)
+	// Test calculate worksheet with not exist worksheet
+	assert.EqualError(t, f.CalcSheet("SheetN"), "sheet SheetN does not exist")
+	// Test calculate worksheet with unsupported charset
+	f.Sheet.Delete("xl/worksheets/sheet1.xml")
+	f.Pkg.Store("xl/worksheets/sheet1.xml", MacintoshCyrillicCharset)
+	assert.EqualError(t, f.CalcSheet("Sheet1"), "XML syntax error on line 1: invalid UTF-8")
+	// Test calculate worksheet with invalid array formula reference
+	f = NewFile()
+	formulaType, ref := STCellFormulaTypeArray, "A1:A2"
+	assert.NoError(t, f.SetCellFormula("Sheet1", "A1", "B1:B2", FormulaOpts{Ref: &ref, Type: &formulaType}))
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	ws.(*xlsxWorksheet).SheetData.Row[0].C[0].F.Ref = ":"
+	assert.Equal(t, newCellNameToCoordinatesError("", newInvalidCellNameError("")), f.CalcSheet("Sheet1"))
+	// Test calculate worksheet with the RoundFloat option
+	f = NewFile(Options{RoundFloat: true})
+	assert.NoError(t, f.SetCellFloat("Sheet1", "A1", 0.1, -1, 64))
+	assert.NoError(t, f.SetCellFormula("Sheet1", "A2", "A1+0.2"))
+	assert.NoError(t, f.CalcSheet("Sheet1"))
+	ws, ok = f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	assert.Equal(t, "0.3", ws.(*xlsxWorksheet).SheetData.Row[1].C[0].V)
+}
+
 func TestCalcWithDefinedName(t *testing.T) {
 	cellData := [][]interface{}{
 		{"A1_as_string", "B1_as_string", 123, nil},
@@ -4747,6 +4855,47 @@ func TestCalcWithDefinedName(t *testing.T) {
 	assert.Equal(t, "YES", result, "=IF(\"B1_as_string\"=defined_name1,\"YES\",\"NO\")")
 }
 
+func TestCalcDate1904(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetWorkbookDate1904(true))
+	formulaList := map[string]string{
+		"=DATE(2024,1,1)":              "43830",
+		"=DATEVALUE(\"01/01/2024\")":   "43830",
+		"=YEAR(0)":                     "1904",
+		"=DAY(0)":                      "1",
+		"=YEAR(43835)":                 "2024",
+		"=MONTH(43835)":                "1",
+		"=DAY(43835)":                  "6",
+		"=WEEKDAY(43835)":              "7",
+		"=EDATE(43835,1)":              "43866",
+		"=EOMONTH(43835,1)":            "43889",
+		"=DATEDIF(43835,43904,\"md\")": "9",
+		"=YEARFRAC(43835,43904,1)":     "0.188524590163934",
+		"=WORKDAY(43835,5)":            "43841",
+		"=COUPNCD(43835,43904,2)":      "43904",
+		"=COUPDAYBS(43835,43904,2,1)":  "113",
+		"=TODAY()-DATE(YEAR(TODAY()),MONTH(TODAY()),DAY(TODAY()))": "0",
+	}
+	for formula, expected := range formulaList {
+		assert.NoError(t, f.SetCellFormula("Sheet1", "A1", formula))
+		result, err := f.CalcCellValue("Sheet1", "A1", Options{RawCellValue: true})
+		assert.NoError(t, err, formula)
+		assert.Equal(t, expected, result, formula)
+	}
+	// Test format the date with the 1904 date system
+	assert.NoError(t, f.SetCellFormula("Sheet1", "A1", "=TEXT(DATE(2024,1,31),\"yyyy-mm-dd\")"))
+	result, err := f.CalcCellValue("Sheet1", "A1")
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-01-31", result)
+	assert.NoError(t, f.CalcSheet("Sheet1"))
+	// Test calculate cell value with unsupported charset workbook
+	f.WorkBook = nil
+	f.Pkg.Store(defaultXMLPathWorkbook, MacintoshCyrillicCharset)
+	_, err = f.CalcCellValue("Sheet1", "A1")
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+	assert.NoError(t, f.Close())
+}
+
 func TestCalcISBLANK(t *testing.T) {
 	argsList := list.New()
 	argsList.PushBack(formulaArg{
@@ -5160,6 +5309,12 @@ func TestCalcDatabase(t *testing.T) {
 		assert.NoError(t, err, formula)
 		assert.Equal(t, expected, result, formula)
 	}
+	// Test the text criteria of the database matches the values begin with the text
+	assert.NoError(t, f.SetSheetCol("Sheet1", "G1", &[]interface{}{"Tree", "ap"}))
+	assert.NoError(t, f.SetCellFormula("Sheet1", "A11", "=DCOUNTA(A4:E10,\"Tree\",G1:G2)"))
+	result, err := f.CalcCellValue("Sheet1", "A11")
+	assert.NoError(t, err)
+	assert.Equal(t, "3", result)
 	calcError := map[string][]string{
 		"=DAVERAGE()":                         {"#VALUE!", "DAVERAGE requires 3 arguments"},
 		"=DAVERAGE(A4:E10,\"x\",A1:F3)":       {"#VALUE!", "#VALUE!"},
@@ -5535,6 +5690,18 @@ func TestCalcSUMIFSAndAVERAGEIFS(t *testing.T) {
 		"=SUMIFS(D2:D13,A2:A13,1,D2:D13,\">100000\",C2:C13,\"Chris\")": "125000",
 		"=SUMIFS(D2:D13,A2:A13,1,D2:D13,\"<40000\",C2:C13,\"Chris\")":  "0",
 		"=SUMIFS(D2:D13,A2:A13,1,A2:A13,2)":                            "0",
+		"=SUMIFS(D2:D13,B2:B13,\"n*\",C2:C13,\"C*\")":                  "1104000",
+		"=SUMIFS(D2:D13,C2:C13,\"?eff\")":                              "1116000",
+		"=SUMIFS(D2:D13,C2:C13,\"<>C*\")":                              "1116000",
+		"=SUMIFS(D2:D13,C2:C13,\"=jeff\",B2:B13,\"<>south\")":          "1116000",
+		"=AVERAGEIFS(D2:D13,C2:C13,\"ca*\",A2:A13,\">=3\")":            "382500",
+		"=COUNTIFS(B2:B13,\"=north\",C2:C13,\"<>jeff\")":               "4",
+		"=COUNTIFS(C2:C13,\"*r*\")":                                    "8",
+		"=COUNTIFS(C2:C13,\"~*\")":                                     "0",
+		"=COUNTIFS(C2:C13,\"C?r*l\")":                                  "4",
+		"=COUNTIFS(A2:A13,\"\")":                                       "0",
+		"=COUNTIFS(E2:E13,\"\")":                                       "12",
+		"=COUNTIFS(A2:A13,\"<>\")":                                     "12",
 	}
 	for formula, expected := range formulaList {
 		assert.NoError(t, f.SetCellFormula("Sheet1", "E1", formula))
@@ -5560,6 +5727,23 @@ func TestCalcSUMIFSAndAVERAGEIFS(t *testing.T) {
 	}
 }
 
+func TestWildcardToRegexp(t *testing.T) {
+	for criteria, expected := range map[string]string{
+		"a*b?":   "(?i)^a.*b.$",
+		"a~*b~?": `(?i)^a\*b\?$`,
+		"~~.":    `(?i)^~\.$`,
+		"a~":     "(?i)^a~$",
+		"(a)*":   `(?i)^\(a\).*$`,
+	} {
+		pattern, ok := wildcardToRegexp(criteria)
+		assert.True(t, ok)
+		assert.Equal(t, expected, pattern, criteria)
+	}
+	pattern, ok := wildcardToRegexp("a.b")
+	assert.False(t, ok)
+	assert.Equal(t, "a.b", pattern)
+}
+
 func TestCalcXIRR(t *testing.T) {
 	cellData := [][]interface{}{
 		{-100.00, "01/01/2016"},
@@ -5703,6 +5887,45 @@ func TestCalcXLOOKUP(t *testing.T) {
 		assert.Equal(t, expected[0], result, formula)
 		assert.EqualError(t, err, expected[1], formula)
 	}
+
+	cellData = [][]interface{}{
+		{50, "E", 10, "A"},
+		{40, "D", 20, "B"},
+		{30, "C", 30, "C"},
+		{20, "B", 40, "D"},
+		{10, "A", 50, "E"},
+	}
+	f = prepareCalcData(cellData)
+	formulaList = map[string]string{
+		// Test approximate match with binary search in ascending order
+		"=XLOOKUP(35,C1:C5,D1:D5,NA(),1,2)":      "D",
+		"=XLOOKUP(35,C1:C5,D1:D5,NA(),-1,2)":     "C",
+		"=XLOOKUP(30,C1:C5,D1:D5,NA(),1,2)":      "C",
+		"=XLOOKUP(60,C1:C5,D1:D5,\"None\",1,2)":  "None",
+		"=XLOOKUP(35,E1:E5,D1:D5,\"None\",1,2)":  "None",
+		"=XLOOKUP(\"BB\",D1:D5,C1:C5,NA(),-1,2)": "20",
+		// Test approximate match with binary search in descending order
+		"=XLOOKUP(35,A1:A5,B1:B5,NA(),1,-2)":     "D",
+		"=XLOOKUP(35,A1:A5,B1:B5,NA(),-1,-2)":    "C",
+		"=XLOOKUP(30,A1:A5,B1:B5,NA(),-1,-2)":    "C",
+		"=XLOOKUP(5,A1:A5,B1:B5,\"None\",-1,-2)": "None",
+		"=XLOOKUP(\"BB\",B1:B5,A1:A5,NA(),1,-2)": "30",
+		// Test approximate match with linear search
+		"=XLOOKUP(35,C1:C5,D1:D5,NA(),-1,-1)":     "C",
+		"=XLOOKUP(35,A1:A5,B1:B5,NA(),1,-1)":      "D",
+		"=XLOOKUP(\"c\",D1:D5,C1:C5,NA(),1)":      "30",
+		"=XLOOKUP(\"BB\",D1:D5,C1:C5,NA(),1)":     "30",
+		"=XLOOKUP(\"BB\",D1:D5,C1:C5,NA(),-1,-1)": "20",
+		"=XLOOKUP(TRUE,D1:D5,C1:C5,NA(),-1)":      "50",
+		// Test return the first value of the result array for scalar use
+		"=XLOOKUP(30,C1:C5,A1:B5)": "30",
+	}
+	for formula, expected := range formulaList {
+		assert.NoError(t, f.SetCellFormula("Sheet1", "F1", formula))
+		result, err := f.CalcCellValue("Sheet1", "F1")
+		assert.NoError(t, err, formula)
+		assert.Equal(t, expected, result, formula)
+	}
 }
 
 func TestCalcXNPV(t *testing.T) {
@@ -6143,6 +6366,11 @@ func TestCalcNETWORKDAYSandWORKDAY(t *testing.T) {
 		"=NETWORKDAYS.INTL(\"01/01/2020\",\"09/12/2020\",1,C1:C2)":  "183",
 		"=WORKDAY(\"12/01/2015\",25)":                               "42374",
 		"=WORKDAY(\"01/01/2020\",123,B1:B12)":                       "44006",
+		"=WORKDAY(59,1)":                                            "60",
+		"=WORKDAY(62,-3)":                                           "59",
+		"=WORKDAY(15,-5,{9,10})":                                    "5",
+		"=NETWORKDAYS(1,14,{2,3})":                                  "8",
+		"=NETWORKDAYS(55,66)":                                       "8",
 		"=WORKDAY.INTL(\"12/01/2015\",0)":                           "42339",
 		"=WORKDAY.INTL(\"12/01/2015\",25)":                          "42374",
 		"=WORKDAY.INTL(\"12/01/2015\",-25)":                         "42304",
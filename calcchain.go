@@ -31,14 +31,114 @@ func (f *File) calcChainReader() (*xlsxCalcChain, error) {
 }
 
 // calcChainWriter provides a function to save xl/calcChain.xml after
-// serialize structure.
+// serialize structure. The cell references which don't contain formulas will
+// be removed from the calculation chain, and the calculation chain will be
+// removed if the DropCalcChain option is true.
 func (f *File) calcChainWriter() {
+	if f.options != nil && f.options.DropCalcChain {
+		_ = f.dropCalcChain()
+		return
+	}
 	if f.CalcChain != nil && f.CalcChain.C != nil {
+		if f.pruneCalcChain(); len(f.CalcChain.C) == 0 {
+			_ = f.deleteCalcChainPart()
+			return
+		}
 		output, _ := xml.Marshal(f.CalcChain)
 		f.saveFileList(defaultXMLPathCalcChain, output)
 	}
 }
 
+// pruneCalcChain provides a function to remove the cell references which
+// don't contain formulas in the loaded worksheets from the calculation chain.
+func (f *File) pruneCalcChain() {
+	formulaCells := map[int]map[string]struct{}{}
+	for sheetID, name := range f.GetSheetMap() {
+		sheetXMLPath, _ := f.getSheetXMLPath(name)
+		ws, ok := f.Sheet.Load(sheetXMLPath)
+		if _, streamed := f.streams[sheetXMLPath]; !ok || ws == nil || streamed {
+			continue
+		}
+		cells := map[string]struct{}{}
+		for _, row := range ws.(*xlsxWorksheet).SheetData.Row {
+			for _, c := range row.C {
+				if c.F != nil {
+					cells[c.R] = struct{}{}
+				}
+			}
+		}
+		formulaCells[sheetID] = cells
+	}
+	// If sheet ID is omitted, it is assumed to be the same as the i value of
+	// the previous cell, set it explicitly to keep the sheet ID of the cells
+	// unchanged after removing any cells from the chain.
+	var prevSheetID int
+	for i := range f.CalcChain.C {
+		if f.CalcChain.C[i].I == 0 {
+			f.CalcChain.C[i].I = prevSheetID
+		}
+		prevSheetID = f.CalcChain.C[i].I
+	}
+	f.CalcChain.C = xlsxCalcChainCollection(f.CalcChain.C).Filter(func(c xlsxCalcChainC) bool {
+		cells, ok := formulaCells[c.I]
+		if !ok {
+			return true
+		}
+		_, ok = cells[c.R]
+		return ok
+	})
+}
+
+// dropCalcChain provides a function to remove the calculation chain of the
+// workbook, and force the spreadsheet application to recalculate all formulas
+// when the workbook is opened.
+func (f *File) dropCalcChain() error {
+	if err := f.deleteCalcChainPart(); err != nil {
+		return err
+	}
+	wb, err := f.workbookReader()
+	if err != nil {
+		return err
+	}
+	if wb.CalcPr == nil {
+		wb.CalcPr = new(xlsxCalcPr)
+	}
+	wb.CalcPr.FullCalcOnLoad = true
+	return err
+}
+
+// deleteCalcChainPart provides a function to remove the calculation chain part
+// with its content type and relationship from the workbook.
+func (f *File) deleteCalcChainPart() error {
+	f.CalcChain = nil
+	f.Pkg.Delete(defaultXMLPathCalcChain)
+	content, err := f.contentTypesReader()
+	if err != nil {
+		return err
+	}
+	content.mu.Lock()
+	for k, v := range content.Overrides {
+		if v.PartName == "/xl/calcChain.xml" {
+			content.Overrides = append(content.Overrides[:k], content.Overrides[k+1:]...)
+			break
+		}
+	}
+	content.mu.Unlock()
+	rels, err := f.relsReader(f.getWorkbookRelsPath())
+	if err != nil || rels == nil {
+		return err
+	}
+	rels.mu.Lock()
+	defer rels.mu.Unlock()
+	for k, v := range rels.Relationships {
+		if v.Type == SourceRelationshipCalcChain {
+			rels.Relationships = append(rels.Relationships[:k], rels.Relationships[k+1:]...)
+			break
+		}
+	}
+	return err
+}
+
 // deleteCalcChain provides a function to remove cell reference on the
 // calculation chain.
 func (f *File) deleteCalcChain(index int, cell string) error {
@@ -52,19 +152,7 @@ func (f *File) deleteCalcChain(index int, cell string) error {
 		})
 	}
 	if len(calc.C) == 0 {
-		f.CalcChain = nil
-		f.Pkg.Delete(defaultXMLPathCalcChain)
-		content, err := f.contentTypesReader()
-		if err != nil {
-			return err
-		}
-		content.mu.Lock()
-		defer content.mu.Unlock()
-		for k, v := range content.Overrides {
-			if v.PartName == "/xl/calcChain.xml" {
-				content.Overrides = append(content.Overrides[:k], content.Overrides[k+1:]...)
-			}
-		}
+		return f.deleteCalcChainPart()
 	}
 	return err
 }
@@ -107,13 +195,3 @@ func (f *File) volatileDepsWriter() {
 		f.saveFileList(defaultXMLPathVolatileDeps, output)
 	}
 }
-
-// deleteVolTopicRef provides a function to remove cell reference on the
-// volatile dependencies topic.
-func (vt *xlsxVolTypes) deleteVolTopicRef(i1, i2, i3, i4 int) {
-	for i := range vt.VolType[i1].Main[i2].Tp[i3].Tr {
-		if i == i4 {
-			vt.VolType[i1].Main[i2].Tp[i3].Tr = append(vt.VolType[i1].Main[i2].Tp[i3].Tr[:i], vt.VolType[i1].Main[i2].Tp[i3].Tr[i+1:]...)
-		}
-	}
-}
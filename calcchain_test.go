@@ -1,6 +1,7 @@
 package excelize
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -44,3 +45,65 @@ func TestDeleteCalcChain(t *testing.T) {
 	f.Pkg.Store(defaultXMLPathContentTypes, MacintoshCyrillicCharset)
 	assert.EqualError(t, f.deleteCalcChain(1, "A1"), "XML syntax error on line 1: invalid UTF-8")
 }
+
+func TestCalcChainWriter(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetCellFormula("Sheet1", "A1", "=1+2"))
+	assert.NoError(t, f.SetCellFormula("Sheet1", "A2", "=A1*2"))
+	f.CalcChain = &xlsxCalcChain{C: []xlsxCalcChainC{{R: "A1", I: 1}, {R: "A2"}, {R: "A3"}, {R: "A1", I: 2}}}
+	// Test prune the cell references which don't contain formulas
+	f.calcChainWriter()
+	assert.Equal(t, []xlsxCalcChainC{{R: "A1", I: 1}, {R: "A2", I: 1}, {R: "A1", I: 2}}, f.CalcChain.C)
+	// Test prune the calculation chain with omitted sheet ID
+	f.CalcChain = nil
+	f.Pkg.Store(defaultXMLPathCalcChain, []byte(`<calcChain xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><c r="A3" i="1"/><c r="A1"/><c r="A2"/></calcChain>`))
+	_, err := f.calcChainReader()
+	assert.NoError(t, err)
+	f.calcChainWriter()
+	assert.Equal(t, []xlsxCalcChainC{{R: "A1", I: 1}, {R: "A2", I: 1}}, f.CalcChain.C)
+	// Test remove the calculation chain part without any formula cells
+	f.ContentTypes.Overrides = append(f.ContentTypes.Overrides, xlsxOverride{PartName: "/xl/calcChain.xml"})
+	f.Relationships.Store(defaultXMLPathWorkbookRels, &xlsxRelationships{Relationships: []xlsxRelationship{
+		{ID: "rId1", Type: SourceRelationshipCalcChain, Target: "calcChain.xml"},
+	}})
+	f.CalcChain = &xlsxCalcChain{C: []xlsxCalcChainC{{R: "B1", I: 1}}}
+	f.calcChainWriter()
+	assert.Nil(t, f.CalcChain)
+	_, ok := f.Pkg.Load(defaultXMLPathCalcChain)
+	assert.False(t, ok)
+	for _, override := range f.ContentTypes.Overrides {
+		assert.NotEqual(t, "/xl/calcChain.xml", override.PartName)
+	}
+	rels, err := f.relsReader(defaultXMLPathWorkbookRels)
+	assert.NoError(t, err)
+	assert.Empty(t, rels.Relationships)
+	assert.NoError(t, f.Close())
+
+	// Test save workbook with dropping the calculation chain
+	f = NewFile(Options{DropCalcChain: true})
+	assert.NoError(t, f.SetCellFormula("Sheet1", "A1", "=1+2"))
+	f.CalcChain = &xlsxCalcChain{C: []xlsxCalcChainC{{R: "A1", I: 1}}}
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestCalcChainWriter.xlsx")))
+	assert.Nil(t, f.CalcChain)
+	assert.True(t, f.WorkBook.CalcPr.FullCalcOnLoad)
+	assert.NoError(t, f.Close())
+	f, err = OpenFile(filepath.Join("test", "TestCalcChainWriter.xlsx"))
+	assert.NoError(t, err)
+	_, ok = f.Pkg.Load(defaultXMLPathCalcChain)
+	assert.False(t, ok)
+	assert.NoError(t, f.Close())
+
+	// Test drop the calculation chain with unsupported charset
+	f = NewFile()
+	f.ContentTypes = nil
+	f.Pkg.Store(defaultXMLPathContentTypes, MacintoshCyrillicCharset)
+	assert.EqualError(t, f.dropCalcChain(), "XML syntax error on line 1: invalid UTF-8")
+	f = NewFile()
+	f.WorkBook = nil
+	f.Pkg.Store(defaultXMLPathWorkbook, MacintoshCyrillicCharset)
+	assert.EqualError(t, f.dropCalcChain(), "XML syntax error on line 1: invalid UTF-8")
+	f = NewFile()
+	f.Relationships.Delete(defaultXMLPathWorkbookRels)
+	f.Pkg.Store(defaultXMLPathWorkbookRels, MacintoshCyrillicCharset)
+	assert.EqualError(t, f.deleteCalcChainPart(), "XML syntax error on line 1: invalid UTF-8")
+}
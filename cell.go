@@ -15,8 +15,10 @@ import (
 	"bytes"
 	"encoding/xml"
 	"fmt"
+	"math"
 	"os"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -77,6 +79,100 @@ func (f *File) GetCellValue(sheet, cell string, opts ...Options) (string, error)
 	})
 }
 
+// GetCellValueWithFormula provides a function to get formatted value and the
+// formula of the cell by given worksheet name and cell reference in
+// spreadsheet in one lookup. The value is the cached result of the formula,
+// and the formula will not be recalculated. The formula will be empty if the
+// cell doesn't contain a formula. This function is concurrency safe.
+func (f *File) GetCellValueWithFormula(sheet, cell string, opts ...Options) (string, string, error) {
+	var formula string
+	value, err := f.getCellStringFunc(sheet, cell, func(x *xlsxWorksheet, c *xlsxC) (string, bool, error) {
+		sst, err := f.sharedStringsReader()
+		if err != nil {
+			return "", true, err
+		}
+		if c.F != nil {
+			formula = c.F.Content
+			if c.F.T == STCellFormulaTypeShared && c.F.Si != nil {
+				formula = getSharedFormula(x, *c.F.Si, c.R)
+			}
+		}
+		val, err := c.getValueFrom(f, sst, f.getOptions(opts...).RawCellValue)
+		return val, true, err
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return value, formula, nil
+}
+
+// GetCellValues provides a function to get formatted values of the cells by
+// given worksheet name and cell references in spreadsheet. The values will be
+// returned in the order of the given cell references. If the worksheet has
+// not been loaded, it will be read in streaming mode until the last row of
+// the given cells without loading all cells into memory, so that it is cheap
+// to read a few cells from a worksheet with a large data. For example, get
+// the values of the cells "A1" and "B2" on Sheet1:
+//
+//	values, err := f.GetCellValues("Sheet1", []string{"A1", "B2"})
+func (f *File) GetCellValues(sheet string, cells []string, opts ...Options) ([]string, error) {
+	values := make([]string, len(cells))
+	if err := checkSheetName(sheet); err != nil {
+		return values, err
+	}
+	name, ok := f.getSheetXMLPath(sheet)
+	if !ok {
+		return values, ErrSheetNotExist{sheet}
+	}
+	if worksheet, ok := f.Sheet.Load(name); ok && worksheet != nil {
+		for i, cell := range cells {
+			value, err := f.GetCellValue(sheet, cell, opts...)
+			if err != nil {
+				return values, err
+			}
+			values[i] = value
+		}
+		return values, nil
+	}
+	coordinates, maxRow := make(map[int]map[int][]int), 0
+	for i, cell := range cells {
+		col, row, err := CellNameToCoordinates(cell)
+		if err != nil {
+			return values, err
+		}
+		if coordinates[row] == nil {
+			coordinates[row] = make(map[int][]int)
+		}
+		coordinates[row][col] = append(coordinates[row][col], i)
+		if row > maxRow {
+			maxRow = row
+		}
+	}
+	rows, err := f.Rows(sheet)
+	if err != nil {
+		return values, err
+	}
+	for rows.Next() && rows.seekRow <= maxRow {
+		if coordinates[rows.seekRow] == nil {
+			continue
+		}
+		row, err := rows.Columns(opts...)
+		if err != nil {
+			_ = rows.Close()
+			return values, err
+		}
+		for col, indexes := range coordinates[rows.seekRow] {
+			if col > len(row) {
+				continue
+			}
+			for _, i := range indexes {
+				values[i] = row[col-1]
+			}
+		}
+	}
+	return values, rows.Close()
+}
+
 // GetCellType provides a function to get the cell's data type by given
 // worksheet name and cell reference in spreadsheet file.
 func (f *File) GetCellType(sheet, cell string) (CellType, error) {
@@ -156,6 +252,125 @@ func (f *File) SetCellValue(sheet, cell string, value interface{}) error {
 	return err
 }
 
+// SetCellValues provides a function to set the values of multiple cells in
+// one pass by given worksheet name and the map of cell reference and value.
+// The cell references are validated before any value is written, and the
+// cells are written in row-major order, so the rows and cells of the
+// worksheet are allocated once. The supported data types of the values are
+// the same as the SetCellValue function. This function is useful to write a
+// large number of scattered cells which don't fit the row-ordered model of
+// the StreamWriter. For example, set values for cells A1, C3 and B10 in
+// Sheet1:
+//
+//	err := f.SetCellValues("Sheet1", map[string]interface{}{
+//	    "A1": "Name", "C3": 100, "B10": true,
+//	})
+func (f *File) SetCellValues(sheet string, values map[string]interface{}) error {
+	f.mu.Lock()
+	ws, err := f.workSheetReader(sheet)
+	if err != nil {
+		f.mu.Unlock()
+		return err
+	}
+	f.mu.Unlock()
+	date1904, err := f.isDate1904()
+	if err != nil {
+		return err
+	}
+	type cellValue struct {
+		col, row int
+		value    interface{}
+	}
+	cells := make([]cellValue, 0, len(values))
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	for cell, value := range values {
+		ref, err := ws.mergeCellsParser(cell)
+		if err != nil {
+			return err
+		}
+		col, row, err := CellNameToCoordinates(ref)
+		if err != nil {
+			return err
+		}
+		cells = append(cells, cellValue{col: col, row: row, value: value})
+	}
+	sort.Slice(cells, func(i, j int) bool {
+		if cells[i].row != cells[j].row {
+			return cells[i].row < cells[j].row
+		}
+		return cells[i].col < cells[j].col
+	})
+	// Allocate the rows and cells from the last row before writing the values
+	for i := len(cells) - 1; i >= 0; i-- {
+		if i == len(cells)-1 || cells[i].row != cells[i+1].row {
+			ws.prepareSheetXML(cells[i].col, cells[i].row)
+		}
+	}
+	for _, cell := range cells {
+		c := &ws.SheetData.Row[cell.row-1].C[cell.col-1]
+		c.S = ws.prepareCellStyle(cell.col, cell.row, c.S)
+		if err = f.setCellValue(ws, sheet, c, cell.value, date1904); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// setCellValue provides a function to set the value of the prepared cell by
+// the data type of the given value, the worksheet should be locked by the
+// caller.
+func (f *File) setCellValue(ws *xlsxWorksheet, sheet string, c *xlsxC, value interface{}, date1904 bool) error {
+	switch v := value.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		setCellIntFunc(c, v)
+	case float32:
+		c.T, c.V = f.formatCellFloat(float64(v), -1, 32)
+	case float64:
+		c.T, c.V = f.formatCellFloat(v, -1, 64)
+	case string:
+		return f.setCellStr(ws, sheet, c, v)
+	case []byte:
+		return f.setCellStr(ws, sheet, c, string(v))
+	case time.Duration:
+		_, d := setCellDuration(v)
+		c.setCellDefault(d)
+		if err := f.setCellDefaultTimeStyle(c, 21); err != nil {
+			return err
+		}
+		return f.removeFormula(c, ws, sheet)
+	case time.Time:
+		isNum, err := c.setCellTime(v, date1904)
+		if err != nil {
+			return err
+		}
+		if isNum {
+			return f.setCellDefaultTimeStyle(c, 22)
+		}
+		return err
+	case bool:
+		c.T, c.V = setCellBool(v)
+	case nil:
+		c.setCellDefault("")
+		return f.removeFormula(c, ws, sheet)
+	default:
+		return f.setCellStr(ws, sheet, c, fmt.Sprint(value))
+	}
+	c.IS = nil
+	return f.removeFormula(c, ws, sheet)
+}
+
+// setCellDefaultTimeStyle provides a function to set the default number
+// format of date and time for the cell without style.
+func (f *File) setCellDefaultTimeStyle(c *xlsxC, format int) error {
+	if c.S != 0 {
+		return nil
+	}
+	style, err := f.NewStyle(&Style{NumFmt: format})
+	c.S = style
+	return err
+}
+
 // String extracts characters from a string item.
 func (x xlsxSI) String() string {
 	var value strings.Builder
@@ -178,8 +393,11 @@ func (c *xlsxC) hasValue() bool {
 	return c.S != 0 || c.V != "" || c.F != nil || c.T != ""
 }
 
-// removeFormula delete formula for the cell.
+// removeFormula delete formula for the cell, and the automatic number stored as
+// text ignored error of the cell will be deleted, since the cell value has been
+// overwritten.
 func (f *File) removeFormula(c *xlsxC, ws *xlsxWorksheet, sheet string) error {
+	ws.deleteIgnoredError(c.R, xlsxIgnoredError{NumberStoredAsText: true})
 	if c.F != nil && c.Vm == nil {
 		sheetID := f.getSheetID(sheet)
 		if err := f.deleteCalcChain(sheetID, c.R); err != nil {
@@ -243,15 +461,12 @@ func (f *File) setCellTimeFunc(sheet, cell string, value time.Time) error {
 	ws.mu.Lock()
 	c.S = ws.prepareCellStyle(col, row, c.S)
 	ws.mu.Unlock()
-	var date1904, isNum bool
-	wb, err := f.workbookReader()
+	date1904, err := f.isDate1904()
 	if err != nil {
 		return err
 	}
-	if wb != nil && wb.WorkbookPr != nil {
-		date1904 = wb.WorkbookPr.Date1904
-	}
-	if isNum, err = c.setCellTime(value, date1904); err != nil {
+	isNum, err := c.setCellTime(value, date1904)
+	if err != nil {
 		return err
 	}
 	if isNum {
@@ -380,10 +595,20 @@ func setCellBool(value bool) (t string, v string) {
 // parameter specifies how many places after the decimal will be shown
 // while -1 is a special value that will use as many decimal places as
 // necessary to represent the number. bitSize is 32 or 64 depending on if a
-// float32 or float64 was originally used for the value. For Example:
+// float32 or float64 was originally used for the value. If the RoundFloat
+// option was set, the value will be rounded to 15 significant digits as the
+// spreadsheet application does, and stored in plain decimal notation without
+// exponent, the halfway values will be rounded to the nearest even digit. For
+// Example:
 //
 //	var x float32 = 1.325
 //	f.SetCellFloat("Sheet1", "A1", float64(x), 2, 32)
+//
+// The value 0.1+0.2 will be stored as 0.3 instead of 0.30000000000000004 with
+// the RoundFloat option:
+//
+//	f := excelize.NewFile(excelize.Options{RoundFloat: true})
+//	err := f.SetCellFloat("Sheet1", "A1", 0.1+0.2, -1, 64)
 func (f *File) SetCellFloat(sheet, cell string, value float64, precision, bitSize int) error {
 	f.mu.Lock()
 	ws, err := f.workSheetReader(sheet)
@@ -399,7 +624,7 @@ func (f *File) SetCellFloat(sheet, cell string, value float64, precision, bitSiz
 		return err
 	}
 	c.S = ws.prepareCellStyle(col, row, c.S)
-	c.T, c.V = setCellFloat(value, precision, bitSize)
+	c.T, c.V = f.formatCellFloat(value, precision, bitSize)
 	c.IS = nil
 	return f.removeFormula(c, ws, sheet)
 }
@@ -411,8 +636,51 @@ func setCellFloat(value float64, precision, bitSize int) (t string, v string) {
 	return
 }
 
+// formatCellFloat prepares cell type and string type cell value by a given
+// float value, the value will be rounded to 15 significant digits if the
+// RoundFloat option was set.
+func (f *File) formatCellFloat(value float64, precision, bitSize int) (t string, v string) {
+	if f.options != nil && f.options.RoundFloat {
+		return setCellSignificantFloat(value, precision, bitSize)
+	}
+	return setCellFloat(value, precision, bitSize)
+}
+
+// setCellSignificantFloat prepares cell type and string type cell value by a
+// given float value. The value will be rounded to 15 significant digits first,
+// and then formatted with given precision in plain decimal notation.
+func setCellSignificantFloat(value float64, precision, bitSize int) (t string, v string) {
+	if math.IsInf(value, 0) || math.IsNaN(value) {
+		return setCellFloat(value, precision, bitSize)
+	}
+	value, _ = strconv.ParseFloat(strconv.FormatFloat(value, 'g', 15, bitSize), bitSize)
+	if value == 0 || precision < 0 {
+		return setCellFloat(value, precision, bitSize)
+	}
+	// The decimal places after the 15 significant digits will be filled by 0
+	exp, _ := strconv.Atoi(strings.Split(strconv.FormatFloat(value, 'e', 14, bitSize), "e")[1])
+	places := precision
+	if places > 14-exp {
+		if places = 14 - exp; places < 0 {
+			places = 0
+		}
+	}
+	if v = strconv.FormatFloat(value, 'f', places, bitSize); places < precision {
+		if places == 0 {
+			v = strconv.FormatFloat(value, 'f', -1, bitSize) + "."
+		}
+		v += strings.Repeat("0", precision-places)
+	}
+	return
+}
+
 // SetCellStr provides a function to set string type value of a cell. Total
-// number of characters that a cell can contain 32767 characters.
+// number of characters that a cell can contain 32767 characters. The value
+// will be stored as inline string if the InlineString option was set. The
+// value is always stored as text even if it looks like a number, so that the
+// leading zeros of the value such as "007" will be kept, and the "number
+// stored as text" error indicator of the cell will be suppressed if the
+// KeepLeadingZeros option was set.
 func (f *File) SetCellStr(sheet, cell, value string) error {
 	f.mu.Lock()
 	ws, err := f.workSheetReader(sheet)
@@ -428,11 +696,238 @@ func (f *File) SetCellStr(sheet, cell, value string) error {
 		return err
 	}
 	c.S = ws.prepareCellStyle(col, row, c.S)
-	if c.T, c.V, err = f.setCellString(value); err != nil {
+	return f.setCellStr(ws, sheet, c, value)
+}
+
+// setCellStr provides a function to set string type value of the prepared
+// cell, the worksheet should be locked by the caller.
+func (f *File) setCellStr(ws *xlsxWorksheet, sheet string, c *xlsxC, value string) error {
+	var err error
+	if f.options != nil && f.options.InlineString {
+		c.T, c.V, c.IS = "inlineStr", "", &xlsxSI{T: &xlsxT{}}
+		c.IS.T.Val, c.IS.T.Space = trimCellValue(value, false)
+	} else {
+		if c.T, c.V, err = f.setCellString(value); err != nil {
+			return err
+		}
+		c.IS = nil
+	}
+	if err = f.removeFormula(c, ws, sheet); err != nil {
 		return err
 	}
-	c.IS = nil
-	return f.removeFormula(c, ws, sheet)
+	if f.options != nil && f.options.KeepLeadingZeros {
+		if isNum, _, _ := isNumeric(value); isNum {
+			ws.addIgnoredError(c.R, xlsxIgnoredError{NumberStoredAsText: true})
+		}
+	}
+	return err
+}
+
+// addIgnoredError provides a function to add the cell range reference into
+// the ignored errors of the worksheet which has the same error types with the
+// given ignored error, a new ignored error will be created if not exist. The
+// cell range references are kept in the index of the ignored errors, and will
+// be merged into the ignored errors by the mergeIgnoredErrors function.
+func (ws *xlsxWorksheet) addIgnoredError(ref string, ignoredError xlsxIgnoredError) {
+	if ws.IgnoredErrors == nil {
+		ws.IgnoredErrors = &xlsxIgnoredErrors{}
+	}
+	if ws.ignoredErrors == nil {
+		ws.loadIgnoredErrors()
+	}
+	ignoredError.Sqref = ""
+	refs, ok := ws.ignoredErrors[ignoredError]
+	if !ok {
+		refs = make(map[string]struct{})
+		ws.ignoredErrors[ignoredError] = refs
+		ws.IgnoredErrors.IgnoredError = append(ws.IgnoredErrors.IgnoredError, ignoredError)
+	}
+	refs[ref] = struct{}{}
+}
+
+// deleteIgnoredError provides a function to delete the cell reference from the
+// ignored errors of the worksheet which has the same error types with the
+// given ignored error. The cell reference inside the cell ranges will be kept.
+func (ws *xlsxWorksheet) deleteIgnoredError(ref string, ignoredError xlsxIgnoredError) {
+	if ws.IgnoredErrors == nil {
+		return
+	}
+	if ws.ignoredErrors == nil {
+		ws.loadIgnoredErrors()
+	}
+	ignoredError.Sqref = ""
+	if refs, ok := ws.ignoredErrors[ignoredError]; ok {
+		delete(refs, ref)
+	}
+}
+
+// loadIgnoredErrors provides a function to create the index of the cell range
+// references of the ignored errors by error types, the ignored errors with the
+// same error types will be combined.
+func (ws *xlsxWorksheet) loadIgnoredErrors() {
+	ws.ignoredErrors = make(map[xlsxIgnoredError]map[string]struct{})
+	var ignoredErrors []xlsxIgnoredError
+	for _, item := range ws.IgnoredErrors.IgnoredError {
+		sqref := item.Sqref
+		item.Sqref = ""
+		refs, ok := ws.ignoredErrors[item]
+		if !ok {
+			refs = make(map[string]struct{})
+			ws.ignoredErrors[item] = refs
+			ignoredErrors = append(ignoredErrors, item)
+		}
+		for _, ref := range strings.Fields(sqref) {
+			refs[ref] = struct{}{}
+		}
+	}
+	ws.IgnoredErrors.IgnoredError = ignoredErrors
+}
+
+// mergeIgnoredErrors provides a function to set the range references of the
+// ignored errors from the index of the ignored errors, and the adjacent cells
+// will be merged into cell ranges. The ignored errors without any range
+// references will be removed. The worksheet should be locked by the caller.
+func (ws *xlsxWorksheet) mergeIgnoredErrors() {
+	if ws.IgnoredErrors == nil || ws.ignoredErrors == nil {
+		return
+	}
+	var ignoredErrors []xlsxIgnoredError
+	for _, item := range ws.IgnoredErrors.IgnoredError {
+		key := item
+		key.Sqref = ""
+		if refs, ok := ws.ignoredErrors[key]; ok {
+			if len(refs) == 0 {
+				delete(ws.ignoredErrors, key)
+				continue
+			}
+			item.Sqref = mergeCellRefs(refs)
+		}
+		ignoredErrors = append(ignoredErrors, item)
+	}
+	if ws.IgnoredErrors.IgnoredError = ignoredErrors; len(ignoredErrors) == 0 {
+		ws.IgnoredErrors = nil
+	}
+}
+
+// mergeCellRefs provides a function to merge the adjacent cells in the given
+// cell references into cell ranges, and returns a space-separated list of the
+// cell and range references. The adjacent cells in each column will be merged
+// first, and then the merged cells with the same rows in the adjacent columns.
+func mergeCellRefs(refs map[string]struct{}) string {
+	var (
+		cells  = map[int][]int{}
+		runs   = map[[2]int][]int{}
+		rects  [][]int
+		ranges []string
+	)
+	for ref := range refs {
+		if col, row, err := CellNameToCoordinates(ref); err == nil {
+			cells[col] = append(cells[col], row)
+			continue
+		}
+		ranges = append(ranges, ref)
+	}
+	for col, rows := range cells {
+		sort.Ints(rows)
+		for start, i := 0, 1; i <= len(rows); i++ {
+			if i == len(rows) || rows[i] != rows[i-1]+1 {
+				runs[[2]int{rows[start], rows[i-1]}] = append(runs[[2]int{rows[start], rows[i-1]}], col)
+				start = i
+			}
+		}
+	}
+	for run, cols := range runs {
+		sort.Ints(cols)
+		for start, i := 0, 1; i <= len(cols); i++ {
+			if i == len(cols) || cols[i] != cols[i-1]+1 {
+				rects = append(rects, []int{cols[start], run[0], cols[i-1], run[1]})
+				start = i
+			}
+		}
+	}
+	sort.Slice(rects, func(i, j int) bool {
+		if rects[i][1] != rects[j][1] {
+			return rects[i][1] < rects[j][1]
+		}
+		return rects[i][0] < rects[j][0]
+	})
+	sqref := make([]string, 0, len(rects)+len(ranges))
+	for _, rect := range rects {
+		if rect[0] == rect[2] && rect[1] == rect[3] {
+			cell, _ := CoordinatesToCellName(rect[0], rect[1])
+			sqref = append(sqref, cell)
+			continue
+		}
+		ref, _ := coordinatesToRangeRef(rect)
+		sqref = append(sqref, ref)
+	}
+	sort.Strings(ranges)
+	return strings.Join(append(sqref, ranges...), " ")
+}
+
+// SetCellWrapText provides a function to set string type value of a cell with
+// the wrap text alignment, and adjust the height of the row to display all
+// lines of the value. The line breaks (\n or \r\n) in the value will split
+// the value into multiple lines, the other properties of the cell style will
+// be kept. The row height will be calculated by the number of lines and the
+// font size of the cell or the default font size of the workbook, and it will
+// not be reduced if the row is already higher than the calculated height. The
+// row height will not be changed if it has been set by the SetRowHeight
+// function. For example, set a value with three lines in the cell A1 on
+// Sheet1:
+//
+//	err := f.SetCellWrapText("Sheet1", "A1", "Line 1\nLine 2\nLine 3")
+func (f *File) SetCellWrapText(sheet, cell, value string) error {
+	value = strings.ReplaceAll(value, "\r\n", "\n")
+	if err := f.SetCellStr(sheet, cell, value); err != nil {
+		return err
+	}
+	styleID, err := f.GetCellStyle(sheet, cell)
+	if err != nil {
+		return err
+	}
+	style, err := f.GetStyle(styleID)
+	if err != nil {
+		return err
+	}
+	if style.Alignment == nil {
+		style.Alignment = &Alignment{}
+	}
+	style.Alignment.WrapText = true
+	if styleID, err = f.NewStyle(style); err != nil {
+		return err
+	}
+	if err = f.SetCellStyle(sheet, cell, cell, styleID); err != nil {
+		return err
+	}
+	font, err := f.readDefaultFont()
+	if err != nil {
+		return err
+	}
+	fontSize := 11.0
+	if font.Sz != nil && font.Sz.Val != nil {
+		fontSize = *font.Sz.Val
+	}
+	if style.Font != nil && style.Font.Size >= MinFontSize {
+		fontSize = style.Font.Size
+	}
+	_, row, _ := CellNameToCoordinates(cell)
+	height := math.Min(float64(strings.Count(value, "\n")+1)*defaultRowHeight*fontSize/11, MaxRowHeight)
+	f.mu.Lock()
+	ws, err := f.workSheetReader(sheet)
+	if err != nil {
+		f.mu.Unlock()
+		return err
+	}
+	f.mu.Unlock()
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	r := &ws.SheetData.Row[row-1]
+	if r.CustomHeight || (r.Ht != nil && *r.Ht >= height) {
+		return err
+	}
+	r.Ht = float64Ptr(height)
+	return err
 }
 
 // setCellString provides a function to set string type to shared string table.
@@ -489,6 +984,7 @@ func (f *File) setSharedString(val string) (int, error) {
 	}
 	sst.mu.Lock()
 	defer sst.mu.Unlock()
+	f.touchPart(defaultXMLPathSharedStrings)
 	t := xlsxT{Val: val}
 	val, t.Space = trimCellValue(val, false)
 	sst.SI = append(sst.SI, xlsxSI{T: &t})
@@ -590,7 +1086,11 @@ func (c *xlsxC) getCellDate(f *File, raw bool) (string, error) {
 			layout = "2006-01-02 15:04:05Z"
 		}
 		if timestamp, err := time.Parse(layout, strings.ReplaceAll(c.V, ",", ".")); err == nil {
-			excelTime, _ := timeToExcelTime(timestamp, false)
+			date1904, err := f.isDate1904()
+			if err != nil {
+				return c.V, err
+			}
+			excelTime, _ := timeToExcelTime(timestamp, date1904)
 			c.V = strconv.FormatFloat(excelTime, 'G', 15, 64)
 		}
 	}
@@ -660,7 +1160,10 @@ func (f *File) SetCellDefault(sheet, cell, value string) error {
 }
 
 // GetCellFormula provides a function to get formula from cell by given
-// worksheet name and cell reference in spreadsheet.
+// worksheet name and cell reference in spreadsheet. The formula of the data
+// table (what-if analysis) cell will be returned as the "TABLE" function with
+// the row and column input cells, such as "TABLE(,A1)", which could be used
+// to set the data table formula by the SetCellFormula function.
 func (f *File) GetCellFormula(sheet, cell string) (string, error) {
 	return f.getCellFormula(sheet, cell, false)
 }
@@ -684,14 +1187,37 @@ func (f *File) getCellFormula(sheet, cell string, transformed bool) (string, err
 		if c.F.T == STCellFormulaTypeShared && c.F.Si != nil {
 			return getSharedFormula(x, *c.F.Si, c.R), true, nil
 		}
+		if !transformed && c.F.T == STCellFormulaTypeDataTable {
+			return getDataTableFormula(c.F), true, nil
+		}
 		return c.F.Content, true, nil
 	})
 }
 
 // FormulaOpts can be passed to SetCellFormula to use other formula types.
+// The Result specifies the cached result value of the formula which will be
+// written into the cell, so that the applications which doesn't calculate
+// formulas could read the value directly, it supports integer, float, string
+// and boolean type values.
+//
+// The R1, R2, Dt2D and Dtr are used for the data table (what-if analysis)
+// formula: R1 specifies the first input cell, R2 specifies the second input
+// cell for the two-dimensional data table, Dt2D specifies the data table is
+// two-dimensional, and Dtr specifies the one-dimensional data table is a row
+// input (true) or a column input (false).
+//
+// The Aca specifies the array formula should always be calculated, and Ca
+// specifies the formula should be calculated on the next recalculation.
 type FormulaOpts struct {
-	Type *string // Formula type
-	Ref  *string // Shared formula ref
+	Type   *string     // Formula type
+	Ref    *string     // Shared formula ref
+	Result interface{} // Cached formula result
+	R1     *string     // First input cell of data table
+	R2     *string     // Second input cell of data table
+	Dt2D   bool        // Two-dimensional data table
+	Dtr    bool        // One-dimensional data table is a row input
+	Aca    bool        // Always calculate array
+	Ca     bool        // Calculate cell
 }
 
 // SetCellFormula provides a function to set formula on the cell is taken
@@ -736,7 +1262,29 @@ type FormulaOpts struct {
 //	err := f.SetCellFormula("Sheet1", "C1", "=A1+B1",
 //	    excelize.FormulaOpts{Ref: &ref, Type: &formulaType})
 //
-// Example 7, set table formula "=SUM(Table1[[A]:[B]])" for the cell "C2"
+// Example 7, set legacy CSE array formula "{=SUM(A1:A2*B1:B2)}" for the cell
+// "A3" on "Sheet1", which is equivalent to set the array formula with the
+// reference "A3":
+//
+//	err := f.SetCellFormula("Sheet1", "A3", "{=SUM(A1:A2*B1:B2)}")
+//
+// Example 8, set one-variable data table with column input cell "A1" for the
+// cells "C2:C5" on "Sheet1", the input cells will be parsed from the "TABLE"
+// formula in the form of "TABLE(row input cell,column input cell)" if the R1
+// field of the formula options is not specified, the other formulas without
+// the R1 field will be set as the table formula like the example 10:
+//
+//	formulaType, ref := excelize.STCellFormulaTypeDataTable, "C2:C5"
+//	err := f.SetCellFormula("Sheet1", "C2", "=TABLE(,A1)",
+//	    excelize.FormulaOpts{Type: &formulaType, Ref: &ref})
+//
+// Example 9, set formula "=SUM(A1,B1)" with the cached result 3 for the
+// cell "A3" on "Sheet1":
+//
+//	err := f.SetCellFormula("Sheet1", "A3", "=SUM(A1,B1)",
+//	    excelize.FormulaOpts{Result: 3})
+//
+// Example 10, set table formula "=SUM(Table1[[A]:[B]])" for the cell "C2"
 // on "Sheet1":
 //
 //	package main
@@ -789,16 +1337,30 @@ func (f *File) SetCellFormula(sheet, cell, formula string, opts ...FormulaOpts)
 		c.F = nil
 		return f.deleteCalcChain(f.getSheetID(sheet), cell)
 	}
+	if strings.HasPrefix(formula, "{=") && strings.HasSuffix(formula, "}") {
+		formulaType, ref := STCellFormulaTypeArray, cell
+		for _, opt := range opts {
+			if opt.Ref != nil {
+				ref = *opt.Ref
+			}
+		}
+		formula = strings.TrimSuffix(strings.TrimPrefix(formula, "{"), "}")
+		opts = append([]FormulaOpts{{Type: &formulaType, Ref: &ref}}, opts...)
+	}
 
 	if c.F != nil {
-		c.F.Content = formula
+		c.F.Content, c.F.Aca, c.F.Ca = formula, false, false
 	} else {
 		c.F = &xlsxF{Content: formula}
 	}
 
 	for _, opt := range opts {
+		c.F.Aca, c.F.Ca = c.F.Aca || opt.Aca, c.F.Ca || opt.Ca
 		if opt.Type != nil {
 			if *opt.Type == STCellFormulaTypeDataTable {
+				if opt.Ref != nil && (opt.R1 != nil || isDataTableFormula(formula)) {
+					return f.setDataTableFormula(c, formula, opt)
+				}
 				return err
 			}
 			c.F.T = *opt.Type
@@ -818,9 +1380,206 @@ func (f *File) SetCellFormula(sheet, cell, formula string, opts ...FormulaOpts)
 		}
 	}
 	c.T, c.IS = "str", nil
+	for _, opt := range opts {
+		if opt.Result != nil {
+			err = f.setCellFormulaResult(c, opt.Result)
+		}
+	}
+	return err
+}
+
+// SetSharedFormula provides a function to set the shared formula for the cells
+// in the given range reference of the worksheet. The top-left cell of the
+// range will be the master cell which holds the formula text, and the other
+// cells in the range will reference the formula of the master cell by the
+// shared formula index, the relative references in the formula will be
+// adjusted for each cell when it is calculated or read by the GetCellFormula
+// function. Using the shared formula instead of setting the same relative
+// formula for each cell can reduce the size of the generated spreadsheet. Any
+// existing formula and value of the cells in the range will be replaced, and
+// an error will be returned if the range contains the master cell of an
+// existing shared formula which extends outside the range. For example, set
+// shared formula "=A1+B1" for the cells "C1:C5" on "Sheet1":
+//
+//	err := f.SetSharedFormula("Sheet1", "C1:C5", "=A1+B1")
+func (f *File) SetSharedFormula(sheet, ref, formula string) error {
+	if formula == "" {
+		return ErrParameterInvalid
+	}
+	coordinates, err := rangeRefToCoordinates(ref)
+	if err != nil {
+		return err
+	}
+	_ = sortCoordinates(coordinates)
+	ws, err := f.workSheetReader(sheet)
+	if err != nil {
+		return err
+	}
+	if err = ws.checkSharedFormulaOverlap(coordinates); err != nil {
+		return err
+	}
+	for col := coordinates[0]; col <= coordinates[2]; col++ {
+		for row := coordinates[1]; row <= coordinates[3]; row++ {
+			ws.prepareSheetXML(col, row)
+			c := &ws.SheetData.Row[row-1].C[col-1]
+			c.F, c.T, c.V, c.IS = nil, "", "", nil
+		}
+	}
+	master, _ := CoordinatesToCellName(coordinates[0], coordinates[1])
+	formulaType := STCellFormulaTypeShared
+	ref, _ = coordinatesToRangeRef(coordinates)
+	return f.SetCellFormula(sheet, master, formula, FormulaOpts{Type: &formulaType, Ref: &ref})
+}
+
+// checkSharedFormulaOverlap provides a function to check if the given range
+// contains the master cell of an existing shared formula which extends outside
+// the range, the cells following that shared formula will lose their formula
+// if the master cell was overwritten.
+func (ws *xlsxWorksheet) checkSharedFormulaOverlap(coordinates []int) error {
+	inRange := func(col, row int) bool {
+		return col >= coordinates[0] && col <= coordinates[2] &&
+			row >= coordinates[1] && row <= coordinates[3]
+	}
+	for _, row := range ws.SheetData.Row {
+		for _, c := range row.C {
+			if c.F == nil || c.F.T != STCellFormulaTypeShared || c.F.Ref == "" {
+				continue
+			}
+			col, r, err := CellNameToCoordinates(c.R)
+			if err != nil {
+				return err
+			}
+			if !inRange(col, r) {
+				continue
+			}
+			existing, err := rangeRefToCoordinates(c.F.Ref)
+			if err != nil {
+				return err
+			}
+			_ = sortCoordinates(existing)
+			if !inRange(existing[0], existing[1]) || !inRange(existing[2], existing[3]) {
+				ref, _ := coordinatesToRangeRef(coordinates)
+				return newSharedFormulaOverlapError(ref, c.F.Ref)
+			}
+		}
+	}
+	return nil
+}
+
+// setDataTableFormula provides a function to set the data table (what-if
+// analysis) formula for the top-left cell of the data table range by given
+// cell, formula and formula settings. The input cells will be parsed from the
+// "TABLE" formula if the R1 field of the formula settings is not specified.
+func (f *File) setDataTableFormula(c *xlsxC, text string, opt FormulaOpts) error {
+	coordinates, err := rangeRefToCoordinates(*opt.Ref)
+	if err != nil {
+		return err
+	}
+	_ = sortCoordinates(coordinates)
+	ref, _ := coordinatesToRangeRef(coordinates)
+	formula := &xlsxF{T: STCellFormulaTypeDataTable, Ref: ref, Dt2D: opt.Dt2D, Dtr: opt.Dtr, Ca: true}
+	if opt.R1 == nil {
+		if opt, err = parseDataTableFormula(text, opt); err != nil {
+			return err
+		}
+		formula.Dt2D, formula.Dtr = opt.Dt2D, opt.Dtr
+	}
+	if opt.R1 != nil {
+		if _, _, err = CellNameToCoordinates(*opt.R1); err != nil {
+			return err
+		}
+		formula.R1 = *opt.R1
+	}
+	if opt.R2 != nil {
+		if _, _, err = CellNameToCoordinates(*opt.R2); err != nil {
+			return err
+		}
+		formula.R2 = *opt.R2
+	}
+	if formula.R1 == "" || (formula.Dt2D && formula.R2 == "") {
+		return ErrParameterInvalid
+	}
+	c.F, c.T, c.IS = formula, "", nil
+	if opt.Result != nil {
+		return f.setCellFormulaResult(c, opt.Result)
+	}
 	return err
 }
 
+// isDataTableFormula provides a function to check if the given formula is the
+// "TABLE" formula with the input cells of the data table.
+func isDataTableFormula(formula string) bool {
+	formula = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(formula), "="))
+	return len(formula) >= 6 && strings.EqualFold(formula[:6], "TABLE(")
+}
+
+// parseDataTableFormula provides a function to parse the row and column input
+// cells of the data table by given "TABLE" formula, such as "TABLE(A1,B1)",
+// and returns the formula settings with the input cells and data table type.
+func parseDataTableFormula(formula string, opt FormulaOpts) (FormulaOpts, error) {
+	if !isDataTableFormula(formula) {
+		return opt, ErrParameterInvalid
+	}
+	formula = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(formula), "="))
+	if !strings.HasSuffix(formula, ")") {
+		return opt, ErrParameterInvalid
+	}
+	args := strings.Split(formula[6:len(formula)-1], ",")
+	if len(args) != 2 {
+		return opt, ErrParameterInvalid
+	}
+	row, col := strings.TrimSpace(args[0]), strings.TrimSpace(args[1])
+	switch {
+	case row != "" && col != "":
+		opt.R1, opt.R2, opt.Dt2D, opt.Dtr = &row, &col, true, true
+	case row != "":
+		opt.R1, opt.Dt2D, opt.Dtr = &row, false, true
+	case col != "":
+		opt.R1, opt.Dt2D, opt.Dtr = &col, false, false
+	default:
+		return opt, ErrParameterInvalid
+	}
+	return opt, nil
+}
+
+// getDataTableFormula provides a function to get the "TABLE" formula with the
+// row and column input cells by given data table formula.
+func getDataTableFormula(formula *xlsxF) string {
+	if formula.Dt2D {
+		if formula.Dtr {
+			return fmt.Sprintf("TABLE(%s,%s)", formula.R1, formula.R2)
+		}
+		return fmt.Sprintf("TABLE(%s,%s)", formula.R2, formula.R1)
+	}
+	if formula.Dtr {
+		return fmt.Sprintf("TABLE(%s,)", formula.R1)
+	}
+	return fmt.Sprintf("TABLE(,%s)", formula.R1)
+}
+
+// setCellFormulaResult provides a function to set the cached result value of
+// the formula cell by given cell and formula result value.
+func (f *File) setCellFormulaResult(c *xlsxC, result interface{}) error {
+	switch v := result.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		setCellIntFunc(c, v)
+	case float32:
+		c.T, c.V = f.formatCellFloat(float64(v), -1, 32)
+	case float64:
+		c.T, c.V = f.formatCellFloat(v, -1, 64)
+	case string:
+		if utf8.RuneCountInString(v) > TotalCellChars {
+			return ErrCellCharsLength
+		}
+		c.T, c.V = "str", v
+	case bool:
+		c.T, c.V = setCellBool(v)
+	default:
+		return ErrParameterInvalid
+	}
+	return nil
+}
+
 // setArrayFormula transform the array formula in an array formula range to the
 // normal formula and set cells in this range to the formula as the normal
 // formula.
@@ -950,6 +1709,53 @@ func (f *File) GetCellHyperLink(sheet, cell string) (bool, string, error) {
 	return false, "", err
 }
 
+// Hyperlink directly maps the settings of the hyperlink in the worksheet. The
+// Type is "External" for the link to a website or file, or "Location" for the
+// link to a location in this workbook.
+type Hyperlink struct {
+	Type    string
+	Link    string
+	Display string
+	Tooltip string
+}
+
+// GetHyperlinks provides a function to get all hyperlinks in the worksheet by
+// given worksheet name. It returns a map which the key is the cell reference
+// or range reference of the hyperlink. The display text is the value of the
+// cell when the hyperlink doesn't specify it. It returns an empty map if the
+// worksheet doesn't have any hyperlinks. For example, get all hyperlinks on a
+// worksheet named 'Sheet1':
+//
+//	links, err := f.GetHyperlinks("Sheet1")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	for ref, link := range links {
+//	    fmt.Println(ref, link.Type, link.Link, link.Display, link.Tooltip)
+//	}
+func (f *File) GetHyperlinks(sheet string) (map[string]Hyperlink, error) {
+	links := map[string]Hyperlink{}
+	ws, err := f.workSheetReader(sheet)
+	if err != nil || ws.Hyperlinks == nil {
+		return links, err
+	}
+	for _, link := range ws.Hyperlinks.Hyperlink {
+		hyperlink := Hyperlink{Type: "Location", Link: link.Location, Display: link.Display, Tooltip: link.Tooltip}
+		if link.RID != "" {
+			hyperlink.Type = "External"
+			hyperlink.Link = f.getSheetRelationshipsTargetByID(sheet, link.RID)
+		}
+		if hyperlink.Display == "" {
+			if hyperlink.Display, err = f.GetCellValue(sheet, strings.Split(link.Ref, ":")[0]); err != nil {
+				return links, err
+			}
+		}
+		links[link.Ref] = hyperlink
+	}
+	return links, err
+}
+
 // HyperlinkOpts can be passed to SetCellHyperlink to set optional hyperlink
 // attributes (e.g. display value)
 type HyperlinkOpts struct {
@@ -1332,6 +2138,7 @@ func (f *File) SetCellRichText(sheet, cell string, runs []RichTextRun) error {
 			return err
 		}
 	}
+	f.touchPart(defaultXMLPathSharedStrings)
 	sst.SI = append(sst.SI, si)
 	sst.Count++
 	sst.UniqueCount++
@@ -1480,14 +2287,10 @@ func (f *File) formattedValue(c *xlsxC, raw bool, cellType CellType) (string, er
 	if styleSheet.CellXfs.Xf[c.S].NumFmtID != nil {
 		numFmtID = *styleSheet.CellXfs.Xf[c.S].NumFmtID
 	}
-	date1904 := false
-	wb, err := f.workbookReader()
+	date1904, err := f.isDate1904()
 	if err != nil {
 		return c.V, err
 	}
-	if wb != nil && wb.WorkbookPr != nil {
-		date1904 = wb.WorkbookPr.Date1904
-	}
 	if fmtCode, ok := styleSheet.getCustomNumFmtCode(numFmtID); ok {
 		return format(c.V, fmtCode, date1904, cellType, f.options), err
 	}
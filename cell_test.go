@@ -99,6 +99,8 @@ func TestConcurrency(t *testing.T) {
 			assert.NoError(t, f.AddDataValidation("Sheet1", dv))
 			// Concurrency delete data validation with reference sequence
 			assert.NoError(t, f.DeleteDataValidation("Sheet1", dv.Sqref))
+			// Concurrency group rows
+			assert.NoError(t, f.GroupRows("Sheet1", 30, 31))
 			wg.Done()
 		}(i, t)
 	}
@@ -112,6 +114,10 @@ func TestConcurrency(t *testing.T) {
 	dataValidations, err := f.GetDataValidations("Sheet1")
 	assert.NoError(t, err)
 	assert.Len(t, dataValidations, 0)
+	// Test the outline level of the grouped rows
+	level, err := f.GetRowOutlineLevel("Sheet1", 30)
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(5), level)
 	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestConcurrency.xlsx")))
 	assert.NoError(t, f.Close())
 }
@@ -186,6 +192,53 @@ func TestSetCellFloat(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, "123.42", val, "A1 should be 123.42")
 	})
+
+	t.Run("with 15 significant digits and plain decimal notation", func(t *testing.T) {
+		f := NewFile()
+		// Test the float value will not be rounded by default
+		x, y := 0.1, 0.2
+		assert.NoError(t, f.SetCellFloat(sheet, "A1", x+y, -1, 64))
+		assert.NoError(t, f.SetCellValue(sheet, "A2", 1e20))
+		assert.NoError(t, f.SetCellValues(sheet, map[string]interface{}{"A3": 1.0 / 3.0}))
+		ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+		assert.True(t, ok)
+		for i, expected := range []string{"0.30000000000000004", "100000000000000000000", "0.3333333333333333"} {
+			assert.Equal(t, expected, ws.(*xlsxWorksheet).SheetData.Row[i].C[0].V)
+		}
+		f = NewFile(Options{RoundFloat: true})
+		for _, c := range []struct {
+			value     float64
+			precision int
+			bitSize   int
+			expected  string
+		}{
+			{x + y, -1, 64, "0.3"},
+			{x + y, 20, 64, "0.30000000000000000000"},
+			{1.0 / 3.0, -1, 64, "0.333333333333333"},
+			{1e20, -1, 64, "100000000000000000000"},
+			{1.5e-10, -1, 64, "0.00000000015"},
+			{123456789012345678, -1, 64, "123456789012346000"},
+			{123456789012345678, 2, 64, "123456789012346000.00"},
+			{1234567890.123456789, 8, 64, "1234567890.12346000"},
+			{float64(float32(1.325)), -1, 32, "1.325"},
+			{0.125, 2, 64, "0.12"},
+			{0.375, 2, 64, "0.38"},
+			{-2.5, 0, 64, "-2"},
+		} {
+			assert.NoError(t, f.SetCellFloat(sheet, "A1", c.value, c.precision, c.bitSize))
+			ws, ok = f.Sheet.Load("xl/worksheets/sheet1.xml")
+			assert.True(t, ok)
+			assert.Equal(t, c.expected, ws.(*xlsxWorksheet).SheetData.Row[0].C[0].V)
+		}
+		assert.NoError(t, f.SetCellFloat(sheet, "A1", math.Inf(1), -1, 64))
+		assert.NoError(t, f.SetCellValue(sheet, "A2", 1.0/3.0))
+		assert.NoError(t, f.SetCellValues(sheet, map[string]interface{}{"A3": x + y}))
+		ws, ok = f.Sheet.Load("xl/worksheets/sheet1.xml")
+		assert.True(t, ok)
+		for i, expected := range []string{"+Inf", "0.333333333333333", "0.3"} {
+			assert.Equal(t, expected, ws.(*xlsxWorksheet).SheetData.Row[i].C[0].V)
+		}
+	})
 	f := NewFile()
 	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), f.SetCellFloat(sheet, "A", 123.42, -1, 64))
 	// Test set cell float data type value with invalid sheet name
@@ -294,6 +347,110 @@ func TestSetCellValue(t *testing.T) {
 	assert.Equal(t, "b", val)
 }
 
+func TestSetCellInlineString(t *testing.T) {
+	f := NewFile(Options{InlineString: true})
+	assert.NoError(t, f.SetCellFormula("Sheet1", "A1", "B1"))
+	for cell, value := range map[string]string{"A1": " <a&b> ", "A2": "_x0000_", "A3": strings.Repeat("c", TotalCellChars+1)} {
+		assert.NoError(t, f.SetCellValue("Sheet1", cell, value))
+	}
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	c := ws.(*xlsxWorksheet).SheetData.Row[0].C[0]
+	assert.Equal(t, "inlineStr", c.T)
+	assert.Nil(t, c.F)
+	assert.Equal(t, " <a&b> ", c.IS.T.Val)
+	assert.Equal(t, "preserve", c.IS.T.Space.Value)
+	buf, err := f.WriteToBuffer()
+	assert.NoError(t, err)
+	f, err = OpenReader(buf)
+	assert.NoError(t, err)
+	for cell, value := range map[string]string{"A1": " <a&b> ", "A2": "_x0000_", "A3": strings.Repeat("c", TotalCellChars)} {
+		val, err := f.GetCellValue("Sheet1", cell)
+		assert.NoError(t, err)
+		assert.Equal(t, value, val)
+	}
+	sst, err := f.sharedStringsReader()
+	assert.NoError(t, err)
+	assert.Empty(t, sst.SI)
+	assert.NoError(t, f.Close())
+}
+
+func TestSetCellKeepLeadingZeros(t *testing.T) {
+	f := NewFile(Options{KeepLeadingZeros: true})
+	for cell, value := range map[string]interface{}{"A1": "007", "A2": []byte("01234"), "A3": "Name", "B1": "1e3"} {
+		assert.NoError(t, f.SetCellValue("Sheet1", cell, value))
+	}
+	assert.NoError(t, f.SetCellStr("Sheet1", "A1", "0070"))
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	ws.(*xlsxWorksheet).mergeIgnoredErrors()
+	ignoredErrors := ws.(*xlsxWorksheet).IgnoredErrors
+	assert.Len(t, ignoredErrors.IgnoredError, 1)
+	assert.True(t, ignoredErrors.IgnoredError[0].NumberStoredAsText)
+	assert.Equal(t, "A1:A2 B1", ignoredErrors.IgnoredError[0].Sqref)
+	// Test keep the existing ignored errors with other error types
+	ws.(*xlsxWorksheet).IgnoredErrors.IgnoredError = append([]xlsxIgnoredError{{Sqref: "C1", EvalError: true}}, ignoredErrors.IgnoredError...)
+	assert.NoError(t, f.SetCellStr("Sheet1", "C1", "08"))
+	buf, err := f.WriteToBuffer()
+	assert.NoError(t, err)
+	f, err = OpenReader(buf)
+	assert.NoError(t, err)
+	for cell, value := range map[string]string{"A1": "0070", "A2": "01234", "C1": "08"} {
+		val, err := f.GetCellValue("Sheet1", cell)
+		assert.NoError(t, err)
+		assert.Equal(t, value, val)
+		cellType, err := f.GetCellType("Sheet1", cell)
+		assert.NoError(t, err)
+		assert.Equal(t, CellTypeSharedString, cellType)
+	}
+	sheet, err := f.workSheetReader("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, []xlsxIgnoredError{
+		{Sqref: "C1", EvalError: true},
+		{Sqref: "A1:A2 B1:C1", NumberStoredAsText: true},
+	}, sheet.IgnoredErrors.IgnoredError)
+	assert.NoError(t, f.Close())
+
+	// Test delete the ignored errors when overwrite the cells
+	f = NewFile(Options{KeepLeadingZeros: true})
+	for _, cell := range []string{"A1", "A2", "A3", "A4"} {
+		assert.NoError(t, f.SetCellStr("Sheet1", cell, "007"))
+	}
+	assert.NoError(t, f.SetCellInt("Sheet1", "A1", 7))
+	assert.NoError(t, f.SetCellValue("Sheet1", "A2", "Name"))
+	opts, err := f.GetIgnoredErrors("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, []IgnoredErrorOptions{{SQRef: "A3:A4", NumberStoredAsText: true}}, opts)
+	// Test adjust the ignored errors when insert and remove rows
+	assert.NoError(t, f.InsertRows("Sheet1", 1, 2))
+	opts, err = f.GetIgnoredErrors("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, []IgnoredErrorOptions{{SQRef: "A5:A6", NumberStoredAsText: true}}, opts)
+	assert.NoError(t, f.SetCellInt("Sheet1", "A6", 7))
+	assert.NoError(t, f.RemoveRow("Sheet1", 1))
+	opts, err = f.GetIgnoredErrors("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, []IgnoredErrorOptions{{SQRef: "A4", NumberStoredAsText: true}}, opts)
+	assert.NoError(t, f.RemoveRows("Sheet1", []int{4}))
+	opts, err = f.GetIgnoredErrors("Sheet1")
+	assert.NoError(t, err)
+	assert.Empty(t, opts)
+	assert.NoError(t, f.SetCellStr("Sheet1", "A1", "007"))
+	opts, err = f.GetIgnoredErrors("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, []IgnoredErrorOptions{{SQRef: "A1", NumberStoredAsText: true}}, opts)
+	assert.NoError(t, f.Close())
+}
+
+func TestMergeCellRefs(t *testing.T) {
+	refs := map[string]struct{}{}
+	for _, ref := range []string{"B2", "A1", "D5", "A2", "B1", "C4:C6", "A4"} {
+		refs[ref] = struct{}{}
+	}
+	assert.Equal(t, "A1:B2 A4 D5 C4:C6", mergeCellRefs(refs))
+	assert.Empty(t, mergeCellRefs(nil))
+}
+
 func TestSetCellValues(t *testing.T) {
 	f := NewFile()
 	err := f.SetCellValue("Sheet1", "A1", time.Date(2010, time.December, 31, 0, 0, 0, 0, time.UTC))
@@ -310,6 +467,62 @@ func TestSetCellValues(t *testing.T) {
 	v, err = f.GetCellValue("Sheet1", "A1")
 	assert.NoError(t, err)
 	assert.Equal(t, v, "1600-12-31T00:00:00Z")
+
+	// Test set values of multiple cells in one pass
+	f = NewFile()
+	assert.NoError(t, f.MergeCell("Sheet1", "E1", "F2"))
+	assert.NoError(t, f.SetCellValues("Sheet1", map[string]interface{}{
+		"C3": 100, "a1": "Name", "B10": true, "B3": 1.5, "F2": "Merged", "D10": nil,
+	}))
+	rows, err := f.GetRows("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"Name", "", "", "", "Merged"}, nil, {"", "1.5", "100"}, nil, nil, nil, nil, nil, nil, {"", "TRUE"},
+	}, rows)
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	assert.Len(t, ws.(*xlsxWorksheet).SheetData.Row, 10)
+	assert.Len(t, ws.(*xlsxWorksheet).SheetData.Row[9].C, 4)
+	// Test set values of multiple cells with the same result as SetCellValue
+	f = NewFile()
+	values := map[string]interface{}{
+		"A1": int8(1), "B1": uint16(2), "C1": float32(0.5), "D1": []byte("bytes"),
+		"E1": time.Duration(1e13), "F1": time.Date(2010, time.December, 31, 0, 0, 0, 0, time.UTC),
+		"G1": time.Date(1600, time.December, 31, 0, 0, 0, 0, time.UTC), "H1": struct{}{}, "I1": false,
+	}
+	expected := NewFile()
+	for cell, value := range values {
+		assert.NoError(t, expected.SetCellValue("Sheet1", cell, value))
+	}
+	assert.NoError(t, f.SetCellFormula("Sheet1", "E1", "SUM(A1:B1)"))
+	assert.NoError(t, f.SetCellValues("Sheet1", values))
+	for cell := range values {
+		val, err := f.GetCellValue("Sheet1", cell)
+		assert.NoError(t, err)
+		expectedVal, err := expected.GetCellValue("Sheet1", cell)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedVal, val, cell)
+	}
+	formula, err := f.GetCellFormula("Sheet1", "E1")
+	assert.NoError(t, err)
+	assert.Empty(t, formula)
+	assert.NoError(t, expected.Close())
+	// Test set values of multiple cells with invalid cell reference
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), f.SetCellValues("Sheet1", map[string]interface{}{"A": 1}))
+	assert.Equal(t, ErrColumnNumber, f.SetCellValues("Sheet1", map[string]interface{}{"XFE1": 1}))
+	v, err = f.GetCellValue("Sheet1", "A1")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", v)
+	// Test set values of multiple cells with invalid sheet name
+	assert.Equal(t, ErrSheetNameInvalid, f.SetCellValues("Sheet:1", map[string]interface{}{"A1": 1}))
+	// Test set values of multiple cells with unsupported charset shared strings table
+	f.SharedStrings = nil
+	f.Pkg.Store(defaultXMLPathSharedStrings, MacintoshCyrillicCharset)
+	assert.EqualError(t, f.SetCellValues("Sheet1", map[string]interface{}{"A1": "Name"}), "XML syntax error on line 1: invalid UTF-8")
+	// Test set values of multiple cells with unsupported charset workbook
+	f.WorkBook = nil
+	f.Pkg.Store(defaultXMLPathWorkbook, MacintoshCyrillicCharset)
+	assert.EqualError(t, f.SetCellValues("Sheet1", map[string]interface{}{"A1": 1}), "XML syntax error on line 1: invalid UTF-8")
 }
 
 func TestSetCellBool(t *testing.T) {
@@ -592,6 +805,86 @@ func TestGetCellFormula(t *testing.T) {
 	assert.EqualError(t, f.setArrayFormulaCells(), "XML syntax error on line 1: invalid UTF-8")
 }
 
+func TestGetCellValueWithFormula(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetCellValue("Sheet1", "A1", 1.5))
+	assert.NoError(t, f.SetCellFormula("Sheet1", "B1", "A1*2", FormulaOpts{Result: 3}))
+	value, formula, err := f.GetCellValueWithFormula("Sheet1", "A1")
+	assert.NoError(t, err)
+	assert.Equal(t, "1.5", value)
+	assert.Empty(t, formula)
+	value, formula, err = f.GetCellValueWithFormula("Sheet1", "B1")
+	assert.NoError(t, err)
+	assert.Equal(t, "3", value)
+	assert.Equal(t, "A1*2", formula)
+	// Test get value and formula from not exist cell
+	value, formula, err = f.GetCellValueWithFormula("Sheet1", "C10")
+	assert.NoError(t, err)
+	assert.Empty(t, value)
+	assert.Empty(t, formula)
+	// Test get value and formula of the shared formula cell
+	f.Sheet.Delete("xl/worksheets/sheet1.xml")
+	f.Pkg.Store("xl/worksheets/sheet1.xml", []byte(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData><row r="1"><c r="A1"><v>1</v></c><c r="B1"><f t="shared" ref="B1:B2" si="0">2*A1</f><v>2</v></c></row><row r="2"><c r="A2"><v>2</v></c><c r="B2"><f t="shared" si="0"/><v>4</v></c></row></sheetData></worksheet>`))
+	value, formula, err = f.GetCellValueWithFormula("Sheet1", "B2")
+	assert.NoError(t, err)
+	assert.Equal(t, "4", value)
+	assert.Equal(t, "2*A2", formula)
+	// Test get value and formula on not exist worksheet
+	_, _, err = f.GetCellValueWithFormula("SheetN", "A1")
+	assert.EqualError(t, err, "sheet SheetN does not exist")
+	// Test get value and formula with invalid cell reference
+	_, _, err = f.GetCellValueWithFormula("Sheet1", "A")
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), err)
+	// Test get value and formula with unsupported charset shared strings table
+	f.SharedStrings = nil
+	f.Pkg.Store(defaultXMLPathSharedStrings, MacintoshCyrillicCharset)
+	_, _, err = f.GetCellValueWithFormula("Sheet1", "A1")
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+}
+
+func TestGetCellValues(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetSheetRow("Sheet1", "A1", &[]interface{}{"A", 1.5, true}))
+	assert.NoError(t, f.SetSheetRow("Sheet1", "B3", &[]interface{}{"B", 2}))
+	cells, expected := []string{"C1", "A1", "C3", "A2", "D10", "A1"}, []string{"TRUE", "A", "2", "", "", "A"}
+	// Test get the cell values from loaded worksheet
+	values, err := f.GetCellValues("Sheet1", cells)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, values)
+	workbookPath := filepath.Join("test", "TestGetCellValues.xlsx")
+	assert.NoError(t, f.SaveAs(workbookPath))
+	assert.NoError(t, f.Close())
+
+	// Test get the cell values in streaming mode
+	f, err = OpenFile(workbookPath)
+	assert.NoError(t, err)
+	values, err = f.GetCellValues("Sheet1", cells)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, values)
+	_, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.False(t, ok)
+	// Test get the cell values with invalid cell reference
+	_, err = f.GetCellValues("Sheet1", []string{"A"})
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), err)
+	// Test get the cell values on not exist worksheet
+	_, err = f.GetCellValues("SheetN", cells)
+	assert.EqualError(t, err, "sheet SheetN does not exist")
+	// Test get the cell values with invalid worksheet name
+	_, err = f.GetCellValues("Sheet:1", cells)
+	assert.Equal(t, ErrSheetNameInvalid, err)
+	// Test get the cell values with unsupported charset shared strings table
+	f.SharedStrings = nil
+	f.Pkg.Store(defaultXMLPathSharedStrings, MacintoshCyrillicCharset)
+	_, err = f.GetCellValues("Sheet1", cells)
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+	assert.NoError(t, f.Close())
+
+	// Test get the cell values from loaded worksheet with invalid cell reference
+	f = NewFile()
+	_, err = f.GetCellValues("Sheet1", []string{"A"})
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), err)
+}
+
 func ExampleFile_SetCellFloat() {
 	f := NewFile()
 	defer func() {
@@ -626,6 +919,32 @@ func BenchmarkSetCellValue(b *testing.B) {
 	}
 }
 
+func BenchmarkSetCellValues(b *testing.B) {
+	cells := make(map[string]interface{}, 10000)
+	for i := 0; i < 10000; i++ {
+		cell, _ := CoordinatesToCellName(i*7%26+1, i*13%1000+1)
+		cells[cell] = i
+	}
+	b.Run("SetCellValue", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			f := NewFile()
+			for cell, value := range cells {
+				if err := f.SetCellValue("Sheet1", cell, value); err != nil {
+					b.Error(err)
+				}
+			}
+		}
+	})
+	b.Run("SetCellValues", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			f := NewFile()
+			if err := f.SetCellValues("Sheet1", cells); err != nil {
+				b.Error(err)
+			}
+		}
+	})
+}
+
 func TestOverflowNumericCell(t *testing.T) {
 	f, err := OpenFile(filepath.Join("test", "OverflowNumericCell.xlsx"))
 	if !assert.NoError(t, err) {
@@ -703,6 +1022,170 @@ func TestSetCellFormula(t *testing.T) {
 	// Test set array formula with invalid cell reference
 	formulaType, ref = STCellFormulaTypeArray, "A1:A2"
 	assert.Equal(t, ErrColumnNumber, f.SetCellFormula("Sheet1", "A1", "SUM(XFE1:XFE2)", FormulaOpts{Ref: &ref, Type: &formulaType}))
+
+	// Test set legacy CSE array formula
+	f = NewFile()
+	assert.NoError(t, f.SetCellFormula("Sheet1", "C1", "{=SUM(A1:A2*B1:B2)}", FormulaOpts{Aca: true}))
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	assert.Equal(t, &xlsxF{Content: "=SUM(A1:A2*B1:B2)", T: STCellFormulaTypeArray, Ref: "C1", Aca: true},
+		ws.(*xlsxWorksheet).SheetData.Row[0].C[2].F)
+	// Test set legacy CSE array formula with the given reference
+	ref = "D1:D2"
+	assert.NoError(t, f.SetCellFormula("Sheet1", "D1", "{=A1:A2*B1:B2}", FormulaOpts{Ref: &ref}))
+	assert.Equal(t, &xlsxF{Content: "=A1:A2*B1:B2", T: STCellFormulaTypeArray, Ref: "D1:D2"},
+		ws.(*xlsxWorksheet).SheetData.Row[0].C[3].F)
+	// Test rewrite formula clears the calculation flags
+	assert.NoError(t, f.SetCellFormula("Sheet1", "C1", "=SUM(A1:A2)"))
+	assert.Equal(t, &xlsxF{Content: "=SUM(A1:A2)", T: STCellFormulaTypeArray, Ref: "C1"},
+		ws.(*xlsxWorksheet).SheetData.Row[0].C[2].F)
+	// Test set data table formula
+	formulaType, ref = STCellFormulaTypeDataTable, "E5:E2"
+	r1, r2 := "A1", "B1"
+	assert.NoError(t, f.SetCellFormula("Sheet1", "E2", "=TABLE(,A1)", FormulaOpts{Type: &formulaType, Ref: &ref, R1: &r1, Result: 1}))
+	assert.Equal(t, &xlsxF{T: STCellFormulaTypeDataTable, Ref: "E2:E5", R1: "A1", Ca: true},
+		ws.(*xlsxWorksheet).SheetData.Row[1].C[4].F)
+	assert.Equal(t, "1", ws.(*xlsxWorksheet).SheetData.Row[1].C[4].V)
+	ref = "G2:H5"
+	assert.NoError(t, f.SetCellFormula("Sheet1", "G2", "=TABLE(A1,B1)", FormulaOpts{Type: &formulaType, Ref: &ref, R1: &r1, R2: &r2, Dt2D: true, Dtr: true}))
+	assert.Equal(t, &xlsxF{T: STCellFormulaTypeDataTable, Ref: "G2:H5", R1: "A1", R2: "B1", Dt2D: true, Dtr: true, Ca: true},
+		ws.(*xlsxWorksheet).SheetData.Row[1].C[6].F)
+	// Test set data table formula with invalid settings
+	assert.Equal(t, ErrParameterInvalid, f.SetCellFormula("Sheet1", "G2", "=TABLE(A1,B1)", FormulaOpts{Type: &formulaType, Ref: &ref, R1: &r1, Dt2D: true}))
+	for _, formula := range []string{"=TABLE(A1)", "=TABLE(,)", "=TABLE(A1,B1"} {
+		assert.Equal(t, ErrParameterInvalid, f.SetCellFormula("Sheet1", "G2", formula, FormulaOpts{Type: &formulaType, Ref: &ref}))
+	}
+	_, err = parseDataTableFormula("=SUM(A1,B1)", FormulaOpts{})
+	assert.Equal(t, ErrParameterInvalid, err)
+	// Test set table formula with the reference and without input cells
+	assert.NoError(t, f.SetCellFormula("Sheet1", "K2", "=SUM(A1,B1)", FormulaOpts{Type: &formulaType, Ref: &ref}))
+	assert.Equal(t, &xlsxF{Content: "=SUM(A1,B1)"}, ws.(*xlsxWorksheet).SheetData.Row[1].C[10].F)
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), f.SetCellFormula("Sheet1", "G2", "=TABLE(A,B1)", FormulaOpts{Type: &formulaType, Ref: &ref}))
+	// Test get and set data table formula parsed from the input cells
+	for cell, formula := range map[string]string{"E2": "TABLE(,A1)", "G2": "TABLE(A1,B1)"} {
+		result, err := f.GetCellFormula("Sheet1", cell)
+		assert.NoError(t, err)
+		assert.Equal(t, formula, result)
+	}
+	assert.NoError(t, f.SetCellFormula("Sheet1", "J2", "=TABLE(A1,)", FormulaOpts{Type: &formulaType, Ref: &ref}))
+	assert.Equal(t, &xlsxF{T: STCellFormulaTypeDataTable, Ref: "G2:H5", R1: "A1", Dtr: true, Ca: true},
+		ws.(*xlsxWorksheet).SheetData.Row[1].C[9].F)
+	result, err := f.GetCellFormula("Sheet1", "J2")
+	assert.NoError(t, err)
+	assert.Equal(t, "TABLE(A1,)", result)
+	assert.NoError(t, f.SetCellFormula("Sheet1", "J2", "=TABLE(B1, A1)", FormulaOpts{Type: &formulaType, Ref: &ref}))
+	assert.Equal(t, &xlsxF{T: STCellFormulaTypeDataTable, Ref: "G2:H5", R1: "B1", R2: "A1", Dt2D: true, Dtr: true, Ca: true},
+		ws.(*xlsxWorksheet).SheetData.Row[1].C[9].F)
+	ws.(*xlsxWorksheet).SheetData.Row[1].C[9].F.Dtr = false
+	result, err = f.GetCellFormula("Sheet1", "J2")
+	assert.NoError(t, err)
+	assert.Equal(t, "TABLE(A1,B1)", result)
+	r1, r2 = "A", "B"
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), f.SetCellFormula("Sheet1", "G2", "=TABLE(A1,B1)", FormulaOpts{Type: &formulaType, Ref: &ref, R1: &r1}))
+	r1 = "A1"
+	assert.Equal(t, newCellNameToCoordinatesError("B", newInvalidCellNameError("B")), f.SetCellFormula("Sheet1", "G2", "=TABLE(A1,B1)", FormulaOpts{Type: &formulaType, Ref: &ref, R1: &r1, R2: &r2}))
+	ref = "G2"
+	assert.Equal(t, ErrParameterInvalid, f.SetCellFormula("Sheet1", "G2", "=TABLE(A1,B1)", FormulaOpts{Type: &formulaType, Ref: &ref, R1: &r1}))
+	assert.NoError(t, f.Close())
+
+	// Test set formula with cached result
+	f = NewFile()
+	for cell, result := range map[string]interface{}{
+		"A1": 3, "A2": uint8(3), "A3": float32(1.5), "A4": 0.3, "A5": "text", "A6": true,
+	} {
+		assert.NoError(t, f.SetCellFormula("Sheet1", cell, "=B1", FormulaOpts{Result: result}))
+	}
+	for cell, expected := range map[string][]string{
+		"A1": {"", "3"}, "A2": {"", "3"}, "A3": {"", "1.5"}, "A4": {"", "0.3"}, "A5": {"str", "text"}, "A6": {"b", "1"},
+	} {
+		ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+		assert.True(t, ok)
+		c, _, _, err := ws.(*xlsxWorksheet).prepareCell(cell)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, []string{c.T, c.V}, cell)
+		assert.Equal(t, "=B1", c.F.Content)
+	}
+	val, err := f.GetCellValue("Sheet1", "A5")
+	assert.NoError(t, err)
+	assert.Equal(t, "text", val)
+	val, err = f.GetCellValue("Sheet1", "A6")
+	assert.NoError(t, err)
+	assert.Equal(t, "TRUE", val)
+	// Test set formula with unsupported cached result type
+	assert.Equal(t, ErrParameterInvalid, f.SetCellFormula("Sheet1", "A7", "=B1", FormulaOpts{Result: []int{1}}))
+	// Test set formula with exceeds length limit cached result
+	assert.Equal(t, ErrCellCharsLength, f.SetCellFormula("Sheet1", "A7", "=B1", FormulaOpts{Result: strings.Repeat("c", TotalCellChars+1)}))
+	assert.NoError(t, f.Close())
+
+	// Test set formula with cached float result and the RoundFloat option
+	f = NewFile(Options{RoundFloat: true})
+	assert.NoError(t, f.SetCellFormula("Sheet1", "A1", "=0.1+0.2", FormulaOpts{Result: 0.1 + 0.2}))
+	ws, ok = f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	assert.Equal(t, "0.3", ws.(*xlsxWorksheet).SheetData.Row[0].C[0].V)
+	assert.NoError(t, f.Close())
+}
+
+func TestSetSharedFormula(t *testing.T) {
+	f := NewFile()
+	for r := 1; r <= 5; r++ {
+		assert.NoError(t, f.SetSheetRow("Sheet1", fmt.Sprintf("A%d", r), &[]interface{}{r, r + 1}))
+	}
+	assert.NoError(t, f.SetCellFormula("Sheet1", "C3", "=A3*B3"))
+	assert.NoError(t, f.SetCellValue("Sheet1", "C4", "text"))
+	assert.NoError(t, f.SetSharedFormula("Sheet1", "C5:C1", "=A1+B1"))
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	master := ws.(*xlsxWorksheet).SheetData.Row[0].C[2]
+	assert.Equal(t, &xlsxF{Content: "=A1+B1", T: STCellFormulaTypeShared, Ref: "C1:C5", Si: intPtr(0)}, master.F)
+	for r := 2; r <= 5; r++ {
+		c := ws.(*xlsxWorksheet).SheetData.Row[r-1].C[2]
+		assert.Equal(t, &xlsxF{T: STCellFormulaTypeShared, Si: intPtr(0)}, c.F)
+		assert.Empty(t, c.V)
+		formula, err := f.GetCellFormula("Sheet1", fmt.Sprintf("C%d", r))
+		assert.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("=A%d+B%d", r, r), formula)
+	}
+	assert.NoError(t, f.SetSharedFormula("Sheet1", "D1:E2", "=A1*2"))
+	formula, err := f.GetCellFormula("Sheet1", "E2")
+	assert.NoError(t, err)
+	assert.Equal(t, "=B2*2", formula)
+	assert.Equal(t, 2, ws.(*xlsxWorksheet).countSharedFormula())
+	// Test set shared formula for the range which contains the whole existing
+	// shared formula
+	assert.NoError(t, f.SetSharedFormula("Sheet1", "D1:E3", "=A1*3"))
+	formula, err = f.GetCellFormula("Sheet1", "E2")
+	assert.NoError(t, err)
+	assert.Equal(t, "=B2*3", formula)
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestSetSharedFormula.xlsx")))
+	// Test set shared formula for the range which overlaps with the master cell
+	// of the existing shared formula
+	assert.Equal(t, newSharedFormulaOverlapError("A1:C2", "C1:C5"), f.SetSharedFormula("Sheet1", "A1:C2", "=1"))
+	formula, err = f.GetCellFormula("Sheet1", "C5")
+	assert.NoError(t, err)
+	assert.Equal(t, "=A5+B5", formula)
+	// Test set shared formula for the range which overlaps with the follower
+	// cells of the existing shared formula
+	assert.NoError(t, f.SetSharedFormula("Sheet1", "C4:C5", "=A4-B4"))
+	formula, err = f.GetCellFormula("Sheet1", "C3")
+	assert.NoError(t, err)
+	assert.Equal(t, "=A3+B3", formula)
+	formula, err = f.GetCellFormula("Sheet1", "C5")
+	assert.NoError(t, err)
+	assert.Equal(t, "=A5-B5", formula)
+	// Test set shared formula with invalid parameters
+	assert.Equal(t, ErrParameterInvalid, f.SetSharedFormula("Sheet1", "C1:C5", ""))
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), f.SetSharedFormula("Sheet1", "A:B1", "=A1"))
+	// Test set shared formula on not exists worksheet
+	assert.EqualError(t, f.SetSharedFormula("SheetN", "C1:C5", "=A1+B1"), "sheet SheetN does not exist")
+	// Test set shared formula with invalid existing shared formula reference
+	ws, ok = f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	ws.(*xlsxWorksheet).SheetData.Row[0].C[2].F.Ref = "C:C5"
+	assert.Equal(t, newCellNameToCoordinatesError("C", newInvalidCellNameError("C")), f.SetSharedFormula("Sheet1", "C1:C1", "=1"))
+	ws.(*xlsxWorksheet).SheetData.Row[0].C[2].R = "C"
+	assert.Equal(t, newCellNameToCoordinatesError("C", newInvalidCellNameError("C")), f.SetSharedFormula("Sheet1", "C1:C1", "=1"))
+	assert.NoError(t, f.Close())
 }
 
 func TestGetCellRichText(t *testing.T) {
@@ -804,6 +1287,61 @@ func TestGetCellRichText(t *testing.T) {
 	assert.Equal(t, ErrSheetNameInvalid, err)
 }
 
+func TestSetCellWrapText(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetCellWrapText("Sheet1", "A1", "Line 1\r\nLine 2\nLine 3"))
+	value, err := f.GetCellValue("Sheet1", "A1")
+	assert.NoError(t, err)
+	assert.Equal(t, "Line 1\nLine 2\nLine 3", value)
+	styleID, err := f.GetCellStyle("Sheet1", "A1")
+	assert.NoError(t, err)
+	style, err := f.GetStyle(styleID)
+	assert.NoError(t, err)
+	assert.True(t, style.Alignment.WrapText)
+	height, err := f.GetRowHeight("Sheet1", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 45.0, height)
+	// Test the row height will not be reduced
+	assert.NoError(t, f.SetCellWrapText("Sheet1", "B1", "Line 1\nLine 2"))
+	height, err = f.GetRowHeight("Sheet1", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 45.0, height)
+	// Test the cell style will be kept and the font size will be applied
+	styleID, err = f.NewStyle(&Style{Font: &Font{Size: 22, Bold: true}, Alignment: &Alignment{Horizontal: "center"}})
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetCellStyle("Sheet1", "A2", "A2", styleID))
+	assert.NoError(t, f.SetCellWrapText("Sheet1", "A2", "Line 1\nLine 2"))
+	styleID, err = f.GetCellStyle("Sheet1", "A2")
+	assert.NoError(t, err)
+	style, err = f.GetStyle(styleID)
+	assert.NoError(t, err)
+	assert.True(t, style.Font.Bold)
+	assert.Equal(t, &Alignment{Horizontal: "center", WrapText: true}, style.Alignment)
+	height, err = f.GetRowHeight("Sheet1", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 60.0, height)
+	// Test the custom row height will be kept
+	assert.NoError(t, f.SetRowHeight("Sheet1", 3, 20))
+	assert.NoError(t, f.SetCellWrapText("Sheet1", "A3", "Line 1\nLine 2\nLine 3"))
+	height, err = f.GetRowHeight("Sheet1", 3)
+	assert.NoError(t, err)
+	assert.Equal(t, 20.0, height)
+	// Test the default font size of the workbook will be applied
+	f.Styles.Fonts.Font[0].Sz = &attrValFloat{Val: float64Ptr(22)}
+	assert.NoError(t, f.SetCellWrapText("Sheet1", "A4", "Line 1\nLine 2"))
+	height, err = f.GetRowHeight("Sheet1", 4)
+	assert.NoError(t, err)
+	assert.Equal(t, 60.0, height)
+	// Test set cell wrap text with invalid arguments
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), f.SetCellWrapText("Sheet1", "A", "Line"))
+	assert.EqualError(t, f.SetCellWrapText("SheetN", "A1", "Line"), "sheet SheetN does not exist")
+	// Test set cell wrap text with unsupported charset style sheet
+	f.Styles = nil
+	f.Pkg.Store(defaultXMLPathStyles, MacintoshCyrillicCharset)
+	assert.EqualError(t, f.SetCellWrapText("Sheet1", "A1", "Line"), "XML syntax error on line 1: invalid UTF-8")
+	assert.NoError(t, f.Close())
+}
+
 func TestSetCellRichText(t *testing.T) {
 	f := NewFile()
 	assert.NoError(t, f.SetRowHeight("Sheet1", 1, 35))
@@ -12,12 +12,21 @@
 package excelize
 
 import (
+	"bytes"
+	"encoding/json"
 	"encoding/xml"
 	"fmt"
+	"io"
+	"math"
+	"regexp"
 	"strconv"
 	"strings"
 )
 
+// chartSeriesNameRefExp defined the regular expression of the cell reference
+// qualified by the worksheet name in the chart series name.
+var chartSeriesNameRefExp = regexp.MustCompile(`^('(?:[^']|'')+'|[^!'"\s]+)!\$?[A-Za-z]{1,3}\$?\d+(:\$?[A-Za-z]{1,3}\$?\d+)?$`)
+
 // ChartType is the type of supported chart types.
 type ChartType byte
 
@@ -687,17 +696,26 @@ func (opts *Chart) parseTitle() {
 // The series options that can be set are:
 //
 //	Name
+//	NameRef
 //	Categories
 //	Values
 //	Fill
 //	Line
 //	Marker
 //	DataLabelPosition
+//	DataLabelSeparator
+//	Secondary
 //
 // Name: Set the name for the series. The name is displayed in the chart legend
 // and in the formula bar. The 'Name' property is optional and if it isn't
-// supplied it will default to Series 1..n. The name can also be a formula such
-// as Sheet1!$A$1
+// supplied it will default to Series 1..n. The name can also be a reference
+// such as Sheet1!$A$1 or =Sheet1!$A$1, the name which starts with the equal
+// sign or looks like a cell reference qualified by the worksheet name will be
+// written as a reference, otherwise it will be written as a literal string.
+//
+// NameRef: Specifies the 'Name' property is a reference, such as a defined
+// name, even if it doesn't look like a cell reference. The default value is
+// false.
 //
 // Categories: This sets the chart category labels. The category is more or less
 // the same as the X axis. In most chart types the 'Categories' property is
@@ -710,6 +728,13 @@ func (opts *Chart) parseTitle() {
 // Sizes: This sets the bubble size in a data series. The 'Sizes' property is
 // optional and the default value was same with 'Values'.
 //
+// The 'Categories', 'Values' and 'Sizes' properties also accept a defined
+// name, such as 'Amount' or 'Sheet1!Amount'. The defined name on the scope of
+// the worksheet where the chart is placed takes precedence over the defined
+// name on the scope of the workbook, and the defined name will be qualified
+// with the worksheet name of its scope in the chart. An error will be returned
+// if the defined name doesn't exist.
+//
 // Fill: This set the format for the data series fill. The 'Fill' property is
 // optional
 //
@@ -736,6 +761,19 @@ func (opts *Chart) parseTitle() {
 //	auto
 //
 // DataLabelPosition: This sets the position of the chart series data label.
+// The available positions depend on the chart type, for example the
+// 'ChartDataLabelsPositionBestFit' is only available for the pie chart, and
+// an error will be returned if the position isn't supported by the chart type.
+//
+// DataLabelSeparator: This sets the separator between the contents of the
+// chart series data label, such as ", " or "\n" to place each content on a
+// new line. The 'DataLabelSeparator' property is optional.
+//
+// Secondary: Specifies the series plots against the secondary vertical axis
+// on the right side of the scatter chart with its own scale, the secondary
+// horizontal axis will be hidden. This only works for the scatter chart which
+// has at least one series on the primary axis, otherwise an error will be
+// returned. The default value is false.
 //
 // Set properties of the chart legend. The options that can be set are:
 //
@@ -777,11 +815,33 @@ func (opts *Chart) parseTitle() {
 //
 // zero: Specifies that blank values shall be treated as zero.
 //
+// Set the format of the chart area, which is the whole frame of the chart, by
+// 'Fill' and 'Border'. The chart area fill and border are distinct from the
+// plot area, and they can be set at the same time. The properties that can be
+// set are:
+//
+//	Fill
+//	Border
+//
+// Fill: This set the background fill of the chart area, the default fill is
+// the background color of the theme. Set the 'Type' to "pattern", 'Pattern' to
+// 1 and with a single 'Color' to specify the solid fill color, or set without
+// color to use no fill.
+//
+// Border: This set the border line of the chart area. The 'Type' property
+// supports ChartLineSolid, ChartLineNone and ChartLineAutomatic, and the
+// 'Width' property specifies the width of the solid border line in points, the
+// default width is 0.75pt.
+//
 // Specifies that each data marker in the series has a different color by
 // 'VaryColors'. The default value is true.
 //
-// Set chart offset, scale, aspect ratio setting and print settings by 'Format',
-// same as function 'AddPicture'.
+// Set chart offset, scale, aspect ratio setting, lock settings and print
+// settings by 'Format', same as function 'AddPicture'. Set the 'Locked',
+// 'LockAspectRatio' and 'DisableResize' properties and protect the worksheet
+// to prevent the chart from being edited, moved or resized. Set the
+// 'Hyperlink' and 'HyperlinkType' properties to navigate to a website or a
+// location in this workbook when the chart is clicked.
 //
 // Set the position of the chart plot area by 'PlotArea'. The properties that
 // can be set are:
@@ -793,6 +853,7 @@ func (opts *Chart) parseTitle() {
 //	ShowPercent
 //	ShowSerName
 //	ShowVal
+//	DataLabelSeparator
 //	NumFmt
 //
 // SecondPlotValues: Specifies the values in second plot for the 'pieOfPie' and
@@ -808,7 +869,10 @@ func (opts *Chart) parseTitle() {
 // 'ShowLeaderLines' property is optional. The default value is false.
 //
 // ShowPercent: Specifies that the percentage shall be shown in a data label.
-// The 'ShowPercent' property is optional. The default value is false.
+// Note that the spreadsheet application only displays the percentage for the
+// pie and doughnut charts, and ignores it for other chart types, including
+// the percent stacked charts. The 'ShowPercent' property is optional. The
+// default value is false.
 //
 // ShowSerName: Specifies that the series name shall be shown in a data label.
 // The 'ShowSerName' property is optional. The default value is false.
@@ -816,6 +880,13 @@ func (opts *Chart) parseTitle() {
 // ShowVal: Specifies that the value shall be shown in a data label.
 // The 'ShowVal' property is optional. The default value is false.
 //
+// DataLabelSeparator: This sets the separator between the contents of the data
+// labels of all series, such as ", " or "\n" to place the value and the
+// percentage on separate lines when both the 'ShowVal' and 'ShowPercent'
+// properties are enabled. The 'DataLabelSeparator' property of the chart
+// series takes precedence over it. The 'DataLabelSeparator' property is
+// optional.
+//
 // NumFmt: Specifies that if linked to source and set custom number format code
 // for data labels. The 'NumFmt' property is optional. The default format code
 // is 'General'.
@@ -833,6 +904,11 @@ func (opts *Chart) parseTitle() {
 //	Font
 //	NumFmt
 //	Title
+//	Date
+//	MajorUnit
+//	BaseTimeUnit
+//	MajorTimeUnit
+//	MinorTimeUnit
 //
 // The properties of 'YAxis' that can be set are:
 //
@@ -848,6 +924,8 @@ func (opts *Chart) parseTitle() {
 //	LogBase
 //	NumFmt
 //	Title
+//	DisplayUnit
+//	DisplayUnitLabel
 //
 // None: Disable axes.
 //
@@ -897,8 +975,58 @@ func (opts *Chart) parseTitle() {
 // Title: Specifies that the primary horizontal or vertical axis title and
 // resize chart. The 'Title' property is optional.
 //
+// Date: Specifies the horizontal axis as a date axis, so that the data points
+// will be spaced by the actual date intervals of the categories instead of
+// evenly spaced. This only works for the charts with the category axis, such
+// as the area, bar, column and line charts. The number format of the source
+// data will be used for the axis labels by default. The 'Date' property is
+// optional. The default value is false.
+//
+// BaseTimeUnit: Specifies the smallest time unit that is represented on the
+// date axis. The 'MajorUnit' property specifies the distance between major
+// ticks on the date axis in the 'MajorTimeUnit', and the 'MinorTimeUnit'
+// specifies the time unit for the minor ticks. These time unit properties are
+// optional and only work with the date axis, the value can be one of the
+// following: 'days', 'months' and 'years'. The default value is auto.
+//
+// DisplayUnit: Specifies the units of the values displayed on the vertical
+// axis, such as display 1000000 as 1 by the 'millions' unit. The value can be
+// one of the following built-in units, or a positive number as the custom
+// unit, such as '500'. The 'DisplayUnit' property is optional. The default
+// value is none.
+//
+//	hundreds
+//	thousands
+//	tenThousands
+//	hundredThousands
+//	millions
+//	tenMillions
+//	hundredMillions
+//	billions
+//	trillions
+//
+// DisplayUnitLabel: Specifies if show the label of the display units on the
+// vertical axis, such as 'Millions'. This only works when the 'DisplayUnit'
+// property was set. The default value is false.
+//
 // Set chart size by 'Dimension' property. The 'Dimension' property is optional.
-// The default width is 480, and height is 260.
+// The default width is 480, and height is 260. Specify a range reference such
+// as "B2:H20" instead of a cell reference for the 'cell' parameter to make the
+// chart exactly fill the range, the 'Dimension', offset and scale settings will
+// be ignored in this case. The chart will be moved and resized with the cells
+// of the range unless the 'Positioning' of the 'Format' property was set. For
+// example, create a chart which fills the range B2:H20 on Sheet1:
+//
+//	err := f.AddChart("Sheet1", "B2:H20", &excelize.Chart{
+//	    Type: excelize.Col,
+//	    Series: []excelize.ChartSeries{
+//	        {
+//	            Name:       "Sheet1!$A$2",
+//	            Categories: "Sheet1!$B$1:$D$1",
+//	            Values:     "Sheet1!$B$2:$D$2",
+//	        },
+//	    },
+//	})
 //
 // Set the bubble size in all data series for the bubble chart or 3D bubble
 // chart by 'BubbleSizes' property. The 'BubbleSizes' property is optional. The
@@ -910,8 +1038,16 @@ func (opts *Chart) parseTitle() {
 // is 75, and the value should be great than 0 and less or equal than 90.
 //
 // combo: Specifies the create a chart that combines two or more chart types in
-// a single chart. For example, create a clustered column - line chart with
-// data Sheet1!$E$1:$L$15:
+// a single chart. All the charts will be overlaid in the same plot area with a
+// single drawing anchor, and the combo charts share the axes of the primary
+// chart, so there is no need to add multiple charts with overlapping anchors.
+// The format settings of the primary and secondary axes are specified by the
+// first chart placed on the axes. The series of the charts drawn in the same
+// kind of chart group, such as the clustered and stacked column charts, on the
+// same axes will be combined into one chart group. Set the 'Secondary'
+// property of the 'YAxis' to place a combo chart on the secondary axes. For
+// example, create a clustered column - line chart with data
+// Sheet1!$E$1:$L$15:
 //
 //	package main
 //
@@ -1025,6 +1161,9 @@ func (f *File) AddChart(sheet, cell string, chart *Chart, combo ...*Chart) error
 	if err != nil {
 		return err
 	}
+	if err = f.resolveChartSeriesRefs(sheet, append([]*Chart{opts}, comboCharts...)); err != nil {
+		return err
+	}
 	// Add first picture for given sheet, create xl/drawings/ and xl/drawings/_rels/ folder.
 	drawingID := f.countDrawings() + 1
 	chartID := f.countCharts() + 1
@@ -1036,7 +1175,7 @@ func (f *File) AddChart(sheet, cell string, chart *Chart, combo ...*Chart) error
 	if err != nil {
 		return err
 	}
-	f.addChart(opts, comboCharts)
+	f.addChart(opts, comboCharts, ws.isRightToLeft())
 	if err = f.addContentTypePart(chartID, "chart"); err != nil {
 		return err
 	}
@@ -1045,6 +1184,58 @@ func (f *File) AddChart(sheet, cell string, chart *Chart, combo ...*Chart) error
 	return err
 }
 
+// AddChartFromJSON provides the method to add chart in a sheet by given
+// worksheet name, cell reference and the chart definitions in JSON format,
+// which makes it possible to store the chart templates outside the code. The
+// JSON object maps the fields of the Chart data type, the numeric value of
+// the enumeration types should be used, such as the ID in the table of the
+// supported chart types in the AddChart function, and the omitted fields will
+// use the default value just like AddChart. The unknown fields in the JSON
+// object will cause an error. Use a JSON array of the charts to create a combo
+// chart, and the first chart in the array will be the primary chart. A chart
+// definition exported by the json.Marshal function creates the same chart as
+// the original definition. For example, create a 2D clustered column chart in
+// Sheet1!E1:
+//
+//	err := f.AddChartFromJSON("Sheet1", "E1", []byte(`{
+//	    "Type": 21,
+//	    "Series": [
+//	        {
+//	            "Name": "Sheet1!$A$2",
+//	            "Categories": "Sheet1!$B$1:$D$1",
+//	            "Values": "Sheet1!$B$2:$D$2"
+//	        }
+//	    ],
+//	    "Title": [{"Text": "Fruit 2D Clustered Column Chart"}]
+//	}`))
+func (f *File) AddChartFromJSON(sheet, cell string, data []byte) error {
+	var charts []*Chart
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		if err := decodeChartJSON(data, &charts); err != nil {
+			return err
+		}
+	} else {
+		var chart Chart
+		if err := decodeChartJSON(data, &chart); err != nil {
+			return err
+		}
+		charts = append(charts, &chart)
+	}
+	if len(charts) == 0 {
+		return ErrParameterInvalid
+	}
+	return f.AddChart(sheet, cell, charts[0], charts[1:]...)
+}
+
+// decodeChartJSON decodes the chart definitions in JSON format, the unknown
+// fields in the JSON object will cause an error.
+func decodeChartJSON(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
+
 // AddChartSheet provides the method to create a chartsheet by given chart
 // format set (such as offset, scale, aspect ratio setting and print settings)
 // and properties set. In Excel a chartsheet is a worksheet that only contains
@@ -1062,6 +1253,9 @@ func (f *File) AddChartSheet(sheet string, chart *Chart, combo ...*Chart) error
 	if err != nil {
 		return err
 	}
+	if err = f.resolveChartSeriesRefs(sheet, append([]*Chart{opts}, comboCharts...)); err != nil {
+		return err
+	}
 	cs := xlsxChartsheet{
 		SheetViews: &xlsxChartsheetViews{
 			SheetView: []*xlsxChartsheetView{{ZoomScaleAttr: 100, ZoomToFitAttr: true}},
@@ -1088,7 +1282,7 @@ func (f *File) AddChartSheet(sheet string, chart *Chart, combo ...*Chart) error
 	if err = f.addSheetDrawingChart(drawingXML, drawingRID, &opts.Format); err != nil {
 		return err
 	}
-	f.addChart(opts, comboCharts)
+	f.addChart(opts, comboCharts, f.options != nil && f.options.RightToLeft)
 	if err = f.addContentTypePart(chartID, "chart"); err != nil {
 		return err
 	}
@@ -1117,6 +1311,17 @@ func (f *File) getChartOptions(opts *Chart, combo []*Chart) (*Chart, []*Chart, e
 		if err != nil {
 			return options, comboCharts, err
 		}
+		if err = comboChart.checkAxes(); err != nil {
+			return options, comboCharts, err
+		}
+		if err = comboChart.checkDataLabelPosition(); err != nil {
+			return options, comboCharts, err
+		}
+		for _, series := range comboChart.Series {
+			if series.Secondary {
+				return options, comboCharts, ErrSecondarySeries
+			}
+		}
 		if _, ok := chartValAxNumFmtFormatCode[comboChart.Type]; !ok {
 			return options, comboCharts, newUnsupportedChartType(comboChart.Type)
 		}
@@ -1125,9 +1330,182 @@ func (f *File) getChartOptions(opts *Chart, combo []*Chart) (*Chart, []*Chart, e
 	if _, ok := chartValAxNumFmtFormatCode[options.Type]; !ok {
 		return options, comboCharts, newUnsupportedChartType(options.Type)
 	}
+	if err = options.checkAxes(); err != nil {
+		return options, comboCharts, err
+	}
+	if err = options.checkDataLabelPosition(); err != nil {
+		return options, comboCharts, err
+	}
+	primary, secondary, err := options.splitSecondarySeries()
+	if err != nil {
+		return options, comboCharts, err
+	}
+	if secondary != nil {
+		options, comboCharts = primary, append([]*Chart{secondary}, comboCharts...)
+	}
 	return options, comboCharts, err
 }
 
+// splitSecondarySeries provides a function to split the series on the
+// secondary axis of the scatter chart into a new scatter chart on the
+// secondary axes, which will be combined with the chart of the series on the
+// primary axes. The original indexes of the series will be kept in both
+// charts. The secondary chart will be nil if there are no series on the
+// secondary axis, and returns an error if the chart isn't scatter chart or
+// the series are all on the secondary axis.
+func (opts *Chart) splitSecondarySeries() (*Chart, *Chart, error) {
+	var primary, secondary []ChartSeries
+	var primaryIdx, secondaryIdx []int
+	for idx, series := range opts.Series {
+		if series.Secondary {
+			secondary, secondaryIdx = append(secondary, series), append(secondaryIdx, idx)
+			continue
+		}
+		primary, primaryIdx = append(primary, series), append(primaryIdx, idx)
+	}
+	if len(secondary) == 0 {
+		return opts, nil, nil
+	}
+	if opts.Type != Scatter || len(primary) == 0 {
+		return opts, nil, ErrSecondarySeries
+	}
+	primaryChart, secondaryChart := *opts, *opts
+	primaryChart.Series, secondaryChart.Series = primary, secondary
+	primaryChart.seriesIdx, secondaryChart.seriesIdx = primaryIdx, secondaryIdx
+	secondaryChart.YAxis = ChartAxis{Secondary: true, Font: opts.YAxis.Font}
+	return &primaryChart, &secondaryChart, nil
+}
+
+// getSeriesIdx provides a function to get the index of the series in the
+// plot area by given series index of the chart.
+func (opts *Chart) getSeriesIdx(i int) int {
+	if opts.seriesIdx != nil {
+		return opts.seriesIdx[i]
+	}
+	return i + opts.order
+}
+
+// checkDataLabelPosition checks the data labels position of each series is
+// supported by the chart type.
+func (opts *Chart) checkDataLabelPosition() error {
+	for _, series := range opts.Series {
+		if series.DataLabelPosition == ChartDataLabelsPositionUnset {
+			continue
+		}
+		if inSupportedChartDataLabelsPositionType(supportedChartDataLabelsPosition[opts.Type], series.DataLabelPosition) == -1 {
+			return newUnsupportedDataLabelPositionError(opts.Type, series.DataLabelPosition)
+		}
+	}
+	return nil
+}
+
+// checkAxes checks the time units of the horizontal axis and the display
+// units of the vertical axis.
+func (opts *Chart) checkAxes() error {
+	if err := opts.XAxis.checkTimeUnits(); err != nil {
+		return err
+	}
+	return opts.YAxis.checkDisplayUnit()
+}
+
+// checkDisplayUnit checks the display units of the axis, the value of the
+// display units should be one of the built-in units or a positive number.
+func (opts *ChartAxis) checkDisplayUnit() error {
+	if opts.DisplayUnit == "" {
+		return nil
+	}
+	if inStrSlice(supportedChartDisplayUnits, opts.DisplayUnit, true) != -1 {
+		return nil
+	}
+	if unit, err := strconv.ParseFloat(opts.DisplayUnit, 64); err == nil && unit > 0 && !math.IsInf(unit, 1) {
+		return nil
+	}
+	return ErrParameterInvalid
+}
+
+// checkTimeUnits checks the time units of the date axis, the value of the
+// time units should be one of days, months and years.
+func (opts *ChartAxis) checkTimeUnits() error {
+	for _, unit := range []string{opts.BaseTimeUnit, opts.MajorTimeUnit, opts.MinorTimeUnit} {
+		if unit != "" && inStrSlice([]string{"days", "months", "years"}, unit, false) == -1 {
+			return ErrParameterInvalid
+		}
+	}
+	return nil
+}
+
+// resolveChartSeriesRefs provides a function to resolve the defined names in
+// the categories, values and sizes references of the chart series by given
+// worksheet name where the chart is placed.
+func (f *File) resolveChartSeriesRefs(sheet string, charts []*Chart) error {
+	for _, chart := range charts {
+		series := make([]ChartSeries, len(chart.Series))
+		copy(series, chart.Series)
+		for i := range series {
+			refs := []*string{&series[i].Categories, &series[i].Values, &series[i].Sizes}
+			if series[i].isNameRef() {
+				series[i].Name, series[i].NameRef = strings.TrimPrefix(series[i].Name, "="), true
+				refs = append(refs, &series[i].Name)
+			}
+			for _, ref := range refs {
+				resolved, err := f.resolveChartSeriesRef(sheet, *ref)
+				if err != nil {
+					return err
+				}
+				*ref = resolved
+			}
+		}
+		chart.Series = series
+	}
+	return nil
+}
+
+// isNameRef returns if the name of the chart series is a reference, the name
+// which is specified as a reference, starts with the equal sign or looks like
+// a cell reference qualified by the worksheet name will be treated as a
+// reference.
+func (series ChartSeries) isNameRef() bool {
+	return series.NameRef || strings.HasPrefix(series.Name, "=") || chartSeriesNameRefExp.MatchString(series.Name)
+}
+
+// resolveChartSeriesRef provides a function to resolve the defined name in the
+// chart series reference by given worksheet name where the chart is placed.
+// The defined name on the scope of the worksheet takes precedence over the
+// defined name on the scope of the workbook, and the defined name will be
+// qualified with the worksheet name of its scope, or "[0]" for the workbook
+// scope. The cell references will be returned as they are.
+func (f *File) resolveChartSeriesRef(sheet, ref string) (string, error) {
+	scope, name := sheet, ref
+	if idx := strings.LastIndex(ref, "!"); idx != -1 {
+		scope, name = ref[:idx], ref[idx+1:]
+		if strings.HasPrefix(scope, "'") && strings.HasSuffix(scope, "'") && len(scope) > 1 {
+			scope = strings.ReplaceAll(scope[1:len(scope)-1], "''", "'")
+		}
+	}
+	if name == "" || checkDefinedName(name) != nil || scope == "[0]" {
+		return ref, nil
+	}
+	if _, _, err := CellNameToCoordinates(name); err == nil {
+		return ref, nil
+	}
+	var workbookName string
+	for _, definedName := range f.GetDefinedName() {
+		if !strings.EqualFold(definedName.Name, name) {
+			continue
+		}
+		if strings.EqualFold(definedName.Scope, scope) {
+			return escapeSheetName(definedName.Scope) + "!" + definedName.Name, nil
+		}
+		if definedName.Scope == "Workbook" {
+			workbookName = definedName.Name
+		}
+	}
+	if workbookName != "" {
+		return "[0]!" + workbookName, nil
+	}
+	return ref, newNoExistDefinedNameError(name, scope)
+}
+
 // DeleteChart provides a function to delete chart in spreadsheet by given
 // worksheet name and cell reference.
 func (f *File) DeleteChart(sheet, cell string) error {
@@ -1149,6 +1527,106 @@ func (f *File) DeleteChart(sheet, cell string) error {
 	return err
 }
 
+// GetChartAxisTitles provides a function to get the plain text of the
+// horizontal (category) axis title and the vertical (value) axis title of the
+// chart by given worksheet name and cell reference of the top-left anchor cell
+// of the chart. The text runs in a paragraph of the title will be
+// concatenated, and the paragraphs will be separated by the line break. Empty
+// strings will be returned for the axes without titles, or if there is no
+// chart at the cell. For example, get the axis titles of the chart at cell E1
+// on Sheet1:
+//
+//	xTitle, yTitle, err := f.GetChartAxisTitles("Sheet1", "E1")
+func (f *File) GetChartAxisTitles(sheet, cell string) (string, string, error) {
+	col, row, err := CellNameToCoordinates(cell)
+	if err != nil {
+		return "", "", err
+	}
+	ws, err := f.workSheetReader(sheet)
+	if err != nil || ws.Drawing == nil {
+		return "", "", err
+	}
+	target := f.getSheetRelationshipsTargetByID(sheet, ws.Drawing.RID)
+	drawingXML := strings.TrimPrefix(strings.ReplaceAll(target, "..", "xl"), "/")
+	drawingRelationships := strings.ReplaceAll(
+		strings.ReplaceAll(target, "../drawings", "xl/drawings/_rels"), ".xml", ".xml.rels")
+	rID, err := f.getChartRID(drawingXML, col-1, row-1)
+	if err != nil || rID == "" {
+		return "", "", err
+	}
+	rel := f.getDrawingRelationships(drawingRelationships, rID)
+	if rel == nil {
+		return "", "", err
+	}
+	chartSpace := decodeChartSpace{}
+	chartXML := strings.TrimPrefix(strings.ReplaceAll(rel.Target, "..", "xl"), "/")
+	if err = f.xmlNewDecoder(bytes.NewReader(namespaceStrictToTransitional(f.readXML(chartXML)))).
+		Decode(&chartSpace); err != nil && err != io.EOF {
+		return "", "", err
+	}
+	plotArea := chartSpace.PlotArea
+	xAxis, yAxis := append(plotArea.CatAx, plotArea.DateAx...), plotArea.ValAx
+	if len(xAxis) == 0 && len(yAxis) > 1 {
+		xAxis, yAxis = yAxis[:1], yAxis[1:]
+	}
+	var xTitle, yTitle string
+	if len(xAxis) > 0 {
+		xTitle = xAxis[0].getTitle()
+	}
+	if len(yAxis) > 0 {
+		yTitle = yAxis[0].getTitle()
+	}
+	return xTitle, yTitle, nil
+}
+
+// getChartRID provides a function to get the relationship ID of the chart by
+// given drawing part path and the zero-based column and row number of the
+// top-left anchor cell of the chart.
+func (f *File) getChartRID(drawingXML string, col, row int) (string, error) {
+	wsDr, _, err := f.drawingParser(drawingXML)
+	if err != nil {
+		return "", err
+	}
+	wsDr.mu.Lock()
+	defer wsDr.mu.Unlock()
+	for _, anchor := range wsDr.TwoCellAnchor {
+		if anchor.Pic != nil || anchor.GraphicFrame == "" {
+			continue
+		}
+		deAnchor := decodeChartAnchor{}
+		if err = f.xmlNewDecoder(strings.NewReader("<decodeChartAnchor>" + anchor.GraphicFrame + "</decodeChartAnchor>")).
+			Decode(&deAnchor); err != nil && err != io.EOF {
+			return "", err
+		}
+		if anchor.From != nil {
+			deAnchor.From = &decodeFrom{Col: anchor.From.Col, Row: anchor.From.Row}
+		}
+		if deAnchor.Chart != nil && deAnchor.From != nil && deAnchor.From.Col == col && deAnchor.From.Row == row {
+			return deAnchor.Chart.RID, nil
+		}
+	}
+	return "", nil
+}
+
+// getTitle returns the plain text of the chart axis title.
+func (ax decodeChartAxis) getTitle() string {
+	if ax.Title == nil {
+		return ""
+	}
+	if len(ax.Title.P) == 0 {
+		return strings.Join(ax.Title.V, "")
+	}
+	var paragraphs []string
+	for _, p := range ax.Title.P {
+		var text strings.Builder
+		for _, r := range p.R {
+			text.WriteString(r.T)
+		}
+		paragraphs = append(paragraphs, text.String())
+	}
+	return strings.Join(paragraphs, "\n")
+}
+
 // countCharts provides a function to get chart files count storage in the
 // folder xl/charts.
 func (f *File) countCharts() int {
@@ -2,10 +2,12 @@ package excelize
 
 import (
 	"bytes"
+	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -183,11 +185,11 @@ func TestAddChart(t *testing.T) {
 	series4 := []ChartSeries{
 		{Name: "Sheet1!$A$30", Categories: "Sheet1!$B$29:$D$29", Values: "Sheet1!$B$30:$D$30", Sizes: "Sheet1!$B$30:$D$30", DataLabelPosition: ChartDataLabelsPositionAbove},
 		{Name: "Sheet1!$A$31", Categories: "Sheet1!$B$29:$D$29", Values: "Sheet1!$B$31:$D$31", Sizes: "Sheet1!$B$31:$D$31", DataLabelPosition: ChartDataLabelsPositionLeft},
-		{Name: "Sheet1!$A$32", Categories: "Sheet1!$B$29:$D$29", Values: "Sheet1!$B$32:$D$32", Sizes: "Sheet1!$B$32:$D$32", DataLabelPosition: ChartDataLabelsPositionBestFit},
+		{Name: "Sheet1!$A$32", Categories: "Sheet1!$B$29:$D$29", Values: "Sheet1!$B$32:$D$32", Sizes: "Sheet1!$B$32:$D$32", DataLabelPosition: ChartDataLabelsPositionBelow},
 		{Name: "Sheet1!$A$33", Categories: "Sheet1!$B$29:$D$29", Values: "Sheet1!$B$33:$D$33", Sizes: "Sheet1!$B$33:$D$33", DataLabelPosition: ChartDataLabelsPositionCenter},
-		{Name: "Sheet1!$A$34", Categories: "Sheet1!$B$29:$D$29", Values: "Sheet1!$B$34:$D$34", Sizes: "Sheet1!$B$34:$D$34", DataLabelPosition: ChartDataLabelsPositionInsideBase},
-		{Name: "Sheet1!$A$35", Categories: "Sheet1!$B$29:$D$29", Values: "Sheet1!$B$35:$D$35", Sizes: "Sheet1!$B$35:$D$35", DataLabelPosition: ChartDataLabelsPositionInsideEnd},
-		{Name: "Sheet1!$A$36", Categories: "Sheet1!$B$29:$D$29", Values: "Sheet1!$B$36:$D$36", Sizes: "Sheet1!$B$36:$D$36", DataLabelPosition: ChartDataLabelsPositionOutsideEnd},
+		{Name: "Sheet1!$A$34", Categories: "Sheet1!$B$29:$D$29", Values: "Sheet1!$B$34:$D$34", Sizes: "Sheet1!$B$34:$D$34", DataLabelPosition: ChartDataLabelsPositionCenter, DataLabelSeparator: "\n"},
+		{Name: "Sheet1!$A$35", Categories: "Sheet1!$B$29:$D$29", Values: "Sheet1!$B$35:$D$35", Sizes: "Sheet1!$B$35:$D$35", DataLabelPosition: ChartDataLabelsPositionLeft},
+		{Name: "Sheet1!$A$36", Categories: "Sheet1!$B$29:$D$29", Values: "Sheet1!$B$36:$D$36", Sizes: "Sheet1!$B$36:$D$36", DataLabelPosition: ChartDataLabelsPositionAbove, DataLabelSeparator: ", "},
 		{Name: "Sheet1!$A$37", Categories: "Sheet1!$B$29:$D$29", Values: "Sheet1!$B$37:$D$37", Sizes: "Sheet1!$B$37:$D$37", DataLabelPosition: ChartDataLabelsPositionRight},
 	}
 	format := GraphicOptions{
@@ -316,6 +318,422 @@ func TestAddChart(t *testing.T) {
 	assert.EqualError(t, f.AddChart("Sheet1", "P1", &Chart{Type: Col, Series: []ChartSeries{{Name: "Sheet1!$A$30", Categories: "Sheet1!$B$29:$D$29", Values: "Sheet1!$B$30:$D$30"}}, Title: []RichTextRun{{Text: "2D Column Chart"}}}), "XML syntax error on line 1: invalid UTF-8")
 }
 
+func TestAddChartWithDefinedName(t *testing.T) {
+	f := NewFile()
+	_, err := f.NewSheet("Sheet 2")
+	assert.NoError(t, err)
+	for _, definedName := range []*DefinedName{
+		{Name: "Amount", RefersTo: "Sheet1!$B$2:$D$2"},
+		{Name: "Amount", RefersTo: "'Sheet 2'!$B$2:$D$2", Scope: "Sheet 2"},
+		{Name: "Fruits", RefersTo: "Sheet1!$B$1:$D$1"},
+	} {
+		assert.NoError(t, f.SetDefinedName(definedName))
+	}
+	for sheet, expected := range map[string][]string{
+		"Sheet1":  {"[0]!Fruits", "[0]!Amount", "'Sheet 2'!Amount", "Sheet1!$B$3:$D$3"},
+		"Sheet 2": {"[0]!Fruits", "'Sheet 2'!Amount", "'Sheet 2'!Amount", "Sheet1!$B$3:$D$3"},
+	} {
+		series := []ChartSeries{
+			{Name: "Sheet1!$A$2", Categories: "Fruits", Values: "amount"},
+			{Name: "Sheet1!$A$3", Categories: "[0]!Fruits", Values: "'Sheet 2'!Amount"},
+			{Name: "Sheet1!$A$4", Values: "Sheet1!$B$3:$D$3"},
+		}
+		assert.NoError(t, f.AddChart(sheet, "A1", &Chart{Type: Col, Series: series}))
+		assert.Equal(t, "amount", series[0].Values)
+		content, ok := f.Pkg.Load(fmt.Sprintf("xl/charts/chart%d.xml", f.countCharts()))
+		assert.True(t, ok)
+		var chartSpace xlsxChartSpace
+		assert.NoError(t, xml.Unmarshal(content.([]byte), &chartSpace))
+		ser := *chartSpace.Chart.PlotArea.BarChart[0].Ser
+		assert.Equal(t, expected, []string{ser[0].Cat.StrRef.F, ser[0].Val.NumRef.F, ser[1].Val.NumRef.F, ser[2].Val.NumRef.F})
+	}
+	// Test add chart with not exist defined name
+	assert.EqualError(t, f.AddChart("Sheet1", "A1", &Chart{Type: Col, Series: []ChartSeries{{Values: "Sheet1!Total"}}}),
+		"defined name Total does not exist on the scope of worksheet Sheet1")
+	assert.EqualError(t, f.AddChartSheet("Chart1", &Chart{Type: Col, Series: []ChartSeries{{Categories: "Fruits", Values: "Total"}}}),
+		"defined name Total does not exist on the scope of worksheet Chart1")
+	assert.NoError(t, f.Close())
+}
+
+func TestAddChartOverlay(t *testing.T) {
+	f := NewFile()
+	series := func(row int) []ChartSeries {
+		return []ChartSeries{{Name: fmt.Sprintf("Sheet1!$A$%d", row), Categories: "Sheet1!$B$1:$D$1", Values: fmt.Sprintf("Sheet1!$B$%d:$D$%d", row, row)}}
+	}
+	assert.NoError(t, f.AddChart("Sheet1", "E1", &Chart{Type: Col, Series: series(2), YAxis: ChartAxis{MajorUnit: 2}},
+		&Chart{Type: Col, Series: series(3), YAxis: ChartAxis{Secondary: true}},
+		&Chart{Type: Line, Series: series(4), YAxis: ChartAxis{MajorUnit: 5}},
+		&Chart{Type: Line, Series: series(5)},
+	))
+	content, ok := f.Pkg.Load("xl/charts/chart1.xml")
+	assert.True(t, ok)
+	var chartSpace xlsxChartSpace
+	assert.NoError(t, xml.Unmarshal(content.([]byte), &chartSpace))
+	plotArea := chartSpace.Chart.PlotArea
+	// Test the column charts on the primary and secondary axes are overlaid
+	assert.Len(t, plotArea.BarChart, 2)
+	assert.Len(t, *plotArea.BarChart[0].Ser, 1)
+	assert.Equal(t, 100000000, *plotArea.BarChart[0].AxID[0].Val)
+	assert.Len(t, *plotArea.BarChart[1].Ser, 1)
+	assert.Equal(t, 100000003, *plotArea.BarChart[1].AxID[0].Val)
+	// Test the line charts on the primary axes are combined into one chart group
+	assert.Len(t, plotArea.LineChart, 1)
+	assert.Len(t, *plotArea.LineChart[0].Ser, 2)
+	assert.Equal(t, []int{2, 3}, []int{*(*plotArea.LineChart[0].Ser)[0].IDx.Val, *(*plotArea.LineChart[0].Ser)[1].IDx.Val})
+	// Test the primary axes are specified by the primary chart
+	assert.Len(t, plotArea.CatAx, 2)
+	assert.Len(t, plotArea.ValAx, 2)
+	assert.Equal(t, 2.0, *plotArea.ValAx[0].MajorUnit.Val)
+	assert.Equal(t, 100000004, *plotArea.ValAx[1].AxID.Val)
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestAddChartOverlay.xlsx")))
+	assert.NoError(t, f.Close())
+}
+
+func TestAddChartAreaFormat(t *testing.T) {
+	f := NewFile()
+	series := []ChartSeries{{Name: "Sheet1!$A$2", Categories: "Sheet1!$B$1:$D$1", Values: "Sheet1!$B$2:$D$2"}}
+	plotArea := ChartPlotArea{Fill: Fill{Type: "pattern", Color: []string{"FFFFFF"}, Pattern: 1}}
+	// Test add chart with the chart area fill and border
+	assert.NoError(t, f.AddChart("Sheet1", "E1", &Chart{Type: Col, Series: series, PlotArea: plotArea,
+		Fill: Fill{Type: "pattern", Color: []string{"1F4E79"}, Pattern: 1}, Border: ChartLine{Type: ChartLineSolid, Width: 1.5}}))
+	// Test add chart with no fill and no border of the chart area
+	assert.NoError(t, f.AddChart("Sheet1", "E20", &Chart{Type: Col, Series: series, PlotArea: plotArea,
+		Fill: Fill{Type: "pattern", Pattern: 1}, Border: ChartLine{Type: ChartLineNone}}))
+	for chart, expected := range map[string]string{
+		"xl/charts/chart1.xml": `</chart><spPr><a:solidFill><a:srgbClr val="1F4E79"></a:srgbClr></a:solidFill><a:ln algn="ctr" cap="flat" cmpd="sng" w="19050"><a:solidFill>`,
+		"xl/charts/chart2.xml": `</chart><spPr><a:noFill></a:noFill><a:ln algn="ctr" cap="flat" cmpd="sng" w="9525"><a:noFill></a:noFill></a:ln></spPr>`,
+	} {
+		content, ok := f.Pkg.Load(chart)
+		assert.True(t, ok)
+		assert.Contains(t, string(content.([]byte)), expected)
+		// Test the plot area fill is distinct from the chart area fill
+		assert.Contains(t, string(content.([]byte)), `<spPr><a:solidFill><a:srgbClr val="FFFFFF"></a:srgbClr></a:solidFill></spPr></plotArea>`)
+	}
+	assert.NoError(t, f.Close())
+}
+
+func TestAddChartDisplayUnits(t *testing.T) {
+	f := NewFile()
+	series := []ChartSeries{{Name: "Sheet1!$A$2", Categories: "Sheet1!$B$1:$D$1", Values: "Sheet1!$B$2:$D$2"}}
+	assert.NoError(t, f.AddChart("Sheet1", "E1", &Chart{Type: Col, Series: series, YAxis: ChartAxis{DisplayUnit: "millions", DisplayUnitLabel: true}},
+		&Chart{Type: Line, Series: series, YAxis: ChartAxis{Secondary: true, DisplayUnit: "500"}}))
+	content, ok := f.Pkg.Load("xl/charts/chart1.xml")
+	assert.True(t, ok)
+	assert.Contains(t, string(content.([]byte)), "<dispUnits><builtInUnit val=\"millions\"></builtInUnit><dispUnitsLbl><layout></layout></dispUnitsLbl></dispUnits>")
+	var chartSpace xlsxChartSpace
+	assert.NoError(t, xml.Unmarshal(content.([]byte), &chartSpace))
+	valAx := chartSpace.Chart.PlotArea.ValAx
+	assert.Len(t, valAx, 2)
+	assert.Equal(t, "millions", *valAx[0].DispUnits.BuiltInUnit.Val)
+	assert.NotNil(t, valAx[0].DispUnits.DispUnitsLbl)
+	assert.Nil(t, valAx[1].DispUnits.BuiltInUnit)
+	assert.Equal(t, 500.0, *valAx[1].DispUnits.CustUnit.Val)
+	assert.Nil(t, valAx[1].DispUnits.DispUnitsLbl)
+	assert.Nil(t, chartSpace.Chart.PlotArea.CatAx[0].DispUnits)
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestAddChartDisplayUnits.xlsx")))
+	// Test add chart with invalid display units
+	for _, unit := range []string{"Millions", "none", "0", "-100", "Inf"} {
+		assert.Equal(t, ErrParameterInvalid, f.AddChart("Sheet1", "E20", &Chart{Type: Col, Series: series, YAxis: ChartAxis{DisplayUnit: unit}}))
+	}
+	assert.Equal(t, ErrParameterInvalid, f.AddChart("Sheet1", "E20", &Chart{Type: Col, Series: series},
+		&Chart{Type: Line, Series: series, YAxis: ChartAxis{DisplayUnit: "thousand"}}))
+	assert.NoError(t, f.Close())
+}
+
+func TestAddChartLocks(t *testing.T) {
+	f := NewFile()
+	series := []ChartSeries{{Name: "Sheet1!$A$2", Categories: "Sheet1!$B$1:$D$1", Values: "Sheet1!$B$2:$D$2"}}
+	assert.NoError(t, f.AddChart("Sheet1", "E1", &Chart{Type: Col, Series: series,
+		Format: GraphicOptions{Locked: boolPtr(true), LockAspectRatio: true, DisableResize: true}}))
+	assert.NoError(t, f.AddChart("Sheet1", "E20", &Chart{Type: Col, Series: series}))
+	assert.NoError(t, f.AddChartSheet("Chart1", &Chart{Type: Col, Series: series, Format: GraphicOptions{DisableResize: true}}))
+	drawing, ok := f.Drawings.Load("xl/drawings/drawing1.xml")
+	assert.True(t, ok)
+	anchors := drawing.(*xlsxWsDr).TwoCellAnchor
+	assert.True(t, anchors[0].ClientData.FLocksWithSheet)
+	assert.Contains(t, anchors[0].GraphicFrame, `<xdr:cNvGraphicFramePr><a:graphicFrameLocks noChangeAspect="true" noResize="true"></a:graphicFrameLocks></xdr:cNvGraphicFramePr>`)
+	assert.False(t, anchors[1].ClientData.FLocksWithSheet)
+	assert.Contains(t, anchors[1].GraphicFrame, `<xdr:cNvGraphicFramePr></xdr:cNvGraphicFramePr>`)
+	drawing, ok = f.Drawings.Load("xl/drawings/drawing2.xml")
+	assert.True(t, ok)
+	assert.Contains(t, drawing.(*xlsxWsDr).AbsoluteAnchor[0].GraphicFrame, `<a:graphicFrameLocks noResize="true"></a:graphicFrameLocks>`)
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestAddChartLocks.xlsx")))
+	assert.NoError(t, f.Close())
+}
+
+func TestAddChartFitToRange(t *testing.T) {
+	f := NewFile()
+	series := []ChartSeries{{Name: "Sheet1!$A$2", Categories: "Sheet1!$B$1:$D$1", Values: "Sheet1!$B$2:$D$2"}}
+	assert.NoError(t, f.AddChart("Sheet1", "B2:H20", &Chart{Type: Col, Series: series,
+		Format: GraphicOptions{OffsetX: 10, OffsetY: 10, ScaleX: 2}}))
+	assert.NoError(t, f.AddChart("Sheet1", "$P$30:J22", &Chart{Type: Line, Series: series,
+		Format: GraphicOptions{Positioning: "oneCell"}}))
+	drawing, ok := f.Drawings.Load("xl/drawings/drawing1.xml")
+	assert.True(t, ok)
+	anchors := drawing.(*xlsxWsDr).TwoCellAnchor
+	assert.Equal(t, &xlsxFrom{Col: 1, Row: 1}, anchors[0].From)
+	assert.Equal(t, &xlsxTo{Col: 8, Row: 20}, anchors[0].To)
+	assert.Empty(t, anchors[0].EditAs)
+	assert.Equal(t, &xlsxFrom{Col: 9, Row: 21}, anchors[1].From)
+	assert.Equal(t, &xlsxTo{Col: 16, Row: 30}, anchors[1].To)
+	assert.Equal(t, "oneCell", anchors[1].EditAs)
+	// Test delete chart by the top-left cell of the range
+	assert.NoError(t, f.DeleteChart("Sheet1", "J22"))
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestAddChartFitToRange.xlsx")))
+	// Test add chart with invalid range reference
+	assert.Equal(t, newCellNameToCoordinatesError("H", newInvalidCellNameError("H")),
+		f.AddChart("Sheet1", "B2:H", &Chart{Type: Col, Series: series}))
+	assert.NoError(t, f.Close())
+}
+
+func TestAddChartHyperlink(t *testing.T) {
+	f := NewFile()
+	series := []ChartSeries{{Name: "Sheet1!$A$2", Categories: "Sheet1!$B$1:$D$1", Values: "Sheet1!$B$2:$D$2"}}
+	assert.NoError(t, f.AddChart("Sheet1", "E1", &Chart{Type: Col, Series: series,
+		Format: GraphicOptions{Hyperlink: "https://github.com/xuri/excelize", HyperlinkType: "External"}}))
+	assert.NoError(t, f.AddChart("Sheet1", "E20", &Chart{Type: Col, Series: series,
+		Format: GraphicOptions{Hyperlink: "#Sheet1!A1", HyperlinkType: "Location"}}))
+	assert.NoError(t, f.AddChart("Sheet1", "E40", &Chart{Type: Col, Series: series,
+		Format: GraphicOptions{Hyperlink: "#Sheet1!A1"}}))
+	assert.NoError(t, f.AddChartSheet("Chart1", &Chart{Type: Col, Series: series,
+		Format: GraphicOptions{Hyperlink: "#Sheet1!A1", HyperlinkType: "Location"}}))
+	drawing, ok := f.Drawings.Load("xl/drawings/drawing1.xml")
+	assert.True(t, ok)
+	anchors := drawing.(*xlsxWsDr).TwoCellAnchor
+	assert.Contains(t, anchors[0].GraphicFrame, `<a:hlinkClick xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships" r:id="rId2"></a:hlinkClick>`)
+	assert.Contains(t, anchors[1].GraphicFrame, `<a:hlinkClick xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships" r:id="rId4"></a:hlinkClick>`)
+	assert.NotContains(t, anchors[2].GraphicFrame, "hlinkClick")
+	rels, err := f.relsReader("xl/drawings/_rels/drawing1.xml.rels")
+	assert.NoError(t, err)
+	assert.Equal(t, xlsxRelationship{ID: "rId2", Type: SourceRelationshipHyperLink, Target: "https://github.com/xuri/excelize", TargetMode: "External"}, rels.Relationships[1])
+	assert.Equal(t, xlsxRelationship{ID: "rId4", Type: SourceRelationshipHyperLink, Target: "#Sheet1!A1"}, rels.Relationships[3])
+	drawing, ok = f.Drawings.Load("xl/drawings/drawing2.xml")
+	assert.True(t, ok)
+	assert.Contains(t, drawing.(*xlsxWsDr).AbsoluteAnchor[0].GraphicFrame, `r:id="rId2"></a:hlinkClick>`)
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestAddChartHyperlink.xlsx")))
+	assert.NoError(t, f.Close())
+}
+
+func TestAddChartSeriesName(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetDefinedName(&DefinedName{Name: "Title", RefersTo: "Sheet1!$A$1"}))
+	series := []ChartSeries{
+		{Name: "Sheet1!$A$2", Values: "Sheet1!$B$2:$D$2"},
+		{Name: "='Sheet 1'!A3", Values: "Sheet1!$B$3:$D$3"},
+		{Name: "Title", NameRef: true, Values: "Sheet1!$B$4:$D$4"},
+		{Name: "Q1 Sales!", Values: "Sheet1!$B$5:$D$5"},
+		{Name: "Q1", Values: "Sheet1!$B$6:$D$6"},
+		{Values: "Sheet1!$B$7:$D$7"},
+	}
+	assert.NoError(t, f.AddChart("Sheet1", "E1", &Chart{Type: Col, Series: series}))
+	content, ok := f.Pkg.Load("xl/charts/chart1.xml")
+	assert.True(t, ok)
+	var chartSpace xlsxChartSpace
+	assert.NoError(t, xml.Unmarshal(content.([]byte), &chartSpace))
+	ser := *chartSpace.Chart.PlotArea.BarChart[0].Ser
+	for i, ref := range []string{"Sheet1!$A$2", "'Sheet 1'!A3", "[0]!Title"} {
+		assert.Equal(t, ref, ser[i].Tx.StrRef.F)
+		assert.Nil(t, ser[i].Tx.V)
+	}
+	for i, name := range []string{"Q1 Sales!", "Q1"} {
+		assert.Nil(t, ser[i+3].Tx.StrRef)
+		assert.Equal(t, name, *ser[i+3].Tx.V)
+	}
+	assert.Nil(t, ser[5].Tx)
+	assert.Equal(t, "Sheet1!$A$2", series[0].Name)
+	// Test add chart with the series name reference to not exist defined name
+	assert.Equal(t, newNoExistDefinedNameError("Amount", "Sheet1"), f.AddChart("Sheet1", "E20", &Chart{Type: Col, Series: []ChartSeries{{Name: "=Amount", Values: "Sheet1!$B$2:$D$2"}}}))
+	assert.NoError(t, f.Close())
+}
+
+func TestAddChartScatterSecondaryAxis(t *testing.T) {
+	f := NewFile()
+	series := []ChartSeries{
+		{Name: "Sheet1!$A$2", Categories: "Sheet1!$B$1:$D$1", Values: "Sheet1!$B$2:$D$2"},
+		{Name: "Sheet1!$A$3", Categories: "Sheet1!$B$1:$D$1", Values: "Sheet1!$B$3:$D$3", Secondary: true},
+		{Name: "Sheet1!$A$4", Categories: "Sheet1!$B$1:$D$1", Values: "Sheet1!$B$4:$D$4"},
+	}
+	chart := &Chart{Type: Scatter, Series: series, YAxis: ChartAxis{MajorUnit: 2}}
+	assert.NoError(t, f.AddChart("Sheet1", "E1", chart))
+	assert.Len(t, chart.Series, 3)
+	content, ok := f.Pkg.Load("xl/charts/chart1.xml")
+	assert.True(t, ok)
+	var chartSpace xlsxChartSpace
+	assert.NoError(t, xml.Unmarshal(content.([]byte), &chartSpace))
+	plotArea := chartSpace.Chart.PlotArea
+	assert.Len(t, plotArea.ScatterChart, 2)
+	primary, secondary := plotArea.ScatterChart[0], plotArea.ScatterChart[1]
+	assert.Equal(t, []int{100000000, 100000001}, []int{*primary.AxID[0].Val, *primary.AxID[1].Val})
+	assert.Equal(t, []int{100000003, 100000004}, []int{*secondary.AxID[0].Val, *secondary.AxID[1].Val})
+	assert.Len(t, *primary.Ser, 2)
+	assert.Equal(t, "Sheet1!$B$4:$D$4", (*primary.Ser)[1].YVal.NumRef.F)
+	assert.Equal(t, []int{0, 2}, []int{*(*primary.Ser)[0].IDx.Val, *(*primary.Ser)[1].IDx.Val})
+	assert.Equal(t, []int{0, 2}, []int{*(*primary.Ser)[0].Order.Val, *(*primary.Ser)[1].Order.Val})
+	assert.Len(t, *secondary.Ser, 1)
+	assert.Equal(t, 1, *(*secondary.Ser)[0].IDx.Val)
+	assert.Equal(t, 1, *(*secondary.Ser)[0].Order.Val)
+	assert.Equal(t, "Sheet1!$B$1:$D$1", (*secondary.Ser)[0].XVal.StrRef.F)
+	assert.Equal(t, "Sheet1!$B$3:$D$3", (*secondary.Ser)[0].YVal.NumRef.F)
+	assert.Len(t, plotArea.CatAx, 2)
+	assert.True(t, *plotArea.CatAx[1].Delete.Val)
+	assert.Len(t, plotArea.ValAx, 2)
+	assert.Equal(t, 2.0, *plotArea.ValAx[0].MajorUnit.Val)
+	assert.Equal(t, "r", *plotArea.ValAx[1].AxPos.Val)
+	assert.Equal(t, 100000003, *plotArea.ValAx[1].CrossAx.Val)
+	// Test add the series on the secondary axis for the unsupported chart type
+	assert.Equal(t, ErrSecondarySeries, f.AddChart("Sheet1", "E20", &Chart{Type: Line, Series: series}))
+	assert.Equal(t, ErrSecondarySeries, f.AddChart("Sheet1", "E20", &Chart{Type: Line, Series: series[:1]}, &Chart{Type: Scatter, Series: series}))
+	// Test add scatter chart with all series on the secondary axis
+	for i := range series {
+		series[i].Secondary = true
+	}
+	assert.Equal(t, ErrSecondarySeries, f.AddChart("Sheet1", "E20", &Chart{Type: Scatter, Series: series}))
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestAddChartScatterSecondaryAxis.xlsx")))
+	assert.NoError(t, f.Close())
+}
+
+func TestAddChartDateAxis(t *testing.T) {
+	f := NewFile()
+	for idx, row := range [][]interface{}{
+		{"Date", "Amount"},
+		{time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), 10},
+		{time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), 20},
+		{time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), 15},
+	} {
+		cell, err := CoordinatesToCellName(1, idx+1)
+		assert.NoError(t, err)
+		assert.NoError(t, f.SetSheetRow("Sheet1", cell, &row))
+	}
+	series := []ChartSeries{{Name: "Sheet1!$B$1", Categories: "Sheet1!$A$2:$A$4", Values: "Sheet1!$B$2:$B$4"}}
+	assert.NoError(t, f.AddChart("Sheet1", "D1", &Chart{
+		Type:   Line,
+		Series: series,
+		XAxis: ChartAxis{
+			Date: true, MajorGridLines: true, MajorUnit: 1,
+			BaseTimeUnit: "days", MajorTimeUnit: "Months", MinorTimeUnit: "days",
+			NumFmt: ChartNumFmt{CustomNumFmt: "yyyy-mm-dd"},
+		},
+	}))
+	content, ok := f.Pkg.Load("xl/charts/chart1.xml")
+	assert.True(t, ok)
+	var chartSpace xlsxChartSpace
+	assert.NoError(t, xml.Unmarshal(content.([]byte), &chartSpace))
+	plotArea := chartSpace.Chart.PlotArea
+	assert.Empty(t, plotArea.CatAx)
+	assert.Len(t, plotArea.DateAx, 1)
+	dateAx := plotArea.DateAx[0]
+	assert.Equal(t, 100000000, *dateAx.AxID.Val)
+	assert.Equal(t, "yyyy-mm-dd", dateAx.NumFmt.FormatCode)
+	assert.False(t, *dateAx.Auto.Val)
+	assert.NotNil(t, dateAx.MajorGridlines)
+	assert.Equal(t, []string{"days", "months", "days"}, []string{*dateAx.BaseTimeUnit.Val, *dateAx.MajorTimeUnit.Val, *dateAx.MinorTimeUnit.Val})
+	assert.Equal(t, 1.0, *dateAx.MajorUnit.Val)
+	// Test add chart with the date axis on the secondary axes of the combo chart
+	assert.NoError(t, f.AddChart("Sheet1", "D20", &Chart{Type: Col, Series: series},
+		&Chart{Type: Line, Series: series, XAxis: ChartAxis{Date: true}, YAxis: ChartAxis{Secondary: true}}))
+	content, ok = f.Pkg.Load("xl/charts/chart2.xml")
+	assert.True(t, ok)
+	chartSpace = xlsxChartSpace{}
+	assert.NoError(t, xml.Unmarshal(content.([]byte), &chartSpace))
+	plotArea = chartSpace.Chart.PlotArea
+	assert.Len(t, plotArea.CatAx, 1)
+	assert.Len(t, plotArea.DateAx, 1)
+	assert.Equal(t, 100000003, *plotArea.DateAx[0].AxID.Val)
+	assert.Equal(t, "General", plotArea.DateAx[0].NumFmt.FormatCode)
+	assert.True(t, plotArea.DateAx[0].NumFmt.SourceLinked)
+	assert.Nil(t, plotArea.DateAx[0].BaseTimeUnit)
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestAddChartDateAxis.xlsx")))
+	// Test add chart with invalid time units
+	assert.Equal(t, ErrParameterInvalid, f.AddChart("Sheet1", "D40", &Chart{Type: Line, Series: series, XAxis: ChartAxis{Date: true, BaseTimeUnit: "hours"}}))
+	assert.Equal(t, ErrParameterInvalid, f.AddChart("Sheet1", "D40", &Chart{Type: Col, Series: series}, &Chart{Type: Line, Series: series, XAxis: ChartAxis{MajorTimeUnit: "weeks"}}))
+	assert.NoError(t, f.Close())
+}
+
+func TestAddChartDataLabels(t *testing.T) {
+	f := NewFile()
+	series := []ChartSeries{
+		{Name: "Sheet1!$A$2", Categories: "Sheet1!$B$1:$D$1", Values: "Sheet1!$B$2:$D$2", DataLabelPosition: ChartDataLabelsPositionBestFit, DataLabelSeparator: "\n"},
+		{Name: "Sheet1!$A$3", Categories: "Sheet1!$B$1:$D$1", Values: "Sheet1!$B$3:$D$3"},
+	}
+	assert.NoError(t, f.AddChart("Sheet1", "E1", &Chart{Type: Pie, Series: series, PlotArea: ChartPlotArea{ShowCatName: true, ShowVal: true}}))
+	content, ok := f.Pkg.Load("xl/charts/chart1.xml")
+	assert.True(t, ok)
+	var chartSpace xlsxChartSpace
+	assert.NoError(t, xml.Unmarshal(content.([]byte), &chartSpace))
+	dLbls := (*chartSpace.Chart.PlotArea.PieChart[0].Ser)[0].DLbls
+	assert.Equal(t, "bestFit", *dLbls.DLblPos.Val)
+	assert.Equal(t, "\n", *dLbls.Separator)
+	assert.Nil(t, (*chartSpace.Chart.PlotArea.PieChart[0].Ser)[1].DLbls.Separator)
+	// Test add chart with both value and percentage in data labels with separator
+	assert.NoError(t, f.AddChart("Sheet1", "E40", &Chart{Type: BarPercentStacked, Series: series[1:], PlotArea: ChartPlotArea{ShowVal: true, ShowPercent: true, DataLabelSeparator: "; "}}))
+	content, ok = f.Pkg.Load("xl/charts/chart2.xml")
+	assert.True(t, ok)
+	chartSpace = xlsxChartSpace{}
+	assert.NoError(t, xml.Unmarshal(content.([]byte), &chartSpace))
+	for _, dLbls := range []*cDLbls{chartSpace.Chart.PlotArea.BarChart[0].DLbls, (*chartSpace.Chart.PlotArea.BarChart[0].Ser)[0].DLbls} {
+		assert.True(t, *dLbls.ShowVal.Val)
+		assert.True(t, *dLbls.ShowPercent.Val)
+		assert.Equal(t, "; ", *dLbls.Separator)
+	}
+	// Test the data label separator of the series takes precedence over the plot area
+	assert.NoError(t, f.AddChart("Sheet1", "E60", &Chart{Type: Pie, Series: series, PlotArea: ChartPlotArea{ShowVal: true, ShowPercent: true, DataLabelSeparator: "; "}}))
+	content, ok = f.Pkg.Load("xl/charts/chart3.xml")
+	assert.True(t, ok)
+	chartSpace = xlsxChartSpace{}
+	assert.NoError(t, xml.Unmarshal(content.([]byte), &chartSpace))
+	assert.Equal(t, "\n", *(*chartSpace.Chart.PlotArea.PieChart[0].Ser)[0].DLbls.Separator)
+	assert.Equal(t, "; ", *(*chartSpace.Chart.PlotArea.PieChart[0].Ser)[1].DLbls.Separator)
+	// Test add chart with the data labels position unsupported by the chart type
+	assert.Equal(t, newUnsupportedDataLabelPositionError(Col, ChartDataLabelsPositionBestFit), f.AddChart("Sheet1", "E20", &Chart{Type: Col, Series: series}))
+	assert.Equal(t, newUnsupportedDataLabelPositionError(Area, ChartDataLabelsPositionBestFit), f.AddChart("Sheet1", "E20", &Chart{Type: Col, Series: series[1:]}, &Chart{Type: Area, Series: series}))
+	assert.NoError(t, f.Close())
+}
+
+func TestAddChartFromJSON(t *testing.T) {
+	chart := &Chart{
+		Type: Col,
+		Series: []ChartSeries{
+			{Name: "Sheet1!$A$2", Categories: "Sheet1!$B$1:$D$1", Values: "Sheet1!$B$2:$D$2", Fill: Fill{Type: "pattern", Color: []string{"FF0000"}, Pattern: 1}},
+			{Name: "Sheet1!$A$3", Categories: "Sheet1!$B$1:$D$1", Values: "Sheet1!$B$3:$D$3", DataLabelPosition: ChartDataLabelsPositionOutsideEnd},
+		},
+		Format:     GraphicOptions{OffsetX: 15, OffsetY: 10, ScaleX: 1.5, Locked: boolPtr(true)},
+		Legend:     ChartLegend{Position: "left", ShowLegendKey: true},
+		Title:      []RichTextRun{{Text: "Fruit", Font: &Font{Bold: true, Color: "0000FF"}}},
+		VaryColors: boolPtr(false),
+		XAxis:      ChartAxis{MajorGridLines: true, Title: []RichTextRun{{Text: "Fruits"}}},
+		YAxis:      ChartAxis{Maximum: float64Ptr(7.5), Minimum: float64Ptr(0.5), NumFmt: ChartNumFmt{CustomNumFmt: "0.00"}},
+		PlotArea:   ChartPlotArea{ShowVal: true, ShowPercent: true},
+	}
+	combo := &Chart{
+		Type:   Line,
+		Series: []ChartSeries{{Name: "Sheet1!$A$4", Categories: "Sheet1!$B$1:$D$1", Values: "Sheet1!$B$4:$D$4", Marker: ChartMarker{Symbol: "none"}}},
+	}
+	data, err := json.Marshal([]*Chart{chart, combo})
+	assert.NoError(t, err)
+	// Test the exported then imported chart creates the same chart
+	f1, f2 := NewFile(), NewFile()
+	assert.NoError(t, f1.AddChart("Sheet1", "E1", chart, combo))
+	assert.NoError(t, f2.AddChartFromJSON("Sheet1", "E1", data))
+	_, err = f1.WriteToBuffer()
+	assert.NoError(t, err)
+	_, err = f2.WriteToBuffer()
+	assert.NoError(t, err)
+	for _, name := range []string{"xl/charts/chart1.xml", "xl/drawings/drawing1.xml"} {
+		assert.NotEmpty(t, f1.readXML(name))
+		assert.Equal(t, string(f1.readXML(name)), string(f2.readXML(name)), name)
+	}
+	// Test add chart from JSON with the omitted fields
+	assert.NoError(t, f2.AddChartFromJSON("Sheet1", "E20", []byte(`{"Type": 21, "Series": [{"Values": "Sheet1!$B$2:$D$2"}]}`)))
+	// Test add chart from JSON with invalid JSON
+	assert.EqualError(t, f2.AddChartFromJSON("Sheet1", "E40", []byte(`{"Type": "Col"}`)), "json: cannot unmarshal string into Go struct field Chart.Type of type excelize.ChartType")
+	assert.EqualError(t, f2.AddChartFromJSON("Sheet1", "E40", []byte(`[{"Typo": 21}]`)), "json: unknown field \"Typo\"")
+	assert.Equal(t, ErrParameterInvalid, f2.AddChartFromJSON("Sheet1", "E40", []byte(` []`)))
+	// Test add chart from JSON with unsupported chart type
+	assert.Equal(t, newUnsupportedChartType(255), f2.AddChartFromJSON("Sheet1", "E40", []byte(`{"Type": 255}`)))
+	assert.NoError(t, f1.Close())
+	assert.NoError(t, f2.Close())
+}
+
 func TestAddChartSheet(t *testing.T) {
 	categories := map[string]string{"A2": "Small", "A3": "Normal", "A4": "Large", "B1": "Apple", "C1": "Orange", "D1": "Pear"}
 	values := map[string]int{"B2": 2, "C2": 3, "D2": 3, "B3": 5, "C3": 2, "D3": 4, "B4": 6, "C4": 7, "D4": 8}
@@ -408,6 +826,70 @@ func TestDeleteChart(t *testing.T) {
 	assert.NoError(t, f.Close())
 }
 
+func TestGetChartAxisTitles(t *testing.T) {
+	f := NewFile()
+	for idx, row := range [][]interface{}{{nil, "Apple", "Orange"}, {"Small", 2, 3}, {"Normal", 5, 2}} {
+		assert.NoError(t, f.SetSheetRow("Sheet1", fmt.Sprintf("A%d", idx+1), &row))
+	}
+	series := []ChartSeries{
+		{Name: "Sheet1!$A$2", Categories: "Sheet1!$B$1:$C$1", Values: "Sheet1!$B$2:$C$2"},
+		{Name: "Sheet1!$A$3", Categories: "Sheet1!$B$1:$C$1", Values: "Sheet1!$B$3:$C$3"},
+	}
+	assert.NoError(t, f.AddChart("Sheet1", "E1", &Chart{
+		Type: Col, Series: series,
+		XAxis: ChartAxis{Title: []RichTextRun{{Text: "Fruit"}}},
+		YAxis: ChartAxis{Title: []RichTextRun{{Text: "Amount"}, {Text: "(kg)", Font: &Font{Bold: true}}}},
+	}))
+	assert.NoError(t, f.AddChart("Sheet1", "E20", &Chart{Type: Line, Series: series}))
+	assert.NoError(t, f.AddChart("Sheet1", "N1", &Chart{
+		Type: Scatter, Series: series, XAxis: ChartAxis{Title: []RichTextRun{{Text: "X"}}},
+	}))
+	check := func(cell, expectedX, expectedY string) {
+		xTitle, yTitle, err := f.GetChartAxisTitles("Sheet1", cell)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedX, xTitle, cell)
+		assert.Equal(t, expectedY, yTitle, cell)
+	}
+	check("E1", "Fruit", "Amount\n(kg)")
+	check("E20", "", "")
+	check("N1", "X", "")
+	// Test get chart axis titles without chart at the cell
+	check("A1", "", "")
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestGetChartAxisTitles.xlsx")))
+	assert.NoError(t, f.Close())
+
+	f, err := OpenFile(filepath.Join("test", "TestGetChartAxisTitles.xlsx"))
+	assert.NoError(t, err)
+	check("E1", "Fruit", "Amount\n(kg)")
+	check("N1", "X", "")
+	// Test get chart axis titles with the cached value of the string reference
+	f.Pkg.Store("xl/charts/chart2.xml", []byte(`<c:chartSpace xmlns:c="http://schemas.openxmlformats.org/drawingml/2006/chart"><c:chart><c:plotArea><c:valAx><c:title><c:tx><c:strRef><c:f>Sheet1!$A$1</c:f><c:strCache><c:pt idx="0"><c:v>Y</c:v></c:pt></c:strCache></c:strRef></c:tx></c:title></c:valAx><c:valAx/></c:plotArea></c:chart></c:chartSpace>`))
+	check("E20", "Y", "")
+	// Test get chart axis titles with unsupported charset chart
+	f.Pkg.Store("xl/charts/chart2.xml", MacintoshCyrillicCharset)
+	_, _, err = f.GetChartAxisTitles("Sheet1", "E20")
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+	// Test get chart axis titles without the chart relationship
+	f.Relationships.Delete("xl/drawings/_rels/drawing1.xml.rels")
+	f.Pkg.Delete("xl/drawings/_rels/drawing1.xml.rels")
+	check("E1", "", "")
+	// Test get chart axis titles with invalid parameters
+	_, _, err = f.GetChartAxisTitles("Sheet1", "A")
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), err)
+	_, _, err = f.GetChartAxisTitles("SheetN", "A1")
+	assert.EqualError(t, err, "sheet SheetN does not exist")
+	assert.NoError(t, f.Close())
+
+	// Test get chart axis titles with unsupported charset drawing
+	f = NewFile()
+	assert.NoError(t, f.AddChart("Sheet1", "E1", &Chart{Type: Col, Series: series}))
+	f.Drawings.Delete("xl/drawings/drawing1.xml")
+	f.Pkg.Store("xl/drawings/drawing1.xml", MacintoshCyrillicCharset)
+	_, _, err = f.GetChartAxisTitles("Sheet1", "E1")
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+	assert.NoError(t, f.Close())
+}
+
 func TestChartWithLogarithmicBase(t *testing.T) {
 	// Create test workbook with data
 	f := NewFile()
@@ -286,7 +286,9 @@ func (f *File) GetColVisible(sheet, col string) (bool, error) {
 //
 //	err := f.SetColVisible("Sheet1", "D", false)
 //
-// Hide the columns from D to F (included):
+// Hide the columns from D to F (included), the columns range in reversed order
+// like "F:D" is invalid, and the adjacent columns with the same settings will
+// be written as a single column definition on saving:
 //
 //	err := f.SetColVisible("Sheet1", "D:F", false)
 func (f *File) SetColVisible(sheet, columns string, visible bool) error {
@@ -294,6 +296,9 @@ func (f *File) SetColVisible(sheet, columns string, visible bool) error {
 	if err != nil {
 		return err
 	}
+	if col, _ := ColumnNameToNumber(strings.Split(columns, ":")[0]); col != minVal {
+		return ErrParameterInvalid
+	}
 	ws, err := f.workSheetReader(sheet)
 	if err != nil {
 		return err
@@ -353,9 +358,109 @@ func (f *File) GetColOutlineLevel(sheet, col string) (uint8, error) {
 	return level, err
 }
 
-// parseColRange parse and convert column range with column name to the column number.
+// GroupColumns provides a function to group columns by given worksheet name,
+// columns range and optional group settings. The outline level of each column
+// in the range will be increased by 1, and the maximum outline level is 7. Set
+// the Collapsed field of the GroupOptions to true to hide the grouped columns
+// and collapse the group, the summary column will be the next column of the
+// group, or the previous column if the OutlineSummaryRight of the worksheet
+// properties was set to false. This function is concurrency safe. For example,
+// group columns from D to F in Sheet1, and group column E in the collapsed
+// state:
+//
+//	err := f.GroupColumns("Sheet1", "D:F")
+//	err = f.GroupColumns("Sheet1", "E", excelize.GroupOptions{Collapsed: true})
+func (f *File) GroupColumns(sheet, columns string, opts ...GroupOptions) error {
+	minVal, maxVal, err := f.parseColRange(columns)
+	if err != nil {
+		return err
+	}
+	ws, err := f.workSheetReader(sheet)
+	if err != nil {
+		return err
+	}
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	var options GroupOptions
+	for _, opt := range opts {
+		options = opt
+	}
+	if ws.Cols == nil {
+		ws.Cols = &xlsxCols{}
+	}
+	level := uint8(1)
+	for _, c := range ws.Cols.Col {
+		if c.Min <= maxVal && c.Max >= minVal {
+			if c.OutlineLevel >= 7 {
+				return ErrOutlineLevel
+			}
+			if c.OutlineLevel+1 > level {
+				level = c.OutlineLevel + 1
+			}
+		}
+	}
+	if ws.SheetFormatPr == nil {
+		ws.SheetFormatPr = &xlsxSheetFormatPr{DefaultRowHeight: defaultRowHeight}
+	}
+	if level > ws.SheetFormatPr.OutlineLevelCol {
+		ws.SheetFormatPr.OutlineLevelCol = level
+	}
+	ws.Cols.Col = flatCols(xlsxCol{
+		Min:          minVal,
+		Max:          maxVal,
+		Width:        float64Ptr(defaultColWidth),
+		Hidden:       options.Collapsed,
+		CustomWidth:  true,
+		OutlineLevel: 1,
+	}, ws.Cols.Col, func(fc, c xlsxCol) xlsxCol {
+		fc.BestFit = c.BestFit
+		fc.Collapsed = c.Collapsed
+		fc.CustomWidth = c.CustomWidth
+		fc.Hidden = c.Hidden || options.Collapsed
+		fc.OutlineLevel = c.OutlineLevel + 1
+		fc.Phonetic = c.Phonetic
+		fc.Style = c.Style
+		fc.Width = c.Width
+		return fc
+	})
+	if options.Collapsed {
+		summaryCol := maxVal + 1
+		if ws.SheetPr != nil && ws.SheetPr.OutlinePr != nil && ws.SheetPr.OutlinePr.SummaryRight != nil &&
+			!*ws.SheetPr.OutlinePr.SummaryRight {
+			summaryCol = minVal - 1
+		}
+		if summaryCol >= 1 && summaryCol <= MaxColumns {
+			ws.Cols.Col = flatCols(xlsxCol{
+				Min:         summaryCol,
+				Max:         summaryCol,
+				Width:       float64Ptr(defaultColWidth),
+				Collapsed:   true,
+				CustomWidth: true,
+			}, ws.Cols.Col, func(fc, c xlsxCol) xlsxCol {
+				fc.BestFit = c.BestFit
+				fc.CustomWidth = c.CustomWidth
+				fc.Hidden = c.Hidden
+				fc.OutlineLevel = c.OutlineLevel
+				fc.Phonetic = c.Phonetic
+				fc.Style = c.Style
+				fc.Width = c.Width
+				return fc
+			})
+		}
+	}
+	return err
+}
+
+// parseColRange parse and convert column range with column name to the column
+// number, the reversed columns range will be normalized in ascending order.
+// The caller should validate the columns order if the reversed columns range
+// is not allowed.
 func (f *File) parseColRange(columns string) (minVal, maxVal int, err error) {
 	colsTab := strings.Split(columns, ":")
+	if len(colsTab) > 2 {
+		err = ErrParameterInvalid
+		return
+	}
 	minVal, err = ColumnNameToNumber(colsTab[0])
 	if err != nil {
 		return
@@ -450,6 +555,21 @@ func (f *File) SetColStyle(sheet, columns string, styleID int) error {
 	}
 	s.mu.Unlock()
 	ws.mu.Lock()
+	ws.setColStyle(minVal, maxVal, styleID)
+	ws.mu.Unlock()
+	if rows := len(ws.SheetData.Row); rows > 0 {
+		for col := minVal; col <= maxVal; col++ {
+			from, _ := CoordinatesToCellName(col, 1)
+			to, _ := CoordinatesToCellName(col, rows)
+			err = f.SetCellStyle(sheet, from, to, styleID)
+		}
+	}
+	return err
+}
+
+// setColStyle provides a function to set the style attribute of the columns
+// by given columns range and style ID.
+func (ws *xlsxWorksheet) setColStyle(minVal, maxVal, styleID int) {
 	if ws.Cols == nil {
 		ws.Cols = &xlsxCols{}
 	}
@@ -472,15 +592,6 @@ func (f *File) SetColStyle(sheet, columns string, styleID int) error {
 		fc.Width = c.Width
 		return fc
 	})
-	ws.mu.Unlock()
-	if rows := len(ws.SheetData.Row); rows > 0 {
-		for col := minVal; col <= maxVal; col++ {
-			from, _ := CoordinatesToCellName(col, 1)
-			to, _ := CoordinatesToCellName(col, rows)
-			err = f.SetCellStyle(sheet, from, to, styleID)
-		}
-	}
-	return err
 }
 
 // SetColWidth provides a function to set the width of a single column or
@@ -219,7 +219,7 @@ func TestColumnVisibility(t *testing.T) {
 		assert.Equal(t, false, visible)
 		assert.NoError(t, err)
 		// ...and displaying them back SetColVisible(...true)
-		assert.NoError(t, f.SetColVisible("Sheet1", "V:F", true))
+		assert.NoError(t, f.SetColVisible("Sheet1", "F:V", true))
 		visible, err = f.GetColVisible("Sheet1", "F")
 		assert.Equal(t, true, visible)
 		assert.NoError(t, err)
@@ -247,10 +247,34 @@ func TestColumnVisibility(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NoError(t, f.SetColVisible("Sheet3", "E", false))
 		assert.EqualError(t, f.SetColVisible("Sheet1", "A:-1", true), newInvalidColumnNameError("-1").Error())
+		// Test set column visible with invalid columns range
+		assert.Equal(t, ErrParameterInvalid, f.SetColVisible("Sheet1", "A:B:C", true))
+		assert.Equal(t, ErrParameterInvalid, f.SetColVisible("Sheet1", "V:F", true))
 		assert.EqualError(t, f.SetColVisible("SheetN", "E", false), "sheet SheetN does not exist")
 		assert.NoError(t, f.SaveAs(filepath.Join("test", "TestColumnVisibility.xlsx")))
 	})
 
+	t.Run("TestColumnsRange", func(t *testing.T) {
+		f := NewFile()
+		assert.NoError(t, f.SetColVisible("Sheet1", "B:D", false))
+		for col, expected := range map[string]bool{"A": true, "B": false, "C": false, "D": false, "E": true} {
+			visible, err := f.GetColVisible("Sheet1", col)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, visible)
+		}
+		// Test the hidden columns range was saved as a single column definition
+		buf, err := f.WriteToBuffer()
+		assert.NoError(t, err)
+		f, err = OpenReader(buf)
+		assert.NoError(t, err)
+		ws, err := f.workSheetReader("Sheet1")
+		assert.NoError(t, err)
+		assert.Len(t, ws.Cols.Col, 1)
+		assert.Equal(t, 2, ws.Cols.Col[0].Min)
+		assert.Equal(t, 4, ws.Cols.Col[0].Max)
+		assert.NoError(t, f.Close())
+	})
+
 	t.Run("TestBook3", func(t *testing.T) {
 		f, err := prepareTestBook3()
 		assert.NoError(t, err)
@@ -329,6 +353,50 @@ func TestOutlineLevel(t *testing.T) {
 	assert.NoError(t, f.Close())
 }
 
+func TestGroupColumns(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetColWidth("Sheet1", "E", "E", 20))
+	assert.NoError(t, f.GroupColumns("Sheet1", "F:B"))
+	assert.NoError(t, f.GroupColumns("Sheet1", "D:E", GroupOptions{Collapsed: true}))
+	for col, expected := range map[string]uint8{"A": 0, "B": 1, "C": 1, "D": 2, "E": 2, "F": 1, "G": 0} {
+		level, err := f.GetColOutlineLevel("Sheet1", col)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, level)
+	}
+	for col, expected := range map[string]bool{"C": true, "D": false, "E": false, "F": true} {
+		visible, err := f.GetColVisible("Sheet1", col)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, visible)
+	}
+	width, err := f.GetColWidth("Sheet1", "E")
+	assert.NoError(t, err)
+	assert.Equal(t, 20.0, width)
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	for _, col := range ws.(*xlsxWorksheet).Cols.Col {
+		assert.Equal(t, col.Min == 6, col.Collapsed)
+	}
+	assert.Equal(t, uint8(2), ws.(*xlsxWorksheet).SheetFormatPr.OutlineLevelCol)
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestGroupColumns.xlsx")))
+
+	// Test group columns with summary columns left to detail
+	f = NewFile()
+	assert.NoError(t, f.SetSheetProps("Sheet1", &SheetPropsOptions{OutlineSummaryRight: boolPtr(false)}))
+	assert.NoError(t, f.GroupColumns("Sheet1", "B:C", GroupOptions{Collapsed: true}))
+	ws, ok = f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	for _, col := range ws.(*xlsxWorksheet).Cols.Col {
+		assert.Equal(t, col.Min == 1, col.Collapsed)
+	}
+	// Test group columns exceeds the maximum outline level
+	assert.NoError(t, f.SetColOutlineLevel("Sheet1", "H", 7))
+	assert.Equal(t, ErrOutlineLevel, f.GroupColumns("Sheet1", "G:H"))
+	// Test group columns with invalid column name
+	assert.Equal(t, newInvalidColumnNameError("*"), f.GroupColumns("Sheet1", "*"))
+	// Test group columns on not exists worksheet
+	assert.EqualError(t, f.GroupColumns("SheetN", "A"), "sheet SheetN does not exist")
+}
+
 func TestSetColStyle(t *testing.T) {
 	f := NewFile()
 	assert.NoError(t, f.SetCellValue("Sheet1", "B2", "Hello"))
@@ -944,7 +944,7 @@ func (c *cfb) writeSectorChains(location []int) sector {
 		if sectorSize = len(sector.content); sectorSize == 0 || sectorSize >= 0x1000 {
 			continue
 		}
-		sector.start = offset
+		c.sectors[j].start = offset
 		offset = writeSectorChain((sectorSize+0x3F)>>6, offset)
 	}
 	for c.position&0x1FF != 0 {
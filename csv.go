@@ -0,0 +1,245 @@
+// Copyright 2016 - 2024 The excelize Authors. All rights reserved. Use of
+// this source code is governed by a BSD-style license that can be found in
+// the LICENSE file.
+//
+// Package excelize providing a set of functions that allow you to write to and
+// read from XLAM / XLSM / XLSX / XLTM / XLTX files. Supports reading and
+// writing spreadsheet documents generated by Microsoft Excel™ 2007 and later.
+// Supports complex components by high compatibility, and provided streaming
+// API for generating or reading data from a worksheet with huge amounts of
+// data. This library needs Go version 1.18 or later.
+
+package excelize
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// csvNumberExp defined the regular expression of the number in the CSV data.
+var csvNumberExp = regexp.MustCompile(`^[-+]?(\d+\.?\d*|\.\d+)([eE][-+]?\d+)?$`)
+
+// csvDateLayouts defined the supported layouts of the date and time in the
+// CSV data.
+var csvDateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"01/02/2006 15:04:05",
+	"01/02/2006 15:04",
+	"01/02/2006",
+	"1/2/2006",
+}
+
+// CSVOptions directly maps the settings of the CSV (comma-separated values)
+// export.
+//
+// Delimiter specifies the field delimiter, the default value is comma (,).
+//
+// Range specifies the cell range reference of the worksheet to be exported,
+// for example A1:D10. The used range of the worksheet will be exported by
+// default.
+//
+// QuoteAll specifies if enclose all fields in double quotes, by default only
+// the fields containing the delimiter, double quotes, line breaks or leading
+// spaces will be quoted.
+//
+// UseCRLF specifies if use \r\n as the line terminator instead of \n.
+//
+// RawCellValue specifies if export the raw values of the cells instead of
+// the values rendered by the number formats of the cells.
+type CSVOptions struct {
+	Delimiter    rune
+	Range        string
+	QuoteAll     bool
+	UseCRLF      bool
+	RawCellValue bool
+}
+
+// CSVImportOptions directly maps the settings of the CSV (comma-separated
+// values) and TSV (tab-separated values) import.
+//
+// Delimiter specifies the field delimiter, the default value is comma (,),
+// use tab (\t) for the TSV data.
+//
+// Cell specifies the top left cell reference of the worksheet where the data
+// will be written, the default value is A1.
+//
+// InferTypes specifies if detect the numbers, dates and booleans in the data
+// and write them as typed cells, the default value is false, which means all
+// fields will be written as strings, so that values such as ZIP codes with
+// leading zeros will be kept as they are.
+type CSVImportOptions struct {
+	Delimiter  rune
+	Cell       string
+	InferTypes bool
+}
+
+// GetSheetCSV provides a function to export the worksheet or a range of the
+// worksheet to CSV (comma-separated values) by given worksheet name and CSV
+// options. The cell values will be rendered with the number formats applied
+// to the cells, so that the dates and currencies appear as displayed in the
+// spreadsheet application. For example, export the range A1:D10 in the
+// worksheet named Sheet1 with semicolon delimiter:
+//
+//	data, err := f.GetSheetCSV("Sheet1", excelize.CSVOptions{
+//	    Delimiter: ';',
+//	    Range:     "A1:D10",
+//	})
+func (f *File) GetSheetCSV(sheet string, opts CSVOptions) ([]byte, error) {
+	delimiter, err := opts.delimiter()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := f.GetRows(sheet, Options{RawCellValue: opts.RawCellValue})
+	if err != nil {
+		return nil, err
+	}
+	fromCol, fromRow, toCol, toRow := 1, 1, 0, len(rows)
+	for _, row := range rows {
+		if len(row) > toCol {
+			toCol = len(row)
+		}
+	}
+	if opts.Range != "" {
+		coordinates, err := rangeRefToCoordinates(opts.Range)
+		if err != nil {
+			return nil, err
+		}
+		_ = sortCoordinates(coordinates)
+		fromCol, fromRow, toCol, toRow = coordinates[0], coordinates[1], coordinates[2], coordinates[3]
+	}
+	lineTerminator := "\n"
+	if opts.UseCRLF {
+		lineTerminator = "\r\n"
+	}
+	var buf bytes.Buffer
+	for r := fromRow; r <= toRow; r++ {
+		for c := fromCol; c <= toCol; c++ {
+			if c > fromCol {
+				buf.WriteRune(delimiter)
+			}
+			var value string
+			if r <= len(rows) && c <= len(rows[r-1]) {
+				value = rows[r-1][c-1]
+			}
+			writeCSVField(&buf, value, delimiter, opts.QuoteAll)
+		}
+		buf.WriteString(lineTerminator)
+	}
+	return buf.Bytes(), nil
+}
+
+// delimiter returns the field delimiter of the CSV options, the comma will be
+// used by default.
+func (opts CSVOptions) delimiter() (rune, error) {
+	if opts.Delimiter == 0 {
+		return ',', nil
+	}
+	if opts.Delimiter == '"' || opts.Delimiter == '\r' || opts.Delimiter == '\n' ||
+		!utf8.ValidRune(opts.Delimiter) || opts.Delimiter == utf8.RuneError {
+		return 0, ErrParameterInvalid
+	}
+	return opts.Delimiter, nil
+}
+
+// writeCSVField writes the field into the buffer, the field will be enclosed
+// in double quotes if required.
+func writeCSVField(buf *bytes.Buffer, field string, delimiter rune, quoteAll bool) {
+	if !quoteAll && (field == "" || field[0] != ' ' && field[0] != '\t' &&
+		!strings.ContainsRune(field, delimiter) && !strings.ContainsAny(field, "\"\r\n")) {
+		buf.WriteString(field)
+		return
+	}
+	buf.WriteByte('"')
+	buf.WriteString(strings.ReplaceAll(field, `"`, `""`))
+	buf.WriteByte('"')
+}
+
+// SetSheetFromCSV provides a function to import the CSV (comma-separated
+// values) or TSV (tab-separated values) data from the reader into the
+// worksheet by given worksheet name, reader and import options. All fields
+// in the data will be written as strings by default. For example, import the
+// TSV data into the worksheet named Sheet1 starting at cell B2, and write the
+// numbers, dates and booleans in the data as typed cells:
+//
+//	file, err := os.Open("data.tsv")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	defer file.Close()
+//	err = f.SetSheetFromCSV("Sheet1", file, excelize.CSVImportOptions{
+//	    Delimiter:  '\t',
+//	    Cell:       "B2",
+//	    InferTypes: true,
+//	})
+func (f *File) SetSheetFromCSV(sheet string, r io.Reader, opts CSVImportOptions) error {
+	delimiter, err := CSVOptions{Delimiter: opts.Delimiter}.delimiter()
+	if err != nil {
+		return err
+	}
+	if opts.Cell == "" {
+		opts.Cell = "A1"
+	}
+	col, row, err := CellNameToCoordinates(opts.Cell)
+	if err != nil {
+		return err
+	}
+	reader := csv.NewReader(r)
+	reader.Comma, reader.FieldsPerRecord = delimiter, -1
+	for ; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		values := make([]interface{}, len(record))
+		for i, field := range record {
+			values[i] = field
+			if opts.InferTypes {
+				values[i] = inferCSVFieldType(field)
+			}
+		}
+		cell, err := CoordinatesToCellName(col, row)
+		if err != nil {
+			return err
+		}
+		if err = f.SetSheetRow(sheet, cell, &values); err != nil {
+			return err
+		}
+	}
+}
+
+// inferCSVFieldType returns the typed value of the field in the CSV data,
+// the numbers, dates and booleans will be detected.
+func inferCSVFieldType(field string) interface{} {
+	value := strings.TrimSpace(field)
+	if value == "" {
+		return field
+	}
+	if csvNumberExp.MatchString(value) {
+		if num, err := strconv.ParseFloat(value, 64); err == nil {
+			return num
+		}
+	}
+	if strings.EqualFold(value, "TRUE") || strings.EqualFold(value, "FALSE") {
+		return strings.EqualFold(value, "TRUE")
+	}
+	for _, layout := range csvDateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t
+		}
+	}
+	return field
+}
@@ -0,0 +1,95 @@
+package excelize
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSheetCSV(t *testing.T) {
+	f := NewFile()
+	for cell, value := range map[string]interface{}{
+		"A1": "Name", "B1": "Date", "C1": "Amount",
+		"A2": "Smith, John", "B2": time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), "C2": 1234.5,
+		"A3": "Say \"Hi\"", "C3": 0.25, "D4": " padded",
+	} {
+		assert.NoError(t, f.SetCellValue("Sheet1", cell, value))
+	}
+	style, err := f.NewStyle(&Style{NumFmt: 4})
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetCellStyle("Sheet1", "C2", "C2", style))
+	style, err = f.NewStyle(&Style{NumFmt: 10})
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetCellStyle("Sheet1", "C3", "C3", style))
+
+	data, err := f.GetSheetCSV("Sheet1", CSVOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "Name,Date,Amount,\n\"Smith, John\",3/1/24 00:00,\"1,234.50\",\n\"Say \"\"Hi\"\"\",,25.00%,\n,,,\" padded\"\n", string(data))
+
+	data, err = f.GetSheetCSV("Sheet1", CSVOptions{Delimiter: ';', Range: "C3:A2", UseCRLF: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "Smith, John;3/1/24 00:00;1,234.50\r\n\"Say \"\"Hi\"\"\";;25.00%\r\n", string(data))
+
+	data, err = f.GetSheetCSV("Sheet1", CSVOptions{Delimiter: '\t', Range: "B2:C6", QuoteAll: true, RawCellValue: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "\"45352\"\t\"1234.5\"\n\"\"\t\"0.25\"\n\"\"\t\"\"\n\"\"\t\"\"\n\"\"\t\"\"\n", string(data))
+
+	// Test get CSV with invalid options
+	for _, delimiter := range []rune{'"', '\r', '\n', -1} {
+		_, err = f.GetSheetCSV("Sheet1", CSVOptions{Delimiter: delimiter})
+		assert.Equal(t, ErrParameterInvalid, err)
+	}
+	_, err = f.GetSheetCSV("Sheet1", CSVOptions{Range: "A1:B"})
+	assert.Equal(t, newCellNameToCoordinatesError("B", newInvalidCellNameError("B")), err)
+	// Test get CSV on not exist worksheet
+	_, err = f.GetSheetCSV("SheetN", CSVOptions{})
+	assert.EqualError(t, err, "sheet SheetN does not exist")
+}
+
+func TestSetSheetFromCSV(t *testing.T) {
+	f := NewFile()
+	data := "Name,ZIP,Amount,Active,Date\n\"Smith, John\",01234,1234.5,TRUE,2024-03-01\n\"Say \"\"Hi\"\"\",98765,-2e3,false,03/15/2024 08:30\nNaN,Inf,0x10,\n"
+	assert.NoError(t, f.SetSheetFromCSV("Sheet1", strings.NewReader(data), CSVImportOptions{InferTypes: true}))
+	rows, err := f.GetRows("Sheet1", Options{RawCellValue: true})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"Name", "ZIP", "Amount", "Active", "Date"},
+		{"Smith, John", "1234", "1234.5", "1", "45352"},
+		{"Say \"Hi\"", "98765", "-2000", "0", "45366.354166666664"},
+		{"NaN", "Inf", "0x10"},
+	}, rows)
+	for cell, expected := range map[string]CellType{
+		"B2": CellTypeUnset, "D2": CellTypeBool, "A4": CellTypeSharedString,
+	} {
+		cellType, err := f.GetCellType("Sheet1", cell)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, cellType, cell)
+	}
+	value, err := f.GetCellValue("Sheet1", "E2")
+	assert.NoError(t, err)
+	assert.Equal(t, "3/1/24 00:00", value)
+
+	// Test import TSV data without type inference by default
+	f = NewFile()
+	assert.NoError(t, f.SetSheetFromCSV("Sheet1", strings.NewReader("ZIP\tActive\n01234\tTRUE\n"), CSVImportOptions{
+		Delimiter: '\t', Cell: "B2",
+	}))
+	rows, err = f.GetRows("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{nil, {"", "ZIP", "Active"}, {"", "01234", "TRUE"}}, rows)
+
+	// Test import CSV data with invalid options
+	assert.Equal(t, ErrParameterInvalid, f.SetSheetFromCSV("Sheet1", strings.NewReader(data), CSVImportOptions{Delimiter: '"'}))
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")),
+		f.SetSheetFromCSV("Sheet1", strings.NewReader(data), CSVImportOptions{Cell: "A"}))
+	assert.Equal(t, ErrMaxRows, f.SetSheetFromCSV("Sheet1", strings.NewReader("1\n2\n"), CSVImportOptions{Cell: "A1048576"}))
+	// Test import CSV data with invalid data
+	assert.EqualError(t, f.SetSheetFromCSV("Sheet1", strings.NewReader("a,\"b\n"), CSVImportOptions{}), "parse error on line 1, column 6: extraneous or missing \" in quoted-field")
+	assert.EqualError(t, f.SetSheetFromCSV("Sheet1", iotest.ErrReader(errors.New("read error")), CSVImportOptions{}), "read error")
+	// Test import CSV data on not exist worksheet
+	assert.EqualError(t, f.SetSheetFromCSV("SheetN", strings.NewReader(data), CSVImportOptions{}), "sheet SheetN does not exist")
+}
@@ -115,7 +115,15 @@ func NewDataValidation(allowBlank bool) *DataValidation {
 	}
 }
 
-// SetError set error notice.
+// SetError set error alert which shows after invalid data is entered by given
+// alert style, title and message. The style determines whether the invalid
+// data is rejected: DataValidationErrorStyleStop prevents the user from
+// entering invalid data, DataValidationErrorStyleWarning warns the user and
+// allows to continue entering the invalid data, and
+// DataValidationErrorStyleInformation only informs the user that the data is
+// invalid. The stop style will be used if the style is unsupported. The
+// length of the title must be less than or equal to 32 characters, and the
+// length of the message must be less than or equal to 255 characters.
 func (dv *DataValidation) SetError(style DataValidationErrorStyle, title, msg string) {
 	dv.Error = &msg
 	dv.ErrorTitle = &title
@@ -133,13 +141,32 @@ func (dv *DataValidation) SetError(style DataValidationErrorStyle, title, msg st
 	dv.ErrorStyle = &strStyle
 }
 
-// SetInput set prompt notice.
+// SetInput set input message which shows when the cell is selected by given
+// title and message. The length of the title must be less than or equal to 32
+// characters, and the length of the message must be less than or equal to 255
+// characters.
 func (dv *DataValidation) SetInput(title, msg string) {
 	dv.ShowInputMessage = true
 	dv.PromptTitle = &title
 	dv.Prompt = &msg
 }
 
+// checkMessages checks the length of the title and message of the input
+// message and error alert for the data validation.
+func (dv *DataValidation) checkMessages() error {
+	for _, title := range []*string{dv.PromptTitle, dv.ErrorTitle} {
+		if title != nil && len(utf16.Encode([]rune(*title))) > MaxDataValidationTitleLength {
+			return ErrDataValidationTitleLength
+		}
+	}
+	for _, msg := range []*string{dv.Prompt, dv.Error} {
+		if msg != nil && len(utf16.Encode([]rune(*msg))) > MaxFieldLength {
+			return ErrDataValidationMessageLength
+		}
+	}
+	return nil
+}
+
 // SetDropList data validation list. If you type the items into the data
 // validation dialog box (a delimited list), the limit is 255 characters,
 // including the separators. If your data validation list source formula is
@@ -258,6 +285,9 @@ func (f *File) AddDataValidation(sheet string, dv *DataValidation) error {
 	if err != nil {
 		return err
 	}
+	if err = dv.checkMessages(); err != nil {
+		return err
+	}
 	ws.mu.Lock()
 	defer ws.mu.Unlock()
 	if nil == ws.DataValidations {
@@ -187,6 +187,35 @@ func TestDataValidationError(t *testing.T) {
 		DataValidationTypeWhole, DataValidationOperatorGreaterThan), ErrDataValidationRange.Error())
 	assert.NoError(t, f.SaveAs(resultFile))
 
+	// Test add data validation with invalid input message and error alert
+	dv = NewDataValidation(true)
+	dv.Sqref = "A11"
+	dv.SetInput(strings.Repeat("\u4E00", MaxDataValidationTitleLength), strings.Repeat("s", MaxFieldLength))
+	dv.SetError(DataValidationErrorStyleWarning, strings.Repeat("s", MaxDataValidationTitleLength), strings.Repeat("\u4E00", MaxFieldLength))
+	assert.NoError(t, f.AddDataValidation("Sheet1", dv))
+	for _, c := range []struct {
+		setMessages func(dv *DataValidation)
+		err         error
+	}{
+		{func(dv *DataValidation) { dv.SetInput(strings.Repeat("s", MaxDataValidationTitleLength+1), "") }, ErrDataValidationTitleLength},
+		{func(dv *DataValidation) { dv.SetInput("", strings.Repeat("s", MaxFieldLength+1)) }, ErrDataValidationMessageLength},
+		{func(dv *DataValidation) {
+			dv.SetError(DataValidationErrorStyleStop, strings.Repeat("\U0001F600", 17), "")
+		}, ErrDataValidationTitleLength},
+		{func(dv *DataValidation) {
+			dv.SetError(DataValidationErrorStyleStop, "", strings.Repeat("s", MaxFieldLength+1))
+		}, ErrDataValidationMessageLength},
+	} {
+		dv = NewDataValidation(true)
+		dv.Sqref = "A12"
+		c.setMessages(dv)
+		assert.Equal(t, c.err, f.AddDataValidation("Sheet1", dv))
+	}
+	dvs, err := f.GetDataValidations("Sheet1")
+	assert.NoError(t, err)
+	assert.Len(t, dvs, 4)
+	assert.Equal(t, "warning", *dvs[3].ErrorStyle)
+
 	// Test add data validation on no exists worksheet
 	f = NewFile()
 	assert.EqualError(t, f.AddDataValidation("SheetN", nil), "sheet SheetN does not exist")
@@ -0,0 +1,174 @@
+// Copyright 2016 - 2024 The excelize Authors. All rights reserved. Use of
+// this source code is governed by a BSD-style license that can be found in
+// the LICENSE file.
+//
+// Package excelize providing a set of functions that allow you to write to and
+// read from XLAM / XLSM / XLSX / XLTM / XLTX files. Supports reading and
+// writing spreadsheet documents generated by Microsoft Excel™ 2007 and later.
+// Supports complex components by high compatibility, and provided streaming
+// API for generating or reading data from a worksheet with huge amounts of
+// data. This library needs Go version 1.18 or later.
+
+package excelize
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// CellDiff directly maps the difference of a cell between two workbooks. The
+// OldValue and OldStyle are the value and style of the cell in the workbook,
+// and the NewValue and NewStyle are the value and style of the cell in the
+// other workbook. The OldStyle and NewStyle will be set only when comparing
+// the cell styles and the styles of the cell are different.
+type CellDiff struct {
+	Sheet    string
+	Cell     string
+	OldValue string
+	NewValue string
+	OldStyle *Style
+	NewStyle *Style
+}
+
+// DiffOptions directly maps the settings of comparing two workbooks.
+//
+// RawCellValue specifies if compare the raw values of the cells instead of
+// the values rendered by the number formats of the cells, so that the
+// differences of the number formats will be ignored.
+//
+// CompareStyles specifies if compare the styles of the cells, the styles will
+// be compared by the style definitions instead of the style indexes.
+type DiffOptions struct {
+	RawCellValue  bool
+	CompareStyles bool
+}
+
+// diffCell directly maps the value and style index of a cell for comparing
+// workbooks.
+type diffCell struct {
+	value   string
+	styleID int
+}
+
+// Diff provides a function to compare the cell values and optionally the cell
+// styles of the worksheets with the same name (case-insensitive) between the
+// workbook and the other workbook, and returns the differences ordered by the
+// worksheets, rows and columns. The worksheets that exist in only one of the
+// workbooks will be compared as blank worksheets. For example, compare the
+// cell values and styles of the generated report with the expected workbook:
+//
+//	diffs, err := expected.Diff(report, excelize.DiffOptions{CompareStyles: true})
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	for _, diff := range diffs {
+//	    fmt.Printf("%s!%s: %q -> %q\n", diff.Sheet, diff.Cell, diff.OldValue, diff.NewValue)
+//	}
+func (f *File) Diff(other *File, opts ...DiffOptions) ([]CellDiff, error) {
+	var (
+		options DiffOptions
+		diffs   []CellDiff
+		sheets  = f.GetSheetList()
+		matched = make(map[string]string)
+		styles  = make(map[[2]int][2]*Style)
+	)
+	for _, opt := range opts {
+		options = opt
+	}
+	for _, sheet := range other.GetSheetList() {
+		matched[strings.ToLower(sheet)] = sheet
+	}
+	for _, sheet := range sheets {
+		delete(matched, strings.ToLower(sheet))
+	}
+	for _, sheet := range other.GetSheetList() {
+		if _, ok := matched[strings.ToLower(sheet)]; ok {
+			sheets = append(sheets, sheet)
+		}
+	}
+	for _, sheet := range sheets {
+		oldCells, err := f.getDiffCells(sheet, options)
+		if err != nil {
+			return diffs, err
+		}
+		newCells, err := other.getDiffCells(sheet, options)
+		if err != nil {
+			return diffs, err
+		}
+		cells := make([][2]int, 0, len(oldCells)+len(newCells))
+		for coordinates := range oldCells {
+			cells = append(cells, coordinates)
+		}
+		for coordinates := range newCells {
+			if _, ok := oldCells[coordinates]; !ok {
+				cells = append(cells, coordinates)
+			}
+		}
+		sort.Slice(cells, func(i, j int) bool {
+			if cells[i][1] == cells[j][1] {
+				return cells[i][0] < cells[j][0]
+			}
+			return cells[i][1] < cells[j][1]
+		})
+		for _, key := range cells {
+			oldCell, newCell := oldCells[key], newCells[key]
+			cell, _ := CoordinatesToCellName(key[0], key[1])
+			diff := CellDiff{Sheet: sheet, Cell: cell, OldValue: oldCell.value, NewValue: newCell.value}
+			if options.CompareStyles {
+				pair, ok := styles[[2]int{oldCell.styleID, newCell.styleID}]
+				if !ok {
+					if pair[0], err = f.GetStyle(oldCell.styleID); err != nil {
+						return diffs, err
+					}
+					if pair[1], err = other.GetStyle(newCell.styleID); err != nil {
+						return diffs, err
+					}
+					if reflect.DeepEqual(pair[0], pair[1]) {
+						pair = [2]*Style{}
+					}
+					styles[[2]int{oldCell.styleID, newCell.styleID}] = pair
+				}
+				diff.OldStyle, diff.NewStyle = pair[0], pair[1]
+			}
+			if diff.OldValue != diff.NewValue || diff.OldStyle != nil {
+				diffs = append(diffs, diff)
+			}
+		}
+	}
+	return diffs, nil
+}
+
+// getDiffCells provides a function to get the values and style indexes of the
+// non-blank cells in the worksheet by given worksheet name for comparing
+// workbooks. The cells with only style will be included when comparing the
+// cell styles. It returns empty cells if the worksheet doesn't exist.
+func (f *File) getDiffCells(sheet string, opts DiffOptions) (map[[2]int]diffCell, error) {
+	cells := make(map[[2]int]diffCell)
+	if _, ok := f.getSheetXMLPath(sheet); !ok {
+		return cells, nil
+	}
+	rows, err := f.Rows(sheet)
+	if err != nil {
+		return cells, err
+	}
+	for row := 1; rows.Next(); row++ {
+		columns, err := rows.Cells(Options{RawCellValue: opts.RawCellValue})
+		if err != nil {
+			_ = rows.Close()
+			return cells, err
+		}
+		for col, cell := range columns {
+			value, _ := cell.Value.(string)
+			if value == "" && (!opts.CompareStyles || cell.StyleID == 0) {
+				continue
+			}
+			if !opts.CompareStyles {
+				cell.StyleID = 0
+			}
+			cells[[2]int{col + 1, row}] = diffCell{value: value, styleID: cell.StyleID}
+		}
+	}
+	return cells, rows.Close()
+}
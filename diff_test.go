@@ -0,0 +1,73 @@
+package excelize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	f1, f2 := NewFile(), NewFile()
+	_, err := f1.NewSheet("Old")
+	assert.NoError(t, err)
+	_, err = f2.NewSheet("New")
+	assert.NoError(t, err)
+	for cell, value := range map[string]interface{}{"A1": "Name", "B1": 100, "C2": 0.5, "D10": "removed"} {
+		assert.NoError(t, f1.SetCellValue("Sheet1", cell, value))
+	}
+	for cell, value := range map[string]interface{}{"A1": "Name", "B1": 200, "C2": 0.5, "E3": "added"} {
+		assert.NoError(t, f2.SetCellValue("SHEET1", cell, value))
+	}
+	assert.NoError(t, f1.SetCellValue("Old", "A1", "old"))
+	assert.NoError(t, f2.SetCellValue("New", "A1", "new"))
+	style, err := f2.NewStyle(&Style{NumFmt: 10, Font: &Font{Bold: true}})
+	assert.NoError(t, err)
+	assert.NoError(t, f2.SetCellStyle("Sheet1", "C2", "C2", style))
+	assert.NoError(t, f2.SetCellStyle("Sheet1", "F5", "F5", style))
+
+	diffs, err := f1.Diff(f2)
+	assert.NoError(t, err)
+	assert.Equal(t, []CellDiff{
+		{Sheet: "Sheet1", Cell: "B1", OldValue: "100", NewValue: "200"},
+		{Sheet: "Sheet1", Cell: "C2", OldValue: "0.5", NewValue: "50.00%"},
+		{Sheet: "Sheet1", Cell: "E3", NewValue: "added"},
+		{Sheet: "Sheet1", Cell: "D10", OldValue: "removed"},
+		{Sheet: "Old", Cell: "A1", OldValue: "old"},
+		{Sheet: "New", Cell: "A1", NewValue: "new"},
+	}, diffs)
+
+	// Test compare the raw cell values and the cell styles
+	diffs, err = f1.Diff(f2, DiffOptions{RawCellValue: true, CompareStyles: true})
+	assert.NoError(t, err)
+	assert.Len(t, diffs, 7)
+	assert.Equal(t, CellDiff{Sheet: "Sheet1", Cell: "B1", OldValue: "100", NewValue: "200"}, diffs[0])
+	for i, cell := range map[int]string{1: "C2", 3: "F5"} {
+		assert.Equal(t, cell, diffs[i].Cell)
+		assert.Equal(t, diffs[i].OldValue, diffs[i].NewValue)
+		assert.Equal(t, 10, diffs[i].NewStyle.NumFmt)
+		assert.True(t, diffs[i].NewStyle.Font.Bold)
+		assert.Zero(t, diffs[i].OldStyle.NumFmt)
+	}
+
+	// Test compare the same workbook
+	diffs, err = f1.Diff(f1, DiffOptions{CompareStyles: true})
+	assert.NoError(t, err)
+	assert.Empty(t, diffs)
+
+	// Test compare workbooks with invalid style
+	f2.Styles.CellXfs.Xf[style].NumFmtID = intPtr(-1)
+	f2.Styles.CellXfs.Xf = f2.Styles.CellXfs.Xf[:style]
+	_, err = f1.Diff(f2, DiffOptions{CompareStyles: true})
+	assert.Equal(t, newInvalidStyleID(style), err)
+	_, err = f2.Diff(f1, DiffOptions{CompareStyles: true})
+	assert.Equal(t, newInvalidStyleID(style), err)
+	// Test compare workbooks with unsupported charset shared strings table
+	f2.SharedStrings = nil
+	f2.Pkg.Store(defaultXMLPathSharedStrings, MacintoshCyrillicCharset)
+	_, err = f1.Diff(f2)
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+	_, err = f2.Diff(f1)
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+	assert.NoError(t, f1.Close())
+	assert.NoError(t, f2.Close())
+}
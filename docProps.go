@@ -14,10 +14,17 @@ package excelize
 import (
 	"bytes"
 	"encoding/xml"
+	"fmt"
 	"io"
 	"reflect"
+	"regexp"
+	"unicode/utf16"
 )
 
+// appVersionExp defined the regular expression of the application version in
+// the document application properties.
+var appVersionExp = regexp.MustCompile(`^\d{1,2}\.\d{4}$`)
+
 // SetAppProps provides a function to set document application properties. The
 // properties that can be set are:
 //
@@ -31,13 +38,19 @@ import (
 //	                   | show only sections that will fit the display.
 //	                   |
 //	 DocSecurity       | Security level of a document as a numeric value. Document security is
-//	                   | defined as:
+//	                   | defined as the combination of the flags:
 //	                   | 1 - Document is password protected.
 //	                   | 2 - Document is recommended to be opened as read-only.
-//	                   | 3 - Document is enforced to be opened as read-only.
-//	                   | 4 - Document is locked for annotation.
+//	                   | 4 - Document is enforced to be opened as read-only.
+//	                   | 8 - Document is locked for annotation.
+//	                   |
+//	 Manager           | The name of a supervisor associated with the document. The length of
+//	                   | this element must be less than or equal to 255 characters, and the
+//	                   | element will be omitted if the value is empty.
 //	                   |
-//	 Company           | The name of a company associated with the document.
+//	 Company           | The name of a company associated with the document. The length of
+//	                   | this element must be less than or equal to 255 characters, and the
+//	                   | element will be omitted if the value is empty.
 //	                   |
 //	 LinksUpToDate     | Indicates whether hyperlinks in a document are up-to-date. Set this
 //	                   | element to 'true' to indicate that hyperlinks are updated. Set this
@@ -51,7 +64,8 @@ import (
 //	 AppVersion        | Specifies the version of the application which produced this document.
 //	                   | The content of this element shall be of the form XX.YYYY where X and Y
 //	                   | represent numerical values, or the document shall be considered
-//	                   | non-conformant.
+//	                   | non-conformant. An error will be returned if the version isn't in
+//	                   | this form, use the FormatAppVersion function to build it.
 //
 // For example:
 //
@@ -59,6 +73,7 @@ import (
 //	    Application:       "Microsoft Excel",
 //	    ScaleCrop:         true,
 //	    DocSecurity:       3,
+//	    Manager:           "Manager Name",
 //	    Company:           "Company Name",
 //	    LinksUpToDate:     true,
 //	    HyperlinksChanged: true,
@@ -73,13 +88,22 @@ func (f *File) SetAppProps(appProperties *AppProperties) error {
 		immutable, mutable reflect.Value
 		output             []byte
 	)
+	if appProperties.AppVersion != "" && !appVersionExp.MatchString(appProperties.AppVersion) {
+		return newInvalidAppVersionError(appProperties.AppVersion)
+	}
+	immutable = reflect.ValueOf(*appProperties)
+	for _, field = range []string{"Manager", "Company"} {
+		if len(utf16.Encode([]rune(immutable.FieldByName(field).String()))) > MaxFieldLength {
+			return newFieldLengthError(field)
+		}
+	}
 	app = new(xlsxProperties)
 	if err = f.xmlNewDecoder(bytes.NewReader(namespaceStrictToTransitional(f.readXML(defaultXMLPathDocPropsApp)))).
 		Decode(app); err != nil && err != io.EOF {
 		return err
 	}
-	fields = []string{"Application", "ScaleCrop", "DocSecurity", "Company", "LinksUpToDate", "HyperlinksChanged", "AppVersion"}
-	immutable, mutable = reflect.ValueOf(*appProperties), reflect.ValueOf(app).Elem()
+	fields = []string{"Application", "ScaleCrop", "DocSecurity", "Manager", "Company", "LinksUpToDate", "HyperlinksChanged", "AppVersion"}
+	mutable = reflect.ValueOf(app).Elem()
 	for _, field = range fields {
 		immutableField := immutable.FieldByName(field)
 		switch immutableField.Kind() {
@@ -97,6 +121,20 @@ func (f *File) SetAppProps(appProperties *AppProperties) error {
 	return err
 }
 
+// FormatAppVersion provides a function to build the application version of
+// the document application properties in the form of XX.YYYY by given major
+// and minor version numbers. The range of the major version is 0-99 and the
+// range of the minor version is 0-9999. For example, build the version
+// "16.0300":
+//
+//	version, err := excelize.FormatAppVersion(16, 300)
+func FormatAppVersion(major, minor int) (string, error) {
+	if major < 0 || major > 99 || minor < 0 || minor > 9999 {
+		return "", ErrParameterInvalid
+	}
+	return fmt.Sprintf("%d.%04d", major, minor), nil
+}
+
 // GetAppProps provides a function to get document application properties.
 func (f *File) GetAppProps() (ret *AppProperties, err error) {
 	app := new(xlsxProperties)
@@ -108,6 +146,7 @@ func (f *File) GetAppProps() (ret *AppProperties, err error) {
 		Application:       app.Application,
 		ScaleCrop:         app.ScaleCrop,
 		DocSecurity:       app.DocSecurity,
+		Manager:           app.Manager,
 		Company:           app.Company,
 		LinksUpToDate:     app.LinksUpToDate,
 		HyperlinksChanged: app.HyperlinksChanged,
@@ -116,6 +155,51 @@ func (f *File) GetAppProps() (ret *AppProperties, err error) {
 	return
 }
 
+// SetDocSecurity provides a function to set the security level of the
+// document by given flags, the flags can be combined by bitwise OR of the
+// DocSecurityPasswordProtected, DocSecurityReadOnlyRecommended,
+// DocSecurityReadOnlyEnforced and DocSecurityLockedForAnnotation, and zero
+// means no document security. The other document application properties will
+// be kept. For example, recommend the document to be opened as read-only:
+//
+//	err := f.SetDocSecurity(excelize.DocSecurityReadOnlyRecommended)
+func (f *File) SetDocSecurity(flags int) error {
+	if flags < 0 || flags > DocSecurityPasswordProtected|DocSecurityReadOnlyRecommended|
+		DocSecurityReadOnlyEnforced|DocSecurityLockedForAnnotation ||
+		flags&DocSecurityReadOnlyRecommended != 0 && flags&DocSecurityReadOnlyEnforced != 0 {
+		return ErrParameterInvalid
+	}
+	app := new(xlsxProperties)
+	if err := f.xmlNewDecoder(bytes.NewReader(namespaceStrictToTransitional(f.readXML(defaultXMLPathDocPropsApp)))).
+		Decode(app); err != nil && err != io.EOF {
+		return err
+	}
+	app.DocSecurity, app.Vt = flags, NameSpaceDocumentPropertiesVariantTypes.Value
+	output, err := xml.Marshal(app)
+	f.saveFileList(defaultXMLPathDocPropsApp, output)
+	return err
+}
+
+// GetDocSecurity provides a function to get the security level flags of the
+// document, use bitwise AND to check if a flag is set. For example, check if
+// the document is recommended to be opened as read-only:
+//
+//	flags, err := f.GetDocSecurity()
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	if flags&excelize.DocSecurityReadOnlyRecommended != 0 {
+//	    fmt.Println("read-only recommended")
+//	}
+func (f *File) GetDocSecurity() (int, error) {
+	app, err := f.GetAppProps()
+	if err != nil {
+		return 0, err
+	}
+	return app.DocSecurity, err
+}
+
 // SetDocProps provides a function to set document core properties. The
 // properties that can be set are:
 //
@@ -13,6 +13,7 @@ package excelize
 
 import (
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -29,22 +30,69 @@ func TestSetAppProps(t *testing.T) {
 		Application:       "Microsoft Excel",
 		ScaleCrop:         true,
 		DocSecurity:       3,
+		Manager:           "Manager Name",
 		Company:           "Company Name",
 		LinksUpToDate:     true,
 		HyperlinksChanged: true,
 		AppVersion:        "16.0000",
 	}))
 	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestSetAppProps.xlsx")))
+	assert.NoError(t, f.Close())
+	// Test the manager and company survive save and reload
+	f, err = OpenFile(filepath.Join("test", "TestSetAppProps.xlsx"))
+	assert.NoError(t, err)
+	props, err := f.GetAppProps()
+	assert.NoError(t, err)
+	assert.Equal(t, "Manager Name", props.Manager)
+	assert.Equal(t, "Company Name", props.Company)
+	// Test set application properties with empty manager and company
+	assert.NoError(t, f.SetAppProps(&AppProperties{Application: "Microsoft Excel"}))
+	assert.NotContains(t, string(f.readXML(defaultXMLPathDocPropsApp)), "Manager")
+	assert.NotContains(t, string(f.readXML(defaultXMLPathDocPropsApp)), "Company")
+	// Test set application properties with the manager and company exceeds the length limit
+	assert.Equal(t, newFieldLengthError("Manager"), f.SetAppProps(&AppProperties{Manager: strings.Repeat("\u4E00", MaxFieldLength+1)}))
+	assert.Equal(t, newFieldLengthError("Company"), f.SetAppProps(&AppProperties{Manager: strings.Repeat("s", MaxFieldLength), Company: strings.Repeat("s", MaxFieldLength+1)}))
 	f.Pkg.Store(defaultXMLPathDocPropsApp, nil)
 	assert.NoError(t, f.SetAppProps(&AppProperties{}))
 	assert.NoError(t, f.Close())
 
+	// Test set application properties with invalid application version
+	f = NewFile()
+	assert.NoError(t, f.SetAppProps(&AppProperties{AppVersion: "16.0300"}))
+	for _, version := range []string{"16", "16.0", "16.00000", "100.0000", "v16.0000", "16.03e0"} {
+		assert.Equal(t, newInvalidAppVersionError(version), f.SetAppProps(&AppProperties{AppVersion: version}))
+	}
+	props, err = f.GetAppProps()
+	assert.NoError(t, err)
+	assert.Equal(t, "16.0300", props.AppVersion)
+
 	// Test unsupported charset
 	f = NewFile()
 	f.Pkg.Store(defaultXMLPathDocPropsApp, MacintoshCyrillicCharset)
 	assert.EqualError(t, f.SetAppProps(&AppProperties{}), "XML syntax error on line 1: invalid UTF-8")
 }
 
+func TestFormatAppVersion(t *testing.T) {
+	f := NewFile()
+	for _, item := range []struct {
+		major, minor int
+		expected     string
+	}{{16, 300, "16.0300"}, {12, 0, "12.0000"}, {0, 9999, "0.9999"}} {
+		version, err := FormatAppVersion(item.major, item.minor)
+		assert.NoError(t, err)
+		assert.Equal(t, item.expected, version)
+		assert.NoError(t, f.SetAppProps(&AppProperties{AppVersion: version}))
+		props, err := f.GetAppProps()
+		assert.NoError(t, err)
+		assert.Equal(t, item.expected, props.AppVersion)
+	}
+	for _, version := range [][]int{{-1, 0}, {100, 0}, {16, -1}, {16, 10000}} {
+		_, err := FormatAppVersion(version[0], version[1])
+		assert.Equal(t, ErrParameterInvalid, err)
+	}
+	assert.NoError(t, f.Close())
+}
+
 func TestGetAppProps(t *testing.T) {
 	f, err := OpenFile(filepath.Join("test", "Book1.xlsx"))
 	if !assert.NoError(t, err) {
@@ -65,6 +113,41 @@ func TestGetAppProps(t *testing.T) {
 	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
 }
 
+func TestDocSecurity(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetAppProps(&AppProperties{Company: "Company Name"}))
+	flags, err := f.GetDocSecurity()
+	assert.NoError(t, err)
+	assert.Zero(t, flags)
+	assert.NoError(t, f.SetDocSecurity(DocSecurityPasswordProtected|DocSecurityReadOnlyRecommended))
+	flags, err = f.GetDocSecurity()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, flags)
+	props, err := f.GetAppProps()
+	assert.NoError(t, err)
+	assert.Equal(t, "Company Name", props.Company)
+	assert.NoError(t, f.SetDocSecurity(DocSecurityReadOnlyEnforced|DocSecurityLockedForAnnotation))
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestDocSecurity.xlsx")))
+	assert.NoError(t, f.Close())
+	f, err = OpenFile(filepath.Join("test", "TestDocSecurity.xlsx"))
+	assert.NoError(t, err)
+	flags, err = f.GetDocSecurity()
+	assert.NoError(t, err)
+	assert.Equal(t, DocSecurityReadOnlyEnforced|DocSecurityLockedForAnnotation, flags)
+	// Test set document security with invalid flags
+	for _, flags := range []int{-1, 16, DocSecurityReadOnlyRecommended | DocSecurityReadOnlyEnforced} {
+		assert.Equal(t, ErrParameterInvalid, f.SetDocSecurity(flags))
+	}
+	assert.NoError(t, f.Close())
+
+	// Test set and get document security with unsupported charset
+	f = NewFile()
+	f.Pkg.Store(defaultXMLPathDocPropsApp, MacintoshCyrillicCharset)
+	assert.EqualError(t, f.SetDocSecurity(DocSecurityPasswordProtected), "XML syntax error on line 1: invalid UTF-8")
+	_, err = f.GetDocSecurity()
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+}
+
 func TestSetDocProps(t *testing.T) {
 	f, err := OpenFile(filepath.Join("test", "Book1.xlsx"))
 	if !assert.NoError(t, err) {
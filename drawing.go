@@ -15,6 +15,7 @@ import (
 	"bytes"
 	"encoding/xml"
 	"io"
+	"path/filepath"
 	"reflect"
 	"strconv"
 	"strings"
@@ -55,12 +56,14 @@ func (f *File) prepareChartSheetDrawing(cs *xlsxChartsheet, drawingID int, sheet
 }
 
 // addChart provides a function to create chart as xl/charts/chart%d.xml by
-// given format sets.
-func (f *File) addChart(opts *Chart, comboCharts []*Chart) {
+// given format sets. The text in the chart will be written from right to left
+// if the rtl parameter is true.
+func (f *File) addChart(opts *Chart, comboCharts []*Chart, rtl bool) {
 	count := f.countCharts()
+	date1904, _ := f.isDate1904()
 	xlsxChartSpace := xlsxChartSpace{
 		XMLNSa:         NameSpaceDrawingML.Value,
-		Date1904:       &attrValBool{Val: boolPtr(false)},
+		Date1904:       &attrValBool{Val: boolPtr(date1904)},
 		Lang:           &attrValString{Val: stringPtr("en-US")},
 		RoundedCorners: &attrValBool{Val: boolPtr(false)},
 		Chart: cChart{
@@ -108,6 +111,15 @@ func (f *File) addChart(opts *Chart, comboCharts []*Chart) {
 		},
 	}
 	xlsxChartSpace.SpPr = f.drawShapeFill(opts.Fill, xlsxChartSpace.SpPr)
+	if rtl {
+		xlsxChartSpace.TxPr = &cTxPr{
+			BodyPr: aBodyPr{RtlCol: true},
+			P: aP{
+				PPr:        &aPPr{Rtl: true},
+				EndParaRPr: &aEndParaRPr{Lang: "en-US"},
+			},
+		}
+	}
 	plotAreaFunc := map[ChartType]func(*Chart) *cPlotArea{
 		Area:                        f.drawBaseChart,
 		AreaStacked:                 f.drawBaseChart,
@@ -176,7 +188,14 @@ func (f *File) addChart(opts *Chart, comboCharts []*Chart) {
 			if field.IsNil() {
 				continue
 			}
-			immutable.FieldByName(mutable.Type().Field(i).Name).Set(field)
+			switch value := field.Interface().(type) {
+			case []*cCharts:
+				immutable.Field(i).Set(reflect.ValueOf(mergeChartGroups(immutable.Field(i).Interface().([]*cCharts), value)))
+			case []*cAxs:
+				immutable.Field(i).Set(reflect.ValueOf(mergeChartAxes(immutable.Field(i).Interface().([]*cAxs), value)))
+			default:
+				immutable.Field(i).Set(field)
+			}
 		}
 	}
 	addChart(xlsxChartSpace.Chart.PlotArea, plotAreaFunc[opts.Type](opts))
@@ -186,11 +205,60 @@ func (f *File) addChart(opts *Chart, comboCharts []*Chart) {
 		addChart(xlsxChartSpace.Chart.PlotArea, plotAreaFunc[comboCharts[idx].Type](comboCharts[idx]))
 		order += len(comboCharts[idx].Series)
 	}
+	for _, c := range append([]*Chart{opts}, comboCharts...) {
+		if c.XAxis.Date {
+			f.drawPlotAreaDateAx(xlsxChartSpace.Chart.PlotArea, &c.XAxis)
+		}
+	}
 	chart, _ := xml.Marshal(xlsxChartSpace)
 	media := "xl/charts/chart" + strconv.Itoa(count+1) + ".xml"
 	f.saveFileList(media, chart)
 }
 
+// mergeChartGroups provides a function to merge the chart groups of the combo
+// chart into the chart groups in the same type of the plot area. The series
+// will be appended to the existing chart group which uses the same axes,
+// grouping, bar direction and vary colors settings, otherwise a new chart
+// group will be added, so that the charts on the primary and secondary axes
+// can be overlaid in a single plot area.
+func mergeChartGroups(groups, others []*cCharts) []*cCharts {
+	for _, other := range others {
+		var merged bool
+		for _, group := range groups {
+			if reflect.DeepEqual(group.AxID, other.AxID) && group.Ser != nil && other.Ser != nil &&
+				reflect.DeepEqual(group.Grouping, other.Grouping) && reflect.DeepEqual(group.BarDir, other.BarDir) &&
+				reflect.DeepEqual(group.VaryColors, other.VaryColors) {
+				*group.Ser = append(*group.Ser, *other.Ser...)
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			groups = append(groups, other)
+		}
+	}
+	return groups
+}
+
+// mergeChartAxes provides a function to merge the axes of the combo chart
+// into the axes of the plot area, the axes which already exist in the plot
+// area will be kept.
+func mergeChartAxes(axes, others []*cAxs) []*cAxs {
+	for _, other := range others {
+		var exist bool
+		for _, ax := range axes {
+			if reflect.DeepEqual(ax.AxID, other.AxID) {
+				exist = true
+				break
+			}
+		}
+		if !exist {
+			axes = append(axes, other)
+		}
+	}
+	return axes
+}
+
 // drawBaseChart provides a function to draw the c:plotArea element for bar,
 // and column series charts by given format sets.
 func (f *File) drawBaseChart(opts *Chart) *cPlotArea {
@@ -221,212 +289,212 @@ func (f *File) drawBaseChart(opts *Chart) *cPlotArea {
 	valAx := f.drawPlotAreaValAx(opts)
 	charts := map[ChartType]*cPlotArea{
 		Area: {
-			AreaChart: &c,
+			AreaChart: []*cCharts{&c},
 			CatAx:     catAx,
 			ValAx:     valAx,
 		},
 		AreaStacked: {
-			AreaChart: &c,
+			AreaChart: []*cCharts{&c},
 			CatAx:     catAx,
 			ValAx:     valAx,
 		},
 		AreaPercentStacked: {
-			AreaChart: &c,
+			AreaChart: []*cCharts{&c},
 			CatAx:     catAx,
 			ValAx:     valAx,
 		},
 		Area3D: {
-			Area3DChart: &c,
+			Area3DChart: []*cCharts{&c},
 			CatAx:       catAx,
 			ValAx:       valAx,
 		},
 		Area3DStacked: {
-			Area3DChart: &c,
+			Area3DChart: []*cCharts{&c},
 			CatAx:       catAx,
 			ValAx:       valAx,
 		},
 		Area3DPercentStacked: {
-			Area3DChart: &c,
+			Area3DChart: []*cCharts{&c},
 			CatAx:       catAx,
 			ValAx:       valAx,
 		},
 		Bar: {
-			BarChart: &c,
+			BarChart: []*cCharts{&c},
 			CatAx:    catAx,
 			ValAx:    valAx,
 		},
 		BarStacked: {
-			BarChart: &c,
+			BarChart: []*cCharts{&c},
 			CatAx:    catAx,
 			ValAx:    valAx,
 		},
 		BarPercentStacked: {
-			BarChart: &c,
+			BarChart: []*cCharts{&c},
 			CatAx:    catAx,
 			ValAx:    valAx,
 		},
 		Bar3DClustered: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Bar3DStacked: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Bar3DPercentStacked: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Bar3DConeClustered: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Bar3DConeStacked: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Bar3DConePercentStacked: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Bar3DPyramidClustered: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Bar3DPyramidStacked: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Bar3DPyramidPercentStacked: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Bar3DCylinderClustered: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Bar3DCylinderStacked: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Bar3DCylinderPercentStacked: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Col: {
-			BarChart: &c,
+			BarChart: []*cCharts{&c},
 			CatAx:    catAx,
 			ValAx:    valAx,
 		},
 		ColStacked: {
-			BarChart: &c,
+			BarChart: []*cCharts{&c},
 			CatAx:    catAx,
 			ValAx:    valAx,
 		},
 		ColPercentStacked: {
-			BarChart: &c,
+			BarChart: []*cCharts{&c},
 			CatAx:    catAx,
 			ValAx:    valAx,
 		},
 		Col3D: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Col3DClustered: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Col3DStacked: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Col3DPercentStacked: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Col3DCone: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Col3DConeClustered: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Col3DConeStacked: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Col3DConePercentStacked: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Col3DPyramid: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Col3DPyramidClustered: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Col3DPyramidStacked: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Col3DPyramidPercentStacked: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Col3DCylinder: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Col3DCylinderClustered: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Col3DCylinderStacked: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Col3DCylinderPercentStacked: {
-			Bar3DChart: &c,
+			Bar3DChart: []*cCharts{&c},
 			CatAx:      catAx,
 			ValAx:      valAx,
 		},
 		Bubble: {
-			BubbleChart: &c,
+			BubbleChart: []*cCharts{&c},
 			CatAx:       catAx,
 			ValAx:       valAx,
 		},
 		Bubble3D: {
-			BubbleChart: &c,
+			BubbleChart: []*cCharts{&c},
 			CatAx:       catAx,
 			ValAx:       valAx,
 		},
@@ -443,13 +511,13 @@ func (f *File) drawDoughnutChart(opts *Chart) *cPlotArea {
 	}
 
 	return &cPlotArea{
-		DoughnutChart: &cCharts{
+		DoughnutChart: []*cCharts{{
 			VaryColors: &attrValBool{
 				Val: opts.VaryColors,
 			},
 			Ser:      f.drawChartSeries(opts),
 			HoleSize: &attrValInt{Val: intPtr(holeSize)},
-		},
+		}},
 	}
 }
 
@@ -457,7 +525,7 @@ func (f *File) drawDoughnutChart(opts *Chart) *cPlotArea {
 // chart by given format sets.
 func (f *File) drawLineChart(opts *Chart) *cPlotArea {
 	return &cPlotArea{
-		LineChart: &cCharts{
+		LineChart: []*cCharts{{
 			Grouping: &attrValString{
 				Val: stringPtr(plotAreaChartGrouping[opts.Type]),
 			},
@@ -467,7 +535,7 @@ func (f *File) drawLineChart(opts *Chart) *cPlotArea {
 			Ser:   f.drawChartSeries(opts),
 			DLbls: f.drawChartDLbls(opts),
 			AxID:  f.genAxID(opts),
-		},
+		}},
 		CatAx: f.drawPlotAreaCatAx(opts),
 		ValAx: f.drawPlotAreaValAx(opts),
 	}
@@ -477,7 +545,7 @@ func (f *File) drawLineChart(opts *Chart) *cPlotArea {
 // chart by given format sets.
 func (f *File) drawLine3DChart(opts *Chart) *cPlotArea {
 	return &cPlotArea{
-		Line3DChart: &cCharts{
+		Line3DChart: []*cCharts{{
 			Grouping: &attrValString{
 				Val: stringPtr(plotAreaChartGrouping[opts.Type]),
 			},
@@ -487,7 +555,7 @@ func (f *File) drawLine3DChart(opts *Chart) *cPlotArea {
 			Ser:   f.drawChartSeries(opts),
 			DLbls: f.drawChartDLbls(opts),
 			AxID:  f.genAxID(opts),
-		},
+		}},
 		CatAx: f.drawPlotAreaCatAx(opts),
 		ValAx: f.drawPlotAreaValAx(opts),
 	}
@@ -497,12 +565,12 @@ func (f *File) drawLine3DChart(opts *Chart) *cPlotArea {
 // chart by given format sets.
 func (f *File) drawPieChart(opts *Chart) *cPlotArea {
 	return &cPlotArea{
-		PieChart: &cCharts{
+		PieChart: []*cCharts{{
 			VaryColors: &attrValBool{
 				Val: opts.VaryColors,
 			},
 			Ser: f.drawChartSeries(opts),
-		},
+		}},
 	}
 }
 
@@ -510,12 +578,12 @@ func (f *File) drawPieChart(opts *Chart) *cPlotArea {
 // pie chart by given format sets.
 func (f *File) drawPie3DChart(opts *Chart) *cPlotArea {
 	return &cPlotArea{
-		Pie3DChart: &cCharts{
+		Pie3DChart: []*cCharts{{
 			VaryColors: &attrValBool{
 				Val: opts.VaryColors,
 			},
 			Ser: f.drawChartSeries(opts),
-		},
+		}},
 	}
 }
 
@@ -527,7 +595,7 @@ func (f *File) drawPieOfPieChart(opts *Chart) *cPlotArea {
 		splitPos = &attrValInt{Val: intPtr(opts.PlotArea.SecondPlotValues)}
 	}
 	return &cPlotArea{
-		OfPieChart: &cCharts{
+		OfPieChart: []*cCharts{{
 			OfPieType: &attrValString{
 				Val: stringPtr("pie"),
 			},
@@ -537,7 +605,7 @@ func (f *File) drawPieOfPieChart(opts *Chart) *cPlotArea {
 			Ser:      f.drawChartSeries(opts),
 			SplitPos: splitPos,
 			SerLines: &attrValString{},
-		},
+		}},
 	}
 }
 
@@ -549,7 +617,7 @@ func (f *File) drawBarOfPieChart(opts *Chart) *cPlotArea {
 		splitPos = &attrValInt{Val: intPtr(opts.PlotArea.SecondPlotValues)}
 	}
 	return &cPlotArea{
-		OfPieChart: &cCharts{
+		OfPieChart: []*cCharts{{
 			OfPieType: &attrValString{
 				Val: stringPtr("bar"),
 			},
@@ -559,7 +627,7 @@ func (f *File) drawBarOfPieChart(opts *Chart) *cPlotArea {
 			SplitPos: splitPos,
 			Ser:      f.drawChartSeries(opts),
 			SerLines: &attrValString{},
-		},
+		}},
 	}
 }
 
@@ -567,7 +635,7 @@ func (f *File) drawBarOfPieChart(opts *Chart) *cPlotArea {
 // chart by given format sets.
 func (f *File) drawRadarChart(opts *Chart) *cPlotArea {
 	return &cPlotArea{
-		RadarChart: &cCharts{
+		RadarChart: []*cCharts{{
 			RadarStyle: &attrValString{
 				Val: stringPtr("marker"),
 			},
@@ -577,7 +645,7 @@ func (f *File) drawRadarChart(opts *Chart) *cPlotArea {
 			Ser:   f.drawChartSeries(opts),
 			DLbls: f.drawChartDLbls(opts),
 			AxID:  f.genAxID(opts),
-		},
+		}},
 		CatAx: f.drawPlotAreaCatAx(opts),
 		ValAx: f.drawPlotAreaValAx(opts),
 	}
@@ -587,7 +655,7 @@ func (f *File) drawRadarChart(opts *Chart) *cPlotArea {
 // scatter chart by given format sets.
 func (f *File) drawScatterChart(opts *Chart) *cPlotArea {
 	return &cPlotArea{
-		ScatterChart: &cCharts{
+		ScatterChart: []*cCharts{{
 			ScatterStyle: &attrValString{
 				Val: stringPtr("smoothMarker"), // line,lineMarker,marker,none,smooth,smoothMarker
 			},
@@ -597,7 +665,7 @@ func (f *File) drawScatterChart(opts *Chart) *cPlotArea {
 			Ser:   f.drawChartSeries(opts),
 			DLbls: f.drawChartDLbls(opts),
 			AxID:  f.genAxID(opts),
-		},
+		}},
 		CatAx: f.drawPlotAreaCatAx(opts),
 		ValAx: f.drawPlotAreaValAx(opts),
 	}
@@ -607,20 +675,20 @@ func (f *File) drawScatterChart(opts *Chart) *cPlotArea {
 // given format sets.
 func (f *File) drawSurface3DChart(opts *Chart) *cPlotArea {
 	plotArea := &cPlotArea{
-		Surface3DChart: &cCharts{
+		Surface3DChart: []*cCharts{{
 			Ser: f.drawChartSeries(opts),
 			AxID: []*attrValInt{
 				{Val: intPtr(100000000)},
 				{Val: intPtr(100000001)},
 				{Val: intPtr(100000005)},
 			},
-		},
+		}},
 		CatAx: f.drawPlotAreaCatAx(opts),
 		ValAx: f.drawPlotAreaValAx(opts),
 		SerAx: f.drawPlotAreaSerAx(opts),
 	}
 	if opts.Type == WireframeSurface3D {
-		plotArea.Surface3DChart.Wireframe = &attrValBool{Val: boolPtr(true)}
+		plotArea.Surface3DChart[0].Wireframe = &attrValBool{Val: boolPtr(true)}
 	}
 	return plotArea
 }
@@ -629,20 +697,20 @@ func (f *File) drawSurface3DChart(opts *Chart) *cPlotArea {
 // given format sets.
 func (f *File) drawSurfaceChart(opts *Chart) *cPlotArea {
 	plotArea := &cPlotArea{
-		SurfaceChart: &cCharts{
+		SurfaceChart: []*cCharts{{
 			Ser: f.drawChartSeries(opts),
 			AxID: []*attrValInt{
 				{Val: intPtr(100000000)},
 				{Val: intPtr(100000001)},
 				{Val: intPtr(100000005)},
 			},
-		},
+		}},
 		CatAx: f.drawPlotAreaCatAx(opts),
 		ValAx: f.drawPlotAreaValAx(opts),
 		SerAx: f.drawPlotAreaSerAx(opts),
 	}
 	if opts.Type == WireframeContour {
-		plotArea.SurfaceChart.Wireframe = &attrValBool{Val: boolPtr(true)}
+		plotArea.SurfaceChart[0].Wireframe = &attrValBool{Val: boolPtr(true)}
 	}
 	return plotArea
 }
@@ -651,18 +719,18 @@ func (f *File) drawSurfaceChart(opts *Chart) *cPlotArea {
 // given format sets.
 func (f *File) drawBubbleChart(opts *Chart) *cPlotArea {
 	plotArea := &cPlotArea{
-		BubbleChart: &cCharts{
+		BubbleChart: []*cCharts{{
 			VaryColors: &attrValBool{
 				Val: opts.VaryColors,
 			},
 			Ser:   f.drawChartSeries(opts),
 			DLbls: f.drawChartDLbls(opts),
 			AxID:  f.genAxID(opts),
-		},
+		}},
 		ValAx: []*cAxs{f.drawPlotAreaCatAx(opts)[0], f.drawPlotAreaValAx(opts)[0]},
 	}
 	if opts.BubbleSize > 0 && opts.BubbleSize <= 300 {
-		plotArea.BubbleChart.BubbleScale = &attrValFloat{Val: float64Ptr(float64(opts.BubbleSize))}
+		plotArea.BubbleChart[0].BubbleScale = &attrValFloat{Val: float64Ptr(float64(opts.BubbleSize))}
 	}
 	return plotArea
 }
@@ -705,13 +773,9 @@ func (f *File) drawChartSeries(opts *Chart) *[]cSer {
 	var ser []cSer
 	for k := range opts.Series {
 		ser = append(ser, cSer{
-			IDx:   &attrValInt{Val: intPtr(k + opts.order)},
-			Order: &attrValInt{Val: intPtr(k + opts.order)},
-			Tx: &cTx{
-				StrRef: &cStrRef{
-					F: opts.Series[k].Name,
-				},
-			},
+			IDx:              &attrValInt{Val: intPtr(opts.getSeriesIdx(k))},
+			Order:            &attrValInt{Val: intPtr(opts.getSeriesIdx(k))},
+			Tx:               f.drawChartSeriesTx(opts.Series[k]),
 			SpPr:             f.drawChartSeriesSpPr(k, opts),
 			Marker:           f.drawChartSeriesMarker(k, opts),
 			DPt:              f.drawChartSeriesDPt(k, opts),
@@ -729,6 +793,19 @@ func (f *File) drawChartSeries(opts *Chart) *[]cSer {
 	return &ser
 }
 
+// drawChartSeriesTx provides a function to draw the c:tx element by given
+// chart series, the series name will be written as a string reference if it
+// is a reference, otherwise it will be written as a literal string.
+func (f *File) drawChartSeriesTx(series ChartSeries) *cTx {
+	if series.Name == "" {
+		return nil
+	}
+	if series.isNameRef() {
+		return &cTx{StrRef: &cStrRef{F: strings.TrimPrefix(series.Name, "=")}}
+	}
+	return &cTx{V: stringPtr(series.Name)}
+}
+
 // drawShapeFill provides a function to draw the a:solidFill element by given
 // fill format sets.
 func (f *File) drawShapeFill(fill Fill, spPr *cSpPr) *cSpPr {
@@ -749,7 +826,7 @@ func (f *File) drawShapeFill(fill Fill, spPr *cSpPr) *cSpPr {
 // drawChartSeriesSpPr provides a function to draw the c:spPr element by given
 // format sets.
 func (f *File) drawChartSeriesSpPr(i int, opts *Chart) *cSpPr {
-	spPr := &cSpPr{SolidFill: &aSolidFill{SchemeClr: &aSchemeClr{Val: "accent" + strconv.Itoa((opts.order+i)%6+1)}}}
+	spPr := &cSpPr{SolidFill: &aSolidFill{SchemeClr: &aSchemeClr{Val: "accent" + strconv.Itoa(opts.getSeriesIdx(i)%6+1)}}}
 	spPr = f.drawShapeFill(opts.Series[i].Fill, spPr)
 	spPrScatter := &cSpPr{
 		Ln: &aLn{
@@ -936,7 +1013,7 @@ func (f *File) drawChartNumFmt(labels ChartNumFmt) *cNumFmt {
 // drawChartDLbls provides a function to draw the c:dLbls element by given
 // format sets.
 func (f *File) drawChartDLbls(opts *Chart) *cDLbls {
-	return &cDLbls{
+	dLbls := &cDLbls{
 		NumFmt:          f.drawChartNumFmt(opts.PlotArea.NumFmt),
 		ShowLegendKey:   &attrValBool{Val: boolPtr(opts.Legend.ShowLegendKey)},
 		ShowVal:         &attrValBool{Val: boolPtr(opts.PlotArea.ShowVal)},
@@ -946,6 +1023,10 @@ func (f *File) drawChartDLbls(opts *Chart) *cDLbls {
 		ShowPercent:     &attrValBool{Val: boolPtr(opts.PlotArea.ShowPercent)},
 		ShowLeaderLines: &attrValBool{Val: boolPtr(opts.PlotArea.ShowLeaderLines)},
 	}
+	if opts.PlotArea.DataLabelSeparator != "" {
+		dLbls.Separator = stringPtr(opts.PlotArea.DataLabelSeparator)
+	}
+	return dLbls
 }
 
 // inSupportedChartDataLabelsPositionType provides a method to check if an
@@ -975,6 +1056,9 @@ func (f *File) drawChartSeriesDLbls(i int, opts *Chart) *cDLbls {
 			dLbls.DLblPos = &attrValString{Val: stringPtr(chartDataLabelsPositionTypes[opts.Series[i].DataLabelPosition])}
 		}
 	}
+	if opts.Series[i].DataLabelSeparator != "" {
+		dLbls.Separator = stringPtr(opts.Series[i].DataLabelSeparator)
+	}
 	return dLbls
 }
 
@@ -1050,6 +1134,53 @@ func (f *File) drawPlotAreaCatAx(opts *Chart) []*cAxs {
 	return axs
 }
 
+// drawPlotAreaDateAx provides a function to replace the c:catAx element with
+// the c:dateAx element in the plot area by given axis format sets.
+func (f *File) drawPlotAreaDateAx(plotArea *cPlotArea, opts *ChartAxis) {
+	for i, ax := range plotArea.CatAx {
+		if ax.AxID == nil || *ax.AxID.Val != opts.axID {
+			continue
+		}
+		dateAx := &cDateAx{
+			AxID:           ax.AxID,
+			Scaling:        ax.Scaling,
+			Delete:         ax.Delete,
+			AxPos:          ax.AxPos,
+			MajorGridlines: ax.MajorGridlines,
+			MinorGridlines: ax.MinorGridlines,
+			Title:          ax.Title,
+			NumFmt:         &cNumFmt{FormatCode: "General", SourceLinked: true},
+			MajorTickMark:  ax.MajorTickMark,
+			MinorTickMark:  ax.MinorTickMark,
+			TickLblPos:     ax.TickLblPos,
+			SpPr:           ax.SpPr,
+			TxPr:           ax.TxPr,
+			CrossAx:        ax.CrossAx,
+			Crosses:        ax.Crosses,
+			Auto:           &attrValBool{Val: boolPtr(false)},
+			LblOffset:      ax.LblOffset,
+		}
+		if numFmt := f.drawChartNumFmt(opts.NumFmt); numFmt != nil {
+			dateAx.NumFmt = numFmt
+		}
+		if opts.BaseTimeUnit != "" {
+			dateAx.BaseTimeUnit = &attrValString{Val: stringPtr(strings.ToLower(opts.BaseTimeUnit))}
+		}
+		if opts.MajorUnit != 0 {
+			dateAx.MajorUnit = &attrValFloat{Val: float64Ptr(opts.MajorUnit)}
+		}
+		if opts.MajorTimeUnit != "" {
+			dateAx.MajorTimeUnit = &attrValString{Val: stringPtr(strings.ToLower(opts.MajorTimeUnit))}
+		}
+		if opts.MinorTimeUnit != "" {
+			dateAx.MinorTimeUnit = &attrValString{Val: stringPtr(strings.ToLower(opts.MinorTimeUnit))}
+		}
+		plotArea.CatAx = append(plotArea.CatAx[:i], plotArea.CatAx[i+1:]...)
+		plotArea.DateAx = append(plotArea.DateAx, dateAx)
+		return
+	}
+}
+
 // drawPlotAreaValAx provides a function to draw the c:valAx element.
 func (f *File) drawPlotAreaValAx(opts *Chart) []*cAxs {
 	maxVal := &attrValFloat{Val: opts.YAxis.Maximum}
@@ -1104,6 +1235,7 @@ func (f *File) drawPlotAreaValAx(opts *Chart) []*cAxs {
 	if opts.YAxis.MajorUnit != 0 {
 		axs[0].MajorUnit = &attrValFloat{Val: float64Ptr(opts.YAxis.MajorUnit)}
 	}
+	axs[0].DispUnits = f.drawChartDispUnits(&opts.YAxis)
 	if opts.order > 0 && opts.YAxis.Secondary {
 		axs = append(axs, &cAxs{
 			AxID: &attrValInt{Val: intPtr(opts.YAxis.axID)},
@@ -1122,11 +1254,30 @@ func (f *File) drawPlotAreaValAx(opts *Chart) []*cAxs {
 			CrossAx:       &attrValInt{Val: intPtr(opts.XAxis.axID)},
 			Crosses:       &attrValString{Val: stringPtr("max")},
 			CrossBetween:  &attrValString{Val: stringPtr(chartValAxCrossBetween[opts.Type])},
+			DispUnits:     f.drawChartDispUnits(&opts.YAxis),
 		})
 	}
 	return axs
 }
 
+// drawChartDispUnits provides a function to draw the c:dispUnits element by
+// given axis format sets.
+func (f *File) drawChartDispUnits(opts *ChartAxis) *cDispUnits {
+	if opts.DisplayUnit == "" {
+		return nil
+	}
+	dispUnits := &cDispUnits{}
+	if unit, err := strconv.ParseFloat(opts.DisplayUnit, 64); err == nil {
+		dispUnits.CustUnit = &attrValFloat{Val: float64Ptr(unit)}
+	} else {
+		dispUnits.BuiltInUnit = &attrValString{Val: stringPtr(opts.DisplayUnit)}
+	}
+	if opts.DisplayUnitLabel {
+		dispUnits.DispUnitsLbl = &cDispUnitsLbl{Layout: stringPtr("")}
+	}
+	return dispUnits
+}
+
 // drawPlotAreaSerAx provides a function to draw the c:serAx element.
 func (f *File) drawPlotAreaSerAx(opts *Chart) []*cAxs {
 	maxVal := &attrValFloat{Val: opts.YAxis.Maximum}
@@ -1346,43 +1497,44 @@ func (f *File) drawingParser(path string) (*xlsxWsDr, int, error) {
 	}
 	wsDr.mu.Lock()
 	defer wsDr.mu.Unlock()
-	return wsDr, len(wsDr.OneCellAnchor) + len(wsDr.TwoCellAnchor) + 2, nil
+	cNvPrID := len(wsDr.OneCellAnchor) + 2
+	for _, anchor := range wsDr.TwoCellAnchor {
+		// The group shape contains multiple drawing objects
+		if count := strings.Count(anchor.GraphicFrame, "cNvPr "); count > 1 {
+			cNvPrID += count
+			continue
+		}
+		cNvPrID++
+	}
+	return wsDr, cNvPrID, nil
 }
 
 // addDrawingChart provides a function to add chart graphic frame by given
-// sheet, drawingXML, cell, width, height, relationship index and format sets.
+// sheet, drawingXML, cell or range reference, width, height, relationship
+// index and format sets.
 func (f *File) addDrawingChart(sheet, drawingXML, cell string, width, height, rID int, opts *GraphicOptions) error {
-	col, row, err := CellNameToCoordinates(cell)
+	from, to, err := f.chartAnchorPosition(sheet, cell, width, height, opts)
 	if err != nil {
 		return err
 	}
-	width = int(float64(width) * opts.ScaleX)
-	height = int(float64(height) * opts.ScaleY)
-	colStart, rowStart, colEnd, rowEnd, x2, y2 := f.positionObjectPixels(sheet, col, row, opts.OffsetX, opts.OffsetY, width, height)
 	content, cNvPrID, err := f.drawingParser(drawingXML)
 	if err != nil {
 		return err
 	}
 	twoCellAnchor := xdrCellAnchor{}
 	twoCellAnchor.EditAs = opts.Positioning
-	from := xlsxFrom{}
-	from.Col = colStart
-	from.ColOff = opts.OffsetX * EMU
-	from.Row = rowStart
-	from.RowOff = opts.OffsetY * EMU
-	to := xlsxTo{}
-	to.Col = colEnd
-	to.ColOff = x2 * EMU
-	to.Row = rowEnd
-	to.RowOff = y2 * EMU
-	twoCellAnchor.From = &from
-	twoCellAnchor.To = &to
+	twoCellAnchor.From = from
+	twoCellAnchor.To = to
 
 	graphicFrame := xlsxGraphicFrame{
 		NvGraphicFramePr: xlsxNvGraphicFramePr{
 			CNvPr: &xlsxCNvPr{
-				ID:   cNvPrID,
-				Name: "Chart " + strconv.Itoa(cNvPrID),
+				ID:         cNvPrID,
+				Name:       "Chart " + strconv.Itoa(cNvPrID),
+				HlinkClick: drawHlinkClick(f.addDrawingHyperlink(drawingXML, opts)),
+			},
+			CNvGraphicFramePr: xlsxCNvGraphicFramePr{
+				GraphicFrameLocks: drawGraphicFrameLocks(opts),
 			},
 		},
 		Graphic: &xlsxGraphic{
@@ -1407,6 +1559,64 @@ func (f *File) addDrawingChart(sheet, drawingXML, cell string, width, height, rI
 	return err
 }
 
+// chartAnchorPosition provides a function to calculate the start and end
+// position of the chart anchor by given sheet, cell or range reference, width,
+// height and format sets. The chart exactly fills the range if a range
+// reference was given.
+func (f *File) chartAnchorPosition(sheet, cell string, width, height int, opts *GraphicOptions) (*xlsxFrom, *xlsxTo, error) {
+	if strings.Contains(cell, ":") {
+		rect, err := rangeRefToCoordinates(cell)
+		if err != nil {
+			return nil, nil, err
+		}
+		_ = sortCoordinates(rect)
+		return &xlsxFrom{Col: rect[0] - 1, Row: rect[1] - 1}, &xlsxTo{Col: rect[2], Row: rect[3]}, err
+	}
+	col, row, err := CellNameToCoordinates(cell)
+	if err != nil {
+		return nil, nil, err
+	}
+	width = int(float64(width) * opts.ScaleX)
+	height = int(float64(height) * opts.ScaleY)
+	colStart, rowStart, colEnd, rowEnd, x2, y2 := f.positionObjectPixels(sheet, col, row, opts.OffsetX, opts.OffsetY, width, height)
+	return &xlsxFrom{Col: colStart, ColOff: opts.OffsetX * EMU, Row: rowStart, RowOff: opts.OffsetY * EMU},
+		&xlsxTo{Col: colEnd, ColOff: x2 * EMU, Row: rowEnd, RowOff: y2 * EMU}, err
+}
+
+// addDrawingHyperlink provides a function to add the hyperlink relationship
+// of the drawing object by given drawing part path and format sets. It returns
+// the relationship index of the hyperlink, or 0 if the hyperlink isn't set.
+func (f *File) addDrawingHyperlink(drawingXML string, opts *GraphicOptions) int {
+	if opts.Hyperlink == "" || opts.HyperlinkType == "" {
+		return 0
+	}
+	var targetMode string
+	if opts.HyperlinkType == "External" {
+		targetMode = opts.HyperlinkType
+	}
+	drawingRels := "xl/drawings/_rels/" + filepath.Base(drawingXML) + ".rels"
+	return f.addRels(drawingRels, SourceRelationshipHyperLink, opts.Hyperlink, targetMode)
+}
+
+// drawHlinkClick provides a function to draw the a:hlinkClick element by
+// given relationship index of the hyperlink.
+func drawHlinkClick(rID int) *xlsxHlinkClick {
+	if rID == 0 {
+		return nil
+	}
+	return &xlsxHlinkClick{R: SourceRelationship.Value, RID: "rId" + strconv.Itoa(rID)}
+}
+
+// drawGraphicFrameLocks provides a function to draw the a:graphicFrameLocks
+// element by given format sets, it returns nil if the graphic frame isn't
+// locked.
+func drawGraphicFrameLocks(opts *GraphicOptions) *xlsxGraphicFrameLocks {
+	if !opts.LockAspectRatio && !opts.DisableResize {
+		return nil
+	}
+	return &xlsxGraphicFrameLocks{NoChangeAspect: opts.LockAspectRatio, NoResize: opts.DisableResize}
+}
+
 // addSheetDrawingChart provides a function to add chart graphic frame for
 // chartsheet by given sheet, drawingXML, width, height, relationship index
 // and format sets.
@@ -1424,8 +1634,12 @@ func (f *File) addSheetDrawingChart(drawingXML string, rID int, opts *GraphicOpt
 	graphicFrame := xlsxGraphicFrame{
 		NvGraphicFramePr: xlsxNvGraphicFramePr{
 			CNvPr: &xlsxCNvPr{
-				ID:   cNvPrID,
-				Name: "Chart " + strconv.Itoa(cNvPrID),
+				ID:         cNvPrID,
+				Name:       "Chart " + strconv.Itoa(cNvPrID),
+				HlinkClick: drawHlinkClick(f.addDrawingHyperlink(drawingXML, opts)),
+			},
+			CNvGraphicFramePr: xlsxCNvGraphicFramePr{
+				GraphicFrameLocks: drawGraphicFrameLocks(opts),
 			},
 		},
 		Graphic: &xlsxGraphic{
@@ -47,3 +47,30 @@ func TestDeleteDrawingRels(t *testing.T) {
 	f.Pkg.Store(rels, MacintoshCyrillicCharset)
 	f.deleteDrawingRels(rels, "")
 }
+
+func TestMergeChartGroups(t *testing.T) {
+	axID := []*attrValInt{{Val: intPtr(100000000)}, {Val: intPtr(100000001)}}
+	newGroup := func(grouping, barDir string, varyColors bool) *cCharts {
+		return &cCharts{
+			Grouping:   &attrValString{Val: stringPtr(grouping)},
+			BarDir:     &attrValString{Val: stringPtr(barDir)},
+			VaryColors: &attrValBool{Val: boolPtr(varyColors)},
+			Ser:        &[]cSer{{}},
+			AxID:       axID,
+		}
+	}
+	// Test merge the chart groups with the same settings
+	groups := mergeChartGroups([]*cCharts{newGroup("clustered", "col", false)}, []*cCharts{newGroup("clustered", "col", false)})
+	assert.Len(t, groups, 1)
+	assert.Len(t, *groups[0].Ser, 2)
+	// Test keep the chart groups with different settings separately
+	for _, other := range []*cCharts{
+		newGroup("stacked", "col", false),
+		newGroup("clustered", "bar", false),
+		newGroup("clustered", "col", true),
+	} {
+		groups = mergeChartGroups([]*cCharts{newGroup("clustered", "col", false)}, []*cCharts{other})
+		assert.Len(t, groups, 2)
+		assert.Len(t, *groups[0].Ser, 1)
+	}
+}
@@ -14,6 +14,7 @@ package excelize
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 var (
@@ -42,9 +43,15 @@ var (
 	// ErrDataValidationFormulaLength defined the error message for receiving a
 	// data validation formula length that exceeds the limit.
 	ErrDataValidationFormulaLength = fmt.Errorf("data validation must be 0-%d characters", MaxFieldLength)
+	// ErrDataValidationMessageLength defined the error message for receiving
+	// a data validation input or error message length that exceeds the limit.
+	ErrDataValidationMessageLength = fmt.Errorf("the message of the data validation must be less than or equal to %d characters", MaxFieldLength)
 	// ErrDataValidationRange defined the error message on set decimal range
 	// exceeds limit.
 	ErrDataValidationRange = errors.New("data validation range exceeds limit")
+	// ErrDataValidationTitleLength defined the error message for receiving a
+	// data validation input or error title length that exceeds the limit.
+	ErrDataValidationTitleLength = fmt.Errorf("the title of the data validation must be less than or equal to %d characters", MaxDataValidationTitleLength)
 	// ErrDefinedNameDuplicate defined the error message on the same name
 	// already exists on the scope.
 	ErrDefinedNameDuplicate = errors.New("the same name already exists on the scope")
@@ -68,6 +75,9 @@ var (
 	// ErrImgExt defined the error message on receive an unsupported image
 	// extension.
 	ErrImgExt = errors.New("unsupported image extension")
+	// ErrIndent defined the error message on receive an invalid indent of the
+	// alignment.
+	ErrIndent = fmt.Errorf("the indent must be between 0 and %d", MaxIndent)
 	// ErrInvalidFormula defined the error message on receive an invalid
 	// formula.
 	ErrInvalidFormula = errors.New("formula not valid")
@@ -99,6 +109,10 @@ var (
 	ErrPasswordLengthInvalid = errors.New("password length invalid")
 	// ErrSave defined the error message for saving file.
 	ErrSave = errors.New("no path defined for file, consider File.WriteTo or File.Write")
+	// ErrSecondarySeries defined the error message on receive the series on
+	// the secondary axis for the unsupported chart type or without any series
+	// on the primary axis.
+	ErrSecondarySeries = errors.New("the series on the secondary axis is only supported for the scatter chart with at least one series on the primary axis")
 	// ErrSheetIdx defined the error message on receive the invalid worksheet
 	// index.
 	ErrSheetIdx = errors.New("invalid worksheet index")
@@ -135,6 +149,12 @@ var (
 	// ErrStreamSetPanes defined the error message on set panes in stream
 	// writing mode.
 	ErrStreamSetPanes = errors.New("must call the SetPanes function before the SetRow function")
+	// ErrTableTotalRowRange defined the error message on the table range
+	// without enough rows for the header row, data row and total row.
+	ErrTableTotalRowRange = errors.New("the table range with a total row must contain a data row besides the header row and total row")
+	// ErrTextRotation defined the error message on receive an invalid text
+	// rotation of the alignment.
+	ErrTextRotation = errors.New("the text rotation must be between -90 and 180 degrees, or 255 for vertical text")
 	// ErrTotalSheetHyperlinks defined the error message on hyperlinks count
 	// overflow.
 	ErrTotalSheetHyperlinks = errors.New("over maximum limit hyperlinks in a worksheet")
@@ -186,6 +206,12 @@ func newCellNameToCoordinatesError(cell string, err error) error {
 	return fmt.Errorf("cannot convert cell %q to coordinates: %v", cell, err)
 }
 
+// newCircularReferenceError defined the error message on calculating the
+// formula cells in the circular references.
+func newCircularReferenceError(sheet string, cells []string) error {
+	return fmt.Errorf("circular reference found in worksheet %s: %s", sheet, strings.Join(cells, ", "))
+}
+
 // newCoordinatesToCellNameError defined the error message on converts [X, Y]
 // coordinates to alpha-numeric cell name.
 func newCoordinatesToCellNameError(col, row int) error {
@@ -198,6 +224,12 @@ func newFieldLengthError(name string) error {
 	return fmt.Errorf("field %s must be less than or equal to 255 characters", name)
 }
 
+// newInvalidAppVersionError defined the error message on receiving the
+// invalid application version of the document properties.
+func newInvalidAppVersionError(version string) error {
+	return fmt.Errorf("invalid application version %q, the version should be in the form of XX.YYYY", version)
+}
+
 // newInvalidAutoFilterColumnError defined the error message on receiving the
 // incorrect index of column.
 func newInvalidAutoFilterColumnError(col string) error {
@@ -234,6 +266,12 @@ func newInvalidExcelDateError(dateValue float64) error {
 	return fmt.Errorf("invalid date value %f, negative values are not supported", dateValue)
 }
 
+// newInvalidHeaderFooterFormatCodeError defined the error message on receiving
+// the invalid formatting code in the header or footer field.
+func newInvalidHeaderFooterFormatCodeError(name, code string) error {
+	return fmt.Errorf("invalid formatting code %q in the header footer field %s", code, name)
+}
+
 // newInvalidLinkTypeError defined the error message on receiving the invalid
 // hyper link type.
 func newInvalidLinkTypeError(linkType string) error {
@@ -258,18 +296,54 @@ func newInvalidSlicerNameError(name string) error {
 	return fmt.Errorf("invalid slicer name %q", name)
 }
 
+// newInvalidTimelineNameError defined the error message on receiving the
+// invalid timeline name.
+func newInvalidTimelineNameError(name string) error {
+	return fmt.Errorf("invalid timeline name %q", name)
+}
+
 // newInvalidStyleID defined the error message on receiving the invalid style
 // ID.
 func newInvalidStyleID(styleID int) error {
 	return fmt.Errorf("invalid style ID %d", styleID)
 }
 
+// newNoExistDefinedNameError defined the error message on receiving the
+// defined name which does not exist on the scope of the given worksheet.
+func newNoExistDefinedNameError(name, sheet string) error {
+	return fmt.Errorf("defined name %s does not exist on the scope of worksheet %s", name, sheet)
+}
+
+// newNoExistShapeError defined the error message on receiving the non existing
+// shape name.
+func newNoExistShapeError(name string) error {
+	return fmt.Errorf("shape %s does not exist", name)
+}
+
 // newNoExistTableError defined the error message on receiving the non existing
 // table name.
 func newNoExistTableError(name string) error {
 	return fmt.Errorf("table %s does not exist", name)
 }
 
+// newMergeCellOverlapError defined the error message on merging cells which
+// overlaps with an existing merged cell range.
+func newMergeCellOverlapError(ref, existingRef string) error {
+	return fmt.Errorf("the range %s overlaps with the existing merged cell range %s", ref, existingRef)
+}
+
+// newPanesTopLeftCellError defined the error message on receiving the top
+// left cell of panes which inside of the frozen region.
+func newPanesTopLeftCellError(cell string) error {
+	return fmt.Errorf("the top left cell %s should be outside of the frozen panes", cell)
+}
+
+// newNoExistSlicerError defined the error message on receiving the non
+// existing slicer name.
+func newNoExistSlicerError(name string) error {
+	return fmt.Errorf("slicer %s does not exist", name)
+}
+
 // newNotWorksheetError defined the error message on receiving a sheet which
 // not a worksheet.
 func newNotWorksheetError(name string) error {
@@ -288,6 +362,13 @@ func newPivotTableRangeError(msg string) error {
 	return fmt.Errorf("parameter 'PivotTableRange' parsing error: %s", msg)
 }
 
+// newSharedFormulaOverlapError defined the error message on setting the
+// shared formula for the range which overlaps with the master cell of an
+// existing shared formula that extends outside the range.
+func newSharedFormulaOverlapError(ref, existingRef string) error {
+	return fmt.Errorf("the range %s overlaps with the master cell of the existing shared formula %s", ref, existingRef)
+}
+
 // newStreamSetRowError defined the error message on the stream writer
 // receiving the non-ascending row number.
 func newStreamSetRowError(row int) error {
@@ -306,6 +387,12 @@ func newUnsupportedChartType(chartType ChartType) error {
 	return fmt.Errorf("unsupported chart type %d", chartType)
 }
 
+// newUnsupportedDataLabelPositionError defined the error message on receiving
+// the data labels position which is unsupported by the chart type.
+func newUnsupportedDataLabelPositionError(chartType ChartType, position ChartDataLabelPositionType) error {
+	return fmt.Errorf("unsupported data labels position %d for chart type %d", position, chartType)
+}
+
 // newUnzipSizeLimitError defined the error message on unzip size exceeds the
 // limit.
 func newUnzipSizeLimitError(unzipSizeLimit int64) error {
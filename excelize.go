@@ -30,14 +30,18 @@ import (
 type File struct {
 	mu               sync.Mutex
 	checked          sync.Map
+	commentAuthor    string
 	formulaChecked   bool
 	options          *Options
 	sharedStringItem [][]uint
 	sharedStringsMap map[string]int
 	sharedStringTemp *os.File
 	sheetMap         map[string]string
+	sourcePath       string
+	sourceParts      map[string][]byte
 	streams          map[string]*StreamWriter
 	tempFiles        sync.Map
+	touchedParts     sync.Map
 	xmlAttr          sync.Map
 	CalcChain        *xlsxCalcChain
 	CharsetReader    charsetTranscoderFn
@@ -54,6 +58,7 @@ type File struct {
 	SheetCount       int
 	Styles           *xlsxStyleSheet
 	Theme            *decodeTheme
+	ThreadedComments map[string]*xlsxThreadedComments
 	VMLDrawing       map[string]*vmlDrawing
 	VolatileDeps     *xlsxVolTypes
 	WorkBook         *xlsxWorkbook
@@ -97,6 +102,65 @@ type charsetTranscoderFn func(charset string, input io.Reader) (rdr io.Reader, e
 //
 // CultureInfo specifies the country code for applying built-in language number
 // format code these effect by the system's local language settings.
+//
+// AutoDimension specifies if recalculate the used range (dimension) of the
+// worksheets by the cells on saving the spreadsheet, the default value is
+// false. Set this option to true to make sure the dimension always reflects
+// the real used range of the worksheets, it takes extra time to iterate all
+// cells in the modified worksheets.
+//
+// InlineString specifies if store the string type cell values as inline
+// strings in the worksheets instead of the shared string table by the
+// SetCellValue and SetCellStr functions, the default value is false. Set this
+// option to true to reduce the memory usage for writing the spreadsheet with
+// a large amount of unique strings, but the file size may become larger.
+// Note that the StreamWriter always writes strings as inline strings.
+//
+// KeepLeadingZeros specifies if suppress the "number stored as text" error
+// indicator of the cells by the SetCellValue and SetCellStr functions, when
+// the string type cell values look like numbers, the default value is false.
+// The string type cell values are always stored as text, so that the values
+// such as part numbers and ZIP codes keep the leading zeros, set this option
+// to true to avoid the green triangle on these cells in the spreadsheet
+// application. The suppression will be removed when the cell is overwritten
+// by a number or non-numeric text.
+//
+// RightToLeft specifies if the worksheets created by the NewFile and NewSheet
+// functions display from right to left by default, the default value is
+// false. Set this option to true for the workbooks in right-to-left languages
+// such as Arabic and Hebrew, the first column will be placed on the right side
+// of the window, and the text boxes added by the AddShape function and the
+// text in the charts added by the AddChart function in these worksheets will
+// be written from right to left, so does the text in the charts added by the
+// AddChartSheet function. Use the SetSheetView function to change the
+// direction of a single worksheet.
+//
+// IncrementalSave specifies if only compress the changed parts on saving the
+// spreadsheet opened by the OpenFile function, the default value is false. Set
+// this option to true to copy the compressed data of the unchanged parts
+// from the source file as they are, which reduces the time of saving the
+// large spreadsheet with a few changes. The part will be treated as unchanged
+// when it hasn't been replaced, its deserialized worksheet hasn't been
+// accessed, and its styles or shared strings table hasn't been changed since
+// the spreadsheet was opened or saved, the unchanged parts will be neither
+// serialized nor compressed. The full save will be used when the source file
+// isn't available, such as the spreadsheet created by the NewFile function,
+// opened by the OpenReader function, or opened with password protection.
+//
+// RoundFloat specifies if round the float cell values to 15 significant
+// digits as the spreadsheet application does, and store them in plain decimal
+// notation without exponent when setting the float cell values by the
+// SetCellFloat, SetCellValue, SetCellValues functions and the stream writer,
+// the default value is false. For example, the value 0.1+0.2 will be stored as
+// 0.3 instead of 0.30000000000000004 when this option was set to true.
+//
+// DropCalcChain specifies if remove the calculation chain of the workbook on
+// saving, the default value is false. The cell references which don't contain
+// formulas are always removed from the calculation chain on saving. Set this
+// option to true to remove the calculation chain part entirely and force the
+// spreadsheet application to recalculate all formulas when the workbook is
+// opened, which avoids the stale calculation chain in the workbook after
+// changing lots of formulas.
 type Options struct {
 	MaxCalcIterations uint
 	Password          string
@@ -107,6 +171,13 @@ type Options struct {
 	LongDatePattern   string
 	LongTimePattern   string
 	CultureInfo       CultureName
+	AutoDimension     bool
+	InlineString      bool
+	KeepLeadingZeros  bool
+	RightToLeft       bool
+	IncrementalSave   bool
+	RoundFloat        bool
+	DropCalcChain     bool
 }
 
 // OpenFile take the name of a spreadsheet file and returns a populated
@@ -129,6 +200,7 @@ func OpenFile(filename string, opts ...Options) (*File, error) {
 		return f, err
 	}
 	f.Path = filename
+	f.setSourceFile(filename)
 	return f, file.Close()
 }
 
@@ -145,6 +217,7 @@ func newFile() *File {
 		sharedStringsMap: make(map[string]int),
 		Sheet:            sync.Map{},
 		DecodeVMLDrawing: make(map[string]*decodeVmlDrawing),
+		ThreadedComments: make(map[string]*xlsxThreadedComments),
 		VMLDrawing:       make(map[string]*vmlDrawing),
 		Relationships:    sync.Map{},
 		CharsetReader:    charset.NewReaderLabel,
@@ -271,11 +344,9 @@ func (f *File) workSheetReader(sheet string) (ws *xlsxWorksheet, err error) {
 		ws = worksheet.(*xlsxWorksheet)
 		return
 	}
-	for _, sheetType := range []string{"xl/chartsheets", "xl/dialogsheet", "xl/macrosheet"} {
-		if strings.HasPrefix(name, sheetType) {
-			err = newNotWorksheetError(sheet)
-			return
-		}
+	if getSheetType(name) != SheetTypeWorksheet {
+		err = newNotWorksheetError(sheet)
+		return
 	}
 	ws = new(xlsxWorksheet)
 	if attrs, ok := f.xmlAttr.Load(name); !ok {
@@ -539,9 +610,10 @@ func (f *File) AddVBAProject(file []byte) error {
 	defer rels.mu.Unlock()
 	var rID int
 	var ok bool
+	path := "xl/vbaProject.bin"
 	for _, rel := range rels.Relationships {
-		if rel.Target == "vbaProject.bin" && rel.Type == SourceRelationshipVBAProject {
-			ok = true
+		if rel.Type == SourceRelationshipVBAProject {
+			ok, path = true, f.getWorksheetPath(rel.Target)
 			continue
 		}
 		t, _ := strconv.Atoi(strings.TrimPrefix(rel.ID, "rId"))
@@ -557,14 +629,52 @@ func (f *File) AddVBAProject(file []byte) error {
 			Type:   SourceRelationshipVBAProject,
 		})
 	}
-	f.Pkg.Store("xl/vbaProject.bin", file)
+	if err = f.setContentTypeVBAProject(); err != nil {
+		return err
+	}
+	// Store a copy of the VBA project to avoid the part be changed by the
+	// caller before saving.
+	f.Pkg.Store(path, append([]byte(nil), file...))
 	return err
 }
 
-// setContentTypePartProjectExtensions provides a function to set the content
-// type for relationship parts and the main document part.
-func (f *File) setContentTypePartProjectExtensions(contentType string) error {
-	var ok bool
+// GetVBAProject provides a function to get the vbaProject.bin file which
+// contains functions and/or macros in the workbook, it returns nil if the
+// workbook doesn't contain the VBA project. This function could be used with
+// the AddVBAProject function to copy the macros from a macro-enabled workbook
+// into another workbook. For example:
+//
+//	file, err := f.GetVBAProject()
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	if file != nil {
+//	    if err := target.AddVBAProject(file); err != nil {
+//	        fmt.Println(err)
+//	    }
+//	}
+func (f *File) GetVBAProject() ([]byte, error) {
+	rels, err := f.relsReader(f.getWorkbookRelsPath())
+	if err != nil || rels == nil {
+		return nil, err
+	}
+	rels.mu.Lock()
+	defer rels.mu.Unlock()
+	for _, rel := range rels.Relationships {
+		if rel.Type != SourceRelationshipVBAProject {
+			continue
+		}
+		if content, ok := f.Pkg.Load(f.getWorksheetPath(rel.Target)); ok {
+			return append([]byte(nil), content.([]byte)...), nil
+		}
+	}
+	return nil, nil
+}
+
+// setContentTypeVBAProject provides a function to set the default content type
+// for the VBA project binary parts.
+func (f *File) setContentTypeVBAProject() error {
 	content, err := f.contentTypesReader()
 	if err != nil {
 		return err
@@ -573,21 +683,31 @@ func (f *File) setContentTypePartProjectExtensions(contentType string) error {
 	defer content.mu.Unlock()
 	for _, v := range content.Defaults {
 		if v.Extension == "bin" {
-			ok = true
+			return err
 		}
 	}
+	content.Defaults = append(content.Defaults, xlsxDefault{
+		Extension:   "bin",
+		ContentType: ContentTypeVBA,
+	})
+	return err
+}
+
+// setContentTypePartProjectExtensions provides a function to set the content
+// type for relationship parts and the main document part.
+func (f *File) setContentTypePartProjectExtensions(contentType string) error {
+	content, err := f.contentTypesReader()
+	if err != nil {
+		return err
+	}
+	content.mu.Lock()
 	for idx, o := range content.Overrides {
 		if o.PartName == "/xl/workbook.xml" {
 			content.Overrides[idx].ContentType = contentType
 		}
 	}
-	if !ok {
-		content.Defaults = append(content.Defaults, xlsxDefault{
-			Extension:   "bin",
-			ContentType: ContentTypeVBA,
-		})
-	}
-	return err
+	content.mu.Unlock()
+	return f.setContentTypeVBAProject()
 }
 
 // metadataReader provides a function to get the pointer to the structure
@@ -614,8 +734,8 @@ func (f *File) richValueReader() (*xlsxRichValueData, error) {
 
 // richValueRelReader provides a function to get the pointer to the structure
 // after deserialization of xl/richData/richValueRel.xml.
-func (f *File) richValueRelReader() (*xlsxRichValueRels, error) {
-	var richValueRels xlsxRichValueRels
+func (f *File) richValueRelReader() (*decodeRichValueRels, error) {
+	var richValueRels decodeRichValueRels
 	if err := f.xmlNewDecoder(bytes.NewReader(namespaceStrictToTransitional(f.readXML(defaultXMLRdRichValueRel)))).
 		Decode(&richValueRels); err != nil && err != io.EOF {
 		return &richValueRels, err
@@ -623,6 +743,17 @@ func (f *File) richValueRelReader() (*xlsxRichValueRels, error) {
 	return &richValueRels, nil
 }
 
+// richValueStructureReader provides a function to get the pointer to the
+// structure after deserialization of xl/richData/rdrichvaluestructure.xml.
+func (f *File) richValueStructureReader() (*xlsxRichValueStructures, error) {
+	var richValueStructures xlsxRichValueStructures
+	if err := f.xmlNewDecoder(bytes.NewReader(namespaceStrictToTransitional(f.readXML(defaultXMLRdRichValueStructurePart)))).
+		Decode(&richValueStructures); err != nil && err != io.EOF {
+		return &richValueStructures, err
+	}
+	return &richValueStructures, nil
+}
+
 // richValueWebImageReader provides a function to get the pointer to the
 // structure after deserialization of xl/richData/rdRichValueWebImage.xml.
 func (f *File) richValueWebImageReader() (*xlsxWebImagesSupportingRichData, error) {
@@ -507,6 +507,43 @@ func TestGetCellHyperLink(t *testing.T) {
 	assert.EqualError(t, err, ErrSheetNameInvalid.Error())
 }
 
+func TestGetHyperlinks(t *testing.T) {
+	f := NewFile()
+	// Test get hyperlinks on the worksheet without hyperlinks
+	links, err := f.GetHyperlinks("Sheet1")
+	assert.NoError(t, err)
+	assert.Empty(t, links)
+	assert.NotNil(t, links)
+
+	assert.NoError(t, f.SetCellValue("Sheet1", "A1", "Excelize"))
+	assert.NoError(t, f.SetCellHyperLink("Sheet1", "A1", "https://github.com/xuri/excelize", "External",
+		HyperlinkOpts{Tooltip: stringPtr("Excelize on GitHub")}))
+	assert.NoError(t, f.SetCellHyperLink("Sheet1", "A2", "Sheet1!D8", "Location",
+		HyperlinkOpts{Display: stringPtr("Go to D8"), Tooltip: stringPtr("Location")}))
+	assert.NoError(t, f.SetCellValue("Sheet1", "B1", 100))
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	ws.(*xlsxWorksheet).Hyperlinks.Hyperlink = append(ws.(*xlsxWorksheet).Hyperlinks.Hyperlink, xlsxHyperlink{Ref: "B1:C2", Location: "Sheet1!A1"})
+	links, err = f.GetHyperlinks("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]Hyperlink{
+		"A1":    {Type: "External", Link: "https://github.com/xuri/excelize", Display: "Excelize", Tooltip: "Excelize on GitHub"},
+		"A2":    {Type: "Location", Link: "Sheet1!D8", Display: "Go to D8", Tooltip: "Location"},
+		"B1:C2": {Type: "Location", Link: "Sheet1!A1", Display: "100"},
+	}, links)
+	// Test get hyperlinks with invalid reference
+	ws.(*xlsxWorksheet).Hyperlinks = &xlsxHyperlinks{Hyperlink: []xlsxHyperlink{{Ref: "A:A"}}}
+	_, err = f.GetHyperlinks("Sheet1")
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), err)
+	// Test get hyperlinks on not exists worksheet
+	_, err = f.GetHyperlinks("SheetN")
+	assert.EqualError(t, err, "sheet SheetN does not exist")
+	// Test get hyperlinks with invalid sheet name
+	_, err = f.GetHyperlinks("Sheet:1")
+	assert.EqualError(t, err, ErrSheetNameInvalid.Error())
+	assert.NoError(t, f.Close())
+}
+
 func TestSetSheetBackground(t *testing.T) {
 	f, err := OpenFile(filepath.Join("test", "Book1.xlsx"))
 	assert.NoError(t, err)
@@ -1029,6 +1066,100 @@ func TestCopySheet(t *testing.T) {
 	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestCopySheet.xlsx")))
 }
 
+func TestCopySheetWithObjects(t *testing.T) {
+	f := NewFile()
+	for i, row := range [][]interface{}{{"Name", "Value"}, {"A", 1}, {"B", 2}, {"C", 3}} {
+		assert.NoError(t, f.SetSheetRow("Sheet1", fmt.Sprintf("A%d", i+1), &row))
+	}
+	assert.NoError(t, f.AddPicture("Sheet1", "D1", filepath.Join("test", "images", "excel.png"), nil))
+	assert.NoError(t, f.AddChart("Sheet1", "D10", &Chart{
+		Type:   Col,
+		Series: []ChartSeries{{Name: "Sheet1!$B$1", Categories: "Sheet1!$A$2:$A$4", Values: "Sheet1!$B$2:$B$4"}},
+	}))
+	assert.NoError(t, f.AddComment("Sheet1", Comment{Cell: "A1", Author: "Excelize", Text: "Comment"}))
+	assert.NoError(t, f.AddTable("Sheet1", &Table{Range: "A1:B4", Name: "Table1"}))
+	assert.NoError(t, f.SetCellFormula("Sheet1", "C1", "SUM(table1[Value])+SUM(Table10[Value])&\"Table1[Value]\"&Table1[[#This Row],[Table1]]"))
+	assert.NoError(t, f.SetDefinedName(&DefinedName{Name: "Values", RefersTo: "Sheet1!$B$2:$B$4", Scope: "Sheet1"}))
+	idx, err := f.NewSheet("Copy")
+	assert.NoError(t, err)
+	assert.NoError(t, f.CopySheet(0, idx))
+
+	// Test edit the objects in the copied worksheet doesn't affect the source worksheet
+	assert.NoError(t, f.DeletePicture("Copy", "D1"))
+	assert.NoError(t, f.DeleteComment("Copy", "A1"))
+	pics, err := f.GetPictures("Sheet1", "D1")
+	assert.NoError(t, err)
+	assert.Len(t, pics, 1)
+	comments, err := f.GetComments("Sheet1")
+	assert.NoError(t, err)
+	assert.Len(t, comments, 1)
+
+	tables, err := f.GetTables("Copy")
+	assert.NoError(t, err)
+	assert.Len(t, tables, 1)
+	assert.Equal(t, "Table1_2", tables[0].Name)
+	// Test rename the structured references to the copied table
+	for sheet, expected := range map[string]string{
+		"Sheet1": "SUM(table1[Value])+SUM(Table10[Value])&\"Table1[Value]\"&Table1[[#This Row],[Table1]]",
+		"Copy":   "SUM(Table1_2[Value])+SUM(Table10[Value])&\"Table1[Value]\"&Table1_2[[#This Row],[Table1]]",
+	} {
+		formula, err := f.GetCellFormula(sheet, "C1")
+		assert.NoError(t, err)
+		assert.Equal(t, expected, formula)
+	}
+	refersTo, err := f.ResolveDefinedName("Values", "Copy")
+	assert.NoError(t, err)
+	assert.Equal(t, "Copy!$B$2:$B$4", refersTo)
+	chart, ok := f.Pkg.Load("xl/charts/chart2.xml")
+	assert.True(t, ok)
+	assert.Contains(t, string(chart.([]byte)), "<f>Copy!$B$2:$B$4</f>")
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestCopySheetWithObjects.xlsx")))
+	assert.NoError(t, f.Close())
+
+	f, err = OpenFile(filepath.Join("test", "TestCopySheetWithObjects.xlsx"))
+	assert.NoError(t, err)
+	for sheet, count := range map[string]int{"Sheet1": 1, "Copy": 0} {
+		pics, err = f.GetPictures(sheet, "D1")
+		assert.NoError(t, err)
+		assert.Len(t, pics, count)
+		comments, err = f.GetComments(sheet)
+		assert.NoError(t, err)
+		assert.Len(t, comments, count)
+	}
+	idx, err = f.NewSheet("Sheet3")
+	assert.NoError(t, err)
+	assert.NoError(t, f.CopySheet(1, idx))
+	tables, err = f.GetTables("Sheet3")
+	assert.NoError(t, err)
+	assert.Len(t, tables, 1)
+	assert.Equal(t, "Table1_2_3", tables[0].Name)
+	assert.NoError(t, f.Close())
+
+	// Test copy worksheet with unsupported charset parts
+	for _, part := range []string{"xl/drawings/drawing1.xml", "xl/drawings/_rels/drawing1.xml.rels", "xl/charts/_rels/chart1.xml.rels", "xl/comments1.xml", "xl/tables/table1.xml"} {
+		f = NewFile()
+		assert.NoError(t, f.AddPicture("Sheet1", "D1", filepath.Join("test", "images", "excel.png"), nil))
+		assert.NoError(t, f.AddChart("Sheet1", "D10", &Chart{
+			Type:   Col,
+			Series: []ChartSeries{{Name: "Sheet1!$B$1", Categories: "Sheet1!$A$2:$A$4", Values: "Sheet1!$B$2:$B$4"}},
+		}))
+		assert.NoError(t, f.AddComment("Sheet1", Comment{Cell: "A1", Author: "Excelize", Text: "Comment"}))
+		assert.NoError(t, f.AddTable("Sheet1", &Table{Range: "A1:B4"}))
+		idx, err = f.NewSheet("Copy")
+		assert.NoError(t, err)
+		assert.NoError(t, f.SaveAs(filepath.Join("test", "TestCopySheetWithObjects.xlsx")))
+		f, err = OpenFile(filepath.Join("test", "TestCopySheetWithObjects.xlsx"))
+		assert.NoError(t, err)
+		f.Pkg.Store(part, MacintoshCyrillicCharset)
+		assert.EqualError(t, f.CopySheet(0, idx), "XML syntax error on line 1: invalid UTF-8", part)
+		assert.NoError(t, f.Close())
+	}
+	// Test copy worksheet with unsupported charset worksheet relationships
+	f = NewFile()
+	f.Pkg.Store("xl/worksheets/_rels/sheet1.xml.rels", MacintoshCyrillicCharset)
+	assert.EqualError(t, f.copySheet(0, 0), "XML syntax error on line 1: invalid UTF-8")
+}
+
 func TestCopySheetError(t *testing.T) {
 	f, err := prepareTestBook1()
 	assert.NoError(t, err)
@@ -1410,6 +1541,50 @@ func TestProtectSheet(t *testing.T) {
 	assert.EqualError(t, f.ProtectSheet("Sheet:1", nil), ErrSheetNameInvalid.Error())
 }
 
+func TestGetSheetProtection(t *testing.T) {
+	f := NewFile()
+	opts, err := f.GetSheetProtection("Sheet1")
+	assert.NoError(t, err)
+	assert.Nil(t, opts)
+	expected := SheetProtectionOptions{
+		AlgorithmName:       "SHA-512",
+		Password:            "password",
+		FormatCells:         true,
+		InsertRows:          true,
+		SelectLockedCells:   true,
+		SelectUnlockedCells: true,
+	}
+	assert.NoError(t, f.ProtectSheet("Sheet1", &expected))
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestGetSheetProtection.xlsx")))
+	assert.NoError(t, f.Close())
+
+	f, err = OpenFile(filepath.Join("test", "TestGetSheetProtection.xlsx"))
+	assert.NoError(t, err)
+	opts, err = f.GetSheetProtection("Sheet1")
+	assert.NoError(t, err)
+	expected.Password, expected.HasPassword = "", true
+	assert.Equal(t, &expected, opts)
+	// Test get sheet protection without password
+	assert.NoError(t, f.ProtectSheet("Sheet1", &SheetProtectionOptions{EditObjects: true}))
+	opts, err = f.GetSheetProtection("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, &SheetProtectionOptions{EditObjects: true}, opts)
+	// Test get sheet protection with omitted default attributes
+	f.Sheet.Delete("xl/worksheets/sheet1.xml")
+	f.Pkg.Store("xl/worksheets/sheet1.xml", []byte(fmt.Sprintf(`<worksheet xmlns="%s"><sheetData/><sheetProtection password="83AF" sheet="1" objects="1" scenarios="1" formatColumns="0" sort="false"/></worksheet>`, NameSpaceSpreadSheet.Value)))
+	f.checked = sync.Map{}
+	opts, err = f.GetSheetProtection("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, &SheetProtectionOptions{FormatColumns: true, HasPassword: true, SelectLockedCells: true, SelectUnlockedCells: true, Sort: true}, opts)
+	// Test get sheet protection with invalid sheet name
+	_, err = f.GetSheetProtection("Sheet:1")
+	assert.EqualError(t, err, ErrSheetNameInvalid.Error())
+	// Test get sheet protection on not exists worksheet
+	_, err = f.GetSheetProtection("SheetN")
+	assert.EqualError(t, err, "sheet SheetN does not exist")
+	assert.NoError(t, f.Close())
+}
+
 func TestUnprotectSheet(t *testing.T) {
 	f, err := OpenFile(filepath.Join("test", "Book1.xlsx"))
 	assert.NoError(t, err)
@@ -1525,6 +1700,62 @@ func TestAddVBAProject(t *testing.T) {
 	f.Relationships.Delete(defaultXMLPathWorkbookRels)
 	f.Pkg.Store(defaultXMLPathWorkbookRels, MacintoshCyrillicCharset)
 	assert.EqualError(t, f.AddVBAProject(file), "XML syntax error on line 1: invalid UTF-8")
+	// Test add VBA with unsupported charset content types
+	f = NewFile()
+	f.ContentTypes = nil
+	f.Pkg.Store(defaultXMLPathContentTypes, MacintoshCyrillicCharset)
+	assert.EqualError(t, f.AddVBAProject(file), "XML syntax error on line 1: invalid UTF-8")
+}
+
+func TestGetVBAProject(t *testing.T) {
+	f := NewFile()
+	vbaProject, err := f.GetVBAProject()
+	assert.NoError(t, err)
+	assert.Nil(t, vbaProject)
+	file, err := os.ReadFile(filepath.Join("test", "vbaProject.bin"))
+	assert.NoError(t, err)
+	expected := append([]byte(nil), file...)
+	assert.NoError(t, f.AddVBAProject(file))
+	// Test the VBA project will not be changed by the caller
+	file[0] = 0
+	vbaProject, err = f.GetVBAProject()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, vbaProject)
+	vbaProject[0] = 0
+	buf, err := f.WriteToBuffer()
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	f, err = OpenReader(buf)
+	assert.NoError(t, err)
+	vbaProject, err = f.GetVBAProject()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, vbaProject)
+	contentTypes, err := f.contentTypesReader()
+	assert.NoError(t, err)
+	assert.Contains(t, contentTypes.Defaults, xlsxDefault{Extension: "bin", ContentType: ContentTypeVBA})
+	// Test replace the VBA project with custom part name
+	rels, err := f.relsReader(defaultXMLPathWorkbookRels)
+	assert.NoError(t, err)
+	for idx, rel := range rels.Relationships {
+		if rel.Type == SourceRelationshipVBAProject {
+			rels.Relationships[idx].Target = "/xl/macros/vbaProject.bin"
+		}
+	}
+	f.Pkg.Delete("xl/vbaProject.bin")
+	vbaProject, err = f.GetVBAProject()
+	assert.NoError(t, err)
+	assert.Nil(t, vbaProject)
+	assert.NoError(t, f.AddVBAProject(expected))
+	content, ok := f.Pkg.Load("xl/macros/vbaProject.bin")
+	assert.True(t, ok)
+	assert.Equal(t, expected, content)
+	// Test get VBA project with unsupported charset workbook relationships
+	f.Relationships.Delete(defaultXMLPathWorkbookRels)
+	f.Pkg.Store(defaultXMLPathWorkbookRels, MacintoshCyrillicCharset)
+	_, err = f.GetVBAProject()
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+	assert.NoError(t, f.Close())
 }
 
 func TestContentTypesReader(t *testing.T) {
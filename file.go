@@ -51,6 +51,7 @@ func NewFile(opts ...Options) *File {
 	f.Sheet.Store("xl/worksheets/sheet1.xml", ws)
 	f.Theme, _ = f.themeReader()
 	f.options = f.getOptions(opts...)
+	ws.SheetViews.SheetView[0].RightToLeft = f.options.RightToLeft
 	return f
 }
 
@@ -75,12 +76,109 @@ func (f *File) SaveAs(name string, opts ...Options) error {
 	if _, ok := supportedContentTypes[strings.ToLower(filepath.Ext(f.Path))]; !ok {
 		return ErrWorkbookFileFormat
 	}
+	if options := f.getOptions(opts...); options != nil && options.IncrementalSave {
+		if fi, err := os.Stat(filepath.Clean(name)); err == nil && f.isSourceFile(fi) {
+			return f.overrideSourceFile(name, fi.Mode(), opts...)
+		}
+	}
 	file, err := os.OpenFile(filepath.Clean(name), os.O_WRONLY|os.O_TRUNC|os.O_CREATE, os.ModePerm)
 	if err != nil {
 		return err
 	}
 	defer file.Close()
-	return f.Write(file, opts...)
+	if err = f.Write(file, opts...); err == nil && f.options != nil && f.options.IncrementalSave {
+		f.setSourceFile(name)
+	}
+	return err
+}
+
+// setSourceFile provides a function to set the source file of the spreadsheet
+// for the incremental save mode. The parts in the package will be recorded as
+// the parts of the source file, and the changed parts will be reset. The
+// package will not be recorded unless the incremental save mode is enabled.
+func (f *File) setSourceFile(name string) {
+	if f.options == nil || !f.options.IncrementalSave {
+		return
+	}
+	f.sourcePath, f.sourceParts = name, make(map[string][]byte)
+	f.Pkg.Range(func(path, content interface{}) bool {
+		if b, ok := content.([]byte); ok {
+			f.sourceParts[path.(string)] = b
+		}
+		return true
+	})
+	f.touchedParts.Range(func(path, _ interface{}) bool {
+		f.touchedParts.Delete(path)
+		return true
+	})
+}
+
+// touchPart provides a function to mark the part as changed by given path,
+// the deserialized structure of the changed part will be serialized on
+// saving in the incremental save mode.
+func (f *File) touchPart(path string) {
+	f.touchedParts.LoadOrStore(path, true)
+}
+
+// isPartTouched provides a function to check if the deserialized structure of
+// the part should be serialized on saving by given path. All parts will be
+// serialized unless the incremental save mode is enabled, and the part of the
+// source file hasn't been changed since the source file was opened or saved.
+func (f *File) isPartTouched(path string) bool {
+	if f.options == nil || !f.options.IncrementalSave || f.sourceParts == nil {
+		return true
+	}
+	if _, ok := f.sourceParts[path]; !ok {
+		return true
+	}
+	_, ok := f.touchedParts.Load(path)
+	return ok
+}
+
+// isSourcePart provides a function to check if the part in the package is
+// still the same one of the source file by given path and content. The part
+// will be changed once it has been replaced in the package.
+func (f *File) isSourcePart(path string, content []byte) bool {
+	source, ok := f.sourceParts[path]
+	return ok && len(source) == len(content) && (len(content) == 0 || &source[0] == &content[0])
+}
+
+// isSourceFile provides a function to check if the given file is the source
+// file of the spreadsheet for the incremental save mode.
+func (f *File) isSourceFile(fi os.FileInfo) bool {
+	if f.sourcePath == "" {
+		return false
+	}
+	source, err := os.Stat(filepath.Clean(f.sourcePath))
+	return err == nil && os.SameFile(fi, source)
+}
+
+// overrideSourceFile provides a function to override the source file of the
+// spreadsheet with the incremental save mode. The spreadsheet will be written
+// into a temporary file in the same directory and then renamed to the source
+// file, so that the unchanged parts could be copied from the source file
+// during saving.
+func (f *File) overrideSourceFile(name string, mode os.FileMode, opts ...Options) error {
+	name = filepath.Clean(name)
+	file, err := os.CreateTemp(filepath.Dir(name), ".excelize-*"+filepath.Ext(name))
+	if err != nil {
+		return err
+	}
+	if err = f.Write(file, opts...); err == nil {
+		err = file.Chmod(mode)
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), name)
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+		return err
+	}
+	f.setSourceFile(name)
+	return nil
 }
 
 // Close closes and cleanup the open temporary file for the spreadsheet.
@@ -182,6 +280,7 @@ func (f *File) writeToZip(zw *zip.Writer) error {
 	f.workBookWriter()
 	f.workSheetWriter()
 	f.relsWriter()
+	f.threadedCommentsWriter()
 	_ = f.sharedStringsLoader()
 	f.sharedStringsWriter()
 	f.styleSheetWriter()
@@ -203,8 +302,13 @@ func (f *File) writeToZip(zw *zip.Writer) error {
 	}
 	var (
 		err              error
+		copied           bool
 		files, tempFiles []string
+		source           = f.openIncrementalSource()
 	)
+	if source != nil {
+		defer source.Close()
+	}
 	f.Pkg.Range(func(path, content interface{}) bool {
 		if _, ok := f.streams[path.(string)]; ok {
 			return true
@@ -214,12 +318,19 @@ func (f *File) writeToZip(zw *zip.Writer) error {
 	})
 	sort.Sort(sort.Reverse(sort.StringSlice(files)))
 	for _, path := range files {
-		var fi io.Writer
-		if fi, err = zw.Create(path); err != nil {
+		content, _ := f.Pkg.Load(path)
+		b, _ := content.([]byte)
+		if f.isSourcePart(path, b) {
+			if copied, err = source.copyPart(zw, path); err != nil {
+				break
+			}
+			if copied {
+				continue
+			}
+		}
+		if err = writePart(zw, path, b); err != nil {
 			break
 		}
-		content, _ := f.Pkg.Load(path)
-		_, err = fi.Write(content.([]byte))
 	}
 	f.tempFiles.Range(func(path, content interface{}) bool {
 		if _, ok := f.Pkg.Load(path); ok {
@@ -230,11 +341,66 @@ func (f *File) writeToZip(zw *zip.Writer) error {
 	})
 	sort.Sort(sort.Reverse(sort.StringSlice(tempFiles)))
 	for _, path := range tempFiles {
-		var fi io.Writer
-		if fi, err = zw.Create(path); err != nil {
+		if copied, err = source.copyPart(zw, path); err != nil {
+			break
+		}
+		if copied {
+			continue
+		}
+		if err = writePart(zw, path, f.readBytes(path)); err != nil {
 			break
 		}
-		_, err = fi.Write(f.readBytes(path))
 	}
 	return err
 }
+
+// incrementalSource directly maps the source file of the spreadsheet for the
+// incremental save mode.
+type incrementalSource struct {
+	*zip.ReadCloser
+	parts map[string]*zip.File
+}
+
+// openIncrementalSource provides a function to open the source file of the
+// spreadsheet for the incremental save mode. It returns nil if the
+// incremental save mode is disabled or the source file isn't available, and
+// then all parts will be compressed.
+func (f *File) openIncrementalSource() *incrementalSource {
+	if f.options == nil || !f.options.IncrementalSave || f.sourcePath == "" {
+		return nil
+	}
+	zr, err := zip.OpenReader(filepath.Clean(f.sourcePath))
+	if err != nil {
+		return nil
+	}
+	source := &incrementalSource{ReadCloser: zr, parts: make(map[string]*zip.File, len(zr.File))}
+	for _, part := range zr.File {
+		source.parts[part.Name] = part
+	}
+	return source
+}
+
+// copyPart provides a function to copy the compressed data of the unchanged
+// part from the source file into the zip writer by given path, it returns
+// false if the part doesn't exist in the source file.
+func (s *incrementalSource) copyPart(zw *zip.Writer, path string) (bool, error) {
+	if s == nil {
+		return false, nil
+	}
+	part, ok := s.parts[path]
+	if !ok {
+		return false, nil
+	}
+	return true, zw.Copy(part)
+}
+
+// writePart provides a function to compress the part into the zip writer by
+// given path and content.
+func writePart(zw *zip.Writer, path string, content []byte) error {
+	fi, err := zw.Create(path)
+	if err != nil {
+		return err
+	}
+	_, err = fi.Write(content)
+	return err
+}
@@ -1,8 +1,10 @@
 package excelize
 
 import (
+	"archive/zip"
 	"bufio"
 	"bytes"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -90,6 +92,123 @@ func TestWriteTo(t *testing.T) {
 	}
 }
 
+func TestIncrementalSave(t *testing.T) {
+	f := NewFile()
+	_, err := f.NewSheet("Sheet2")
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetCellValue("Sheet2", "A1", "unchanged"))
+	buf, err := f.WriteToBuffer()
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+	// Create the source file with the stored (uncompressed) parts, so that the
+	// copied parts could be distinguished from the compressed parts
+	source := filepath.Join("test", "TestIncrementalSave.xlsx")
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.NoError(t, err)
+	file, err := os.Create(source)
+	assert.NoError(t, err)
+	zw := zip.NewWriter(file)
+	for _, part := range zr.File {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: part.Name, Method: zip.Store})
+		assert.NoError(t, err)
+		r, err := part.Open()
+		assert.NoError(t, err)
+		_, err = io.Copy(w, r)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, zw.Close())
+	assert.NoError(t, file.Close())
+	getMethods := func(path string) map[string]uint16 {
+		zr, err := zip.OpenReader(path)
+		assert.NoError(t, err)
+		defer zr.Close()
+		methods := make(map[string]uint16)
+		for _, part := range zr.File {
+			methods[part.Name] = part.Method
+		}
+		return methods
+	}
+
+	f, err = OpenFile(source, Options{IncrementalSave: true})
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetCellValue("Sheet1", "A1", "changed"))
+	target := filepath.Join("test", "TestIncrementalSave2.xlsx")
+	assert.NoError(t, f.SaveAs(target))
+	methods := getMethods(target)
+	assert.Equal(t, zip.Deflate, methods["xl/worksheets/sheet1.xml"])
+	assert.Equal(t, zip.Store, methods["xl/worksheets/sheet2.xml"])
+	assert.Equal(t, zip.Store, methods["xl/theme/theme1.xml"])
+	assert.Equal(t, zip.Store, methods["xl/styles.xml"])
+	assert.Equal(t, zip.Deflate, methods["xl/sharedStrings.xml"])
+	// Test override the source file with incremental save
+	assert.NoError(t, f.SetCellValue("Sheet1", "A2", "changed"))
+	assert.NoError(t, f.SaveAs(target))
+	methods = getMethods(target)
+	assert.Equal(t, zip.Store, methods["xl/worksheets/sheet2.xml"])
+	assert.Equal(t, zip.Store, methods["xl/styles.xml"])
+	// Test save the accessed styles with incremental save
+	_, err = f.NewStyle(&Style{Font: &Font{Bold: true}})
+	assert.NoError(t, err)
+	assert.NoError(t, f.SaveAs(target))
+	assert.NoError(t, f.Close())
+	methods = getMethods(target)
+	assert.Equal(t, zip.Deflate, methods["xl/styles.xml"])
+	assert.Equal(t, zip.Deflate, methods["xl/sharedStrings.xml"])
+	assert.Equal(t, zip.Store, methods["xl/worksheets/sheet2.xml"])
+	assert.Equal(t, zip.Store, methods["xl/theme/theme1.xml"])
+	f, err = OpenFile(target)
+	assert.NoError(t, err)
+	for cell, expected := range map[string]string{"Sheet1!A1": "changed", "Sheet1!A2": "changed", "Sheet2!A1": "unchanged"} {
+		ref := strings.Split(cell, "!")
+		value, err := f.GetCellValue(ref[0], ref[1])
+		assert.NoError(t, err)
+		assert.Equal(t, expected, value)
+	}
+	assert.NoError(t, f.Close())
+	// Test the source file will not be recorded without incremental save
+	assert.Nil(t, f.sourceParts)
+
+	// Test incremental save without changing the shared strings table and styles
+	f, err = OpenFile(source, Options{IncrementalSave: true})
+	assert.NoError(t, err)
+	value, err := f.GetCellValue("Sheet2", "A1")
+	assert.NoError(t, err)
+	assert.Equal(t, "unchanged", value)
+	_, err = f.NewStyle(&Style{})
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetCellValue("Sheet2", "A2", "unchanged"))
+	target = filepath.Join("test", "TestIncrementalSave4.xlsx")
+	assert.NoError(t, f.SaveAs(target))
+	assert.NoError(t, f.Close())
+	methods = getMethods(target)
+	assert.Equal(t, zip.Store, methods["xl/sharedStrings.xml"])
+	assert.Equal(t, zip.Store, methods["xl/styles.xml"])
+	assert.Equal(t, zip.Deflate, methods["xl/worksheets/sheet2.xml"])
+
+	// Test incremental save without the source file
+	file, err = os.Open(source)
+	assert.NoError(t, err)
+	f, err = OpenReader(file, Options{IncrementalSave: true})
+	assert.NoError(t, err)
+	assert.NoError(t, file.Close())
+	target = filepath.Join("test", "TestIncrementalSave3.xlsx")
+	assert.NoError(t, f.SaveAs(target))
+	assert.Equal(t, zip.Deflate, getMethods(target)["xl/worksheets/sheet2.xml"])
+	assert.NoError(t, f.Close())
+	f, err = OpenFile(source, Options{IncrementalSave: true})
+	assert.NoError(t, err)
+	f.sourcePath = filepath.Join("test", "SourceNotExist.xlsx")
+	assert.NoError(t, f.SaveAs(target))
+	assert.Equal(t, zip.Deflate, getMethods(target)["xl/worksheets/sheet2.xml"])
+	// Test override the source file with incremental save on write error
+	f.Pkg.Store("/d/", []byte("s"))
+	assert.EqualError(t, f.Save(), "zip: write to directory")
+	matches, err := filepath.Glob(filepath.Join("test", ".excelize-*"))
+	assert.NoError(t, err)
+	assert.Empty(t, matches)
+	assert.NoError(t, f.Close())
+}
+
 func TestClose(t *testing.T) {
 	f := NewFile()
 	f.tempFiles.Store("/d/", "/d/")
@@ -0,0 +1,286 @@
+// Copyright 2016 - 2024 The excelize Authors. All rights reserved. Use of
+// this source code is governed by a BSD-style license that can be found in
+// the LICENSE file.
+//
+// Package excelize providing a set of functions that allow you to write to and
+// read from XLAM / XLSM / XLSX / XLTM / XLTX files. Supports reading and
+// writing spreadsheet documents generated by Microsoft Excel™ 2007 and later.
+// Supports complex components by high compatibility, and provided streaming
+// API for generating or reading data from a worksheet with huge amounts of
+// data. This library needs Go version 1.18 or later.
+
+package excelize
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+)
+
+var (
+	// htmlBorderStyles defined the CSS border width and style for each cell
+	// border style index.
+	htmlBorderStyles = []string{
+		"", "1px solid", "2px solid", "1px dashed", "1px dotted", "3px solid",
+		"3px double", "1px dotted", "2px dashed", "1px dashed", "2px dashed",
+		"1px dotted", "2px dotted", "2px dashed",
+	}
+	// htmlHorizontalAlignments defined the CSS text alignment for each cell
+	// horizontal alignment.
+	htmlHorizontalAlignments = map[string]string{
+		"left": "left", "center": "center", "right": "right", "fill": "left",
+		"justify": "justify", "centerContinuous": "center", "distributed": "justify",
+	}
+	// htmlVerticalAlignments defined the CSS vertical alignment for each cell
+	// vertical alignment.
+	htmlVerticalAlignments = map[string]string{
+		"top": "top", "center": "middle", "bottom": "bottom",
+		"justify": "middle", "distributed": "middle",
+	}
+)
+
+// HTMLOptions directly maps the settings of exporting the cells of the
+// worksheet as an HTML table.
+//
+// Range specifies the range reference of the cells to be exported, for
+// example "A1:D10". The default value is the used range of the worksheet.
+//
+// ValuesOnly specifies if only export the cell values and merged cells
+// without the inline CSS of the cell styles, the default value is false.
+type HTMLOptions struct {
+	Range      string
+	ValuesOnly bool
+}
+
+// htmlMergeCell defined the merged cell in the exported HTML table.
+type htmlMergeCell struct {
+	col, row, colSpan, rowSpan int
+}
+
+// GetSheetHTML provides a function to render the cells of the worksheet as an
+// HTML table by given worksheet name and options. The cell values are
+// rendered as the formatted values as displayed in the spreadsheet
+// application, merged cells are rendered by the colspan and rowspan
+// attributes, and the fills, fonts, borders and alignments of the cells are
+// translated into the inline CSS unless the 'ValuesOnly' option is true. For
+// example, export the range A1:D10 on Sheet1 as an HTML table:
+//
+//	table, err := f.GetSheetHTML("Sheet1", excelize.HTMLOptions{Range: "A1:D10"})
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	fmt.Println(string(table))
+func (f *File) GetSheetHTML(sheet string, opts HTMLOptions) ([]byte, error) {
+	rows, err := f.GetRows(sheet)
+	if err != nil {
+		return nil, err
+	}
+	rect := []int{1, 1, 0, len(rows)}
+	for _, row := range rows {
+		if len(row) > rect[2] {
+			rect[2] = len(row)
+		}
+	}
+	if opts.Range == "" {
+		mergeCells, err := f.GetMergeCells(sheet)
+		if err != nil {
+			return nil, err
+		}
+		for _, mergeCell := range mergeCells {
+			col, row, err := CellNameToCoordinates(mergeCell.GetEndAxis())
+			if err != nil {
+				return nil, err
+			}
+			if col > rect[2] {
+				rect[2] = col
+			}
+			if row > rect[3] {
+				rect[3] = row
+			}
+		}
+	} else {
+		ref := opts.Range
+		if !strings.Contains(ref, ":") {
+			ref += ":" + ref
+		}
+		if rect, err = rangeRefToCoordinates(ref); err != nil {
+			return nil, err
+		}
+		_ = sortCoordinates(rect)
+	}
+	mergeCells, err := f.getHTMLMergeCells(sheet, rect)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		buf    bytes.Buffer
+		styles = map[int]string{}
+	)
+	buf.WriteString("<table")
+	if !opts.ValuesOnly {
+		buf.WriteString(` style="border-collapse:collapse"`)
+	}
+	buf.WriteString(">")
+	for row := rect[1]; row <= rect[3]; row++ {
+		buf.WriteString("<tr>")
+		for col := rect[0]; col <= rect[2]; col++ {
+			valueCol, valueRow := col, row
+			mergeCell, ok := mergeCells[[2]int{col, row}]
+			if ok && mergeCell == nil {
+				continue
+			}
+			buf.WriteString("<td")
+			if mergeCell != nil {
+				valueCol, valueRow = mergeCell.col, mergeCell.row
+				if mergeCell.colSpan > 1 {
+					buf.WriteString(` colspan="` + strconv.Itoa(mergeCell.colSpan) + `"`)
+				}
+				if mergeCell.rowSpan > 1 {
+					buf.WriteString(` rowspan="` + strconv.Itoa(mergeCell.rowSpan) + `"`)
+				}
+			}
+			if !opts.ValuesOnly {
+				cell, _ := CoordinatesToCellName(col, row)
+				styleID, err := f.GetCellStyle(sheet, cell)
+				if err != nil {
+					return nil, err
+				}
+				css, ok := styles[styleID]
+				if !ok {
+					style, err := f.GetStyle(styleID)
+					if err != nil {
+						return nil, err
+					}
+					css = f.getHTMLStyle(style)
+					styles[styleID] = css
+				}
+				if css != "" {
+					buf.WriteString(` style="` + html.EscapeString(css) + `"`)
+				}
+			}
+			buf.WriteString(">")
+			if valueRow <= len(rows) && valueCol <= len(rows[valueRow-1]) {
+				buf.WriteString(html.EscapeString(rows[valueRow-1][valueCol-1]))
+			}
+			buf.WriteString("</td>")
+		}
+		buf.WriteString("</tr>")
+	}
+	buf.WriteString("</table>")
+	return buf.Bytes(), err
+}
+
+// getHTMLMergeCells provides a function to get the merged cells in the given
+// range of the worksheet for exporting as an HTML table. It returns a map
+// which the key is the coordinates of the cell, the value is the merged cell
+// for the first visible cell of the merged range, or nil for the other cells
+// covered by the merged range.
+func (f *File) getHTMLMergeCells(sheet string, rect []int) (map[[2]int]*htmlMergeCell, error) {
+	mergeCells := map[[2]int]*htmlMergeCell{}
+	cells, err := f.GetMergeCells(sheet)
+	if err != nil {
+		return mergeCells, err
+	}
+	for _, cell := range cells {
+		coordinates, err := rangeRefToCoordinates(cell.GetStartAxis() + ":" + cell.GetEndAxis())
+		if err != nil {
+			return mergeCells, err
+		}
+		_ = sortCoordinates(coordinates)
+		x1, y1, x2, y2 := coordinates[0], coordinates[1], coordinates[2], coordinates[3]
+		if x1 > rect[2] || x2 < rect[0] || y1 > rect[3] || y2 < rect[1] {
+			continue
+		}
+		if x1 < rect[0] {
+			x1 = rect[0]
+		}
+		if y1 < rect[1] {
+			y1 = rect[1]
+		}
+		if x2 > rect[2] {
+			x2 = rect[2]
+		}
+		if y2 > rect[3] {
+			y2 = rect[3]
+		}
+		for col := x1; col <= x2; col++ {
+			for row := y1; row <= y2; row++ {
+				mergeCells[[2]int{col, row}] = nil
+			}
+		}
+		mergeCells[[2]int{x1, y1}] = &htmlMergeCell{
+			col: coordinates[0], row: coordinates[1], colSpan: x2 - x1 + 1, rowSpan: y2 - y1 + 1,
+		}
+	}
+	return mergeCells, err
+}
+
+// getHTMLStyle provides a function to translate the fill, font, border and
+// alignment of the cell style into the inline CSS.
+func (f *File) getHTMLStyle(style *Style) string {
+	var css []string
+	if fill := style.Fill; len(fill.Color) > 0 && fill.Color[0] != "" {
+		if fill.Type == "gradient" && len(fill.Color) > 1 {
+			css = append(css, fmt.Sprintf("background:linear-gradient(#%s,#%s)", fill.Color[0], fill.Color[1]))
+		}
+		if fill.Type == "pattern" && fill.Pattern > 0 {
+			css = append(css, "background-color:#"+fill.Color[0])
+		}
+	}
+	if font := style.Font; font != nil {
+		if font.Family != "" {
+			css = append(css, "font-family:'"+strings.ReplaceAll(font.Family, "'", "\\'")+"'")
+		}
+		if font.Size > 0 {
+			css = append(css, "font-size:"+strconv.FormatFloat(font.Size, 'f', -1, 64)+"pt")
+		}
+		if font.Bold {
+			css = append(css, "font-weight:bold")
+		}
+		if font.Italic {
+			css = append(css, "font-style:italic")
+		}
+		var decorations []string
+		if font.Underline != "" && font.Underline != "none" {
+			decorations = append(decorations, "underline")
+		}
+		if font.Strike {
+			decorations = append(decorations, "line-through")
+		}
+		if len(decorations) > 0 {
+			css = append(css, "text-decoration:"+strings.Join(decorations, " "))
+		}
+		if font.Color != "" || font.ColorIndexed != 0 || font.ColorTheme != nil {
+			if color := f.getThemeColor(&xlsxColor{
+				RGB: font.Color, Indexed: font.ColorIndexed, Theme: font.ColorTheme, Tint: font.ColorTint,
+			}); color != "" {
+				css = append(css, "color:#"+color)
+			}
+		}
+	}
+	for _, border := range style.Border {
+		if border.Style < 1 || border.Style >= len(htmlBorderStyles) || strings.HasPrefix(border.Type, "diagonal") {
+			continue
+		}
+		color := border.Color
+		if color == "" {
+			color = "000000"
+		}
+		css = append(css, fmt.Sprintf("border-%s:%s #%s", border.Type, htmlBorderStyles[border.Style], color))
+	}
+	if alignment := style.Alignment; alignment != nil {
+		if textAlign, ok := htmlHorizontalAlignments[alignment.Horizontal]; ok {
+			css = append(css, "text-align:"+textAlign)
+		}
+		if verticalAlign, ok := htmlVerticalAlignments[alignment.Vertical]; ok {
+			css = append(css, "vertical-align:"+verticalAlign)
+		}
+		if alignment.WrapText {
+			css = append(css, "white-space:pre-wrap")
+		}
+	}
+	return strings.Join(css, ";")
+}
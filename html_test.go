@@ -0,0 +1,81 @@
+package excelize
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSheetHTML(t *testing.T) {
+	f := NewFile()
+	// Test get HTML table on the empty worksheet
+	table, err := f.GetSheetHTML("Sheet1", HTMLOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, `<table style="border-collapse:collapse"></table>`, string(table))
+
+	assert.NoError(t, f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Name", "Price", "Date"}))
+	assert.NoError(t, f.SetSheetRow("Sheet1", "A2", &[]interface{}{"<Apple & Pear>", 1.5, 45292}))
+	assert.NoError(t, f.SetCellValue("Sheet1", "A3", "Total"))
+	assert.NoError(t, f.MergeCell("Sheet1", "A3", "B4"))
+	style, err := f.NewStyle(&Style{
+		Font:      &Font{Bold: true, Italic: true, Underline: "single", Strike: true, Family: "Times New Roman", Size: 12, Color: "FF0000"},
+		Fill:      Fill{Type: "pattern", Color: []string{"E0EBF5"}, Pattern: 1},
+		Border:    []Border{{Type: "left", Style: 1}, {Type: "bottom", Color: "0000FF", Style: 6}, {Type: "diagonalUp", Style: 1}},
+		Alignment: &Alignment{Horizontal: "center", Vertical: "center", WrapText: true},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetCellStyle("Sheet1", "A1", "C1", style))
+	numFmt, err := f.NewStyle(&Style{NumFmt: 14, Fill: Fill{Type: "gradient", Color: []string{"FFFFFF", "E0EBF5"}, Shading: 1}})
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetCellStyle("Sheet1", "C2", "C2", numFmt))
+
+	table, err = f.GetSheetHTML("Sheet1", HTMLOptions{ValuesOnly: true})
+	assert.NoError(t, err)
+	assert.Equal(t, `<table><tr><td>Name</td><td>Price</td><td>Date</td></tr>`+
+		`<tr><td>&lt;Apple &amp; Pear&gt;</td><td>1.5</td><td>01-01-24</td></tr>`+
+		`<tr><td colspan="2" rowspan="2">Total</td><td></td></tr><tr><td></td></tr></table>`, string(table))
+
+	table, err = f.GetSheetHTML("Sheet1", HTMLOptions{Range: "C2:A1"})
+	assert.NoError(t, err)
+	headerStyle := `font-family:&#39;Times New Roman&#39;;font-size:12pt;font-weight:bold;font-style:italic;` +
+		`text-decoration:underline line-through;color:#FF0000;border-left:1px solid #000000;border-bottom:3px double #0000FF;` +
+		`text-align:center;vertical-align:middle;white-space:pre-wrap`
+	defaultStyle := `font-family:&#39;Calibri&#39;;font-size:11pt;color:#000000`
+	assert.Equal(t, `<table style="border-collapse:collapse"><tr>`+
+		`<td style="background-color:#E0EBF5;`+headerStyle+`">Name</td>`+
+		`<td style="background-color:#E0EBF5;`+headerStyle+`">Price</td>`+
+		`<td style="background-color:#E0EBF5;`+headerStyle+`">Date</td></tr><tr>`+
+		`<td style="`+defaultStyle+`">&lt;Apple &amp; Pear&gt;</td>`+
+		`<td style="`+defaultStyle+`">1.5</td>`+
+		`<td style="background:linear-gradient(#FFFFFF,#E0EBF5);`+defaultStyle+`">01-01-24</td></tr></table>`, string(table))
+
+	// Test get HTML table with partially visible merged cells
+	table, err = f.GetSheetHTML("Sheet1", HTMLOptions{Range: "B4", ValuesOnly: true})
+	assert.NoError(t, err)
+	assert.Equal(t, `<table><tr><td>Total</td></tr></table>`, string(table))
+	table, err = f.GetSheetHTML("Sheet1", HTMLOptions{Range: "B3:C5", ValuesOnly: true})
+	assert.NoError(t, err)
+	assert.Equal(t, `<table><tr><td rowspan="2">Total</td><td></td></tr><tr><td></td></tr><tr><td></td><td></td></tr></table>`, string(table))
+	assert.NoError(t, os.WriteFile(filepath.Join("test", "TestGetSheetHTML.html"), table, 0o644))
+
+	// Test get HTML table with invalid range reference
+	_, err = f.GetSheetHTML("Sheet1", HTMLOptions{Range: "A:B"})
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), err)
+	// Test get HTML table on not exists worksheet
+	_, err = f.GetSheetHTML("SheetN", HTMLOptions{})
+	assert.EqualError(t, err, "sheet SheetN does not exist")
+	// Test get HTML table with invalid merged cell reference
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	ws.(*xlsxWorksheet).MergeCells = &xlsxMergeCells{Cells: []*xlsxMergeCell{{Ref: "A:B"}}}
+	_, err = f.GetSheetHTML("Sheet1", HTMLOptions{})
+	assert.Error(t, err)
+	// Test get HTML table with invalid style ID
+	ws.(*xlsxWorksheet).MergeCells = nil
+	ws.(*xlsxWorksheet).SheetData.Row[0].C[0].S = 100
+	_, err = f.GetSheetHTML("Sheet1", HTMLOptions{})
+	assert.Equal(t, newInvalidStyleID(100), err)
+	assert.NoError(t, f.Close())
+}
@@ -342,12 +342,12 @@ func coordinatesToRangeRef(coordinates []int, abs ...bool) (string, error) {
 func (f *File) getDefinedNameRefTo(definedNameName, currentSheet string) (refTo string) {
 	var workbookRefTo, worksheetRefTo string
 	for _, definedName := range f.GetDefinedName() {
-		if definedName.Name == definedNameName {
+		if strings.EqualFold(definedName.Name, definedNameName) {
 			// worksheet scope takes precedence over scope workbook when both definedNames exist
 			if definedName.Scope == "Workbook" {
 				workbookRefTo = definedName.RefersTo
 			}
-			if definedName.Scope == currentSheet {
+			if currentSheet != "" && strings.EqualFold(definedName.Scope, currentSheet) {
 				worksheetRefTo = definedName.RefersTo
 			}
 		}
@@ -555,6 +555,24 @@ func (ext *xlsxExt) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
 	return nil
 }
 
+// UnmarshalXML applies the default values of the sheet protection attributes
+// defined in the schema on deserialization, since the attributes with the
+// default values could be omitted in the worksheet.
+func (sp *xlsxSheetProtection) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type sheetProtection xlsxSheetProtection
+	p := sheetProtection{
+		FormatCells: true, FormatColumns: true, FormatRows: true,
+		InsertColumns: true, InsertRows: true, InsertHyperlinks: true,
+		DeleteColumns: true, DeleteRows: true, Sort: true, AutoFilter: true,
+		PivotTables: true,
+	}
+	if err := d.DecodeElement(&p, &start); err != nil {
+		return err
+	}
+	*sp = xlsxSheetProtection(p)
+	return nil
+}
+
 // namespaceStrictToTransitional provides a method to convert Strict and
 // Transitional namespaces.
 func namespaceStrictToTransitional(content []byte) []byte {
@@ -634,6 +652,17 @@ func genSheetPasswd(plaintext string) string {
 	return strings.ToUpper(strconv.FormatInt(password, 16))
 }
 
+// genGUID generates a random GUID in the registry format, for example
+// {2C1C8A8B-A9E3-4D9A-9C32-4F4F9E3B7D10}.
+func genGUID() (string, error) {
+	b, err := randomBytes(16)
+	if err != nil {
+		return "", err
+	}
+	b[6], b[8] = b[6]&0x0f|0x40, b[8]&0x3f|0x80
+	return fmt.Sprintf("{%X-%X-%X-%X-%X}", b[:4], b[4:6], b[6:8], b[8:10], b[10:]), err
+}
+
 // getRootElement extract root element attributes by given XML decoder.
 func getRootElement(d *xml.Decoder) []xml.Attr {
 	tokenIdx := 0
@@ -34,21 +34,10 @@ func (mc *xlsxMergeCell) Rect() ([]int, error) {
 //	err := f.MergeCell("Sheet1", "D3", "E9")
 //
 // If you create a merged cell that overlaps with another existing merged cell,
-// those merged cells that already exist will be removed. The cell references
-// tuple after merging in the following range will be: A1(x3,y1) D1(x2,y1)
-// A8(x3,y4) D8(x2,y4)
-//
-//	             B1(x1,y1)      D1(x2,y1)
-//	           +------------------------+
-//	           |                        |
-//	A4(x3,y3)  |    C4(x4,y3)           |
-//	+------------------------+          |
-//	|          |             |          |
-//	|          |B5(x1,y2)    | D5(x2,y2)|
-//	|          +------------------------+
-//	|                        |
-//	|A8(x3,y4)      C8(x4,y4)|
-//	+------------------------+
+// an error which contains the conflicting range reference will be returned,
+// please unmerge the existing merged cell by the UnmergeCell function before
+// merging. Merging the same range reference of an existing merged cell again
+// has no effect.
 func (f *File) MergeCell(sheet, topLeftCell, bottomRightCell string) error {
 	rect, err := rangeRefToCoordinates(topLeftCell + ":" + bottomRightCell)
 	if err != nil {
@@ -66,6 +55,25 @@ func (f *File) MergeCell(sheet, topLeftCell, bottomRightCell string) error {
 	}
 	ws.mu.Lock()
 	defer ws.mu.Unlock()
+	ref := topLeftCell + ":" + bottomRightCell
+	if ws.MergeCells != nil {
+		for _, mergeCell := range ws.MergeCells.Cells {
+			if mergeCell == nil {
+				continue
+			}
+			rect2, err := mergeCell.Rect()
+			if err != nil {
+				return err
+			}
+			_ = sortCoordinates(rect2)
+			if isOverlap(rect, rect2) {
+				if rect2[0] == rect[0] && rect2[1] == rect[1] && rect2[2] == rect[2] && rect2[3] == rect[3] {
+					return nil
+				}
+				return newMergeCellOverlapError(ref, mergeCell.Ref)
+			}
+		}
+	}
 	for col := rect[0]; col <= rect[2]; col++ {
 		for row := rect[1]; row <= rect[3]; row++ {
 			if col == rect[0] && row == rect[1] {
@@ -77,7 +85,6 @@ func (f *File) MergeCell(sheet, topLeftCell, bottomRightCell string) error {
 			_ = f.removeFormula(c, ws, sheet)
 		}
 	}
-	ref := topLeftCell + ":" + bottomRightCell
 	if ws.MergeCells != nil {
 		ws.MergeCells.Cells = append(ws.MergeCells.Cells, &xlsxMergeCell{Ref: ref, rect: rect})
 	} else {
@@ -162,6 +169,46 @@ func (f *File) GetMergeCells(sheet string) ([]MergeCell, error) {
 	return mergeCells, err
 }
 
+// GetMergeCellValue provides a function to get the value of the merged cell
+// by given worksheet name and any cell reference inside the merged range. The
+// value of the upper-left cell of the merged range will be returned, and the
+// value of the given cell will be returned if the cell isn't in any merged
+// range. For example, get the value of the merged range which contains the
+// cell B2 on Sheet1:
+//
+//	value, err := f.GetMergeCellValue("Sheet1", "B2")
+func (f *File) GetMergeCellValue(sheet, cell string, opts ...Options) (string, error) {
+	col, row, err := CellNameToCoordinates(cell)
+	if err != nil {
+		return "", err
+	}
+	ws, err := f.workSheetReader(sheet)
+	if err != nil {
+		return "", err
+	}
+	if ws.MergeCells != nil {
+		if err = f.mergeOverlapCells(ws); err != nil {
+			return "", err
+		}
+		for _, mergeCell := range ws.MergeCells.Cells {
+			if mergeCell == nil {
+				continue
+			}
+			ref, err := mergeCell.Rect()
+			if err != nil {
+				return "", err
+			}
+			rect := append([]int{}, ref...)
+			_ = sortCoordinates(rect)
+			if rect[0] <= col && col <= rect[2] && rect[1] <= row && row <= rect[3] {
+				cell, _ = CoordinatesToCellName(rect[0], rect[1])
+				break
+			}
+		}
+	}
+	return f.GetCellValue(sheet, cell, opts...)
+}
+
 // overlapRange calculate overlap range of merged cells, and returns max
 // column and rows of the range.
 func overlapRange(ws *xlsxWorksheet) (row, col int, err error) {
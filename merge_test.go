@@ -15,24 +15,27 @@ func TestMergeCell(t *testing.T) {
 	assert.EqualError(t, f.MergeCell("Sheet1", "A", "B"), newCellNameToCoordinatesError("A", newInvalidCellNameError("A")).Error())
 	for _, cells := range [][]string{
 		{"D9", "D9"},
-		{"D9", "E9"},
+		{"E9", "F9"},
 		{"H14", "G13"},
-		{"C9", "D8"},
-		{"F11", "G13"},
-		{"H7", "B15"},
+		{"C9", "B8"},
 		{"D11", "F13"},
 		{"G10", "K12"},
 	} {
 		assert.NoError(t, f.MergeCell("Sheet1", cells[0], cells[1]))
 	}
+	// Test merge cells which overlaps with existing merged cells
+	assert.Equal(t, newMergeCellOverlapError("B7:H15", "D9:D9"), f.MergeCell("Sheet1", "H7", "B15"))
+	assert.Equal(t, newMergeCellOverlapError("F11:G13", "G13:H14"), f.MergeCell("Sheet1", "F11", "G13"))
+	// Test merge cells with the same range of an existing merged cell
+	assert.NoError(t, f.MergeCell("Sheet1", "K12", "G10"))
 	assert.NoError(t, f.SetCellValue("Sheet1", "G11", "set value in merged cell"))
 	assert.NoError(t, f.SetCellInt("Sheet1", "H11", 100))
-	assert.NoError(t, f.SetCellValue("Sheet1", "I11", 0.5))
-	assert.NoError(t, f.SetCellHyperLink("Sheet1", "J11", "https://github.com/xuri/excelize", "External"))
-	assert.NoError(t, f.SetCellFormula("Sheet1", "G12", "SUM(Sheet1!B19,Sheet1!C19)"))
 	value, err := f.GetCellValue("Sheet1", "H11")
 	assert.Equal(t, "100", value)
 	assert.NoError(t, err)
+	assert.NoError(t, f.SetCellValue("Sheet1", "I11", 0.5))
+	assert.NoError(t, f.SetCellHyperLink("Sheet1", "J11", "https://github.com/xuri/excelize", "External"))
+	assert.NoError(t, f.SetCellFormula("Sheet1", "G12", "SUM(Sheet1!B19,Sheet1!C19)"))
 	// Merged cell ref is single coordinate
 	value, err = f.GetCellValue("Sheet2", "A6")
 	assert.Equal(t, "", value)
@@ -47,25 +50,28 @@ func TestMergeCell(t *testing.T) {
 	for _, cells := range [][]string{
 		{"D11", "F13"},
 		{"G10", "K12"},
-		{"B1", "D5"}, // B1:D5
-		{"E1", "F5"}, // E1:F5
+		{"B1", "D5"},
+		{"E1", "F5"},
 		{"H2", "I5"},
-		{"I4", "J6"}, // H2:J6
 		{"M2", "N5"},
-		{"L4", "M6"}, // L2:N6
 		{"P4", "Q7"},
-		{"O2", "P5"}, // O2:Q7
 		{"A9", "B12"},
-		{"B7", "C9"}, // A7:C12
 		{"E9", "F10"},
-		{"D8", "G12"},
-		{"I8", "I12"},
-		{"I10", "K10"},
 		{"M8", "Q13"},
-		{"N10", "O11"},
 	} {
 		assert.NoError(t, f.MergeCell("Sheet3", cells[0], cells[1]))
 	}
+	for _, cells := range [][]string{
+		{"I4", "J6", "I4:J6", "H2:I5"},
+		{"L4", "M6", "L4:M6", "M2:N5"},
+		{"O2", "P5", "O2:P5", "P4:Q7"},
+		{"B7", "C9", "B7:C9", "A9:B12"},
+		{"D8", "G12", "D8:G12", "D11:F13"},
+		{"I10", "K10", "I10:K10", "G10:K12"},
+		{"N10", "O11", "N10:O11", "M8:Q13"},
+	} {
+		assert.Equal(t, newMergeCellOverlapError(cells[2], cells[3]), f.MergeCell("Sheet3", cells[0], cells[1]))
+	}
 
 	// Test merge cells on not exists worksheet
 	assert.EqualError(t, f.MergeCell("SheetN", "N10", "O11"), "sheet SheetN does not exist")
@@ -92,7 +98,14 @@ func TestMergeCell(t *testing.T) {
 func TestMergeCellOverlap(t *testing.T) {
 	f := NewFile()
 	assert.NoError(t, f.MergeCell("Sheet1", "A1", "C2"))
-	assert.NoError(t, f.MergeCell("Sheet1", "B2", "D3"))
+	assert.Equal(t, newMergeCellOverlapError("B2:D3", "A1:C2"), f.MergeCell("Sheet1", "B2", "D3"))
+	// Test merge cells with invalid existing merged cell range reference
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	ws.(*xlsxWorksheet).MergeCells.Cells = append(ws.(*xlsxWorksheet).MergeCells.Cells, &xlsxMergeCell{Ref: "A:B"})
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), f.MergeCell("Sheet1", "E1", "F2"))
+	// Test the overlapped merged cells in the worksheet will be combined
+	ws.(*xlsxWorksheet).MergeCells.Cells[1] = &xlsxMergeCell{Ref: "B2:D3"}
 	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestMergeCellOverlap.xlsx")))
 
 	f, err := OpenFile(filepath.Join("test", "TestMergeCellOverlap.xlsx"))
@@ -157,6 +170,30 @@ func TestGetMergeCells(t *testing.T) {
 	assert.NoError(t, f.Close())
 }
 
+func TestGetMergeCellValue(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Header", nil, "Value"}))
+	assert.NoError(t, f.MergeCell("Sheet1", "B3", "A1"))
+	for cell, expected := range map[string]string{"A1": "Header", "B1": "Header", "A3": "Header", "B3": "Header", "C1": "Value", "C3": ""} {
+		value, err := f.GetMergeCellValue("Sheet1", cell)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, value, cell)
+	}
+	// Test get merged cell value with invalid cell reference
+	_, err := f.GetMergeCellValue("Sheet1", "A")
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), err)
+	// Test get merged cell value with invalid sheet name
+	_, err = f.GetMergeCellValue("Sheet:1", "A1")
+	assert.Equal(t, ErrSheetNameInvalid, err)
+	// Test get merged cell value with invalid merged cell reference
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	ws.(*xlsxWorksheet).MergeCells = &xlsxMergeCells{Cells: []*xlsxMergeCell{nil, {Ref: "A1:B"}}}
+	_, err = f.GetMergeCellValue("Sheet1", "A1")
+	assert.Equal(t, newCellNameToCoordinatesError("B", newInvalidCellNameError("B")), err)
+	assert.NoError(t, f.Close())
+}
+
 func TestUnmergeCell(t *testing.T) {
 	f, err := OpenFile(filepath.Join("test", "MergeCell.xlsx"))
 	if !assert.NoError(t, err) {
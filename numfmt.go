@@ -7045,7 +7045,7 @@ func (nf *numberFormat) hoursNext(i int) int {
 	for idx := i + 1; idx < len(tokens); idx++ {
 		if tokens[idx].TType == nfp.TokenTypeDateTimes {
 			if strings.Contains(strings.ToUpper(tokens[idx].TValue), "H") {
-				t := timeFromExcelTime(nf.number, false)
+				t := timeFromExcelTime(nf.number, nf.date1904)
 				return t.Hour()
 			}
 		}
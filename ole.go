@@ -0,0 +1,380 @@
+// Copyright 2016 - 2024 The excelize Authors. All rights reserved. Use of
+// this source code is governed by a BSD-style license that can be found in
+// the LICENSE file.
+//
+// Package excelize providing a set of functions that allow you to write to and
+// read from XLAM / XLSM / XLSX / XLTM / XLTX files. Supports reading and
+// writing spreadsheet documents generated by Microsoft Excel™ 2007 and later.
+// Supports complex components by high compatibility, and provided streaming
+// API for generating or reading data from a worksheet with huge amounts of
+// data. This library needs Go version 1.18 or later.
+
+package excelize
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"image"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/richardlehane/mscfb"
+)
+
+// AddOLEObject provides the method to embed a file as an OLE package object
+// with an icon in a worksheet by given worksheet name, cell reference and OLE
+// object settings. The embedded file will be stored in the xl/embeddings
+// folder of the workbook, and could be opened by double-clicking the icon in
+// the spreadsheet application. For example, embed the PDF file Report.pdf at
+// the cell B2 of Sheet1:
+//
+//	file, err := os.ReadFile("Report.pdf")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	err = f.AddOLEObject("Sheet1", "B2", excelize.OLEObject{
+//	    FileName: "Report.pdf",
+//	    Data:     file,
+//	})
+//
+// FileName specifies the file name of the embedded file, which is required
+// and will be shown as the label of the OLE object.
+//
+// Data specifies the raw content of the embedded file, which is required.
+//
+// Icon specifies the raw content of the icon image of the OLE object, a
+// default document icon will be used if it is empty. The image decoder of the
+// icon image should be registered if the Width or Height isn't specified.
+//
+// IconExtension specifies the extension name of the icon image, such as
+// ".png", which is required if the Icon is specified.
+//
+// Width and Height specify the size of the icon in pixels, the size of the
+// icon image will be used by default.
+func (f *File) AddOLEObject(sheet, cell string, obj OLEObject) error {
+	if obj.FileName == "" || len(obj.Data) == 0 {
+		return ErrParameterRequired
+	}
+	col, row, err := CellNameToCoordinates(cell)
+	if err != nil {
+		return err
+	}
+	icon, ext := obj.Icon, ".png"
+	if len(icon) == 0 {
+		icon = defaultOLEObjectIcon
+		if obj.Width == 0 {
+			obj.Width = 32
+		}
+		if obj.Height == 0 {
+			obj.Height = 40
+		}
+	} else {
+		var ok bool
+		if ext, ok = supportedImageTypes[strings.ToLower(obj.IconExtension)]; !ok {
+			return ErrImgExt
+		}
+		if obj.Width == 0 || obj.Height == 0 {
+			img, _, err := image.DecodeConfig(bytes.NewReader(icon))
+			if err != nil {
+				return err
+			}
+			if obj.Width == 0 {
+				obj.Width = uint(img.Width)
+			}
+			if obj.Height == 0 {
+				obj.Height = uint(img.Height)
+			}
+		}
+	}
+	ws, err := f.workSheetReader(sheet)
+	if err != nil {
+		return err
+	}
+	// Add the embedded OLE package object and the relationships.
+	oleID := f.countOLEObjects() + 1
+	f.Pkg.Store("xl/embeddings/oleObject"+strconv.Itoa(oleID)+".bin", newOLEPackage(obj.FileName, obj.Data))
+	sheetXMLPath, _ := f.getSheetXMLPath(sheet)
+	sheetRels := "xl/worksheets/_rels/" + strings.TrimPrefix(sheetXMLPath, "xl/worksheets/") + ".rels"
+	oleRID := f.addRels(sheetRels, SourceRelationshipOLEObject, "../embeddings/oleObject"+strconv.Itoa(oleID)+".bin", "")
+	f.addSheetNameSpace(sheet, SourceRelationship)
+	// Add the icon of the OLE object as a VML shape.
+	vmlID := f.countVMLDrawing() + 1
+	sheetRelationshipsDrawingVML := "../drawings/vmlDrawing" + strconv.Itoa(vmlID) + ".vml"
+	if ws.LegacyDrawing != nil {
+		sheetRelationshipsDrawingVML = f.getSheetRelationshipsTargetByID(sheet, ws.LegacyDrawing.RID)
+		vmlID, _ = strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(sheetRelationshipsDrawingVML, "../drawings/vmlDrawing"), ".vml"))
+	} else {
+		rID := f.addRels(sheetRels, SourceRelationshipDrawingVML, sheetRelationshipsDrawingVML, "")
+		f.addSheetLegacyDrawing(sheet, rID)
+	}
+	drawingVML := strings.ReplaceAll(sheetRelationshipsDrawingVML, "..", "xl")
+	vml, err := f.prepareVMLDrawing(f.VMLDrawing[drawingVML], vmlID, 75, drawingVML)
+	if err != nil {
+		return err
+	}
+	vmlRels := "xl/drawings/_rels/" + filepath.Base(drawingVML) + ".rels"
+	iconRID := f.addRels(vmlRels, SourceRelationshipImage, ".."+strings.TrimPrefix(f.addMedia(icon, ext), "xl"), "")
+	shapeID := f.getVMLShapeID(vml, vmlID)
+	colStart, rowStart, colEnd, rowEnd, x2, y2 := f.positionObjectPixels(sheet, col, row, 0, 0, int(obj.Width), int(obj.Height))
+	vml.Shape = append(vml.Shape, xlsxShape{
+		ID:   fmt.Sprintf("_x0000_s%d", shapeID),
+		Type: "#" + vml.addPictureShapeType(),
+		Style: fmt.Sprintf("position:absolute;margin-left:0;margin-top:0;width:%gpt;height:%gpt;z-index:1",
+			float64(obj.Width)*0.75, float64(obj.Height)*0.75),
+		Filled: "t", FillColor: "window [65]", Stroked: "t", StrokeColor: "windowText [64]", InsetMode: "auto",
+		Val: fmt.Sprintf(`<v:fill color2="window [65]"></v:fill><v:imagedata o:relid="rId%d" o:title=""></v:imagedata>`+
+			`<x:ClientData ObjectType="Pict"><x:SizeWithCells></x:SizeWithCells><x:Anchor>%d, 0, %d, 0, %d, %d, %d, %d</x:Anchor>`+
+			`<x:CF>Pict</x:CF><x:AutoPict></x:AutoPict></x:ClientData>`, iconRID, colStart, rowStart, colEnd, x2, rowEnd, y2),
+	})
+	f.VMLDrawing[drawingVML] = vml
+	// Add the OLE object element in the worksheet.
+	oleObject, _ := xml.Marshal(xlsxOleObject{
+		ProgID: "Package", DvAspect: "DVASPECT_ICON", ShapeID: shapeID, RID: "rId" + strconv.Itoa(oleRID),
+	})
+	if ws.OleObjects == nil {
+		ws.OleObjects = &xlsxInnerXML{}
+	}
+	ws.OleObjects.Content += string(oleObject)
+	return f.addContentTypePart(oleID, "oleObject")
+}
+
+// GetOLEObjects provides the method to get all embedded OLE objects in a
+// worksheet by given worksheet name. The FileName and Data of the OLE package
+// objects will be the file name and raw content of the embedded file, and the
+// Data of the other OLE objects will be the raw content of the embedded part.
+// For example, save the embedded files in Sheet1:
+//
+//	objects, err := f.GetOLEObjects("Sheet1")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	for _, obj := range objects {
+//	    if err := os.WriteFile(obj.FileName, obj.Data, 0644); err != nil {
+//	        fmt.Println(err)
+//	    }
+//	}
+func (f *File) GetOLEObjects(sheet string) ([]OLEObject, error) {
+	var objects []OLEObject
+	ws, err := f.workSheetReader(sheet)
+	if err != nil {
+		return objects, err
+	}
+	var content string
+	for _, innerXML := range []*xlsxInnerXML{ws.OleObjects, ws.DecodeAlternateContent} {
+		if innerXML != nil {
+			content += innerXML.Content
+		}
+	}
+	if ws.AlternateContent != nil {
+		content += ws.AlternateContent.Content
+	}
+	if content == "" {
+		return objects, err
+	}
+	dec := f.xmlNewDecoder(strings.NewReader("<worksheet" + templateNamespaceIDMap + content + "</worksheet>"))
+	seen := map[string]struct{}{}
+	for {
+		token, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return objects, err
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "oleObject" {
+			continue
+		}
+		var decodeObject decodeOleObject
+		if err = dec.DecodeElement(&decodeObject, &start); err != nil {
+			return objects, err
+		}
+		if _, ok = seen[decodeObject.RID]; ok || decodeObject.RID == "" {
+			continue
+		}
+		seen[decodeObject.RID] = struct{}{}
+		target := f.getSheetRelationshipsTargetByID(sheet, decodeObject.RID)
+		data, ok := f.Pkg.Load(strings.ReplaceAll(target, "..", "xl"))
+		if !ok {
+			continue
+		}
+		obj := OLEObject{ProgID: decodeObject.ProgID, FileName: filepath.Base(target), Data: data.([]byte)}
+		if fileName, file, ok := extractOLEPackage(obj.Data); ok {
+			obj.FileName, obj.Data = fileName, file
+		}
+		if obj.Cell, err = f.getOLEObjectCell(ws, sheet, &decodeObject); err != nil {
+			return objects, err
+		}
+		objects = append(objects, obj)
+	}
+	return objects, err
+}
+
+// getOLEObjectCell provides a function to get the cell reference of the OLE
+// object by given worksheet and OLE object settings, which will be read from
+// the anchor of the OLE object or the anchor of the VML shape.
+func (f *File) getOLEObjectCell(ws *xlsxWorksheet, sheet string, obj *decodeOleObject) (string, error) {
+	if obj.ObjectPr != nil && obj.ObjectPr.Anchor != nil {
+		return CoordinatesToCellName(obj.ObjectPr.Anchor.From.Col+1, obj.ObjectPr.Anchor.From.Row+1)
+	}
+	if ws.LegacyDrawing == nil {
+		return "", nil
+	}
+	drawingVML := strings.ReplaceAll(f.getSheetRelationshipsTargetByID(sheet, ws.LegacyDrawing.RID), "..", "xl")
+	vml, err := f.prepareVMLDrawing(f.VMLDrawing[drawingVML], 0, 75, drawingVML)
+	if err != nil {
+		return "", err
+	}
+	for _, sp := range vml.Shape {
+		if sp.ID != fmt.Sprintf("_x0000_s%d", obj.ShapeID) {
+			continue
+		}
+		var shapeVal decodeShapeVal
+		if err = xml.Unmarshal([]byte(fmt.Sprintf("<shape>%s</shape>", sp.Val)), &shapeVal); err != nil {
+			return "", err
+		}
+		col, row, err := extractAnchorCell(shapeVal.ClientData.Anchor)
+		if err != nil {
+			return "", err
+		}
+		return CoordinatesToCellName(col+1, row+1)
+	}
+	return "", err
+}
+
+// countOLEObjects provides a function to get the largest embedded OLE object
+// file index storage in the folder xl/embeddings.
+func (f *File) countOLEObjects() int {
+	count := 0
+	f.Pkg.Range(func(k, v interface{}) bool {
+		if strings.Contains(k.(string), "xl/embeddings/oleObject") {
+			if ID, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(k.(string), "xl/embeddings/oleObject"), ".bin")); err == nil && ID > count {
+				count = ID
+			}
+		}
+		return true
+	})
+	return count
+}
+
+// getVMLShapeID provides a function to get an unused shape ID in the VML
+// drawing by given VML drawing and data ID.
+func (f *File) getVMLShapeID(vml *vmlDrawing, dataID int) int {
+	shapes := map[string]struct{}{}
+	for _, sp := range vml.Shape {
+		shapes[sp.ID] = struct{}{}
+	}
+	shapeID := dataID*1024 + 1
+	for {
+		if _, ok := shapes[fmt.Sprintf("_x0000_s%d", shapeID)]; !ok {
+			return shapeID
+		}
+		shapeID++
+	}
+}
+
+// newOLEPackage provides a function to create the compound file of the OLE
+// package object by given file name and raw content of the embedded file.
+func newOLEPackage(fileName string, data []byte) []byte {
+	var native bytes.Buffer
+	writeUint16 := func(v int) { _ = binary.Write(&native, binary.LittleEndian, uint16(v)) }
+	writeUint32 := func(v int) { _ = binary.Write(&native, binary.LittleEndian, uint32(v)) }
+	writeUint16(2)
+	native.WriteString(fileName + "\x00")
+	native.WriteString(fileName + "\x00")
+	writeUint16(0)
+	writeUint16(3)
+	writeUint32(len(fileName) + 1)
+	native.WriteString(fileName + "\x00")
+	writeUint32(len(data))
+	native.Write(data)
+	stream := make([]byte, 4, 4+native.Len())
+	binary.LittleEndian.PutUint32(stream, uint32(native.Len()))
+	stream = append(stream, native.Bytes()...)
+	// The CompObj stream contains the class identifier, user type and
+	// clipboard format of the OLE package object.
+	compObj := append([]byte{0x01, 0x00, 0xFE, 0xFF, 0x03, 0x0A, 0x00, 0x00, 0xFF, 0xFF, 0xFF, 0xFF}, oleObjectPackageCLSID...)
+	compObj = append(compObj, 0x0C, 0x00, 0x00, 0x00)
+	compObj = append(compObj, []byte("OLE Package\x00")...)
+	compObj = append(compObj, 0x00, 0x00, 0x00, 0x00, 0x08, 0x00, 0x00, 0x00)
+	compObj = append(compObj, []byte("Package\x00")...)
+	compObj = append(compObj, 0xF4, 0x39, 0xB2, 0x71, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00)
+	compoundFile := &cfb{
+		paths:   []string{"Root Entry/"},
+		sectors: []sector{{name: "Root Entry", typeID: 5, clsID: oleObjectPackageCLSID}},
+	}
+	compoundFile.put("\x01CompObj", compObj)
+	compoundFile.put("\x01Ole10Native", stream)
+	return compoundFile.write()
+}
+
+// extractOLEPackage provides a function to extract the file name and raw
+// content of the embedded file from the compound file of the OLE package
+// object, the last returned value will be false if the given content isn't an
+// OLE package object.
+func extractOLEPackage(content []byte) (string, []byte, bool) {
+	doc, err := mscfb.New(bytes.NewReader(content))
+	if err != nil {
+		return "", nil, false
+	}
+	for entry, err := doc.Next(); err == nil; entry, err = doc.Next() {
+		if !strings.HasSuffix(entry.Name, "Ole10Native") {
+			continue
+		}
+		buf := make([]byte, entry.Size)
+		if _, err = io.ReadFull(doc, buf); err != nil {
+			return "", nil, false
+		}
+		return parseOLENativeStream(buf)
+	}
+	return "", nil, false
+}
+
+// parseOLENativeStream provides a function to parse the file name and raw
+// content of the embedded file from the Ole10Native stream.
+func parseOLENativeStream(buf []byte) (string, []byte, bool) {
+	pos := 6
+	readString := func() (string, bool) {
+		idx := bytes.IndexByte(buf[pos:], 0)
+		if idx == -1 {
+			return "", false
+		}
+		s := string(buf[pos : pos+idx])
+		pos += idx + 1
+		return s, true
+	}
+	readUint32 := func() (int, bool) {
+		if pos+4 > len(buf) {
+			return 0, false
+		}
+		v := int(binary.LittleEndian.Uint32(buf[pos : pos+4]))
+		pos += 4
+		return v, true
+	}
+	if len(buf) < pos {
+		return "", nil, false
+	}
+	fileName, ok := readString()
+	if !ok {
+		return "", nil, false
+	}
+	if _, ok = readString(); !ok {
+		return "", nil, false
+	}
+	pos += 4
+	size, ok := readUint32()
+	if !ok || pos+size > len(buf) {
+		return "", nil, false
+	}
+	pos += size
+	if size, ok = readUint32(); !ok || pos+size > len(buf) {
+		return "", nil, false
+	}
+	return fileName, buf[pos : pos+size], true
+}
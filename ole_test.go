@@ -0,0 +1,124 @@
+// Copyright 2016 - 2024 The excelize Authors. All rights reserved. Use of
+// this source code is governed by a BSD-style license that can be found in
+// the LICENSE file.
+//
+// Package excelize providing a set of functions that allow you to write to and
+// read from XLAM / XLSM / XLSX / XLTM / XLTX files. Supports reading and
+// writing spreadsheet documents generated by Microsoft Excel™ 2007 and later.
+// Supports complex components by high compatibility, and provided streaming
+// API for generating or reading data from a worksheet with huge amounts of
+// data. This library needs Go version 1.18 or later.
+
+package excelize
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddOLEObject(t *testing.T) {
+	f := NewFile()
+	data := []byte("Hello, Excelize!")
+	assert.NoError(t, f.AddComment("Sheet1", Comment{Cell: "A1", Author: "Excelize", Text: "This is a comment."}))
+	assert.NoError(t, f.AddOLEObject("Sheet1", "B2", OLEObject{FileName: "hello.txt", Data: data}))
+	icon, err := os.ReadFile(filepath.Join("test", "images", "excel.png"))
+	assert.NoError(t, err)
+	assert.NoError(t, f.AddOLEObject("Sheet1", "D5", OLEObject{FileName: "Book1.xlsx", Data: []byte{0x50, 0x4B}, Icon: icon, IconExtension: ".png"}))
+	_, err = f.NewSheet("Sheet2")
+	assert.NoError(t, err)
+	assert.NoError(t, f.AddOLEObject("Sheet2", "C3", OLEObject{FileName: "empty.bin", Data: []byte{0}}))
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestAddOLEObject.xlsx")))
+	assert.NoError(t, f.Close())
+
+	f, err = OpenFile(filepath.Join("test", "TestAddOLEObject.xlsx"))
+	assert.NoError(t, err)
+	objects, err := f.GetOLEObjects("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, []OLEObject{
+		{Cell: "B2", ProgID: "Package", FileName: "hello.txt", Data: data},
+		{Cell: "D5", ProgID: "Package", FileName: "Book1.xlsx", Data: []byte{0x50, 0x4B}},
+	}, objects)
+	comments, err := f.GetComments("Sheet1")
+	assert.NoError(t, err)
+	assert.Len(t, comments, 1)
+	// Test add OLE object after reopen the workbook
+	assert.NoError(t, f.AddOLEObject("Sheet1", "F2", OLEObject{FileName: "world.txt", Data: data}))
+	objects, err = f.GetOLEObjects("Sheet1")
+	assert.NoError(t, err)
+	assert.Len(t, objects, 3)
+	assert.Equal(t, "F2", objects[2].Cell)
+	// Test the icons of the OLE objects use the picture frame shape type
+	vml := f.VMLDrawing["xl/drawings/vmlDrawing1.vml"]
+	assert.Len(t, vml.ShapeType, 2)
+	assert.Equal(t, []int{202, 75}, []int{vml.ShapeType[0].Spt, vml.ShapeType[1].Spt})
+	assert.Equal(t, "#_x0000_t75", vml.Shape[len(vml.Shape)-1].Type)
+	// Test get OLE objects with not embedded package
+	f.Pkg.Store("xl/embeddings/oleObject1.bin", []byte("raw"))
+	objects, err = f.GetOLEObjects("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, OLEObject{Cell: "B2", ProgID: "Package", FileName: "oleObject1.bin", Data: []byte("raw")}, objects[0])
+	assert.NoError(t, f.Close())
+
+	f = NewFile()
+	// Test add OLE object with the largest existing OLE object file index
+	f.Pkg.Store("xl/embeddings/oleObject3.bin", []byte("raw"))
+	assert.NoError(t, f.AddOLEObject("Sheet1", "A1", OLEObject{FileName: "hello.txt", Data: data}))
+	_, ok := f.Pkg.Load("xl/embeddings/oleObject4.bin")
+	assert.True(t, ok)
+	vml = f.VMLDrawing["xl/drawings/vmlDrawing1.vml"]
+	assert.Len(t, vml.ShapeType, 1)
+	assert.Equal(t, "_x0000_t75", vml.ShapeType[0].ID)
+	assert.NoError(t, f.Close())
+
+	f = NewFile()
+	// Test add OLE object without file name or data
+	assert.Equal(t, ErrParameterRequired, f.AddOLEObject("Sheet1", "A1", OLEObject{Data: data}))
+	assert.Equal(t, ErrParameterRequired, f.AddOLEObject("Sheet1", "A1", OLEObject{FileName: "hello.txt"}))
+	// Test add OLE object with invalid cell reference
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), f.AddOLEObject("Sheet1", "A", OLEObject{FileName: "hello.txt", Data: data}))
+	// Test add OLE object with unsupported icon extension
+	assert.Equal(t, ErrImgExt, f.AddOLEObject("Sheet1", "A1", OLEObject{FileName: "hello.txt", Data: data, Icon: icon, IconExtension: ".txt"}))
+	// Test add OLE object with invalid icon image
+	assert.EqualError(t, f.AddOLEObject("Sheet1", "A1", OLEObject{FileName: "hello.txt", Data: data, Icon: []byte("icon"), IconExtension: ".png"}), "image: unknown format")
+	// Test add OLE object on not exists worksheet
+	assert.EqualError(t, f.AddOLEObject("SheetN", "A1", OLEObject{FileName: "hello.txt", Data: data}), "sheet SheetN does not exist")
+	// Test get OLE objects on not exists worksheet
+	_, err = f.GetOLEObjects("SheetN")
+	assert.EqualError(t, err, "sheet SheetN does not exist")
+	// Test get OLE objects without OLE objects
+	objects, err = f.GetOLEObjects("Sheet1")
+	assert.NoError(t, err)
+	assert.Empty(t, objects)
+	// Test get OLE objects with the anchor of the OLE object
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	ws.(*xlsxWorksheet).OleObjects = &xlsxInnerXML{Content: `<oleObject progId="Package" shapeId="1025" r:id="rId1"><objectPr><anchor><from><xdr:col>2</xdr:col><xdr:row>3</xdr:row></from></anchor></objectPr></oleObject>`}
+	f.Relationships.Store("xl/worksheets/_rels/sheet1.xml.rels", &xlsxRelationships{Relationships: []xlsxRelationship{{ID: "rId1", Type: SourceRelationshipOLEObject, Target: "../embeddings/oleObject1.bin"}}})
+	f.Pkg.Store("xl/embeddings/oleObject1.bin", newOLEPackage("a.txt", []byte("a")))
+	objects, err = f.GetOLEObjects("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, []OLEObject{{Cell: "C4", ProgID: "Package", FileName: "a.txt", Data: []byte("a")}}, objects)
+	// Test get OLE objects with invalid worksheet XML
+	ws.(*xlsxWorksheet).OleObjects = &xlsxInnerXML{Content: `<oleObject shapeId="x" r:id="rId1"/>`}
+	_, err = f.GetOLEObjects("Sheet1")
+	assert.Error(t, err)
+	assert.NoError(t, f.Close())
+}
+
+func TestParseOLENativeStream(t *testing.T) {
+	for _, buf := range [][]byte{
+		{0x00},
+		{0x00, 0x00, 0x00, 0x00, 0x02, 0x00, 'a'},
+		{0x00, 0x00, 0x00, 0x00, 0x02, 0x00, 'a', 0x00, 'a'},
+		{0x00, 0x00, 0x00, 0x00, 0x02, 0x00, 'a', 0x00, 'a', 0x00, 0x00, 0x00, 0x03, 0x00, 0xFF},
+		{0x00, 0x00, 0x00, 0x00, 0x02, 0x00, 'a', 0x00, 'a', 0x00, 0x00, 0x00, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0xFF},
+	} {
+		_, _, ok := parseOLENativeStream(buf)
+		assert.False(t, ok)
+	}
+	_, _, ok := extractOLEPackage([]byte("raw"))
+	assert.False(t, ok)
+}
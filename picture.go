@@ -14,11 +14,13 @@ package excelize
 import (
 	"bytes"
 	"encoding/xml"
+	"fmt"
 	"image"
 	"io"
 	"os"
 	"path"
 	"path/filepath"
+	"reflect"
 	"strconv"
 	"strings"
 )
@@ -128,6 +130,9 @@ func parseGraphicOptions(opts *GraphicOptions) *GraphicOptions {
 // The optional parameter "AltText" is used to add alternative text to a graph
 // object.
 //
+// The optional parameter "AltTextTitle" is used to add the title of the
+// alternative text to a graph object.
+//
 // The optional parameter "PrintObject" indicates whether the graph object is
 // printed when the worksheet is printed, the default value of that is 'true'.
 //
@@ -187,9 +192,10 @@ func (f *File) AddPicture(sheet, cell, name string, opts *GraphicOptions) error
 // picture format set (such as offset, scale, aspect ratio setting and print
 // settings), file base name, extension name and file bytes, supported image
 // types: EMF, EMZ, GIF, JPEG, JPG, PNG, SVG, TIF, TIFF, WMF, and WMZ. Note that
-// this function only supports adding pictures placed over the cells currently,
-// and doesn't support adding pictures placed in cells or creating the Kingsoft
-// WPS Office embedded image cells. For example:
+// this function only supports adding pictures placed over the cells, please
+// use the AddPictureInCell function to add pictures placed in cells. This
+// function doesn't support creating the Kingsoft WPS Office embedded image
+// cells. For example:
 //
 //	package main
 //
@@ -226,8 +232,6 @@ func (f *File) AddPicture(sheet, cell, name string, opts *GraphicOptions) error
 //	    }
 //	}
 func (f *File) AddPictureFromBytes(sheet, cell string, pic *Picture) error {
-	var drawingHyperlinkRID int
-	var hyperlinkType string
 	ext, ok := supportedImageTypes[strings.ToLower(pic.Extension)]
 	if !ok {
 		return ErrImgExt
@@ -265,15 +269,10 @@ func (f *File) AddPictureFromBytes(sheet, cell string, pic *Picture) error {
 		}
 	}
 	if drawingRID == 0 {
-		drawingRID = f.addRels(drawingRels, SourceRelationshipImage, mediaStr, hyperlinkType)
+		drawingRID = f.addRels(drawingRels, SourceRelationshipImage, mediaStr, "")
 	}
 	// Add picture with hyperlink.
-	if options.Hyperlink != "" && options.HyperlinkType != "" {
-		if options.HyperlinkType == "External" {
-			hyperlinkType = options.HyperlinkType
-		}
-		drawingHyperlinkRID = f.addRels(drawingRels, SourceRelationshipHyperLink, options.Hyperlink, hyperlinkType)
-	}
+	drawingHyperlinkRID := f.addDrawingHyperlink(drawingXML, options)
 	ws.mu.Unlock()
 	err = f.addDrawingPicture(sheet, drawingXML, cell, ext, drawingRID, drawingHyperlinkRID, img, options)
 	if err != nil {
@@ -286,6 +285,264 @@ func (f *File) AddPictureFromBytes(sheet, cell string, pic *Picture) error {
 	return err
 }
 
+// AddPictureFromReader provides the method to add picture in a sheet by given
+// worksheet name, cell reference, the reader of the picture data, picture
+// format and graphic options. The format could be an extension name such as
+// ".png" or "png", or a MIME type such as "image/png". If the format is empty,
+// the format will be detected from the picture data, in this case, the image
+// decoder of the format should be registered. The supported image types and
+// graphic options are same as the AddPictureFromBytes function. For example,
+// add a picture fetched from the network in the cell A2 of Sheet1:
+//
+//	resp, err := http.Get("https://github.com/xuri/excelize/raw/master/logo.png")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	defer resp.Body.Close()
+//	err = f.AddPictureFromReader("Sheet1", "A2", resp.Body,
+//	    resp.Header.Get("Content-Type"), &excelize.GraphicOptions{AltText: "Logo"})
+func (f *File) AddPictureFromReader(sheet, cell string, r io.Reader, format string, opts *GraphicOptions) error {
+	if r == nil {
+		return ErrParameterInvalid
+	}
+	file, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if format == "" {
+		_, format, _ = image.DecodeConfig(bytes.NewReader(file))
+	}
+	ext, ok := getImageExtension(format)
+	if !ok {
+		return ErrImgExt
+	}
+	return f.AddPictureFromBytes(sheet, cell, &Picture{Extension: ext, File: file, Format: opts})
+}
+
+// getImageExtension provides a function to get the supported image extension
+// name by given extension name, image format name or MIME type.
+func getImageExtension(format string) (string, bool) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if idx := strings.Index(format, ";"); idx != -1 {
+		format = strings.TrimSpace(format[:idx])
+	}
+	format = strings.TrimPrefix(format, "image/")
+	format = strings.TrimPrefix(format, "x-")
+	format = strings.TrimSuffix(format, "+xml")
+	if !strings.HasPrefix(format, ".") {
+		format = "." + format
+	}
+	ext, ok := supportedImageTypes[format]
+	return ext, ok
+}
+
+// AddPictureInCell provides the method to add picture placed in a cell by given
+// worksheet name, cell reference and picture settings. The picture will be
+// stored as the value of the cell, and will be resized with the cell, supported
+// image types: BMP, GIF, JPEG, JPG, PNG, TIF, and TIFF. The optional "AltText"
+// in the "Format" of the picture is used to add alternative text to the
+// picture, and other format settings will be ignored. For example, insert the
+// picture image.jpg in the cell A2 of Sheet1:
+//
+//	file, err := os.ReadFile("image.jpg")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	err = f.AddPictureInCell("Sheet1", "A2", &excelize.Picture{
+//	    Extension: ".jpg",
+//	    File:      file,
+//	    Format:    &excelize.GraphicOptions{AltText: "Excel Logo"},
+//	})
+func (f *File) AddPictureInCell(sheet, cell string, pic *Picture) error {
+	ext, ok := supportedImageTypes[strings.ToLower(pic.Extension)]
+	if !ok || inStrSlice([]string{".emf", ".emz", ".svg", ".wmf", ".wmz"}, ext, true) != -1 {
+		return ErrImgExt
+	}
+	if _, _, err := image.DecodeConfig(bytes.NewReader(pic.File)); err != nil {
+		return err
+	}
+	f.mu.Lock()
+	ws, err := f.workSheetReader(sheet)
+	if err != nil {
+		f.mu.Unlock()
+		return err
+	}
+	f.mu.Unlock()
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	c, col, row, err := ws.prepareCell(cell)
+	if err != nil {
+		return err
+	}
+	var altText string
+	if pic.Format != nil {
+		altText = pic.Format.AltText
+	}
+	vm, err := f.addRichValueImage(pic.File, ext, altText)
+	if err != nil {
+		return err
+	}
+	c.S = ws.prepareCellStyle(col, row, c.S)
+	c.IS, c.Vm = nil, nil
+	if err = f.removeFormula(c, ws, sheet); err != nil {
+		return err
+	}
+	c.T, c.V, c.Vm = "e", formulaErrorVALUE, &vm
+	return err
+}
+
+// addRichValueImage provides a function to add the local image rich value by
+// given image content, extension name and alternative text, and returns the
+// index of the value metadata block of the rich value.
+func (f *File) addRichValueImage(file []byte, ext, altText string) (uint, error) {
+	richValueRel, err := f.richValueRelReader()
+	if err != nil {
+		return 0, err
+	}
+	structures, err := f.richValueStructureReader()
+	if err != nil {
+		return 0, err
+	}
+	richValue, err := f.richValueReader()
+	if err != nil {
+		return 0, err
+	}
+	metadata, err := f.metadataReader()
+	if err != nil {
+		return 0, err
+	}
+	if err = f.addRichValueParts(); err != nil {
+		return 0, err
+	}
+	// Add the image relationship of the rich value
+	mediaStr, relIdx := ".."+strings.TrimPrefix(f.addMedia(file, ext), "xl"), -1
+	rels := xlsxRichValueRels{XMLNS: NameSpaceSpreadSheetRichValueRel, XMLNSR: SourceRelationship.Value, ExtLst: richValueRel.ExtLst}
+	for i, rel := range richValueRel.Rels {
+		if r := f.getRichDataRichValueRelRelationships(rel.ID); r != nil && r.Type == SourceRelationshipImage && r.Target == mediaStr {
+			relIdx = i
+		}
+		rels.Rels = append(rels.Rels, xlsxRichValueRelRelationship{ID: rel.ID})
+	}
+	if relIdx == -1 {
+		rID := f.addRels(defaultXMLRdRichValueRelRels, SourceRelationshipImage, mediaStr, "")
+		rels.Rels = append(rels.Rels, xlsxRichValueRelRelationship{ID: "rId" + strconv.Itoa(rID)})
+		relIdx = len(rels.Rels) - 1
+	}
+	output, _ := xml.Marshal(rels)
+	f.saveFileList(defaultXMLRdRichValueRel, output)
+	// Add the local image rich value structure and rich value
+	keys := []xlsxRichValueKey{{N: "_rvRel:LocalImageIdentifier", T: "i"}, {N: "CalcOrigin", T: "i"}}
+	values := []string{strconv.Itoa(relIdx), "5"}
+	if altText != "" {
+		keys = append(keys, xlsxRichValueKey{N: "Text", T: "s"})
+		values = append(values, altText)
+	}
+	structureIdx := -1
+	for i, s := range structures.S {
+		if s.T == "_localImage" && reflect.DeepEqual(s.K, keys) {
+			structureIdx = i
+			break
+		}
+	}
+	if structureIdx == -1 {
+		structures.S = append(structures.S, xlsxRichValueStructure{T: "_localImage", K: keys})
+		structureIdx = len(structures.S) - 1
+	}
+	structures.XMLNS, structures.Count = NameSpaceSpreadSheetRichData, len(structures.S)
+	output, _ = xml.Marshal(structures)
+	f.saveFileList(defaultXMLRdRichValueStructurePart, output)
+	richValue.Rv = append(richValue.Rv, xlsxRichValue{S: structureIdx, V: values})
+	richValue.XMLNS, richValue.Count = NameSpaceSpreadSheetRichData, len(richValue.Rv)
+	output, _ = xml.Marshal(richValue)
+	f.saveFileList(defaultXMLRdRichValuePart, output)
+	return f.addRichValueMetadata(metadata, len(richValue.Rv)-1), err
+}
+
+// addRichValueMetadata provides a function to add the value metadata of the
+// rich value by given metadata and index of the rich value, and returns the
+// index of the value metadata block.
+func (f *File) addRichValueMetadata(metadata *xlsxMetadata, richValueIdx int) uint {
+	metadata.XMLNS = NameSpaceSpreadSheet.Value
+	metadata.XMLNSXda, metadata.XMLNSXlrd = NameSpaceSpreadSheetDynamicArray, NameSpaceSpreadSheetRichData
+	if metadata.MetadataTypes == nil {
+		metadata.MetadataTypes = &xlsxMetadataTypes{}
+	}
+	typeIdx, futureIdx := -1, -1
+	for i, metadataType := range metadata.MetadataTypes.MetadataType {
+		if metadataType.Name == "XLRICHVALUE" {
+			typeIdx = i
+		}
+	}
+	if typeIdx == -1 {
+		metadata.MetadataTypes.MetadataType = append(metadata.MetadataTypes.MetadataType, xlsxMetadataType{
+			Name: "XLRICHVALUE", MinSupportedVersion: 120000, Copy: true, PasteAll: true, PasteValues: true,
+			Merge: true, SplitFirst: true, RowColShift: true, ClearFormats: true, ClearComments: true,
+			Assign: true, Coerce: true,
+		})
+		typeIdx = len(metadata.MetadataTypes.MetadataType) - 1
+	}
+	metadata.MetadataTypes.Count = len(metadata.MetadataTypes.MetadataType)
+	for i, futureMetadata := range metadata.FutureMetadata {
+		if futureMetadata.Name == "XLRICHVALUE" {
+			futureIdx = i
+		}
+	}
+	if futureIdx == -1 {
+		metadata.FutureMetadata = append(metadata.FutureMetadata, xlsxFutureMetadata{Name: "XLRICHVALUE"})
+		futureIdx = len(metadata.FutureMetadata) - 1
+	}
+	futureMetadata := &metadata.FutureMetadata[futureIdx]
+	futureMetadata.Bk = append(futureMetadata.Bk, xlsxFutureMetadataBlock{ExtLst: &xlsxInnerXML{
+		Content: fmt.Sprintf(`<ext uri="%s"><xlrd:rvb i="%d"/></ext>`, ExtURIRichValueBlock, richValueIdx),
+	}})
+	futureMetadata.Count = len(futureMetadata.Bk)
+	if metadata.ValueMetadata == nil {
+		metadata.ValueMetadata = &xlsxMetadataBlocks{}
+	}
+	metadata.ValueMetadata.Bk = append(metadata.ValueMetadata.Bk, xlsxMetadataBlock{
+		Rc: []xlsxMetadataRecord{{T: typeIdx + 1, V: len(futureMetadata.Bk) - 1}},
+	})
+	metadata.ValueMetadata.Count = len(metadata.ValueMetadata.Bk)
+	output, _ := xml.Marshal(metadata)
+	f.saveFileList(defaultXMLMetadata, output)
+	return uint(len(metadata.ValueMetadata.Bk))
+}
+
+// addRichValueParts provides a function to add the workbook relationships and
+// content types of the metadata and rich value parts if not exist.
+func (f *File) addRichValueParts() error {
+	rels, err := f.relsReader(f.getWorkbookRelsPath())
+	if err != nil {
+		return err
+	}
+	for _, part := range []struct{ contentType, relType, target string }{
+		{"metadata", SourceRelationshipSheetMetadata, strings.TrimPrefix(defaultXMLMetadata, "xl/")},
+		{"rdRichValue", SourceRelationshipRdRichValue, strings.TrimPrefix(defaultXMLRdRichValuePart, "xl/")},
+		{"rdRichValueStructure", SourceRelationshipRdRichValueStructure, strings.TrimPrefix(defaultXMLRdRichValueStructurePart, "xl/")},
+		{"richValueRel", SourceRelationshipRichValueRel, strings.TrimPrefix(defaultXMLRdRichValueRel, "xl/")},
+	} {
+		var exist bool
+		if rels != nil {
+			rels.mu.Lock()
+			for _, rel := range rels.Relationships {
+				if rel.Type == part.relType {
+					exist = true
+				}
+			}
+			rels.mu.Unlock()
+		}
+		if !exist {
+			f.addRels(f.getWorkbookRelsPath(), part.relType, part.target, "")
+		}
+		if err = f.addContentTypePart(0, part.contentType); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
 // addSheetLegacyDrawing provides a function to add legacy drawing element to
 // xl/worksheets/sheet%d.xml by given worksheet name and relationship index.
 func (f *File) addSheetLegacyDrawing(sheet string, rID int) {
@@ -379,13 +636,9 @@ func (f *File) addDrawingPicture(sheet, drawingXML, cell, ext string, rID, hyper
 	pic.NvPicPr.CNvPicPr.PicLocks.NoChangeAspect = opts.LockAspectRatio
 	pic.NvPicPr.CNvPr.ID = cNvPrID
 	pic.NvPicPr.CNvPr.Descr = opts.AltText
+	pic.NvPicPr.CNvPr.Title = opts.AltTextTitle
 	pic.NvPicPr.CNvPr.Name = "Picture " + strconv.Itoa(cNvPrID)
-	if hyperlinkRID != 0 {
-		pic.NvPicPr.CNvPr.HlinkClick = &xlsxHlinkClick{
-			R:   SourceRelationship.Value,
-			RID: "rId" + strconv.Itoa(hyperlinkRID),
-		}
-	}
+	pic.NvPicPr.CNvPr.HlinkClick = drawHlinkClick(hyperlinkRID)
 	pic.BlipFill.Blip.R = SourceRelationship.Value
 	pic.BlipFill.Blip.Embed = "rId" + strconv.Itoa(rID)
 	if ext == ".svg" {
@@ -454,8 +707,11 @@ func (f *File) addMedia(file []byte, ext string) string {
 
 // GetPictures provides a function to get picture meta info and raw content
 // embed in spreadsheet by given worksheet and cell name. This function
-// returns the image contents as []byte data types. This function is
-// concurrency safe. For example:
+// returns the image contents as []byte data types, and the format settings
+// of the pictures placed over cells include the offset, scale, positioning,
+// print and locked settings. Use the GetPictureInfos function to get the
+// anchor cell and size of the pictures. This function is concurrency safe.
+// For example:
 //
 //	f, err := excelize.OpenFile("Book1.xlsx")
 //	if err != nil {
@@ -503,7 +759,7 @@ func (f *File) GetPictures(sheet, cell string) ([]Picture, error) {
 	if err != nil {
 		return nil, err
 	}
-	pics, err := f.getPicture(row, col, drawingXML, drawingRelationships)
+	pics, err := f.getPicture(sheet, row, col, drawingXML, drawingRelationships)
 	if err != nil {
 		return nil, err
 	}
@@ -572,7 +828,7 @@ func (f *File) DeletePicture(sheet, cell string) error {
 				return true
 			}
 			for _, rel := range r.Relationships {
-				if rel.ID != rels.ID && rel.Type == SourceRelationshipImage &&
+				if (k.(string) != drawingRels || rel.ID != rels.ID) && rel.Type == SourceRelationshipImage &&
 					filepath.Base(rel.Target) == filepath.Base(rels.Target) {
 					used = true
 				}
@@ -591,40 +847,165 @@ func (f *File) DeletePicture(sheet, cell string) error {
 
 // getPicture provides a function to get picture base name and raw content
 // embed in spreadsheet by given coordinates and drawing relationships.
-func (f *File) getPicture(row, col int, drawingXML, drawingRelationships string) (pics []Picture, err error) {
+func (f *File) getPicture(sheet string, row, col int, drawingXML, drawingRelationships string) (pics []Picture, err error) {
+	infos, err := f.getPictureInfos(sheet, drawingXML, drawingRelationships, func(c, r int) bool { return c == col && r == row })
+	for _, info := range infos {
+		pics = append(pics, Picture{Extension: info.Extension, File: info.File, Format: info.Format, InsertType: info.InsertType})
+	}
+	return
+}
+
+// pictureAnchor defines the structure used to the anchor and non-visual
+// properties of the picture in the in-memory or decoded drawing.
+type pictureAnchor struct {
+	editAs, descr, title, hlinkRID string
+	from                           xlsxFrom
+	to                             *xlsxTo
+	ext                            *aExt
+	noChangeAspect                 bool
+	clientData                     *xdrClientData
+}
+
+// getPictureInfos provides a function to get the pictures with anchor, size
+// and format settings by given worksheet name, drawing part path, drawing
+// relationships part path and the conditional function of the zero-based
+// column and row number of the anchor cell.
+func (f *File) getPictureInfos(sheet, drawingXML, drawingRelationships string, cond func(col, row int) bool) (infos []PictureInfo, err error) {
 	var wsDr *xlsxWsDr
 	if wsDr, _, err = f.drawingParser(drawingXML); err != nil {
 		return
 	}
 	wsDr.mu.Lock()
 	defer wsDr.mu.Unlock()
-	cond := func(from *xlsxFrom) bool { return from.Col == col && from.Row == row }
-	cond2 := func(from *decodeFrom) bool { return from.Col == col && from.Row == row }
+	appendInfo := func(a *pictureAnchor, r *xlsxRelationship) {
+		if info := f.newPictureInfo(sheet, drawingRelationships, a, r); info != nil {
+			infos = append(infos, *info)
+		}
+	}
+	cond1 := func(from *xlsxFrom) bool { return cond(from.Col, from.Row) }
+	cond2 := func(from *decodeFrom) bool { return cond(from.Col, from.Row) }
 	cb := func(a *xdrCellAnchor, r *xlsxRelationship) {
-		pic := Picture{Extension: filepath.Ext(r.Target), Format: &GraphicOptions{}, InsertType: PictureInsertTypePlaceOverCells}
-		if buffer, _ := f.Pkg.Load(filepath.ToSlash(filepath.Clean("xl/drawings/" + r.Target))); buffer != nil {
-			pic.File = buffer.([]byte)
-			pic.Format.AltText = a.Pic.NvPicPr.CNvPr.Descr
-			pics = append(pics, pic)
+		anchor := &pictureAnchor{
+			editAs: a.EditAs, from: *a.From, to: a.To, ext: a.Ext,
+			descr: a.Pic.NvPicPr.CNvPr.Descr, title: a.Pic.NvPicPr.CNvPr.Title,
+			noChangeAspect: a.Pic.NvPicPr.CNvPicPr.PicLocks.NoChangeAspect, clientData: a.ClientData,
 		}
+		if a.Pic.NvPicPr.CNvPr.HlinkClick != nil {
+			anchor.hlinkRID = a.Pic.NvPicPr.CNvPr.HlinkClick.RID
+		}
+		appendInfo(anchor, r)
 	}
 	cb2 := func(a *decodeCellAnchor, r *xlsxRelationship) {
-		pic := Picture{Extension: filepath.Ext(r.Target), Format: &GraphicOptions{}, InsertType: PictureInsertTypePlaceOverCells}
-		if buffer, _ := f.Pkg.Load(filepath.ToSlash(filepath.Clean("xl/drawings/" + r.Target))); buffer != nil {
-			pic.File = buffer.([]byte)
-			pic.Format.AltText = a.Pic.NvPicPr.CNvPr.Descr
-			pics = append(pics, pic)
+		anchor := &pictureAnchor{
+			editAs: a.EditAs,
+			from:   xlsxFrom{Col: a.From.Col, ColOff: a.From.ColOff, Row: a.From.Row, RowOff: a.From.RowOff},
+			descr:  a.Pic.NvPicPr.CNvPr.Descr, title: a.Pic.NvPicPr.CNvPr.Title,
+			noChangeAspect: a.Pic.NvPicPr.CNvPicPr.PicLocks.NoChangeAspect,
+		}
+		if a.To != nil {
+			anchor.to = &xlsxTo{Col: a.To.Col, ColOff: a.To.ColOff, Row: a.To.Row, RowOff: a.To.RowOff}
 		}
+		if a.Ext != nil {
+			anchor.ext = &aExt{Cx: a.Ext.Cx, Cy: a.Ext.Cy}
+		}
+		if a.ClientData != nil {
+			anchor.clientData = &xdrClientData{FLocksWithSheet: a.ClientData.FLocksWithSheet, FPrintsWithSheet: a.ClientData.FPrintsWithSheet}
+		}
+		if a.Pic.NvPicPr.CNvPr.HlinkClick != nil {
+			anchor.hlinkRID = a.Pic.NvPicPr.CNvPr.HlinkClick.RID
+		}
+		appendInfo(anchor, r)
 	}
 	for _, anchor := range wsDr.TwoCellAnchor {
-		f.extractCellAnchor(anchor, drawingRelationships, cond, cb, cond2, cb2)
+		f.extractCellAnchor(anchor, drawingRelationships, cond1, cb, cond2, cb2)
 	}
 	for _, anchor := range wsDr.OneCellAnchor {
-		f.extractCellAnchor(anchor, drawingRelationships, cond, cb, cond2, cb2)
+		f.extractCellAnchor(anchor, drawingRelationships, cond1, cb, cond2, cb2)
 	}
 	return
 }
 
+// newPictureInfo provides a function to create the picture information by
+// given worksheet name, drawing relationships part path, picture anchor and
+// the relationship of the picture, returns nil if the media doesn't exist.
+func (f *File) newPictureInfo(sheet, drawingRelationships string, a *pictureAnchor, r *xlsxRelationship) *PictureInfo {
+	name := filepath.ToSlash(filepath.Clean("xl/drawings/" + r.Target))
+	buffer, _ := f.Pkg.Load(name)
+	if buffer == nil {
+		return nil
+	}
+	cell, _ := CoordinatesToCellName(a.from.Col+1, a.from.Row+1)
+	info := PictureInfo{
+		Cell: cell, Name: name, Extension: filepath.Ext(r.Target), File: buffer.([]byte),
+		InsertType: PictureInsertTypePlaceOverCells,
+		Format: &GraphicOptions{
+			AltText: a.descr, AltTextTitle: a.title, LockAspectRatio: a.noChangeAspect,
+			OffsetX: a.from.ColOff / EMU, OffsetY: a.from.RowOff / EMU, Positioning: a.editAs,
+		},
+	}
+	if a.hlinkRID != "" {
+		info.Format.Hyperlink, info.Format.HyperlinkType = f.getPictureHyperlink(drawingRelationships, a.hlinkRID)
+	}
+	if a.clientData != nil {
+		info.Format.Locked = boolPtr(a.clientData.FLocksWithSheet)
+		info.Format.PrintObject = boolPtr(a.clientData.FPrintsWithSheet)
+	}
+	if a.to != nil {
+		x1, y1, x2, y2 := f.getAnchorPixels(sheet, &a.from, a.to)
+		info.Width, info.Height = x2-x1, y2-y1
+	}
+	if a.to == nil && a.ext != nil {
+		info.Width, info.Height = a.ext.Cx/EMU, a.ext.Cy/EMU
+	}
+	if img, _, err := image.DecodeConfig(bytes.NewReader(info.File)); err == nil && img.Width > 0 && img.Height > 0 {
+		info.Format.ScaleX = float64(info.Width) / float64(img.Width)
+		info.Format.ScaleY = float64(info.Height) / float64(img.Height)
+	}
+	return &info
+}
+
+// GetPictureInfos provides a function to get the pictures placed over cells
+// with the anchor cell, size and format settings in the worksheet by given
+// worksheet name. The 'Name' field of the returned picture information is the
+// path of the media part resolved by the drawing relationship, the 'Width'
+// and 'Height' fields are the displayed size in pixels, and the 'Format'
+// field contains the offset, scale, alt text, hyperlink, positioning, print
+// and locked settings of the picture. The scale will be zero if the
+// dimensions of the image can't be decoded. Use the GetPictures function to
+// get the pictures placed in cells. For example, copy the pictures of Sheet1
+// in one workbook to the same worksheet in another workbook:
+//
+//	infos, err := f.GetPictureInfos("Sheet1")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	for _, info := range infos {
+//	    if err := f2.AddPictureFromBytes("Sheet1", info.Cell, &excelize.Picture{
+//	        Extension: info.Extension, File: info.File, Format: info.Format,
+//	    }); err != nil {
+//	        fmt.Println(err)
+//	        return
+//	    }
+//	}
+func (f *File) GetPictureInfos(sheet string) ([]PictureInfo, error) {
+	f.mu.Lock()
+	ws, err := f.workSheetReader(sheet)
+	if err != nil {
+		f.mu.Unlock()
+		return nil, err
+	}
+	f.mu.Unlock()
+	if ws.Drawing == nil {
+		return nil, err
+	}
+	target := f.getSheetRelationshipsTargetByID(sheet, ws.Drawing.RID)
+	drawingXML := strings.TrimPrefix(strings.ReplaceAll(target, "..", "xl"), "/")
+	drawingRelationships := strings.ReplaceAll(
+		strings.ReplaceAll(target, "../drawings", "xl/drawings/_rels"), ".xml", ".xml.rels")
+	return f.getPictureInfos(sheet, drawingXML, drawingRelationships, func(col, row int) bool { return true })
+}
+
 // extractCellAnchor extract drawing object from cell anchor by giving drawing
 // cell anchor, drawing relationships part path, conditional and callback
 // function.
@@ -660,6 +1041,7 @@ func (f *File) extractDecodeCellAnchor(anchor *xdrCellAnchor, drawingRelationshi
 		deCellAnchor = new(decodeCellAnchor)
 	)
 	_ = f.xmlNewDecoder(strings.NewReader("<decodeCellAnchor>" + anchor.GraphicFrame + "</decodeCellAnchor>")).Decode(&deCellAnchor)
+	deCellAnchor.EditAs = anchor.EditAs
 	if deCellAnchor.From != nil && deCellAnchor.Pic != nil {
 		if cond(deCellAnchor.From) {
 			if drawRel = f.getDrawingRelationships(drawingRelationships, deCellAnchor.Pic.BlipFill.Blip.Embed); drawRel != nil {
@@ -687,6 +1069,20 @@ func (f *File) getDrawingRelationships(rels, rID string) *xlsxRelationship {
 	return nil
 }
 
+// getPictureHyperlink provides a function to get the hyperlink and hyperlink
+// type of the picture by given drawing relationships part path and
+// relationship ID of the hyperlink.
+func (f *File) getPictureHyperlink(drawingRelationships, rID string) (string, string) {
+	rel := f.getDrawingRelationships(drawingRelationships, rID)
+	if rel == nil {
+		return "", ""
+	}
+	if rel.TargetMode == "External" {
+		return rel.Target, "External"
+	}
+	return rel.Target, "Location"
+}
+
 // drawingsWriter provides a function to save xl/drawings/drawing%d.xml after
 // serialize structure.
 func (f *File) drawingsWriter() {
@@ -898,8 +1294,11 @@ func (f *File) getImageCellRel(c *xlsxC, pic *Picture) (*xlsxRelationship, error
 		return r, err
 	}
 	rv := richValue.Rv[richValueIdx].V
-	if len(rv) == 2 && rv[1] == "5" {
+	if len(rv) >= 2 && rv[1] == "5" {
 		pic.InsertType = PictureInsertTypePlaceInCell
+		if len(rv) > 2 && pic.Format != nil {
+			pic.Format.AltText = rv[2]
+		}
 		return f.getRichDataRichValueRel(rv[0])
 	}
 	// cell image inserted by IMAGE formula function
@@ -965,6 +1364,7 @@ func (f *File) getDispImages(sheet, cell string) ([]Picture, error) {
 					if buffer, _ := f.Pkg.Load("xl/" + r.Target); buffer != nil {
 						pic.File = buffer.([]byte)
 						pic.Format.AltText = cellImg.Pic.NvPicPr.CNvPr.Descr
+						pic.Format.AltTextTitle = cellImg.Pic.NvPicPr.CNvPr.Title
 						pics = append(pics, pic)
 					}
 				}
@@ -1,6 +1,7 @@
 package excelize
 
 import (
+	"bytes"
 	"fmt"
 	"image"
 	_ "image/gif"
@@ -11,6 +12,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/stretchr/testify/assert"
 	_ "golang.org/x/image/bmp"
@@ -40,7 +42,15 @@ func TestAddPicture(t *testing.T) {
 		&GraphicOptions{OffsetX: 140, OffsetY: 120, Hyperlink: "#Sheet2!D8", HyperlinkType: "Location"}))
 	// Test add picture to worksheet with offset, external hyperlink and positioning
 	assert.NoError(t, f.AddPicture("Sheet1", "F21", filepath.Join("test", "images", "excel.jpg"),
-		&GraphicOptions{OffsetX: 10, OffsetY: 10, Hyperlink: "https://github.com/xuri/excelize", HyperlinkType: "External", Positioning: "oneCell"}))
+		&GraphicOptions{OffsetX: 10, OffsetY: 10, Hyperlink: "https://github.com/xuri/excelize", HyperlinkType: "External", Positioning: "oneCell", AltText: "Excel Logo", AltTextTitle: "Logo"}))
+	// Test add picture to worksheet with location hyperlink and get the picture
+	assert.NoError(t, f.AddPicture("Sheet2", "K1", filepath.Join("test", "images", "excel.jpg"),
+		&GraphicOptions{Hyperlink: "#Sheet2!D8", HyperlinkType: "Location"}))
+	pics, err := f.GetPictures("Sheet2", "K1")
+	assert.NoError(t, err)
+	assert.Len(t, pics, 1)
+	assert.Equal(t, "#Sheet2!D8", pics[0].Format.Hyperlink)
+	assert.Equal(t, "Location", pics[0].Format.HyperlinkType)
 
 	file, err := os.ReadFile(filepath.Join("test", "images", "excel.png"))
 	assert.NoError(t, err)
@@ -76,9 +86,17 @@ func TestAddPicture(t *testing.T) {
 	f, err = OpenFile(filepath.Join("test", "TestAddPicture1.xlsx"))
 	assert.NoError(t, err)
 	assert.NoError(t, f.AddPicture("Sheet1", "A30", filepath.Join("test", "images", "excel.jpg"), nil))
-	pics, err := f.GetPictures("Sheet1", "A30")
+	pics, err = f.GetPictures("Sheet1", "A30")
 	assert.NoError(t, err)
 	assert.Len(t, pics, 2)
+	// Test get picture with external hyperlink and alternative text
+	pics, err = f.GetPictures("Sheet1", "F21")
+	assert.NoError(t, err)
+	assert.Len(t, pics, 1)
+	assert.Equal(t, "https://github.com/xuri/excelize", pics[0].Format.Hyperlink)
+	assert.Equal(t, "External", pics[0].Format.HyperlinkType)
+	assert.Equal(t, "Excel Logo", pics[0].Format.AltText)
+	assert.Equal(t, "Logo", pics[0].Format.AltTextTitle)
 
 	// Test get picture cells
 	cells, err := f.GetPictureCells("Sheet1")
@@ -118,6 +136,76 @@ func TestAddPicture(t *testing.T) {
 	assert.EqualError(t, f.AddPicture("Sheet:1", "A1", filepath.Join("test", "images", "excel.jpg"), nil), ErrSheetNameInvalid.Error())
 }
 
+func TestAddPictureInCell(t *testing.T) {
+	f := NewFile()
+	file, err := os.ReadFile(filepath.Join("test", "images", "excel.png"))
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetCellFormula("Sheet1", "A1", "=1+1"))
+	assert.NoError(t, f.AddPictureInCell("Sheet1", "A1", &Picture{Extension: ".png", File: file}))
+	assert.NoError(t, f.AddPictureInCell("Sheet1", "B2", &Picture{Extension: ".png", File: file, Format: &GraphicOptions{AltText: "Excel Logo"}}))
+	assert.NoError(t, f.AddPictureInCell("Sheet1", "C3", &Picture{Extension: ".png", File: file, Format: &GraphicOptions{AltText: "Logo"}}))
+	formula, err := f.GetCellFormula("Sheet1", "A1")
+	assert.NoError(t, err)
+	assert.Empty(t, formula)
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestAddPictureInCell.xlsx")))
+	assert.NoError(t, f.Close())
+
+	f, err = OpenFile(filepath.Join("test", "TestAddPictureInCell.xlsx"))
+	assert.NoError(t, err)
+	for cell, altText := range map[string]string{"A1": "", "B2": "Excel Logo", "C3": "Logo"} {
+		pics, err := f.GetPictures("Sheet1", cell)
+		assert.NoError(t, err)
+		assert.Len(t, pics, 1)
+		assert.Equal(t, PictureInsertTypePlaceInCell, pics[0].InsertType)
+		assert.Equal(t, file, pics[0].File)
+		assert.Equal(t, ".png", pics[0].Extension)
+		assert.Equal(t, altText, pics[0].Format.AltText)
+	}
+	cells, err := f.GetPictureCells("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"A1", "B2", "C3"}, cells)
+	structures, err := f.richValueStructureReader()
+	assert.NoError(t, err)
+	assert.Len(t, structures.S, 2)
+	richValueRels, err := f.richValueRelReader()
+	assert.NoError(t, err)
+	assert.Len(t, richValueRels.Rels, 1)
+	// Test add picture in cell after reopen the workbook
+	assert.NoError(t, f.AddPictureInCell("Sheet1", "D4", &Picture{Extension: ".png", File: file}))
+	pics, err := f.GetPictures("Sheet1", "D4")
+	assert.NoError(t, err)
+	assert.Len(t, pics, 1)
+	assert.NoError(t, f.Close())
+
+	f = NewFile()
+	// Test add picture in cell with unsupported image extension
+	assert.Equal(t, ErrImgExt, f.AddPictureInCell("Sheet1", "A1", &Picture{Extension: ".svg", File: file}))
+	assert.Equal(t, ErrImgExt, f.AddPictureInCell("Sheet1", "A1", &Picture{Extension: ".txt", File: file}))
+	// Test add picture in cell with invalid image
+	assert.Equal(t, image.ErrFormat, f.AddPictureInCell("Sheet1", "A1", &Picture{Extension: ".png", File: []byte("image")}))
+	// Test add picture in cell with not exist worksheet
+	assert.EqualError(t, f.AddPictureInCell("SheetN", "A1", &Picture{Extension: ".png", File: file}), "sheet SheetN does not exist")
+	// Test add picture in cell with invalid cell reference
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), f.AddPictureInCell("Sheet1", "A", &Picture{Extension: ".png", File: file}))
+	// Test add picture in cell with unsupported charset rich data parts
+	for _, part := range []string{defaultXMLRdRichValueRel, defaultXMLRdRichValueStructurePart, defaultXMLRdRichValuePart, defaultXMLMetadata} {
+		f.Pkg.Store(part, MacintoshCyrillicCharset)
+		assert.EqualError(t, f.AddPictureInCell("Sheet1", "A1", &Picture{Extension: ".png", File: file}), "XML syntax error on line 1: invalid UTF-8")
+		f.Pkg.Delete(part)
+	}
+	// Test add picture in cell with unsupported charset workbook relationships
+	f.Relationships.Delete(defaultXMLPathWorkbookRels)
+	f.Pkg.Store(defaultXMLPathWorkbookRels, MacintoshCyrillicCharset)
+	assert.EqualError(t, f.AddPictureInCell("Sheet1", "A1", &Picture{Extension: ".png", File: file}), "XML syntax error on line 1: invalid UTF-8")
+	assert.NoError(t, f.Close())
+	// Test add picture in cell with unsupported charset content types
+	f = NewFile()
+	f.ContentTypes = nil
+	f.Pkg.Store(defaultXMLPathContentTypes, MacintoshCyrillicCharset)
+	assert.EqualError(t, f.AddPictureInCell("Sheet1", "A1", &Picture{Extension: ".png", File: file}), "XML syntax error on line 1: invalid UTF-8")
+	assert.NoError(t, f.Close())
+}
+
 func TestAddPictureErrors(t *testing.T) {
 	f, err := OpenFile(filepath.Join("test", "Book1.xlsx"))
 	assert.NoError(t, err)
@@ -235,10 +323,10 @@ func TestGetPicture(t *testing.T) {
 	f.Pkg.Store(path, MacintoshCyrillicCharset)
 	_, err = f.GetPictures("Sheet1", "F21")
 	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
-	_, err = f.getPicture(20, 5, path, "xl/drawings/_rels/drawing2.xml.rels")
+	_, err = f.getPicture("Sheet1", 20, 5, path, "xl/drawings/_rels/drawing2.xml.rels")
 	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
 	f.Drawings.Delete(path)
-	_, err = f.getPicture(20, 5, path, "xl/drawings/_rels/drawing2.xml.rels")
+	_, err = f.getPicture("Sheet1", 20, 5, path, "xl/drawings/_rels/drawing2.xml.rels")
 	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
 	assert.NoError(t, f.Close())
 
@@ -271,6 +359,93 @@ func TestGetPicture(t *testing.T) {
 	assert.NoError(t, f.Close())
 }
 
+func TestGetPictureInfos(t *testing.T) {
+	f := NewFile()
+	// Test get picture infos without drawing
+	infos, err := f.GetPictureInfos("Sheet1")
+	assert.NoError(t, err)
+	assert.Empty(t, infos)
+	file, err := os.ReadFile(filepath.Join("test", "images", "excel.png"))
+	assert.NoError(t, err)
+	cfg, _, err := image.DecodeConfig(strings.NewReader(string(file)))
+	assert.NoError(t, err)
+	assert.NoError(t, f.AddPictureFromBytes("Sheet1", "B2", &Picture{Extension: ".png", File: file, Format: &GraphicOptions{
+		AltText: "Excel Logo", OffsetX: 10, OffsetY: 5, ScaleX: 0.5, ScaleY: 0.25, Positioning: "oneCell",
+		LockAspectRatio: true, Locked: boolPtr(true), PrintObject: boolPtr(false), Hyperlink: "https://github.com/xuri/excelize", HyperlinkType: "External",
+	}}))
+	assert.NoError(t, f.AddShape("Sheet1", &Shape{Cell: "H2", Type: "rect"}))
+	assert.NoError(t, f.AddPicture("Sheet1", "D20", filepath.Join("test", "images", "excel.jpg"), nil))
+	expected := PictureInfo{
+		Cell: "B2", Name: "xl/media/image1.png", Extension: ".png", File: file,
+		Width: cfg.Width / 2, Height: cfg.Height / 4, InsertType: PictureInsertTypePlaceOverCells,
+		Format: &GraphicOptions{
+			AltText: "Excel Logo", OffsetX: 10, OffsetY: 5, ScaleX: 0.5, ScaleY: 0.25, Positioning: "oneCell",
+			LockAspectRatio: true, Locked: boolPtr(true), PrintObject: boolPtr(false), Hyperlink: "https://github.com/xuri/excelize", HyperlinkType: "External",
+		},
+	}
+	check := func(infos []PictureInfo) {
+		assert.Len(t, infos, 2)
+		assert.Equal(t, expected, infos[0])
+		assert.Equal(t, "D20", infos[1].Cell)
+		assert.Equal(t, "xl/media/image2.jpeg", infos[1].Name)
+		assert.Equal(t, 1.0, infos[1].Format.ScaleX)
+		assert.Equal(t, 1.0, infos[1].Format.ScaleY)
+	}
+	infos, err = f.GetPictureInfos("Sheet1")
+	assert.NoError(t, err)
+	check(infos)
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestGetPictureInfos.xlsx")))
+	assert.NoError(t, f.Close())
+
+	// Test get picture infos after reopen the workbook
+	f, err = OpenFile(filepath.Join("test", "TestGetPictureInfos.xlsx"))
+	assert.NoError(t, err)
+	infos, err = f.GetPictureInfos("Sheet1")
+	assert.NoError(t, err)
+	check(infos)
+	// Test get pictures with the format settings
+	pics, err := f.GetPictures("Sheet1", "B2")
+	assert.NoError(t, err)
+	assert.Len(t, pics, 1)
+	assert.Equal(t, expected.Format, pics[0].Format)
+	// Test copy pictures by the picture infos
+	f2 := NewFile()
+	for _, info := range infos {
+		assert.NoError(t, f2.AddPictureFromBytes("Sheet1", info.Cell, &Picture{Extension: info.Extension, File: info.File, Format: info.Format}))
+	}
+	infos2, err := f2.GetPictureInfos("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, infos, infos2)
+	assert.NoError(t, f2.Close())
+	// Test get picture infos with one cell anchor
+	drawing, ok := f.Drawings.Load("xl/drawings/drawing1.xml")
+	assert.True(t, ok)
+	wsDr := drawing.(*xlsxWsDr)
+	wsDr.OneCellAnchor = append(wsDr.OneCellAnchor, &xdrCellAnchor{
+		GraphicFrame: `<xdr:from><xdr:col>0</xdr:col><xdr:colOff>0</xdr:colOff><xdr:row>0</xdr:row><xdr:rowOff>0</xdr:rowOff></xdr:from><xdr:ext cx="952500" cy="476250"/><xdr:pic><xdr:nvPicPr><xdr:cNvPr id="10" name="Picture 10"/><xdr:cNvPicPr/></xdr:nvPicPr><xdr:blipFill><a:blip r:embed="rId1"/></xdr:blipFill></xdr:pic>`,
+	})
+	infos, err = f.GetPictureInfos("Sheet1")
+	assert.NoError(t, err)
+	assert.Len(t, infos, 3)
+	assert.Equal(t, "A1", infos[2].Cell)
+	assert.Equal(t, 100, infos[2].Width)
+	assert.Equal(t, 50, infos[2].Height)
+	// Test get picture infos with not exists media
+	f.Pkg.Delete("xl/media/image2.jpeg")
+	infos, err = f.GetPictureInfos("Sheet1")
+	assert.NoError(t, err)
+	assert.Len(t, infos, 2)
+	// Test get picture infos on not exists worksheet
+	_, err = f.GetPictureInfos("SheetN")
+	assert.EqualError(t, err, "sheet SheetN does not exist")
+	// Test get picture infos with unsupported charset drawing
+	f.Drawings.Delete("xl/drawings/drawing1.xml")
+	f.Pkg.Store("xl/drawings/drawing1.xml", MacintoshCyrillicCharset)
+	_, err = f.GetPictureInfos("Sheet1")
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+	assert.NoError(t, f.Close())
+}
+
 func TestAddDrawingPicture(t *testing.T) {
 	// Test addDrawingPicture with illegal cell reference
 	f := NewFile()
@@ -304,6 +479,40 @@ func TestAddPictureFromBytes(t *testing.T) {
 	assert.EqualError(t, f.AddPictureFromBytes("Sheet:1", fmt.Sprint("A", 1), &Picture{Extension: ".png", File: imgFile, Format: &GraphicOptions{AltText: "logo"}}), ErrSheetNameInvalid.Error())
 }
 
+func TestAddPictureFromReader(t *testing.T) {
+	f := NewFile()
+	imgFile, err := os.ReadFile("logo.png")
+	assert.NoError(t, err, "Unable to load logo for test")
+	for cell, format := range map[string]string{
+		"A1": ".png", "A20": "PNG", "A40": "image/png", "A60": "image/png; charset=binary", "A80": "",
+	} {
+		assert.NoError(t, f.AddPictureFromReader("Sheet1", cell, bytes.NewReader(imgFile), format, &GraphicOptions{AltText: "logo"}))
+	}
+	pics, err := f.GetPictures("Sheet1", "A40")
+	assert.NoError(t, err)
+	assert.Len(t, pics, 1)
+	assert.Equal(t, ".png", pics[0].Extension)
+	assert.Equal(t, imgFile, pics[0].File)
+	// Test add picture from reader with unsupported image format
+	assert.Equal(t, ErrImgExt, f.AddPictureFromReader("Sheet1", "A1", bytes.NewReader(imgFile), "image/webp", nil))
+	assert.Equal(t, ErrImgExt, f.AddPictureFromReader("Sheet1", "A1", strings.NewReader("text"), "text/plain", nil))
+	// Test add picture from reader with invalid parameters
+	assert.Equal(t, ErrParameterInvalid, f.AddPictureFromReader("Sheet1", "A1", nil, ".png", nil))
+	// Test add picture from reader with invalid sheet name
+	assert.EqualError(t, f.AddPictureFromReader("Sheet:1", "A1", bytes.NewReader(imgFile), ".png", nil), ErrSheetNameInvalid.Error())
+	// Test add picture from reader with reading error
+	assert.EqualError(t, f.AddPictureFromReader("Sheet1", "A1", iotest.ErrReader(io.ErrUnexpectedEOF), ".png", nil), io.ErrUnexpectedEOF.Error())
+	// Test get image extension with MIME types
+	for format, expected := range map[string]string{
+		"image/svg+xml": ".svg", "image/x-emf": ".emf", "image/x-wmf": ".wmf", "image/jpeg": ".jpeg", "tif": ".tiff",
+	} {
+		ext, ok := getImageExtension(format)
+		assert.True(t, ok)
+		assert.Equal(t, expected, ext)
+	}
+	assert.NoError(t, f.Close())
+}
+
 func TestDeletePicture(t *testing.T) {
 	f, err := OpenFile(filepath.Join("test", "Book1.xlsx"))
 	assert.NoError(t, err)
@@ -17,6 +17,7 @@ import (
 	"io"
 	"math"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -149,14 +150,51 @@ func (rows *Rows) Close() error {
 // data as a stream, returns each cell in a row as is, and will not skip empty
 // rows in the tail of the worksheet.
 func (rows *Rows) Columns(opts ...Options) ([]string, error) {
+	rowIterator := rows.columns(false, opts...)
+	return rowIterator.cells, rowIterator.err
+}
+
+// Cells return the current row's cells, includes the style ID, data type,
+// formula and the value of each cell. This fetches the worksheet data as a
+// stream like the Columns function, the blank cells between the cells will
+// be returned as the zero value of the Cell. This allows the format
+// preserving streaming transformations without random access lookups. For
+// example:
+//
+//	rows, err := f.Rows("Sheet1")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	for rows.Next() {
+//	    cells, err := rows.Cells()
+//	    if err != nil {
+//	        fmt.Println(err)
+//	    }
+//	    for _, cell := range cells {
+//	        fmt.Print(cell.Value, cell.StyleID, "\t")
+//	    }
+//	    fmt.Println()
+//	}
+//	if err = rows.Close(); err != nil {
+//	    fmt.Println(err)
+//	}
+func (rows *Rows) Cells(opts ...Options) ([]Cell, error) {
+	rowIterator := rows.columns(true, opts...)
+	return rowIterator.styledCells, rowIterator.err
+}
+
+// columns parse the current row's cells by given options, the style ID, data
+// type and formula of the cells will be returned if withStyle is true.
+func (rows *Rows) columns(withStyle bool, opts ...Options) rowXMLIterator {
+	rowIterator := rowXMLIterator{withStyle: withStyle}
 	if rows.curRow > rows.seekRow {
-		return nil, nil
+		return rowIterator
 	}
-	var rowIterator rowXMLIterator
 	var token xml.Token
 	rows.rawCellValue = rows.f.getOptions(opts...).RawCellValue
 	if rows.sst, rowIterator.err = rows.f.sharedStringsReader(); rowIterator.err != nil {
-		return rowIterator.cells, rowIterator.err
+		return rowIterator
 	}
 	for {
 		if rows.token != nil {
@@ -178,21 +216,21 @@ func (rows *Rows) Columns(opts ...Options) ([]string, error) {
 				rows.seekRowOpts = extractRowOpts(xmlElement.Attr)
 				if rows.curRow > rows.seekRow {
 					rows.token = nil
-					return rowIterator.cells, rowIterator.err
+					return rowIterator
 				}
 			}
 			if rows.rowXMLHandler(&rowIterator, &xmlElement, rows.rawCellValue); rowIterator.err != nil {
 				rows.token = nil
-				return rowIterator.cells, rowIterator.err
+				return rowIterator
 			}
 			rows.token = nil
 		case xml.EndElement:
 			if xmlElement.Name.Local == "sheetData" {
-				return rowIterator.cells, rowIterator.err
+				return rowIterator
 			}
 		}
 	}
-	return rowIterator.cells, rowIterator.err
+	return rowIterator
 }
 
 // extractRowOpts extract row element attributes.
@@ -224,6 +262,8 @@ type rowXMLIterator struct {
 	inElement        string
 	cellCol, cellRow int
 	cells            []string
+	withStyle        bool
+	styledCells      []Cell
 }
 
 // rowXMLHandler parse the row XML element of the worksheet.
@@ -237,8 +277,22 @@ func (rows *Rows) rowXMLHandler(rowIterator *rowXMLIterator, xmlElement *xml.Sta
 				return
 			}
 		}
+		val, _ := colCell.getValueFrom(rows.f, rows.sst, raw)
+		if rowIterator.withStyle {
+			if val != "" || colCell.F != nil || colCell.S != 0 {
+				for len(rowIterator.styledCells) < rowIterator.cellCol-1 {
+					rowIterator.styledCells = append(rowIterator.styledCells, Cell{})
+				}
+				cell := Cell{StyleID: colCell.S, Value: val, Type: cellTypes[colCell.T]}
+				if colCell.F != nil {
+					cell.Formula = colCell.F.Content
+				}
+				rowIterator.styledCells = append(rowIterator.styledCells, cell)
+			}
+			return
+		}
 		blank := rowIterator.cellCol - len(rowIterator.cells)
-		if val, _ := colCell.getValueFrom(rows.f, rows.sst, raw); val != "" || colCell.F != nil {
+		if val != "" || colCell.F != nil {
 			rowIterator.cells = append(appendSpace(blank, rowIterator.cells), val)
 		}
 	}
@@ -557,6 +611,71 @@ func (f *File) GetRowOutlineLevel(sheet string, row int) (uint8, error) {
 	return ws.SheetData.Row[row-1].OutlineLevel, nil
 }
 
+// GroupRows provides a function to group rows by given worksheet name, the
+// first and last Excel row number, and optional group settings. The outline
+// level of each row in the range will be increased by 1, and the maximum
+// outline level is 7. Set the Collapsed field of the GroupOptions to true to
+// hide the grouped rows and collapse the group, the summary row will be the
+// next row of the group, or the previous row if the OutlineSummaryBelow of
+// the worksheet properties was set to false. This function is concurrency
+// safe. For example, group rows 2 to 5 in Sheet1, and group rows 3 to 4 in the
+// collapsed state:
+//
+//	err := f.GroupRows("Sheet1", 2, 5)
+//	err = f.GroupRows("Sheet1", 3, 4, excelize.GroupOptions{Collapsed: true})
+func (f *File) GroupRows(sheet string, start, end int, opts ...GroupOptions) error {
+	if start > end {
+		start, end = end, start
+	}
+	if start < 1 {
+		return newInvalidRowNumberError(start)
+	}
+	if end > TotalRows {
+		return ErrMaxRows
+	}
+	ws, err := f.workSheetReader(sheet)
+	if err != nil {
+		return err
+	}
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	var options GroupOptions
+	for _, opt := range opts {
+		options = opt
+	}
+	ws.prepareSheetXML(0, end)
+	for row := start; row <= end; row++ {
+		if ws.SheetData.Row[row-1].OutlineLevel >= 7 {
+			return ErrOutlineLevel
+		}
+	}
+	if ws.SheetFormatPr == nil {
+		ws.SheetFormatPr = &xlsxSheetFormatPr{DefaultRowHeight: defaultRowHeight}
+	}
+	for row := start; row <= end; row++ {
+		r := &ws.SheetData.Row[row-1]
+		r.OutlineLevel++
+		if r.OutlineLevel > ws.SheetFormatPr.OutlineLevelRow {
+			ws.SheetFormatPr.OutlineLevelRow = r.OutlineLevel
+		}
+		if options.Collapsed {
+			r.Hidden = true
+		}
+	}
+	if options.Collapsed {
+		summaryRow := end + 1
+		if ws.SheetPr != nil && ws.SheetPr.OutlinePr != nil && ws.SheetPr.OutlinePr.SummaryBelow != nil &&
+			!*ws.SheetPr.OutlinePr.SummaryBelow {
+			summaryRow = start - 1
+		}
+		if summaryRow >= 1 && summaryRow <= TotalRows {
+			ws.prepareSheetXML(0, summaryRow)
+			ws.SheetData.Row[summaryRow-1].Collapsed = true
+		}
+	}
+	return err
+}
+
 // RemoveRow provides a function to remove single row by given worksheet name
 // and Excel row number. For example, remove row 3 in Sheet1:
 //
@@ -590,6 +709,54 @@ func (f *File) RemoveRow(sheet string, row int) error {
 	return f.adjustHelper(sheet, rows, row, -1)
 }
 
+// RemoveRows provides a function to remove multiple rows by given worksheet
+// name and Excel row numbers, the row numbers can be in any order and
+// non-contiguous. The rows will be removed and the remaining rows will be
+// re-indexed in a single pass, which is much faster than removing the rows one
+// by one with the RemoveRow function for removing a large number of rows. For
+// example, remove rows 3, 5 and 10 in Sheet1:
+//
+//	err := f.RemoveRows("Sheet1", []int{3, 5, 10})
+//
+// Use this method with caution, which will affect changes in references such
+// as formulas, charts, and so on. If there is any referenced value of the
+// worksheet, it will cause a file error when you open it. The excelize only
+// partially updates these references currently.
+func (f *File) RemoveRows(sheet string, rowNums []int) error {
+	removed := make([]int, 0, len(rowNums))
+	for _, row := range rowNums {
+		if row < 1 {
+			return newInvalidRowNumberError(row)
+		}
+		removed = append(removed, row)
+	}
+	ws, err := f.workSheetReader(sheet)
+	if err != nil || len(removed) == 0 {
+		return err
+	}
+	sort.Ints(removed)
+	keep := 0
+	for _, row := range removed {
+		if keep == 0 || removed[keep-1] != row {
+			removed[keep] = row
+			keep++
+		}
+	}
+	a := newDeletedNums(removed[:keep])
+	keep = 0
+	for rowIdx := 0; rowIdx < len(ws.SheetData.Row); rowIdx++ {
+		if v := &ws.SheetData.Row[rowIdx]; !a.isDeleted(v.R) {
+			ws.SheetData.Row[keep] = *v
+			keep++
+		}
+	}
+	ws.SheetData.Row = ws.SheetData.Row[:keep]
+	if err = f.adjustRowDimensions(sheet, ws, a); err != nil {
+		return err
+	}
+	return f.adjustReferences(ws, sheet, rows, a)
+}
+
 // InsertRows provides a function to insert new rows after the given Excel row
 // number starting from 1 and number of rows. For example, create two rows
 // before row 3 in Sheet1:
@@ -676,7 +843,7 @@ func (f *File) DuplicateRowTo(sheet string, row, row2 int) error {
 	}
 	rowCopy.C = append(make([]xlsxC, 0, len(rowCopy.C)), rowCopy.C...)
 	rowCopy.adjustSingleRowDimensions(row2 - row)
-	_ = f.adjustSingleRowFormulas(sheet, sheet, &rowCopy, row, row2-row, true)
+	_ = f.adjustSingleRowFormulas(sheet, sheet, &rowCopy, adjustNums{adjust: newAdjustNumFunc(row, row2-row)}, true)
 
 	if idx2 != -1 {
 		ws.SheetData.Row[idx2] = rowCopy
@@ -925,6 +1092,13 @@ func (f *File) SetRowStyle(sheet string, start, end, styleID int) error {
 	if err != nil {
 		return err
 	}
+	ws.setRowStyle(start, end, styleID)
+	return nil
+}
+
+// setRowStyle provides a function to set the style attribute of the rows and
+// the style of the existing cells in the rows by given row range and style ID.
+func (ws *xlsxWorksheet) setRowStyle(start, end, styleID int) {
 	ws.prepareSheetXML(0, end)
 	for row := start - 1; row < end; row++ {
 		ws.SheetData.Row[row].S = styleID
@@ -935,7 +1109,6 @@ func (f *File) SetRowStyle(sheet string, start, end, styleID int) error {
 			}
 		}
 	}
-	return nil
 }
 
 // convertRowHeightToPixels provides a function to convert the height of a
@@ -247,6 +247,60 @@ func TestColumns(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestRowsCells(t *testing.T) {
+	f := NewFile()
+	style, err := f.NewStyle(&Style{NumFmt: 14})
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Name", 45352, true}))
+	assert.NoError(t, f.SetCellStyle("Sheet1", "B1", "B1", style))
+	assert.NoError(t, f.SetCellStyle("Sheet1", "E1", "E1", style))
+	assert.NoError(t, f.SetCellFormula("Sheet1", "B3", "B1+1"))
+	rows, err := f.Rows("Sheet1")
+	assert.NoError(t, err)
+	var collectedCells [][]Cell
+	for rows.Next() {
+		cells, err := rows.Cells()
+		assert.NoError(t, err)
+		collectedCells = append(collectedCells, cells)
+	}
+	assert.NoError(t, rows.Close())
+	assert.Equal(t, [][]Cell{
+		{
+			{Value: "Name", Type: CellTypeSharedString},
+			{StyleID: style, Value: "03-01-24", Type: CellTypeUnset},
+			{Value: "TRUE", Type: CellTypeBool},
+			{},
+			{StyleID: style, Value: "", Type: CellTypeUnset},
+		},
+		nil,
+		{{}, {Formula: "B1+1", Value: "", Type: CellTypeFormula}},
+	}, collectedCells)
+
+	// Test get cells with raw cell value
+	rows, err = f.Rows("Sheet1")
+	assert.NoError(t, err)
+	assert.True(t, rows.Next())
+	cells, err := rows.Cells(Options{RawCellValue: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "45352", cells[1].Value)
+	assert.NoError(t, rows.Close())
+
+	// Test get cells with invalid cell reference
+	rows.decoder = f.xmlNewDecoder(bytes.NewReader([]byte(`<worksheet><sheetData><row r="1"><c r="A" t="s"><v>1</v></c></row></sheetData></worksheet>`)))
+	assert.True(t, rows.Next())
+	_, err = rows.Cells()
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), err)
+
+	// Test get cells with unsupported charset shared strings table
+	f.SharedStrings = nil
+	f.Pkg.Store(defaultXMLPathSharedStrings, MacintoshCyrillicCharset)
+	rows, err = f.Rows("Sheet1")
+	assert.NoError(t, err)
+	assert.True(t, rows.Next())
+	_, err = rows.Cells()
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+}
+
 func TestSharedStringsReader(t *testing.T) {
 	f := NewFile()
 	// Test read shared string with unsupported charset
@@ -296,6 +350,47 @@ func TestRowVisibility(t *testing.T) {
 	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestRowVisibility.xlsx")))
 }
 
+func TestGroupRows(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.GroupRows("Sheet1", 5, 2))
+	assert.NoError(t, f.GroupRows("Sheet1", 3, 4, GroupOptions{Collapsed: true}))
+	for row, expected := range map[int]uint8{1: 0, 2: 1, 3: 2, 4: 2, 5: 1, 6: 0} {
+		level, err := f.GetRowOutlineLevel("Sheet1", row)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, level)
+	}
+	for row, expected := range map[int]bool{2: true, 3: false, 4: false, 5: true} {
+		visible, err := f.GetRowVisible("Sheet1", row)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, visible)
+	}
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	assert.True(t, ws.(*xlsxWorksheet).SheetData.Row[4].Collapsed)
+	assert.Equal(t, uint8(2), ws.(*xlsxWorksheet).SheetFormatPr.OutlineLevelRow)
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestGroupRows.xlsx")))
+
+	// Test group rows with summary rows above detail
+	f = NewFile()
+	assert.NoError(t, f.SetSheetProps("Sheet1", &SheetPropsOptions{OutlineSummaryBelow: boolPtr(false)}))
+	assert.NoError(t, f.GroupRows("Sheet1", 2, 3, GroupOptions{Collapsed: true}))
+	ws, ok = f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	assert.True(t, ws.(*xlsxWorksheet).SheetData.Row[0].Collapsed)
+	assert.Len(t, ws.(*xlsxWorksheet).SheetData.Row, 3)
+	// Test group rows exceeds the maximum outline level
+	assert.NoError(t, f.SetRowOutlineLevel("Sheet1", 10, 7))
+	assert.Equal(t, ErrOutlineLevel, f.GroupRows("Sheet1", 9, 10))
+	level, err := f.GetRowOutlineLevel("Sheet1", 9)
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(0), level)
+	// Test group rows with invalid row number
+	assert.Equal(t, newInvalidRowNumberError(0), f.GroupRows("Sheet1", 0, 1))
+	assert.Equal(t, ErrMaxRows, f.GroupRows("Sheet1", 1, TotalRows+1))
+	// Test group rows on not exists worksheet
+	assert.EqualError(t, f.GroupRows("SheetN", 1, 2), "sheet SheetN does not exist")
+}
+
 func TestRemoveRow(t *testing.T) {
 	f := NewFile()
 	sheet1 := f.GetSheetName(0)
@@ -368,6 +463,76 @@ func TestRemoveRow(t *testing.T) {
 	assert.EqualError(t, f.RemoveRow("Sheet:1", 1), ErrSheetNameInvalid.Error())
 }
 
+func TestRemoveRows(t *testing.T) {
+	prepare := func() *File {
+		f := NewFile()
+		assert.NoError(t, fillCells(f, "Sheet1", 5, 12))
+		_, err := f.NewSheet("Sheet2")
+		assert.NoError(t, err)
+		assert.NoError(t, f.SetCellFormula("Sheet1", "F12", "SUM(A1:A11)"))
+		assert.NoError(t, f.SetCellFormula("Sheet1", "F1", "B9+C10"))
+		assert.NoError(t, f.SetCellFormula("Sheet2", "A1", "Sheet1!A12"))
+		assert.NoError(t, f.SetCellHyperLink("Sheet1", "A8", "https://github.com/xuri/excelize", "External"))
+		assert.NoError(t, f.MergeCell("Sheet1", "B2", "C3"))
+		assert.NoError(t, f.MergeCell("Sheet1", "B6", "C9"))
+		assert.NoError(t, f.AddDataValidation("Sheet1", &DataValidation{Sqref: "D10:D12"}))
+		return f
+	}
+	f, expected := prepare(), prepare()
+	assert.NoError(t, f.RemoveRows("Sheet1", []int{7, 3, 5, 3, 15, 11}))
+	for _, row := range []int{15, 11, 7, 5, 3} {
+		assert.NoError(t, expected.RemoveRow("Sheet1", row))
+	}
+	// Test the result is the same as removing the rows one by one
+	for _, sheet := range []string{"Sheet1", "Sheet2"} {
+		rows, err := f.GetRows(sheet)
+		assert.NoError(t, err)
+		expectedRows, err := expected.GetRows(sheet)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedRows, rows)
+	}
+	for _, item := range [][]string{{"Sheet1", "F8"}, {"Sheet1", "F1"}, {"Sheet2", "A1"}} {
+		formula, err := f.GetCellFormula(item[0], item[1])
+		assert.NoError(t, err)
+		expectedFormula, err := expected.GetCellFormula(item[0], item[1])
+		assert.NoError(t, err)
+		assert.Equal(t, expectedFormula, formula)
+	}
+	formula, err := f.GetCellFormula("Sheet1", "F8")
+	assert.NoError(t, err)
+	assert.Equal(t, "SUM(A1:A7)", formula)
+	mergeCells, err := f.GetMergeCells("Sheet1")
+	assert.NoError(t, err)
+	expectedMergeCells, err := expected.GetMergeCells("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMergeCells, mergeCells)
+	link, target, err := f.GetCellHyperLink("Sheet1", "A5")
+	assert.NoError(t, err)
+	assert.True(t, link)
+	assert.Equal(t, "https://github.com/xuri/excelize", target)
+	dvs, err := f.GetDataValidations("Sheet1")
+	assert.NoError(t, err)
+	expectedDvs, err := expected.GetDataValidations("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, expectedDvs, dvs)
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestRemoveRows.xlsx")))
+
+	// Test remove rows with empty row numbers
+	assert.NoError(t, f.RemoveRows("Sheet1", nil))
+	// Test remove rows with invalid row number
+	assert.Equal(t, newInvalidRowNumberError(0), f.RemoveRows("Sheet1", []int{1, 0}))
+	// Test remove rows on not exist worksheet
+	assert.EqualError(t, f.RemoveRows("SheetN", []int{1}), "sheet SheetN does not exist")
+	// Test remove rows with invalid sheet name
+	assert.EqualError(t, f.RemoveRows("Sheet:1", []int{1}), ErrSheetNameInvalid.Error())
+	// Test remove rows with unsupported charset worksheet
+	f.Sheet.Delete("xl/worksheets/sheet2.xml")
+	f.Pkg.Store("xl/worksheets/sheet2.xml", MacintoshCyrillicCharset)
+	assert.EqualError(t, f.RemoveRows("Sheet1", []int{1}), "XML syntax error on line 1: invalid UTF-8")
+	assert.NoError(t, f.Close())
+	assert.NoError(t, expected.Close())
+}
+
 func TestInsertRows(t *testing.T) {
 	f := NewFile()
 	sheet1 := f.GetSheetName(0)
@@ -915,6 +1080,7 @@ func TestDuplicateRow(t *testing.T) {
 	cfs, err := f.GetConditionalFormats("Sheet1")
 	assert.NoError(t, err)
 	assert.Len(t, cfs, 2)
+	expected[0].Priority = 1
 	assert.Equal(t, expected, cfs["A10:A10"])
 
 	dvs, err := f.GetDataValidations("Sheet1")
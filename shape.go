@@ -12,6 +12,7 @@
 package excelize
 
 import (
+	"encoding/xml"
 	"strconv"
 	"strings"
 )
@@ -78,6 +79,33 @@ func parseShapeOptions(opts *Shape) (*Shape, error) {
 //	    },
 //	)
 //
+// The optional parameter 'Name' specifies the name of the shape, the default
+// name is "Shape N". Use the name to connect the shape by the AddConnector
+// function or combine shapes by the GroupShapes function.
+//
+// The text in the shape will be written from right to left when the worksheet
+// displays from right to left, which is set by the 'RightToLeft' field of the
+// SetSheetView function or the options of the workbook.
+//
+// The optional parameters 'Hyperlink' and 'HyperlinkType' in 'Format' specify
+// the hyperlink of the shape, clicking the shape navigates to the website when
+// the 'HyperlinkType' is "External", or moves to one of the cells in this
+// workbook when the 'HyperlinkType' is "Location", the coordinates need to
+// start with "#". For example, add a clickable button which goes to the cell
+// A1 in Sheet2:
+//
+//	err := f.AddShape("Sheet1",
+//	    &excelize.Shape{
+//	        Cell:      "B2",
+//	        Type:      "roundRect",
+//	        Paragraph: []excelize.RichTextRun{{Text: "Go to Sheet2"}},
+//	        Format: excelize.GraphicOptions{
+//	            Hyperlink:     "#Sheet2!A1",
+//	            HyperlinkType: "Location",
+//	        },
+//	    },
+//	)
+//
 // The following shows the type of shape supported by excelize:
 //
 //	accentBorderCallout1 (Callout 1 with Border and Accent Shape)
@@ -316,7 +344,7 @@ func (f *File) AddShape(sheet string, opts *Shape) error {
 		f.addSheetDrawing(sheet, rID)
 		f.addSheetNameSpace(sheet, SourceRelationship)
 	}
-	if err = f.addDrawingShape(sheet, drawingXML, opts.Cell, options); err != nil {
+	if err = f.addDrawingShape(ws, sheet, drawingXML, opts.Cell, options); err != nil {
 		return err
 	}
 	return f.addContentTypePart(drawingID, "drawings")
@@ -355,7 +383,7 @@ func (f *File) twoCellAnchorShape(sheet, drawingXML, cell string, width, height
 
 // addDrawingShape provides a function to add preset geometry by given sheet,
 // drawingXML and format sets.
-func (f *File) addDrawingShape(sheet, drawingXML, cell string, opts *Shape) error {
+func (f *File) addDrawingShape(ws *xlsxWorksheet, sheet, drawingXML, cell string, opts *Shape) error {
 	content, twoCellAnchor, cNvPrID, err := f.twoCellAnchorShape(
 		sheet, drawingXML, cell, opts.Width, opts.Height, opts.Format)
 	if err != nil {
@@ -365,18 +393,28 @@ func (f *File) addDrawingShape(sheet, drawingXML, cell string, opts *Shape) erro
 	if len(opts.Fill.Color) == 1 {
 		solidColor = opts.Fill.Color[0]
 	}
+	name := opts.Name
+	if name == "" {
+		name = "Shape " + strconv.Itoa(cNvPrID)
+	}
+	x1, y1, x2, y2 := f.getAnchorPixels(sheet, twoCellAnchor.From, twoCellAnchor.To)
 	shape := xdrSp{
 		Macro: opts.Macro,
 		NvSpPr: &xdrNvSpPr{
 			CNvPr: &xlsxCNvPr{
-				ID:   cNvPrID,
-				Name: "Shape " + strconv.Itoa(cNvPrID),
+				ID:         cNvPrID,
+				Name:       name,
+				HlinkClick: drawHlinkClick(f.addDrawingHyperlink(drawingXML, &opts.Format)),
 			},
 			CNvSpPr: &xdrCNvSpPr{
 				TxBox: true,
 			},
 		},
 		SpPr: &xlsxSpPr{
+			Xfrm: xlsxXfrm{
+				Off: xlsxOff{X: x1 * EMU, Y: y1 * EMU},
+				Ext: aExt{Cx: (x2 - x1) * EMU, Cy: (y2 - y1) * EMU},
+			},
 			PrstGeom: xlsxPrstGeom{
 				Prst: opts.Type,
 			},
@@ -397,7 +435,7 @@ func (f *File) addDrawingShape(sheet, drawingXML, cell string, opts *Shape) erro
 				VertOverflow: "clip",
 				HorzOverflow: "clip",
 				Wrap:         "none",
-				RtlCol:       false,
+				RtlCol:       ws.isRightToLeft(),
 				Anchor:       "t",
 			},
 		},
@@ -435,16 +473,19 @@ func (f *File) addDrawingShape(sheet, drawingXML, cell string, opts *Shape) erro
 		if idx := inStrSlice(supportedDrawingUnderlineTypes, font.Underline, true); idx != -1 {
 			u = supportedDrawingUnderlineTypes[idx]
 		}
-		text := p.Text
+		text, lang := p.Text, p.Lang
 		if text == "" {
 			text = " "
 		}
+		if lang == "" {
+			lang = "en-US"
+		}
 		paragraph := &aP{
 			R: &aR{
 				RPr: aRPr{
 					I:       font.Italic,
 					B:       font.Bold,
-					Lang:    "en-US",
+					Lang:    lang,
 					AltLang: "en-US",
 					U:       u,
 					Sz:      font.Size * 100,
@@ -453,7 +494,7 @@ func (f *File) addDrawingShape(sheet, drawingXML, cell string, opts *Shape) erro
 				T: text,
 			},
 			EndParaRPr: &aEndParaRPr{
-				Lang: "en-US",
+				Lang: lang,
 			},
 		}
 		srgbClr := strings.ReplaceAll(strings.ToUpper(font.Color), "#", "")
@@ -464,6 +505,9 @@ func (f *File) addDrawingShape(sheet, drawingXML, cell string, opts *Shape) erro
 				},
 			}
 		}
+		if ws.isRightToLeft() {
+			paragraph.PPr = &aPPr{Algn: "r", Rtl: true, DefRPr: paragraph.R.RPr}
+		}
 		shape.TxBody.P = append(shape.TxBody.P, paragraph)
 	}
 	twoCellAnchor.Sp = &shape
@@ -496,3 +540,411 @@ func setShapeRef(color string, i int) *aRef {
 		},
 	}
 }
+
+// getAnchorPixels provides a function to get the absolute position in pixels
+// of the top left and bottom right corners of the drawing object by given
+// worksheet name and two cell anchor.
+func (f *File) getAnchorPixels(sheet string, from *xlsxFrom, to *xlsxTo) (int, int, int, int) {
+	var x1, y1, x2, y2 int
+	for col := 1; col <= to.Col; col++ {
+		width := f.getColWidth(sheet, col)
+		if col <= from.Col {
+			x1 += width
+		}
+		x2 += width
+	}
+	for row := 1; row <= to.Row; row++ {
+		height := f.getRowHeight(sheet, row)
+		if row <= from.Row {
+			y1 += height
+		}
+		y2 += height
+	}
+	return x1 + from.ColOff/EMU, y1 + from.RowOff/EMU, x2 + to.ColOff/EMU, y2 + to.RowOff/EMU
+}
+
+// getPixelsAnchor provides a function to get the cell anchor by given
+// worksheet name and absolute position in pixels.
+func (f *File) getPixelsAnchor(sheet string, x, y int) (int, int, int, int) {
+	var col, row int
+	for width := f.getColWidth(sheet, col+1); x >= width; width = f.getColWidth(sheet, col+1) {
+		x -= width
+		col++
+	}
+	for height := f.getRowHeight(sheet, row+1); y >= height; height = f.getRowHeight(sheet, row+1) {
+		y -= height
+		row++
+	}
+	return col, x * EMU, row, y * EMU
+}
+
+// drawingShape defines the structure used to locate a shape or a connector in
+// the drawing by its name.
+type drawingShape struct {
+	anchor         *xdrCellAnchor
+	id             int
+	x1, y1, x2, y2 int
+}
+
+// getDrawingShapes provides a function to get the shapes and connectors in
+// the two cell anchors of the drawing by given worksheet name and drawing
+// part, the key of the returned map is the name of the shape.
+func (f *File) getDrawingShapes(sheet string, wsDr *xlsxWsDr) map[string]*drawingShape {
+	shapes := map[string]*drawingShape{}
+	for _, anchor := range wsDr.TwoCellAnchor {
+		var (
+			cNvPr    *xlsxCNvPr
+			from, to = anchor.From, anchor.To
+		)
+		if anchor.GraphicFrame == "" {
+			if anchor.Sp != nil && anchor.Sp.NvSpPr != nil {
+				cNvPr = anchor.Sp.NvSpPr.CNvPr
+			}
+			if anchor.CxnSp != nil && anchor.CxnSp.NvCxnSpPr != nil {
+				cNvPr = anchor.CxnSp.NvCxnSpPr.CNvPr
+			}
+		} else {
+			deCellAnchor := decodeCellAnchor{}
+			_ = f.xmlNewDecoder(strings.NewReader("<decodeCellAnchor>" + anchor.GraphicFrame + "</decodeCellAnchor>")).Decode(&deCellAnchor)
+			if deCellAnchor.From != nil && deCellAnchor.To != nil {
+				from = &xlsxFrom{Col: deCellAnchor.From.Col, ColOff: deCellAnchor.From.ColOff, Row: deCellAnchor.From.Row, RowOff: deCellAnchor.From.RowOff}
+				to = &xlsxTo{Col: deCellAnchor.To.Col, ColOff: deCellAnchor.To.ColOff, Row: deCellAnchor.To.Row, RowOff: deCellAnchor.To.RowOff}
+			}
+			if deCellAnchor.Sp != nil && deCellAnchor.Sp.NvSpPr != nil && deCellAnchor.Sp.NvSpPr.CNvPr != nil {
+				cNvPr = &xlsxCNvPr{ID: deCellAnchor.Sp.NvSpPr.CNvPr.ID, Name: deCellAnchor.Sp.NvSpPr.CNvPr.Name}
+			}
+			if deCellAnchor.CxnSp != nil && deCellAnchor.CxnSp.NvCxnSpPr != nil && deCellAnchor.CxnSp.NvCxnSpPr.CNvPr != nil {
+				cNvPr = &xlsxCNvPr{ID: deCellAnchor.CxnSp.NvCxnSpPr.CNvPr.ID, Name: deCellAnchor.CxnSp.NvCxnSpPr.CNvPr.Name}
+			}
+		}
+		if cNvPr == nil || from == nil || to == nil {
+			continue
+		}
+		if _, ok := shapes[cNvPr.Name]; !ok {
+			x1, y1, x2, y2 := f.getAnchorPixels(sheet, from, to)
+			shapes[cNvPr.Name] = &drawingShape{anchor: anchor, id: cNvPr.ID, x1: x1, y1: y1, x2: x2, y2: y2}
+		}
+	}
+	return shapes
+}
+
+// getSheetDrawing provides a function to get the drawing part and the path of
+// the drawing part by given worksheet name. The returned drawing part will be
+// nil if the worksheet doesn't contain any drawing object.
+func (f *File) getSheetDrawing(sheet string) (*xlsxWsDr, string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ws, err := f.workSheetReader(sheet)
+	if err != nil || ws.Drawing == nil {
+		return nil, "", err
+	}
+	target := f.getSheetRelationshipsTargetByID(sheet, ws.Drawing.RID)
+	drawingXML := strings.TrimPrefix(strings.ReplaceAll(target, "..", "xl"), "/")
+	wsDr, _, err := f.drawingParser(drawingXML)
+	return wsDr, drawingXML, err
+}
+
+// getConnectionSites provides a function to get the connection site index
+// and the position in pixels of the connection site of the start and end
+// shapes. The connection sites 0, 1, 2 and 3 are the top, left, bottom and
+// right side of the shape.
+func getConnectionSites(start, end *drawingShape) (int, int, int, int, int, int) {
+	sites := func(s *drawingShape) [4][2]int {
+		cx, cy := (s.x1+s.x2)/2, (s.y1+s.y2)/2
+		return [4][2]int{{cx, s.y1}, {s.x1, cy}, {cx, s.y2}, {s.x2, cy}}
+	}
+	dx := (end.x1 + end.x2 - start.x1 - start.x2) / 2
+	dy := (end.y1 + end.y2 - start.y1 - start.y2) / 2
+	stIdx, endIdx := 3, 1
+	if dx < 0 {
+		stIdx, endIdx = 1, 3
+	}
+	if dy*dy > dx*dx {
+		stIdx, endIdx = 2, 0
+		if dy < 0 {
+			stIdx, endIdx = 0, 2
+		}
+	}
+	st, ed := sites(start)[stIdx], sites(end)[endIdx]
+	return stIdx, endIdx, st[0], st[1], ed[0], ed[1]
+}
+
+// AddConnector provides the method to add a connector shape between two
+// shapes in a worksheet by given worksheet name and connector settings. The
+// connector stays attached to the start and end shapes when they are moved in
+// the spreadsheet application. For example, connect the shape named
+// "Process" with the shape named "Decision" by an elbow connector with an
+// arrow in Sheet1:
+//
+//	err := f.AddShape("Sheet1", &excelize.Shape{
+//	    Cell: "B2", Type: "rect", Name: "Process",
+//	})
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	err = f.AddShape("Sheet1", &excelize.Shape{
+//	    Cell: "G8", Type: "flowChartDecision", Name: "Decision",
+//	})
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	err = f.AddConnector("Sheet1", &excelize.ShapeConnector{
+//	    Type:       "elbow",
+//	    StartShape: "Process",
+//	    EndShape:   "Decision",
+//	    EndArrow:   "triangle",
+//	    Line:       excelize.ShapeLine{Color: "4286F4"},
+//	})
+//
+// The optional parameter 'Name' specifies the name of the connector, the
+// default name is "Connector N".
+//
+// The required parameter 'Type' specifies the type of the connector, the
+// following types are supported:
+//
+//	Type     | Preset geometry
+//	---------+-------------------
+//	straight | straightConnector1
+//	elbow    | bentConnector3
+//	curved   | curvedConnector3
+//
+// The required parameters 'StartShape' and 'EndShape' specifies the names of
+// the shapes connected by the connector. The connector is attached to the
+// facing sides of the shapes.
+//
+// The optional parameters 'BeginArrow' and 'EndArrow' specifies the arrow
+// type at the beginning and end of the connector, the following types are
+// supported:
+//
+//	none
+//	triangle
+//	stealth
+//	diamond
+//	oval
+//	arrow
+//
+// The optional parameter 'Line' specifies the color and width of the
+// connector. The optional parameter 'Format' specifies the print and locked
+// settings of the connector.
+func (f *File) AddConnector(sheet string, opts *ShapeConnector) error {
+	if opts == nil || opts.StartShape == "" || opts.EndShape == "" {
+		return ErrParameterRequired
+	}
+	prst, ok := map[string]string{
+		"straight": "straightConnector1",
+		"elbow":    "bentConnector3",
+		"curved":   "curvedConnector3",
+	}[opts.Type]
+	if !ok {
+		return ErrParameterInvalid
+	}
+	for _, arrow := range []string{opts.BeginArrow, opts.EndArrow} {
+		if arrow != "" && inStrSlice(supportedLineEndTypes, arrow, true) == -1 {
+			return ErrParameterInvalid
+		}
+	}
+	wsDr, drawingXML, err := f.getSheetDrawing(sheet)
+	if err != nil {
+		return err
+	}
+	if wsDr == nil {
+		return newNoExistShapeError(opts.StartShape)
+	}
+	shapes := f.getDrawingShapes(sheet, wsDr)
+	start, ok := shapes[opts.StartShape]
+	if !ok {
+		return newNoExistShapeError(opts.StartShape)
+	}
+	end, ok := shapes[opts.EndShape]
+	if !ok {
+		return newNoExistShapeError(opts.EndShape)
+	}
+	_, cNvPrID, _ := f.drawingParser(drawingXML)
+	if opts.Name == "" {
+		opts.Name = "Connector " + strconv.Itoa(cNvPrID)
+	}
+	if opts.Line.Width == nil {
+		opts.Line.Width = float64Ptr(defaultShapeLineWidth)
+	}
+	if opts.Format.PrintObject == nil {
+		opts.Format.PrintObject = boolPtr(true)
+	}
+	if opts.Format.Locked == nil {
+		opts.Format.Locked = boolPtr(false)
+	}
+	stIdx, endIdx, x1, y1, x2, y2 := getConnectionSites(start, end)
+	left, top, right, bottom := x1, y1, x2, y2
+	if left > right {
+		left, right = right, left
+	}
+	if top > bottom {
+		top, bottom = bottom, top
+	}
+	fromCol, fromColOff, fromRow, fromRowOff := f.getPixelsAnchor(sheet, left, top)
+	toCol, toColOff, toRow, toRowOff := f.getPixelsAnchor(sheet, right, bottom)
+	connector := xdrCxnSp{
+		Macro: opts.Macro,
+		NvCxnSpPr: &xdrNvCxnSpPr{
+			CNvPr: &xlsxCNvPr{ID: cNvPrID, Name: opts.Name},
+			CNvCxnSpPr: &xdrCNvCxnSpPr{
+				StCxn:  &aCxn{ID: start.id, Idx: stIdx},
+				EndCxn: &aCxn{ID: end.id, Idx: endIdx},
+			},
+		},
+		SpPr: &xlsxSpPr{
+			Xfrm: xlsxXfrm{
+				FlipH: x2 < x1,
+				FlipV: y2 < y1,
+				Off:   xlsxOff{X: left * EMU, Y: top * EMU},
+				Ext:   aExt{Cx: (right - left) * EMU, Cy: (bottom - top) * EMU},
+			},
+			PrstGeom: xlsxPrstGeom{Prst: prst},
+			Ln:       xlsxLineProperties{W: f.ptToEMUs(*opts.Line.Width)},
+		},
+		Style: &xdrStyle{
+			LnRef:     &aRef{Idx: 1, SchemeClr: &attrValString{Val: stringPtr("accent1")}},
+			FillRef:   setShapeRef("", 0),
+			EffectRef: setShapeRef("", 0),
+			FontRef:   &aFontRef{Idx: "minor", SchemeClr: &attrValString{Val: stringPtr("tx1")}},
+		},
+	}
+	if opts.Line.Color != "" {
+		connector.Style.LnRef = setShapeRef(opts.Line.Color, 1)
+	}
+	if opts.BeginArrow != "" {
+		connector.SpPr.Ln.HeadEnd = &aLineEnd{Type: opts.BeginArrow}
+	}
+	if opts.EndArrow != "" {
+		connector.SpPr.Ln.TailEnd = &aLineEnd{Type: opts.EndArrow}
+	}
+	wsDr.TwoCellAnchor = append(wsDr.TwoCellAnchor, &xdrCellAnchor{
+		EditAs:     opts.Format.Positioning,
+		From:       &xlsxFrom{Col: fromCol, ColOff: fromColOff, Row: fromRow, RowOff: fromRowOff},
+		To:         &xlsxTo{Col: toCol, ColOff: toColOff, Row: toRow, RowOff: toRowOff},
+		CxnSp:      &connector,
+		ClientData: &xdrClientData{FLocksWithSheet: *opts.Format.Locked, FPrintsWithSheet: *opts.Format.PrintObject},
+	})
+	return err
+}
+
+// extractAnchorObject provides a function to extract the XML of the drawing
+// object in the cell anchor which loaded from the drawing part.
+func extractAnchorObject(content string) string {
+	decoder := xml.NewDecoder(strings.NewReader(content))
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.Token()
+		if err != nil {
+			return ""
+		}
+		if element, ok := token.(xml.StartElement); ok {
+			if err = decoder.Skip(); err != nil {
+				return ""
+			}
+			if inStrSlice([]string{"sp", "cxnSp"}, element.Name.Local, true) != -1 {
+				return content[offset:decoder.InputOffset()]
+			}
+		}
+	}
+}
+
+// GroupShapes provides the method to combine the shapes and connectors into a
+// group shape by given worksheet name, group name and the names of the shapes.
+// At least two shapes are required. The connectors connected to the grouped
+// shapes stay attached to them. For example, group the shapes named
+// "Process", "Decision" and the connector named "Connector 4" in Sheet1:
+//
+//	err := f.GroupShapes("Sheet1", "Flowchart", []string{"Process", "Decision", "Connector 4"})
+func (f *File) GroupShapes(sheet, name string, shapes []string) error {
+	if len(shapes) < 2 {
+		return ErrParameterInvalid
+	}
+	wsDr, drawingXML, err := f.getSheetDrawing(sheet)
+	if err != nil {
+		return err
+	}
+	if wsDr == nil {
+		return newNoExistShapeError(shapes[0])
+	}
+	var (
+		drawingShapes  = f.getDrawingShapes(sheet, wsDr)
+		grouped        = map[*xdrCellAnchor]bool{}
+		children       strings.Builder
+		x1, y1, x2, y2 int
+	)
+	for i, shapeName := range shapes {
+		shape, ok := drawingShapes[shapeName]
+		if !ok {
+			return newNoExistShapeError(shapeName)
+		}
+		if grouped[shape.anchor] {
+			return ErrParameterInvalid
+		}
+		grouped[shape.anchor] = true
+		if i == 0 || shape.x1 < x1 {
+			x1 = shape.x1
+		}
+		if i == 0 || shape.y1 < y1 {
+			y1 = shape.y1
+		}
+		if i == 0 || shape.x2 > x2 {
+			x2 = shape.x2
+		}
+		if i == 0 || shape.y2 > y2 {
+			y2 = shape.y2
+		}
+	}
+	_, cNvPrID, _ := f.drawingParser(drawingXML)
+	if name == "" {
+		name = "Group " + strconv.Itoa(cNvPrID)
+	}
+	nvGrpSpPr, _ := xml.Marshal(xdrNvGrpSpPr{
+		CNvPr:      &xlsxCNvPr{ID: cNvPrID, Name: name},
+		CNvGrpSpPr: &xlsxInnerXML{},
+	})
+	off, ext := xlsxOff{X: x1 * EMU, Y: y1 * EMU}, aExt{Cx: (x2 - x1) * EMU, Cy: (y2 - y1) * EMU}
+	grpSpPr, _ := xml.Marshal(xdrGrpSpPr{Xfrm: &xlsxGrpXfrm{Off: off, Ext: ext, ChOff: off, ChExt: ext}})
+	children.Write(nvGrpSpPr)
+	children.Write(grpSpPr)
+	for _, shapeName := range shapes {
+		anchor := drawingShapes[shapeName].anchor
+		if anchor.GraphicFrame != "" {
+			children.WriteString(extractAnchorObject(anchor.GraphicFrame))
+			continue
+		}
+		var child []byte
+		if anchor.Sp != nil {
+			child, _ = xml.Marshal(anchor.Sp)
+		}
+		if anchor.CxnSp != nil {
+			child, _ = xml.Marshal(anchor.CxnSp)
+		}
+		children.Write(child)
+	}
+	fromCol, fromColOff, fromRow, fromRowOff := f.getPixelsAnchor(sheet, x1, y1)
+	toCol, toColOff, toRow, toRowOff := f.getPixelsAnchor(sheet, x2, y2)
+	cellAnchor, _ := xml.Marshal(xlsxCellAnchorPos{
+		From:       &xlsxFrom{Col: fromCol, ColOff: fromColOff, Row: fromRow, RowOff: fromRowOff},
+		To:         &xlsxTo{Col: toCol, ColOff: toColOff, Row: toRow, RowOff: toRowOff},
+		GrpSp:      &xlsxInnerXML{Content: children.String()},
+		ClientData: &xlsxInnerXML{},
+	})
+	group := &xdrCellAnchor{
+		GraphicFrame: strings.TrimSuffix(strings.TrimPrefix(string(cellAnchor), "<xlsxCellAnchorPos>"), "</xlsxCellAnchorPos>"),
+	}
+	var anchors []*xdrCellAnchor
+	for _, anchor := range wsDr.TwoCellAnchor {
+		if !grouped[anchor] {
+			anchors = append(anchors, anchor)
+			continue
+		}
+		if group != nil {
+			anchors = append(anchors, group)
+			group = nil
+		}
+	}
+	wsDr.TwoCellAnchor = anchors
+	return err
+}
@@ -1,6 +1,7 @@
 package excelize
 
 import (
+	"encoding/xml"
 	"path/filepath"
 	"testing"
 
@@ -77,6 +78,35 @@ func TestAddShape(t *testing.T) {
 			},
 			Height: 90,
 		}))
+	// Test add shape with hyperlink
+	assert.NoError(t, f.AddShape("Sheet1", &Shape{
+		Cell: "E1", Type: "roundRect", Paragraph: []RichTextRun{{Text: "Go to Sheet1"}},
+		Format: GraphicOptions{Hyperlink: "#Sheet1!A1", HyperlinkType: "Location"},
+	}))
+	assert.NoError(t, f.AddShape("Sheet1", &Shape{
+		Cell: "E5", Type: "roundRect", Paragraph: []RichTextRun{{Text: "Excelize"}},
+		Format: GraphicOptions{Hyperlink: "https://github.com/xuri/excelize", HyperlinkType: "External"},
+	}))
+	// Test add shape with the language of the rich text run
+	assert.NoError(t, f.AddShape("Sheet1", &Shape{
+		Cell: "E9", Type: "rect", Paragraph: []RichTextRun{{Text: "Bonjour", Lang: "fr-FR"}, {Text: "Hello"}},
+	}))
+	drawing, ok := f.Drawings.Load("xl/drawings/drawing1.xml")
+	assert.True(t, ok)
+	anchors := drawing.(*xlsxWsDr).TwoCellAnchor
+	for i, lang := range []string{"fr-FR", "en-US"} {
+		assert.Equal(t, lang, anchors[3].Sp.TxBody.P[i].R.RPr.Lang)
+		assert.Equal(t, lang, anchors[3].Sp.TxBody.P[i].EndParaRPr.Lang)
+	}
+	assert.Nil(t, anchors[0].Sp.NvSpPr.CNvPr.HlinkClick)
+	assert.Equal(t, &xlsxHlinkClick{R: SourceRelationship.Value, RID: "rId1"}, anchors[1].Sp.NvSpPr.CNvPr.HlinkClick)
+	assert.Equal(t, &xlsxHlinkClick{R: SourceRelationship.Value, RID: "rId2"}, anchors[2].Sp.NvSpPr.CNvPr.HlinkClick)
+	rels, err := f.relsReader("xl/drawings/_rels/drawing1.xml.rels")
+	assert.NoError(t, err)
+	assert.Equal(t, []xlsxRelationship{
+		{ID: "rId1", Type: SourceRelationshipHyperLink, Target: "#Sheet1!A1"},
+		{ID: "rId2", Type: SourceRelationshipHyperLink, Target: "https://github.com/xuri/excelize", TargetMode: "External"},
+	}, rels.Relationships)
 	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestAddShape2.xlsx")))
 	// Test add shape with invalid sheet name
 	assert.Equal(t, ErrSheetNameInvalid, f.AddShape("Sheet:1", &Shape{
@@ -102,7 +132,9 @@ func TestAddDrawingShape(t *testing.T) {
 	f := NewFile()
 	path := "xl/drawings/drawing1.xml"
 	f.Pkg.Store(path, MacintoshCyrillicCharset)
-	assert.EqualError(t, f.addDrawingShape("sheet1", path, "A1",
+	ws, err := f.workSheetReader("Sheet1")
+	assert.NoError(t, err)
+	assert.EqualError(t, f.addDrawingShape(ws, "sheet1", path, "A1",
 		&Shape{
 			Width:  defaultShapeSize,
 			Height: defaultShapeSize,
@@ -113,3 +145,113 @@ func TestAddDrawingShape(t *testing.T) {
 		},
 	), "XML syntax error on line 1: invalid UTF-8")
 }
+
+func TestAddConnector(t *testing.T) {
+	f := NewFile()
+	// Test add connector without drawing
+	assert.EqualError(t, f.AddConnector("Sheet1", &ShapeConnector{Type: "straight", StartShape: "Start", EndShape: "End"}), "shape Start does not exist")
+	assert.NoError(t, f.AddShape("Sheet1", &Shape{Cell: "B2", Type: "rect", Name: "Start"}))
+	assert.NoError(t, f.AddShape("Sheet1", &Shape{Cell: "H2", Type: "ellipse", Name: "Right"}))
+	assert.NoError(t, f.AddShape("Sheet1", &Shape{Cell: "B12", Type: "flowChartDecision", Name: "Bottom"}))
+	assert.NoError(t, f.AddShape("Sheet1", &Shape{Cell: "A6", Type: "rect"}))
+	assert.NoError(t, f.AddConnector("Sheet1", &ShapeConnector{Type: "straight", StartShape: "Start", EndShape: "Right", EndArrow: "triangle"}))
+	assert.NoError(t, f.AddConnector("Sheet1", &ShapeConnector{Name: "Elbow", Type: "elbow", StartShape: "Right", EndShape: "Bottom", Line: ShapeLine{Color: "4286F4"}}))
+	assert.NoError(t, f.AddConnector("Sheet1", &ShapeConnector{Type: "curved", StartShape: "Bottom", EndShape: "Start", BeginArrow: "oval"}))
+	assert.NoError(t, f.AddConnector("Sheet1", &ShapeConnector{Type: "straight", StartShape: "Right", EndShape: "Start"}))
+	drawing, ok := f.Drawings.Load("xl/drawings/drawing1.xml")
+	assert.True(t, ok)
+	wsDr := drawing.(*xlsxWsDr)
+	assert.Len(t, wsDr.TwoCellAnchor, 8)
+	for i, expected := range []struct {
+		name                string
+		stCxn, endCxn       aCxn
+		flipH, flipV, arrow bool
+	}{
+		{name: "Connector 6", stCxn: aCxn{ID: 2, Idx: 3}, endCxn: aCxn{ID: 3, Idx: 1}, arrow: true},
+		{name: "Elbow", stCxn: aCxn{ID: 3, Idx: 1}, endCxn: aCxn{ID: 4, Idx: 3}, flipH: true},
+		{name: "Connector 8", stCxn: aCxn{ID: 4, Idx: 0}, endCxn: aCxn{ID: 2, Idx: 2}, flipV: true},
+		{name: "Connector 9", stCxn: aCxn{ID: 3, Idx: 1}, endCxn: aCxn{ID: 2, Idx: 3}, flipH: true},
+	} {
+		cxnSp := wsDr.TwoCellAnchor[i+4].CxnSp
+		assert.Equal(t, expected.name, cxnSp.NvCxnSpPr.CNvPr.Name)
+		assert.Equal(t, expected.stCxn, *cxnSp.NvCxnSpPr.CNvCxnSpPr.StCxn)
+		assert.Equal(t, expected.endCxn, *cxnSp.NvCxnSpPr.CNvCxnSpPr.EndCxn)
+		assert.Equal(t, expected.flipH, cxnSp.SpPr.Xfrm.FlipH)
+		assert.Equal(t, expected.flipV, cxnSp.SpPr.Xfrm.FlipV)
+		assert.Equal(t, expected.arrow, cxnSp.SpPr.Ln.TailEnd != nil)
+	}
+	assert.Equal(t, "4286F4", *wsDr.TwoCellAnchor[5].CxnSp.Style.LnRef.SrgbClr.Val)
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestAddConnector.xlsx")))
+	assert.NoError(t, f.Close())
+
+	// Test add connector between the shapes after reopen the workbook
+	f, err := OpenFile(filepath.Join("test", "TestAddConnector.xlsx"))
+	assert.NoError(t, err)
+	assert.NoError(t, f.AddConnector("Sheet1", &ShapeConnector{Type: "straight", StartShape: "Shape 5", EndShape: "Elbow"}))
+	drawing, ok = f.Drawings.Load("xl/drawings/drawing1.xml")
+	assert.True(t, ok)
+	wsDr = drawing.(*xlsxWsDr)
+	assert.Equal(t, aCxn{ID: 5, Idx: 3}, *wsDr.TwoCellAnchor[8].CxnSp.NvCxnSpPr.CNvCxnSpPr.StCxn)
+	assert.Equal(t, aCxn{ID: 7, Idx: 1}, *wsDr.TwoCellAnchor[8].CxnSp.NvCxnSpPr.CNvCxnSpPr.EndCxn)
+	// Test add connector with invalid options
+	assert.Equal(t, ErrParameterRequired, f.AddConnector("Sheet1", nil))
+	assert.Equal(t, ErrParameterRequired, f.AddConnector("Sheet1", &ShapeConnector{Type: "straight", StartShape: "Start"}))
+	assert.Equal(t, ErrParameterInvalid, f.AddConnector("Sheet1", &ShapeConnector{Type: "unknown", StartShape: "Start", EndShape: "Right"}))
+	assert.Equal(t, ErrParameterInvalid, f.AddConnector("Sheet1", &ShapeConnector{Type: "straight", StartShape: "Start", EndShape: "Right", EndArrow: "unknown"}))
+	// Test add connector with not exist shapes
+	assert.EqualError(t, f.AddConnector("Sheet1", &ShapeConnector{Type: "straight", StartShape: "ShapeN", EndShape: "Right"}), "shape ShapeN does not exist")
+	assert.EqualError(t, f.AddConnector("Sheet1", &ShapeConnector{Type: "straight", StartShape: "Start", EndShape: "ShapeN"}), "shape ShapeN does not exist")
+	// Test add connector on not exists worksheet
+	assert.EqualError(t, f.AddConnector("SheetN", &ShapeConnector{Type: "straight", StartShape: "Start", EndShape: "Right"}), "sheet SheetN does not exist")
+	assert.NoError(t, f.Close())
+}
+
+func TestGroupShapes(t *testing.T) {
+	f := NewFile()
+	// Test group shapes without drawing
+	assert.EqualError(t, f.GroupShapes("Sheet1", "Group", []string{"Start", "End"}), "shape Start does not exist")
+	assert.NoError(t, f.AddShape("Sheet1", &Shape{Cell: "B2", Type: "rect", Name: "Start"}))
+	assert.NoError(t, f.AddShape("Sheet1", &Shape{Cell: "H6", Type: "ellipse", Name: "End"}))
+	assert.NoError(t, f.AddShape("Sheet1", &Shape{Cell: "B20", Type: "rect", Name: "Single"}))
+	assert.NoError(t, f.AddConnector("Sheet1", &ShapeConnector{Name: "Line", Type: "elbow", StartShape: "Start", EndShape: "End"}))
+	assert.NoError(t, f.GroupShapes("Sheet1", "Flowchart", []string{"Start", "Line", "End"}))
+	drawing, ok := f.Drawings.Load("xl/drawings/drawing1.xml")
+	assert.True(t, ok)
+	wsDr := drawing.(*xlsxWsDr)
+	assert.Len(t, wsDr.TwoCellAnchor, 2)
+	assert.Equal(t, "Single", wsDr.TwoCellAnchor[1].Sp.NvSpPr.CNvPr.Name)
+	deCellAnchor, deCellAnchorPos := decodeCellAnchor{}, decodeCellAnchorPos{}
+	assert.NoError(t, xml.Unmarshal([]byte("<decodeCellAnchor>"+wsDr.TwoCellAnchor[0].GraphicFrame+"</decodeCellAnchor>"), &deCellAnchor))
+	assert.NoError(t, xml.Unmarshal([]byte("<decodeCellAnchorPos>"+wsDr.TwoCellAnchor[0].GraphicFrame+"</decodeCellAnchorPos>"), &deCellAnchorPos))
+	assert.Equal(t, &decodeFrom{Col: 1, Row: 1}, deCellAnchor.From)
+	assert.Contains(t, deCellAnchorPos.GrpSp.Content, `<xdr:cNvPr id="6" name="Flowchart" descr=""></xdr:cNvPr>`)
+	assert.Contains(t, deCellAnchorPos.GrpSp.Content, `<a:stCxn id="2" idx="3"></a:stCxn><a:endCxn id="3" idx="1"></a:endCxn>`)
+	// Test group shape with the group on reopen
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestGroupShapes.xlsx")))
+	assert.NoError(t, f.Close())
+
+	f, err := OpenFile(filepath.Join("test", "TestGroupShapes.xlsx"))
+	assert.NoError(t, err)
+	// Test group shapes with the shapes in the group
+	assert.EqualError(t, f.GroupShapes("Sheet1", "Group", []string{"Start", "Single"}), "shape Start does not exist")
+	assert.NoError(t, f.AddShape("Sheet1", &Shape{Cell: "H20", Type: "rect", Name: "Other"}))
+	assert.NoError(t, f.GroupShapes("Sheet1", "", []string{"Single", "Other"}))
+	drawing, ok = f.Drawings.Load("xl/drawings/drawing1.xml")
+	assert.True(t, ok)
+	wsDr = drawing.(*xlsxWsDr)
+	assert.Len(t, wsDr.TwoCellAnchor, 2)
+	assert.Contains(t, wsDr.TwoCellAnchor[1].GraphicFrame, `name="Group 8"`)
+	assert.Contains(t, wsDr.TwoCellAnchor[1].GraphicFrame, `name="Single"`)
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestGroupShapes.xlsx")))
+	// Test group shapes with invalid shapes
+	assert.Equal(t, ErrParameterInvalid, f.GroupShapes("Sheet1", "Group", []string{"Single"}))
+	assert.NoError(t, f.AddShape("Sheet1", &Shape{Cell: "A1", Type: "rect", Name: "A"}))
+	assert.Equal(t, ErrParameterInvalid, f.GroupShapes("Sheet1", "Group", []string{"A", "A"}))
+	assert.EqualError(t, f.GroupShapes("Sheet1", "Group", []string{"A", "ShapeN"}), "shape ShapeN does not exist")
+	// Test group shapes on not exists worksheet
+	assert.EqualError(t, f.GroupShapes("SheetN", "Group", []string{"A", "B"}), "sheet SheetN does not exist")
+	assert.NoError(t, f.Close())
+	// Test extract drawing object with invalid XML
+	assert.Empty(t, extractAnchorObject("<xdr:sp>"))
+	assert.Empty(t, extractAnchorObject("<xdr:from></xdr:from>"))
+}
@@ -30,6 +30,10 @@ import (
 	"github.com/mohae/deepcopy"
 )
 
+// chartFormulaExp defined the regular expression to match the formula elements
+// of the data references in the chart part.
+var chartFormulaExp = regexp.MustCompile(`(<(?:\w+:)?f>)([^<]*)(</(?:\w+:)?f>)`)
+
 // NewSheet provides the function to create a new sheet by given a worksheet
 // name and returns the index of the sheets in the workbook after it appended.
 // Note that when creating a new workbook, the default worksheet named
@@ -151,6 +155,13 @@ func (f *File) workSheetWriter() {
 				f.mergeExpandedCols(sheet)
 			}
 			sheet.SheetData.Row = trimRow(&sheet.SheetData)
+			sheet.mergeIgnoredErrors()
+			if f.options != nil && f.options.AutoDimension {
+				sheet.Dimension = &xlsxDimension{Ref: "A1"}
+				if ref := sheet.getUsedRange(); ref != "" {
+					sheet.Dimension.Ref = ref
+				}
+			}
 			if sheet.SheetPr != nil || sheet.Drawing != nil || sheet.Hyperlinks != nil || sheet.Picture != nil || sheet.TableParts != nil {
 				f.addNameSpaces(p.(string), SourceRelationship)
 			}
@@ -234,7 +245,7 @@ func (f *File) setSheet(index int, name string) {
 	ws := xlsxWorksheet{
 		Dimension: &xlsxDimension{Ref: "A1"},
 		SheetViews: &xlsxSheetViews{
-			SheetView: []xlsxSheetView{{WorkbookViewID: 0}},
+			SheetView: []xlsxSheetView{{WorkbookViewID: 0, RightToLeft: f.options.RightToLeft}},
 		},
 	}
 	sheetXMLPath := "xl/worksheets/sheet" + strconv.Itoa(index) + ".xml"
@@ -294,7 +305,7 @@ func (f *File) SetActiveSheet(index int) {
 		ws, err := f.workSheetReader(name)
 		if err != nil {
 			// Chartsheet, macrosheet or dialogsheet
-			return
+			continue
 		}
 		if ws.SheetViews == nil {
 			ws.SheetViews = &xlsxSheetViews{
@@ -316,6 +327,107 @@ func (f *File) SetActiveSheet(index int) {
 	}
 }
 
+// SetActiveSheetByName provides a function to set the default active sheet
+// of the workbook by a given sheet name. The sheet will be selected, and the
+// other sheets will be unselected. For example, set Sheet2 as the active
+// sheet:
+//
+//	err := f.SetActiveSheetByName("Sheet2")
+func (f *File) SetActiveSheetByName(sheet string) error {
+	index, err := f.GetSheetIndex(sheet)
+	if err != nil {
+		return err
+	}
+	if index == -1 {
+		return ErrSheetNotExist{sheet}
+	}
+	f.SetActiveSheet(index)
+	return err
+}
+
+// SetSelectedSheets provides a function to select a group of sheets by given
+// sheet names. The tabs of the given sheets will be marked as selected, and
+// the other sheets will be unselected. The active sheet stays active when it
+// is one of the given sheets, otherwise the first given worksheet will be set
+// as the active sheet. The active sheet is always selected, so giving an empty
+// list only keeps the active sheet selected. The tab selection state of
+// chartsheets, macrosheets and dialogsheets will be ignored. For example,
+// select Sheet1 and Sheet3 as a group, and keep Sheet3 as the active sheet:
+//
+//	if err := f.SetActiveSheetByName("Sheet3"); err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	err := f.SetSelectedSheets([]string{"Sheet1", "Sheet3"})
+func (f *File) SetSelectedSheets(sheets []string) error {
+	var (
+		indexes    []int
+		worksheets []*xlsxWorksheet
+		selected   = map[int]bool{}
+	)
+	for _, sheet := range sheets {
+		index, err := f.GetSheetIndex(sheet)
+		if err != nil {
+			return err
+		}
+		if index == -1 {
+			return ErrSheetNotExist{sheet}
+		}
+		name := f.GetSheetName(index)
+		ws, err := f.workSheetReader(name)
+		if err != nil {
+			if err.Error() == newNotWorksheetError(name).Error() {
+				continue
+			}
+			return err
+		}
+		indexes, selected[index] = append(indexes, index), true
+		worksheets = append(worksheets, ws)
+	}
+	activeTab := f.GetActiveSheetIndex()
+	if len(indexes) > 0 && !selected[activeTab] {
+		activeTab = indexes[0]
+	}
+	f.SetActiveSheet(activeTab)
+	for _, ws := range worksheets {
+		if ws.SheetViews == nil {
+			ws.SheetViews = &xlsxSheetViews{}
+		}
+		if len(ws.SheetViews.SheetView) == 0 {
+			ws.SheetViews.SheetView = append(ws.SheetViews.SheetView, xlsxSheetView{})
+		}
+		ws.SheetViews.SheetView[0].TabSelected = true
+	}
+	return nil
+}
+
+// GetSelectedSheets provides a function to get the names of the selected
+// worksheets in the workbook, the names are returned in the order of the sheet
+// tabs.
+func (f *File) GetSelectedSheets() ([]string, error) {
+	var sheets []string
+	for _, name := range f.GetSheetList() {
+		ws, err := f.workSheetReader(name)
+		if err != nil {
+			if err.Error() == newNotWorksheetError(name).Error() {
+				continue
+			}
+			return sheets, err
+		}
+		if ws.SheetViews != nil && len(ws.SheetViews.SheetView) > 0 && ws.SheetViews.SheetView[0].TabSelected {
+			sheets = append(sheets, name)
+		}
+	}
+	return sheets, nil
+}
+
+// GetActiveSheetName provides a function to get active sheet name of the
+// spreadsheet. If not found the active sheet, the name of the first sheet
+// will be returned.
+func (f *File) GetActiveSheetName() string {
+	return f.GetSheetName(f.GetActiveSheetIndex())
+}
+
 // GetActiveSheetIndex provides a function to get active sheet index of the
 // spreadsheet. If not found the active sheet will be return integer 0.
 func (f *File) GetActiveSheetIndex() (index int) {
@@ -449,8 +561,8 @@ func (f *File) GetSheetMap() map[int]string {
 	return sheetMap
 }
 
-// GetSheetList provides a function to get worksheets, chart sheets, and
-// dialog sheets name list of the workbook.
+// GetSheetList provides a function to get worksheets, chart sheets, dialog
+// sheets and macro sheets name list of the workbook.
 func (f *File) GetSheetList() (list []string) {
 	wb, _ := f.workbookReader()
 	if wb != nil {
@@ -461,6 +573,52 @@ func (f *File) GetSheetList() (list []string) {
 	return
 }
 
+// SheetType is the type of sheet in the workbook.
+type SheetType byte
+
+// Sheet types enumeration.
+const (
+	SheetTypeUnset SheetType = iota
+	SheetTypeWorksheet
+	SheetTypeChartsheet
+	SheetTypeDialogsheet
+	SheetTypeMacrosheet
+)
+
+// GetSheetType provides a function to get the type of the sheet by given
+// sheet name, the type will be one of SheetTypeWorksheet, SheetTypeChartsheet,
+// SheetTypeDialogsheet and SheetTypeMacrosheet. The dialog sheets and macro
+// sheets are used by legacy templates, they will be preserved on saving the
+// workbook, but can't be modified by the worksheet functions. For example,
+// get the type of the sheet named Sheet1:
+//
+//	sheetType, err := f.GetSheetType("Sheet1")
+func (f *File) GetSheetType(sheet string) (SheetType, error) {
+	if err := checkSheetName(sheet); err != nil {
+		return SheetTypeUnset, err
+	}
+	name, ok := f.getSheetXMLPath(sheet)
+	if !ok {
+		return SheetTypeUnset, ErrSheetNotExist{sheet}
+	}
+	return getSheetType(name), nil
+}
+
+// getSheetType provides a function to get the type of the sheet by given
+// sheet XML path.
+func getSheetType(name string) SheetType {
+	for prefix, sheetType := range map[string]SheetType{
+		"xl/chartsheets": SheetTypeChartsheet,
+		"xl/dialogsheet": SheetTypeDialogsheet,
+		"xl/macrosheet":  SheetTypeMacrosheet,
+	} {
+		if strings.HasPrefix(name, prefix) {
+			return sheetType
+		}
+	}
+	return SheetTypeWorksheet
+}
+
 // getSheetMap provides a function to get worksheet name and XML file path map
 // of the spreadsheet.
 func (f *File) getSheetMap() (map[string]string, error) {
@@ -682,8 +840,18 @@ func (f *File) getSheetRelationshipsTargetByID(sheet, rID string) string {
 }
 
 // CopySheet provides a function to duplicate a worksheet by gave source and
-// target worksheet index. Note that currently doesn't support duplicate
-// workbooks that contain tables, charts or pictures. For Example:
+// target worksheet index. The cells, styles, merged cells, column widths,
+// conditional formats and sheet scoped defined names will be copied. The
+// drawings, charts, comments and tables in the source worksheet will be
+// deep-copied into new parts, so editing the target worksheet doesn't affect
+// the source worksheet. The pictures in the copied drawing share the same
+// media with the source worksheet, the data references of the copied charts
+// which refer to the source worksheet will refer to the target worksheet, and
+// the copied tables will be renamed with the suffix of table ID to keep the
+// table name unique in the workbook, and the structured references to the
+// copied tables in the formulas of the target worksheet will be renamed as
+// well. Note that currently doesn't support duplicate pivot tables and printer
+// settings. For Example:
 //
 //	// Sheet1 already exists...
 //	index, err := f.NewSheet("Sheet2")
@@ -702,32 +870,296 @@ func (f *File) CopySheet(from, to int) error {
 // copySheet provides a function to duplicate a worksheet by gave source and
 // target worksheet name.
 func (f *File) copySheet(from, to int) error {
-	fromSheet := f.GetSheetName(from)
+	fromSheet, toSheet := f.GetSheetName(from), f.GetSheetName(to)
 	sheet, err := f.workSheetReader(fromSheet)
 	if err != nil {
 		return err
 	}
+	sheet.mergeIgnoredErrors()
 	worksheet := deepcopy.Copy(sheet).(*xlsxWorksheet)
-	toSheetID := strconv.Itoa(f.getSheetID(f.GetSheetName(to)))
+	toSheetID := strconv.Itoa(f.getSheetID(toSheet))
 	sheetXMLPath := "xl/worksheets/sheet" + toSheetID + ".xml"
 	if len(worksheet.SheetViews.SheetView) > 0 {
 		worksheet.SheetViews.SheetView[0].TabSelected = false
 	}
-	worksheet.Drawing = nil
-	worksheet.TableParts = nil
-	worksheet.PageSetUp = nil
-	f.Sheet.Store(sheetXMLPath, worksheet)
-	toRels := "xl/worksheets/_rels/sheet" + toSheetID + ".xml.rels"
-	fromRels := "xl/worksheets/_rels/sheet" + strconv.Itoa(f.getSheetID(fromSheet)) + ".xml.rels"
-	if rels, ok := f.Pkg.Load(fromRels); ok && rels != nil {
-		f.Pkg.Store(toRels, rels.([]byte))
+	if worksheet.PageSetUp != nil {
+		worksheet.PageSetUp.RID = ""
 	}
+	f.Sheet.Store(sheetXMLPath, worksheet)
 	fromSheetXMLPath, _ := f.getSheetXMLPath(fromSheet)
 	fromSheetAttr, _ := f.xmlAttr.Load(fromSheetXMLPath)
 	f.xmlAttr.Store(sheetXMLPath, fromSheetAttr)
+	if err = f.copySheetDefinedNames(from, to, fromSheet, toSheet); err != nil {
+		return err
+	}
+	rels, err := f.relsReader("xl/worksheets/_rels/" + strings.TrimPrefix(fromSheetXMLPath, "xl/worksheets/") + ".rels")
+	if err != nil || rels == nil {
+		return err
+	}
+	rels.mu.Lock()
+	relationships := make([]xlsxRelationship, len(rels.Relationships))
+	copy(relationships, rels.Relationships)
+	rels.mu.Unlock()
+	toRels, tableNames := &xlsxRelationships{}, map[string]string{}
+	for _, rel := range relationships {
+		target := strings.TrimPrefix(strings.ReplaceAll(rel.Target, "..", "xl"), "/")
+		switch rel.Type {
+		case SourceRelationshipDrawingML:
+			rel.Target, err = f.copyDrawing(target, fromSheet, toSheet)
+		case SourceRelationshipComments:
+			rel.Target, err = f.copyComments(target)
+		case SourceRelationshipDrawingVML:
+			rel.Target, err = f.copyVMLDrawing(target)
+		case SourceRelationshipTable:
+			rel.Target, err = f.copyTable(target, tableNames)
+		case SourceRelationshipPivotTable, SourceRelationshipPrinterSettings, SourceRelationshipThreadedComment:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		toRels.Relationships = append(toRels.Relationships, rel)
+	}
+	for r := range worksheet.SheetData.Row {
+		for c := range worksheet.SheetData.Row[r].C {
+			if cell := &worksheet.SheetData.Row[r].C[c]; cell.F != nil {
+				cell.F.Content = renameTableReferences(cell.F.Content, tableNames)
+			}
+		}
+	}
+	f.Relationships.Store("xl/worksheets/_rels/sheet"+toSheetID+".xml.rels", toRels)
 	return err
 }
 
+// copySheetDefinedNames provides a function to duplicate the defined names
+// which scoped in the source worksheet to the target worksheet.
+func (f *File) copySheetDefinedNames(from, to int, fromSheet, toSheet string) error {
+	wb, err := f.workbookReader()
+	if err != nil || wb.DefinedNames == nil {
+		return err
+	}
+	exists := map[string]bool{}
+	var definedNames []xlsxDefinedName
+	for _, dn := range wb.DefinedNames.DefinedName {
+		if dn.LocalSheetID == nil {
+			continue
+		}
+		if *dn.LocalSheetID == to {
+			exists[strings.ToLower(dn.Name)] = true
+		}
+		if *dn.LocalSheetID == from {
+			definedNames = append(definedNames, dn)
+		}
+	}
+	for _, dn := range definedNames {
+		if exists[strings.ToLower(dn.Name)] {
+			continue
+		}
+		dn.LocalSheetID = intPtr(to)
+		dn.Data = adjustRangeSheetName(dn.Data, fromSheet, toSheet)
+		wb.DefinedNames.DefinedName = append(wb.DefinedNames.DefinedName, dn)
+	}
+	return err
+}
+
+// copyRels provides a function to duplicate the relationships part by given
+// source and target relationships part path.
+func (f *File) copyRels(fromRels, toRels string) (*xlsxRelationships, error) {
+	rels, err := f.relsReader(fromRels)
+	if err != nil || rels == nil {
+		return nil, err
+	}
+	rels.mu.Lock()
+	defer rels.mu.Unlock()
+	relationships := &xlsxRelationships{
+		Relationships: make([]xlsxRelationship, len(rels.Relationships)),
+	}
+	copy(relationships.Relationships, rels.Relationships)
+	f.Relationships.Store(toRels, relationships)
+	return relationships, err
+}
+
+// copyDrawing provides a function to duplicate the drawing part by given
+// drawing part path, and returns the relationship target of the new drawing
+// part. The charts in the drawing will be duplicated, and the pictures in the
+// drawing share the same media with the source drawing.
+func (f *File) copyDrawing(drawingXML, fromSheet, toSheet string) (string, error) {
+	wsDr, _, err := f.drawingParser(drawingXML)
+	if err != nil {
+		return "", err
+	}
+	drawingID := f.countDrawings() + 1
+	toDrawingXML := "xl/drawings/drawing" + strconv.Itoa(drawingID) + ".xml"
+	wsDr.mu.Lock()
+	f.Drawings.Store(toDrawingXML, deepcopy.Copy(wsDr).(*xlsxWsDr))
+	wsDr.mu.Unlock()
+	rels, err := f.copyRels("xl/drawings/_rels/"+filepath.Base(drawingXML)+".rels",
+		"xl/drawings/_rels/drawing"+strconv.Itoa(drawingID)+".xml.rels")
+	if err != nil {
+		return "", err
+	}
+	if rels != nil {
+		for i, rel := range rels.Relationships {
+			if rel.Type != SourceRelationshipChart {
+				continue
+			}
+			target := strings.ReplaceAll(rel.Target, "..", "xl")
+			if rels.Relationships[i].Target, err = f.copyChart(target, fromSheet, toSheet); err != nil {
+				return "", err
+			}
+		}
+	}
+	return "../drawings/drawing" + strconv.Itoa(drawingID) + ".xml", f.addContentTypePart(drawingID, "drawings")
+}
+
+// copyChart provides a function to duplicate the chart part by given chart
+// part path, and returns the relationship target of the new chart part. The
+// data references which refer to the source worksheet will refer to the
+// target worksheet.
+func (f *File) copyChart(chartXML, fromSheet, toSheet string) (string, error) {
+	content, ok := f.Pkg.Load(chartXML)
+	if !ok || content == nil {
+		return strings.Replace(chartXML, "xl", "..", 1), nil
+	}
+	chartID := f.countCharts() + 1
+	toChartXML := "xl/charts/chart" + strconv.Itoa(chartID) + ".xml"
+	f.Pkg.Store(toChartXML, chartFormulaExp.ReplaceAllFunc(content.([]byte), func(match []byte) []byte {
+		sub := chartFormulaExp.FindSubmatch(match)
+		ref := adjustRangeSheetName(string(sub[2]), fromSheet, toSheet)
+		return []byte(string(sub[1]) + ref + string(sub[3]))
+	}))
+	if _, err := f.copyRels("xl/charts/_rels/"+filepath.Base(chartXML)+".rels",
+		"xl/charts/_rels/chart"+strconv.Itoa(chartID)+".xml.rels"); err != nil {
+		return "", err
+	}
+	return "../charts/chart" + strconv.Itoa(chartID) + ".xml", f.addContentTypePart(chartID, "chart")
+}
+
+// copyComments provides a function to duplicate the comments part by given
+// comments part path, and returns the relationship target of the new
+// comments part.
+func (f *File) copyComments(commentsXML string) (string, error) {
+	comments, err := f.commentsReader(commentsXML)
+	if err != nil || comments == nil {
+		return strings.Replace(commentsXML, "xl", "..", 1), err
+	}
+	commentsID := f.countComments() + 1
+	if vmlID := f.countVMLDrawing() + 1; vmlID > commentsID {
+		commentsID = vmlID
+	}
+	f.Comments["xl/comments"+strconv.Itoa(commentsID)+".xml"] = deepcopy.Copy(comments).(*xlsxComments)
+	return "../comments" + strconv.Itoa(commentsID) + ".xml", f.addContentTypePart(commentsID, "comments")
+}
+
+// copyVMLDrawing provides a function to duplicate the VML drawing part by
+// given VML drawing part path, and returns the relationship target of the new
+// VML drawing part.
+func (f *File) copyVMLDrawing(drawingVML string) (string, error) {
+	vmlID := f.countVMLDrawing() + 1
+	if commentsID := f.countComments() + 1; commentsID > vmlID {
+		vmlID = commentsID
+	}
+	toDrawingVML := "xl/drawings/vmlDrawing" + strconv.Itoa(vmlID) + ".vml"
+	if vml := f.VMLDrawing[drawingVML]; vml != nil {
+		f.VMLDrawing[toDrawingVML] = deepcopy.Copy(vml).(*vmlDrawing)
+	} else if content, ok := f.Pkg.Load(drawingVML); ok && content != nil {
+		f.Pkg.Store(toDrawingVML, content)
+	} else {
+		return strings.Replace(drawingVML, "xl", "..", 1), nil
+	}
+	if _, err := f.copyRels("xl/drawings/_rels/"+filepath.Base(drawingVML)+".rels",
+		"xl/drawings/_rels/vmlDrawing"+strconv.Itoa(vmlID)+".vml.rels"); err != nil {
+		return "", err
+	}
+	return "../drawings/vmlDrawing" + strconv.Itoa(vmlID) + ".vml", f.setContentTypePartVMLExtensions()
+}
+
+// copyTable provides a function to duplicate the table part by given table
+// part path, and returns the relationship target of the new table part. The
+// new table will be renamed with the suffix of table ID, and the original and
+// new table names will be stored in the given names map.
+func (f *File) copyTable(tableXML string, names map[string]string) (string, error) {
+	content, ok := f.Pkg.Load(tableXML)
+	if !ok || content == nil {
+		return strings.Replace(tableXML, "xl", "..", 1), nil
+	}
+	var t xlsxTable
+	if err := f.xmlNewDecoder(bytes.NewReader(namespaceStrictToTransitional(content.([]byte)))).
+		Decode(&t); err != nil && err != io.EOF {
+		return "", err
+	}
+	tableID := f.countTables() + 1
+	name := t.Name + "_" + strconv.Itoa(tableID)
+	names[t.Name] = name
+	t.XMLNS = NameSpaceSpreadSheet.Value
+	t.ID = tableID
+	t.Name, t.DisplayName = name, name
+	if t.TableColumns != nil {
+		for _, column := range t.TableColumns.TableColumn {
+			column.TotalsRowFormula = renameTableReferences(column.TotalsRowFormula, names)
+		}
+	}
+	table, err := xml.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	f.saveFileList("xl/tables/table"+strconv.Itoa(tableID)+".xml", table)
+	return "../tables/table" + strconv.Itoa(tableID) + ".xml", f.addContentTypePart(tableID, "table")
+}
+
+// renameTableReferences provides a function to rename the table names of the
+// structured references in the formula by given original and new table names,
+// such as rename "SUM(Table1[Amount])" to "SUM(Table1_2[Amount])". The table
+// names are case-insensitive, and the string literals and the content in the
+// brackets of the structured references will be kept.
+func renameTableReferences(formula string, names map[string]string) string {
+	if len(names) == 0 || !strings.Contains(formula, "[") {
+		return formula
+	}
+	var (
+		buf           strings.Builder
+		inStr         bool
+		depth, offset int
+	)
+	isNameChar := func(r byte) bool {
+		return r == '_' || r == '.' || r == '\\' || r >= '0' && r <= '9' ||
+			r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' || r >= 0x80
+	}
+	for i := 0; i < len(formula); i++ {
+		switch c := formula[i]; {
+		case c == '"' && depth == 0:
+			inStr = !inStr
+		case inStr:
+		case c == '[':
+			depth++
+		case c == ']' && depth > 0:
+			depth--
+		case depth == 0 && (i == 0 || !isNameChar(formula[i-1])):
+			for name, newName := range names {
+				end := i + len(name)
+				if end < len(formula) && formula[end] == '[' && strings.EqualFold(formula[i:end], name) {
+					buf.WriteString(formula[offset:i])
+					buf.WriteString(newName)
+					offset, i = end, end-1
+					break
+				}
+			}
+		}
+	}
+	buf.WriteString(formula[offset:])
+	return buf.String()
+}
+
+// SheetVisibility is the type of worksheet visibility state.
+type SheetVisibility byte
+
+// Worksheet visibility states enumeration.
+const (
+	SheetVisible SheetVisibility = iota
+	SheetHidden
+	SheetVeryHidden
+)
+
 // getSheetState returns sheet visible enumeration by given hidden status.
 func getSheetState(visible bool, veryHidden []bool) string {
 	state := "hidden"
@@ -788,9 +1220,13 @@ func (ws *xlsxWorksheet) setPanes(panes *Panes) error {
 	if panes == nil {
 		return ErrParameterInvalid
 	}
+	topLeftCell, err := checkPanesTopLeftCell(panes)
+	if err != nil {
+		return err
+	}
 	p := &xlsxPane{
 		ActivePane:  panes.ActivePane,
-		TopLeftCell: panes.TopLeftCell,
+		TopLeftCell: topLeftCell,
 		XSplit:      float64(panes.XSplit),
 		YSplit:      float64(panes.YSplit),
 	}
@@ -818,6 +1254,29 @@ func (ws *xlsxWorksheet) setPanes(panes *Panes) error {
 	return nil
 }
 
+// checkPanesTopLeftCell provides a function to check the top left cell of the
+// panes, and returns the first cell outside of the frozen region as the default
+// top left cell for the frozen panes if it is omitted.
+func checkPanesTopLeftCell(panes *Panes) (string, error) {
+	if panes.Freeze && (panes.XSplit < 0 || panes.YSplit < 0) {
+		return panes.TopLeftCell, ErrParameterInvalid
+	}
+	if panes.TopLeftCell == "" {
+		if !panes.Freeze || (panes.XSplit == 0 && panes.YSplit == 0) {
+			return panes.TopLeftCell, nil
+		}
+		return CoordinatesToCellName(panes.XSplit+1, panes.YSplit+1)
+	}
+	col, row, err := CellNameToCoordinates(panes.TopLeftCell)
+	if err != nil {
+		return panes.TopLeftCell, err
+	}
+	if panes.Freeze && (col <= panes.XSplit || row <= panes.YSplit) {
+		return panes.TopLeftCell, newPanesTopLeftCellError(panes.TopLeftCell)
+	}
+	return panes.TopLeftCell, err
+}
+
 // SetPanes provides a function to create and remove freeze panes and split panes
 // by given worksheet name and panes options.
 //
@@ -879,7 +1338,9 @@ func (ws *xlsxWorksheet) setPanes(panes *Panes) error {
 // attribute are defined by the W3C XML Schema double datatype.
 //
 // TopLeftCell: Location of the top left visible cell in the bottom right pane
-// (when in Left-To-Right mode).
+// (when in Left-To-Right mode). For the frozen panes, the top left cell should
+// be outside of the frozen region, and it will be set as the first cell after
+// the frozen rows and columns if it is omitted.
 //
 // SQRef (Sequence of References): Range of the selection. Can be non-contiguous
 // set of ranges.
@@ -943,6 +1404,38 @@ func (f *File) SetPanes(sheet string, panes *Panes) error {
 	return ws.setPanes(panes)
 }
 
+// FreezeFirstRow provides a function to freeze the first row of the worksheet
+// by given worksheet name, so that the header row keeps visible while
+// scrolling through the worksheet. The existing panes of the worksheet will be
+// replaced. For example, freeze the first row on Sheet1:
+//
+//	err := f.FreezeFirstRow("Sheet1")
+func (f *File) FreezeFirstRow(sheet string) error {
+	return f.SetPanes(sheet, &Panes{
+		Freeze:      true,
+		YSplit:      1,
+		TopLeftCell: "A2",
+		ActivePane:  "bottomLeft",
+		Selection:   []Selection{{SQRef: "A2", ActiveCell: "A2", Pane: "bottomLeft"}},
+	})
+}
+
+// FreezeFirstColumn provides a function to freeze the first column of the
+// worksheet by given worksheet name, so that the first column keeps visible
+// while scrolling through the worksheet. The existing panes of the worksheet
+// will be replaced. For example, freeze the first column on Sheet1:
+//
+//	err := f.FreezeFirstColumn("Sheet1")
+func (f *File) FreezeFirstColumn(sheet string) error {
+	return f.SetPanes(sheet, &Panes{
+		Freeze:      true,
+		XSplit:      1,
+		TopLeftCell: "B1",
+		ActivePane:  "topRight",
+		Selection:   []Selection{{SQRef: "B1", ActiveCell: "B1", Pane: "topRight"}},
+	})
+}
+
 // getPanes returns freeze panes, split panes, and views of the worksheet.
 func (ws *xlsxWorksheet) getPanes() Panes {
 	var (
@@ -987,6 +1480,165 @@ func (f *File) GetPanes(sheet string) (Panes, error) {
 	return ws.getPanes(), err
 }
 
+// SetActiveCell provides a function to set the active cell of the worksheet
+// by given worksheet name and cell reference, so that the cursor will be
+// placed on the cell when the workbook is opened. The active cell will be set
+// in the selection of the active pane of the last sheet view, and the
+// selection range will be replaced by the active cell if it doesn't contain
+// the cell. For example, set the active cell of Sheet1 to B5:
+//
+//	err := f.SetActiveCell("Sheet1", "B5")
+func (f *File) SetActiveCell(sheet, cell string) error {
+	col, row, err := CellNameToCoordinates(cell)
+	if err != nil {
+		return err
+	}
+	ws, err := f.workSheetReader(sheet)
+	if err != nil {
+		return err
+	}
+	cell, _ = CoordinatesToCellName(col, row)
+	selection := ws.prepareActiveSelection()
+	if selection.ActiveCell, selection.ActiveCellID = cell, nil; !inSelectionRef(selection.SQRef, col, row) {
+		selection.SQRef = cell
+	}
+	return err
+}
+
+// SetSelection provides a function to set the selected range of the
+// worksheet by given worksheet name and range reference, the range reference
+// could be a cell reference, a cell range reference or a space-separated list
+// of them. The selection will be set in the active pane of the last sheet
+// view, and the active cell will be moved to the top-left cell of the first
+// range if it is outside of the selection. For example, select the range
+// A2:D10 on Sheet1:
+//
+//	err := f.SetSelection("Sheet1", "A2:D10")
+func (f *File) SetSelection(sheet, ref string) error {
+	refs := strings.Fields(ref)
+	if len(refs) == 0 {
+		return ErrParameterInvalid
+	}
+	var topLeftCell string
+	for i, rangeRef := range refs {
+		cells := strings.Split(rangeRef, ":")
+		if len(cells) > 2 {
+			return ErrParameterInvalid
+		}
+		coordinates, err := cellRefsToCoordinates(cells[0], cells[len(cells)-1])
+		if err != nil {
+			return err
+		}
+		_ = sortCoordinates(coordinates)
+		if refs[i], _ = coordinatesToRangeRef(coordinates); len(cells) == 1 {
+			refs[i], _ = CoordinatesToCellName(coordinates[0], coordinates[1])
+		}
+		if i == 0 {
+			topLeftCell, _ = CoordinatesToCellName(coordinates[0], coordinates[1])
+		}
+	}
+	ws, err := f.workSheetReader(sheet)
+	if err != nil {
+		return err
+	}
+	selection := ws.prepareActiveSelection()
+	selection.SQRef = strings.Join(refs, " ")
+	if col, row, err := CellNameToCoordinates(selection.ActiveCell); err != nil || !inSelectionRef(selection.SQRef, col, row) {
+		selection.ActiveCell, selection.ActiveCellID = topLeftCell, nil
+	}
+	return err
+}
+
+// GetActiveCell provides a function to get the active cell of the worksheet
+// by given worksheet name. The cell "A1" will be returned if the worksheet
+// doesn't specify the active cell.
+func (f *File) GetActiveCell(sheet string) (string, error) {
+	ws, err := f.workSheetReader(sheet)
+	if err != nil {
+		return "", err
+	}
+	if selection := ws.getActiveSelection(); selection != nil && selection.ActiveCell != "" {
+		return selection.ActiveCell, err
+	}
+	return "A1", err
+}
+
+// GetSelection provides a function to get the selected range of the worksheet
+// by given worksheet name. The cell "A1" will be returned if the worksheet
+// doesn't specify the selection.
+func (f *File) GetSelection(sheet string) (string, error) {
+	ws, err := f.workSheetReader(sheet)
+	if err != nil {
+		return "", err
+	}
+	if selection := ws.getActiveSelection(); selection != nil {
+		if selection.SQRef != "" {
+			return selection.SQRef, err
+		}
+		if selection.ActiveCell != "" {
+			return selection.ActiveCell, err
+		}
+	}
+	return "A1", err
+}
+
+// getActiveSelection returns the selection of the active pane in the last
+// sheet view of the worksheet, and returns nil if it doesn't exist.
+func (ws *xlsxWorksheet) getActiveSelection() *xlsxSelection {
+	if ws.SheetViews == nil || len(ws.SheetViews.SheetView) < 1 {
+		return nil
+	}
+	sw := ws.SheetViews.SheetView[len(ws.SheetViews.SheetView)-1]
+	activePane := "topLeft"
+	if sw.Pane != nil && sw.Pane.ActivePane != "" {
+		activePane = sw.Pane.ActivePane
+	}
+	for _, selection := range sw.Selection {
+		if selection == nil {
+			continue
+		}
+		if pane := selection.Pane; pane == activePane || (pane == "" && activePane == "topLeft") {
+			return selection
+		}
+	}
+	return nil
+}
+
+// prepareActiveSelection returns the selection of the active pane in the last
+// sheet view of the worksheet, and creates it if it doesn't exist.
+func (ws *xlsxWorksheet) prepareActiveSelection() *xlsxSelection {
+	if selection := ws.getActiveSelection(); selection != nil {
+		return selection
+	}
+	if ws.SheetViews == nil || len(ws.SheetViews.SheetView) < 1 {
+		ws.SheetViews = &xlsxSheetViews{SheetView: []xlsxSheetView{{}}}
+	}
+	sw := &ws.SheetViews.SheetView[len(ws.SheetViews.SheetView)-1]
+	selection := &xlsxSelection{}
+	if sw.Pane != nil && sw.Pane.ActivePane != "" && sw.Pane.ActivePane != "topLeft" {
+		selection.Pane = sw.Pane.ActivePane
+	}
+	sw.Selection = append(sw.Selection, selection)
+	return selection
+}
+
+// inSelectionRef returns whether the cell of given column and row number is
+// within the space-separated list of the cell and range references.
+func inSelectionRef(ref string, col, row int) bool {
+	for _, rangeRef := range strings.Fields(ref) {
+		cells := strings.Split(rangeRef, ":")
+		coordinates, err := cellRefsToCoordinates(cells[0], cells[len(cells)-1])
+		if err != nil {
+			continue
+		}
+		_ = sortCoordinates(coordinates)
+		if cellInRange([]int{col, row}, coordinates) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetSheetVisible provides a function to get worksheet visible by given worksheet
 // name. For example, get visible state of Sheet1:
 //
@@ -1007,6 +1659,36 @@ func (f *File) GetSheetVisible(sheet string) (bool, error) {
 	return visible, nil
 }
 
+// GetSheetVisibility provides a function to get the visibility state of the
+// worksheet by given worksheet name. The return value is one of SheetVisible,
+// SheetHidden and SheetVeryHidden, the very hidden worksheet can't be shown by
+// the user in the spreadsheet application interface. For example, get the
+// visibility state of Sheet1:
+//
+//	state, err := f.GetSheetVisibility("Sheet1")
+func (f *File) GetSheetVisibility(sheet string) (SheetVisibility, error) {
+	if err := checkSheetName(sheet); err != nil {
+		return SheetVisible, err
+	}
+	wb, err := f.workbookReader()
+	if err != nil {
+		return SheetVisible, err
+	}
+	for _, v := range wb.Sheets.Sheet {
+		if strings.EqualFold(v.Name, sheet) {
+			switch v.State {
+			case "hidden":
+				return SheetHidden, err
+			case "veryHidden":
+				return SheetVeryHidden, err
+			default:
+				return SheetVisible, err
+			}
+		}
+	}
+	return SheetVisible, ErrSheetNotExist{sheet}
+}
+
 // SearchSheet provides a function to get cell reference by given worksheet name,
 // cell value, and regular expression. The function doesn't support searching
 // on the calculated result, formatted numbers and conditional lookup
@@ -1230,6 +1912,9 @@ func attrValToBool(name string, attrs []xml.Attr) (val bool, err error) {
 //	                        |
 //	 &Z                     | Current workbook's file path
 //
+// The function returns an error if any of these fields contains an unknown or
+// incomplete formatting code, use "&&" to insert the character "&" as text.
+//
 // For example:
 //
 //	err := f.SetHeaderFooter("Sheet1", &excelize.HeaderFooterOptions{
@@ -1275,9 +1960,13 @@ func (f *File) SetHeaderFooter(sheet string, opts *HeaderFooterOptions) error {
 	v := reflect.ValueOf(*opts)
 	// Check 6 string type fields: OddHeader, OddFooter, EvenHeader, EvenFooter,
 	// FirstFooter, FirstHeader
-	for i := 4; i < v.NumField()-1; i++ {
-		if len(utf16.Encode([]rune(v.Field(i).String()))) > MaxFieldLength {
-			return newFieldLengthError(v.Type().Field(i).Name)
+	for i := 4; i < v.NumField(); i++ {
+		name, text := v.Type().Field(i).Name, v.Field(i).String()
+		if len(utf16.Encode([]rune(text))) > MaxFieldLength {
+			return newFieldLengthError(name)
+		}
+		if err = checkHeaderFooterFormatCodes(name, text); err != nil {
+			return err
 		}
 	}
 	ws.HeaderFooter = &xlsxHeaderFooter{
@@ -1295,6 +1984,61 @@ func (f *File) SetHeaderFooter(sheet string, opts *HeaderFooterOptions) error {
 	return err
 }
 
+// checkHeaderFooterFormatCodes provides a function to check the formatting
+// codes in the header or footer field by given field name and text.
+func checkHeaderFooterFormatCodes(name, text string) error {
+	isDigit := func(c byte) bool { return '0' <= c && c <= '9' }
+	isColor := func(s string) bool {
+		if _, err := strconv.ParseUint(s, 16, 32); err == nil {
+			return true
+		}
+		return isDigit(s[0]) && isDigit(s[1]) && (s[2] == '+' || s[2] == '-') &&
+			isDigit(s[3]) && isDigit(s[4]) && isDigit(s[5])
+	}
+	for i := 0; i < len(text); i++ {
+		if text[i] != '&' {
+			continue
+		}
+		if i++; i == len(text) {
+			return newInvalidHeaderFooterFormatCodeError(name, "&")
+		}
+		start := i - 1
+		switch c := text[i]; {
+		case strings.IndexByte("&ABCDEFGHILNORSTUXYZ", c) != -1:
+		case isDigit(c):
+			for i+1 < len(text) && isDigit(text[i+1]) {
+				i++
+			}
+		case c == '"':
+			end := strings.IndexByte(text[i+1:], '"')
+			if end == -1 {
+				return newInvalidHeaderFooterFormatCodeError(name, text[start:])
+			}
+			i += end + 1
+		case c == 'K':
+			// The color is specified as RRGGBB, or TTSNNN for the theme color
+			if i+7 > len(text) {
+				return newInvalidHeaderFooterFormatCodeError(name, text[start:])
+			}
+			if !isColor(text[i+1 : i+7]) {
+				return newInvalidHeaderFooterFormatCodeError(name, text[start:i+7])
+			}
+			i += 6
+		case c == 'P':
+			if i+2 < len(text) && (text[i+1] == '+' || text[i+1] == '-') && isDigit(text[i+2]) {
+				i += 2
+				for i+1 < len(text) && isDigit(text[i+1]) {
+					i++
+				}
+			}
+		default:
+			_, size := utf8.DecodeRuneInString(text[i:])
+			return newInvalidHeaderFooterFormatCodeError(name, text[start:i+size])
+		}
+	}
+	return nil
+}
+
 // GetHeaderFooter provides a function to get worksheet header and footer by
 // given worksheet name.
 func (f *File) GetHeaderFooter(sheet string) (*HeaderFooterOptions, error) {
@@ -1325,8 +2069,9 @@ func (f *File) GetHeaderFooter(sheet string) (*HeaderFooterOptions, error) {
 // deliberately changing, moving, or deleting data in a worksheet. The
 // optional field AlgorithmName specified hash algorithm, support XOR, MD4,
 // MD5, SHA-1, SHA2-56, SHA-384, and SHA-512 currently, if no hash algorithm
-// specified, will be using the XOR algorithm as default. For example, protect
-// Sheet1 with protection settings:
+// specified, will be using the XOR algorithm as default. The HasPassword field
+// is returned by the GetSheetProtection function and will be ignored. For
+// example, protect Sheet1 with protection settings:
 //
 //	err := f.ProtectSheet("Sheet1", &excelize.SheetProtectionOptions{
 //	    AlgorithmName:       "SHA-512",
@@ -1379,6 +2124,44 @@ func (f *File) ProtectSheet(sheet string, opts *SheetProtectionOptions) error {
 	return err
 }
 
+// GetSheetProtection provides a function to get worksheet protection settings
+// by given worksheet name. This function returns nil options if the worksheet
+// is not protected. The boolean fields of the options specify which actions
+// are allowed on the protected worksheet, and the HasPassword field specifies
+// whether the protection has a password, the password or its hash will not be
+// returned. For example, get the protection settings of Sheet1:
+//
+//	opts, err := f.GetSheetProtection("Sheet1")
+func (f *File) GetSheetProtection(sheet string) (*SheetProtectionOptions, error) {
+	ws, err := f.workSheetReader(sheet)
+	if err != nil {
+		return nil, err
+	}
+	if ws.SheetProtection == nil || !ws.SheetProtection.Sheet {
+		return nil, err
+	}
+	sp := ws.SheetProtection
+	return &SheetProtectionOptions{
+		AlgorithmName:       sp.AlgorithmName,
+		AutoFilter:          !sp.AutoFilter,
+		DeleteColumns:       !sp.DeleteColumns,
+		DeleteRows:          !sp.DeleteRows,
+		EditObjects:         !sp.Objects,
+		EditScenarios:       !sp.Scenarios,
+		FormatCells:         !sp.FormatCells,
+		FormatColumns:       !sp.FormatColumns,
+		FormatRows:          !sp.FormatRows,
+		HasPassword:         sp.Password != "" || sp.HashValue != "",
+		InsertColumns:       !sp.InsertColumns,
+		InsertHyperlinks:    !sp.InsertHyperlinks,
+		InsertRows:          !sp.InsertRows,
+		PivotTables:         !sp.PivotTables,
+		SelectLockedCells:   !sp.SelectLockedCells,
+		SelectUnlockedCells: !sp.SelectUnlockedCells,
+		Sort:                !sp.Sort,
+	}, err
+}
+
 // UnprotectSheet provides a function to remove protection for a sheet,
 // specified the second optional password parameter to remove sheet
 // protection with password verification.
@@ -1431,8 +2214,21 @@ func checkSheetName(name string) error {
 	return nil
 }
 
-// SetPageLayout provides a function to sets worksheet page layout.
+// SetPageLayout provides a function to sets worksheet page layout. The fit to
+// page print option of the worksheet will be enabled when the number of
+// horizontal or vertical pages to fit on is specified. For example, print
+// Sheet1 on A4 paper in landscape orientation and fit to one page:
+//
+//	size, orientation, fitTo := 9, "landscape", 1
+//	err := f.SetPageLayout("Sheet1", &excelize.PageLayoutOptions{
+//	    Size:        &size,
+//	    Orientation: &orientation,
+//	    FitToHeight: &fitTo,
+//	    FitToWidth:  &fitTo,
+//	})
 //
+// An error will be returned if the paper size isn't one of the following
+// index numbers, and the paper size setting will be removed if the size is 0.
 // The following shows the paper size sorted by excelize index number:
 //
 //	 Index | Paper Size
@@ -1561,10 +2357,19 @@ func (f *File) SetPageLayout(sheet string, opts *PageLayoutOptions) error {
 	if opts == nil {
 		return err
 	}
+	if opts.Size != nil && *opts.Size != 0 && !checkPaperSize(*opts.Size) {
+		return ErrParameterInvalid
+	}
 	ws.setPageSetUp(opts)
 	return err
 }
 
+// checkPaperSize provides a function to check whether the given paper size
+// index number is supported.
+func checkPaperSize(size int) bool {
+	return (1 <= size && size <= 47) || (50 <= size && size <= 118)
+}
+
 // newPageSetUp initialize page setup settings for the worksheet if which not
 // exist.
 func (ws *xlsxWorksheet) newPageSetUp() {
@@ -1577,7 +2382,9 @@ func (ws *xlsxWorksheet) newPageSetUp() {
 func (ws *xlsxWorksheet) setPageSetUp(opts *PageLayoutOptions) {
 	if opts.Size != nil {
 		ws.newPageSetUp()
-		ws.PageSetUp.PaperSize = opts.Size
+		if ws.PageSetUp.PaperSize = opts.Size; *opts.Size == 0 {
+			ws.PageSetUp.PaperSize = nil
+		}
 	}
 	if opts.Orientation != nil && (*opts.Orientation == "portrait" || *opts.Orientation == "landscape") {
 		ws.newPageSetUp()
@@ -1600,6 +2407,9 @@ func (ws *xlsxWorksheet) setPageSetUp(opts *PageLayoutOptions) {
 		ws.newPageSetUp()
 		ws.PageSetUp.FitToWidth = opts.FitToWidth
 	}
+	if opts.FitToHeight != nil || opts.FitToWidth != nil {
+		ws.setSheetProps(&SheetPropsOptions{FitToPage: boolPtr(true)})
+	}
 	if opts.BlackAndWhite != nil {
 		ws.newPageSetUp()
 		ws.PageSetUp.BlackAndWhite = *opts.BlackAndWhite
@@ -1746,6 +2556,34 @@ func (f *File) GetDefinedName() []DefinedName {
 	return definedNames
 }
 
+// ResolveDefinedName provides a function to get the reference of the defined
+// name by given defined name and the worksheet name where the defined name is
+// used. The defined name on the scope of the given worksheet takes precedence
+// over the defined name on the scope of the workbook, the same as the
+// spreadsheet application resolving the defined name in formulas. The name
+// is case-insensitive. If the worksheet name is empty, only the defined names
+// on the scope of the workbook will be resolved. For example, get the
+// reference of the defined name "Amount" used in Sheet2:
+//
+//	refersTo, err := f.ResolveDefinedName("Amount", "Sheet2")
+func (f *File) ResolveDefinedName(name, sheet string) (string, error) {
+	if sheet != "" {
+		if err := checkSheetName(sheet); err != nil {
+			return "", err
+		}
+		if f.getSheetID(sheet) == -1 {
+			return "", ErrSheetNotExist{sheet}
+		}
+	}
+	if _, err := f.workbookReader(); err != nil {
+		return "", err
+	}
+	if refersTo := f.getDefinedNameRefTo(name, sheet); refersTo != "" {
+		return refersTo, nil
+	}
+	return "", ErrDefinedNameScope
+}
+
 // GroupSheets provides a function to group worksheets by given worksheets
 // name. Group worksheets must contain an active worksheet.
 func (f *File) GroupSheets(sheets []string) error {
@@ -2019,15 +2857,246 @@ func (f *File) SetSheetDimension(sheet string, rangeRef string) error {
 	return err
 }
 
-// GetSheetDimension provides the method to get the used range of the worksheet.
+// usedRange defines the coordinates of the top-left and bottom-right used
+// cells in the worksheet.
+type usedRange struct {
+	minCol, minRow, maxCol, maxRow int
+}
+
+// add provides a function to extend the used range by given column and row
+// number of the used cell.
+func (u *usedRange) add(col, row int) {
+	if u.minCol == 0 || col < u.minCol {
+		u.minCol = col
+	}
+	if u.minRow == 0 || row < u.minRow {
+		u.minRow = row
+	}
+	if col > u.maxCol {
+		u.maxCol = col
+	}
+	if row > u.maxRow {
+		u.maxRow = row
+	}
+}
+
+// ref returns the range reference of the used range, the single cell
+// reference will be returned if only one cell has been used, and returns
+// empty string if no cells have been used.
+func (u *usedRange) ref() string {
+	if u.minCol == 0 {
+		return ""
+	}
+	if u.minCol == u.maxCol && u.minRow == u.maxRow {
+		cell, _ := CoordinatesToCellName(u.minCol, u.minRow)
+		return cell
+	}
+	ref, _ := coordinatesToRangeRef([]int{u.minCol, u.minRow, u.maxCol, u.maxRow})
+	return ref
+}
+
+// getUsedRange returns the range reference of the used cells in the
+// worksheet, the cells have value, formula or style will be treated as used
+// cells. The empty string will be returned if the worksheet has no used cells.
+func (ws *xlsxWorksheet) getUsedRange() string {
+	var u usedRange
+	for _, row := range ws.SheetData.Row {
+		for _, c := range row.C {
+			if !c.hasValue() {
+				continue
+			}
+			if col, r, err := CellNameToCoordinates(c.R); err == nil {
+				u.add(col, r)
+			}
+		}
+	}
+	return u.ref()
+}
+
+// GetSheetDimension provides the method to get the used range of the
+// worksheet. The range reference in the dimension element of the worksheet
+// will be returned, and the used range will be computed by the cells of the
+// worksheet if the dimension element doesn't exist. The empty string will be
+// returned if the dimension element without range reference or the worksheet
+// has no used cells. If the worksheet has not been loaded, it will be read in
+// streaming mode without loading all cells into memory, so that it is cheap
+// to scan the metadata of many workbooks. For example, get the used range of
+// the worksheet named Sheet1:
+//
+//	ref, err := f.GetSheetDimension("Sheet1")
 func (f *File) GetSheetDimension(sheet string) (string, error) {
-	var ref string
+	if err := checkSheetName(sheet); err != nil {
+		return "", err
+	}
+	name, ok := f.getSheetXMLPath(sheet)
+	if !ok {
+		return "", ErrSheetNotExist{sheet}
+	}
+	if worksheet, ok := f.Sheet.Load(name); ok && worksheet != nil {
+		ws := worksheet.(*xlsxWorksheet)
+		ws.mu.Lock()
+		defer ws.mu.Unlock()
+		if ws.Dimension != nil {
+			return ws.Dimension.Ref, nil
+		}
+		return ws.getUsedRange(), nil
+	}
+	if getSheetType(name) != SheetTypeWorksheet {
+		return "", newNotWorksheetError(sheet)
+	}
+	return f.getSheetDimension(name)
+}
+
+// getSheetDimension read the range reference in the dimension element of the
+// worksheet by given worksheet XML path in streaming mode, and compute the
+// used range by the cells of the worksheet if the dimension element doesn't
+// exist.
+func (f *File) getSheetDimension(name string) (string, error) {
+	needClose, decoder, tempFile, err := f.xmlDecoder(name)
+	if needClose && err == nil {
+		defer tempFile.Close()
+	}
+	if err != nil {
+		return "", err
+	}
+	var (
+		u        usedRange
+		col, row int
+	)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		switch xmlElement := token.(type) {
+		case xml.StartElement:
+			switch xmlElement.Name.Local {
+			case "dimension":
+				for _, attr := range xmlElement.Attr {
+					if attr.Name.Local == "ref" {
+						return attr.Value, nil
+					}
+				}
+				return "", nil
+			case "row":
+				row++
+				if rowNum, _ := attrValToInt("r", xmlElement.Attr); rowNum != 0 {
+					row = rowNum
+				}
+				col = 0
+			case "c":
+				var c xlsxC
+				if err = decoder.DecodeElement(&c, &xmlElement); err != nil {
+					return "", err
+				}
+				col++
+				if c.R != "" {
+					if col, _, err = CellNameToCoordinates(c.R); err != nil {
+						return "", err
+					}
+				}
+				if c.hasValue() {
+					u.add(col, row)
+				}
+			}
+		case xml.EndElement:
+			if xmlElement.Name.Local == "sheetData" {
+				return u.ref(), nil
+			}
+		}
+	}
+	return u.ref(), nil
+}
+
+// SetIgnoredErrors provides a function to suppress the error indicators of the
+// cells in the worksheet by given worksheet name, range reference and the
+// error types. The range reference could be a cell reference, a cell range
+// reference or a space-separated list of them. The SQRef field of the options
+// will be ignored. For example, suppress the "number stored as text" and the
+// "two digit text year" error indicators for the cells "A1:A10" on Sheet1:
+//
+//	err := f.SetIgnoredErrors("Sheet1", "A1:A10", excelize.IgnoredErrorOptions{
+//	    NumberStoredAsText: true,
+//	    TwoDigitTextYear:   true,
+//	})
+func (f *File) SetIgnoredErrors(sheet, ref string, opts IgnoredErrorOptions) error {
+	ignoredError := xlsxIgnoredError{
+		CalculatedColumn:   opts.CalculatedColumn,
+		EmptyCellReference: opts.EmptyCellReference,
+		EvalError:          opts.EvalError,
+		Formula:            opts.Formula,
+		FormulaRange:       opts.FormulaRange,
+		ListDataValidation: opts.ListDataValidation,
+		NumberStoredAsText: opts.NumberStoredAsText,
+		TwoDigitTextYear:   opts.TwoDigitTextYear,
+		UnlockedFormula:    opts.UnlockedFormula,
+	}
+	if ignoredError == (xlsxIgnoredError{}) {
+		return ErrParameterInvalid
+	}
+	refs := strings.Fields(ref)
+	if len(refs) == 0 {
+		return ErrParameterInvalid
+	}
+	for i, rangeRef := range refs {
+		if !strings.Contains(rangeRef, ":") {
+			col, row, err := CellNameToCoordinates(rangeRef)
+			if err != nil {
+				return err
+			}
+			refs[i], _ = CoordinatesToCellName(col, row)
+			continue
+		}
+		coordinates, err := rangeRefToCoordinates(rangeRef)
+		if err != nil {
+			return err
+		}
+		_ = sortCoordinates(coordinates)
+		refs[i], _ = coordinatesToRangeRef(coordinates)
+	}
 	ws, err := f.workSheetReader(sheet)
 	if err != nil {
-		return ref, err
+		return err
+	}
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	for _, rangeRef := range refs {
+		ws.addIgnoredError(rangeRef, ignoredError)
 	}
-	if ws.Dimension != nil {
-		ref = ws.Dimension.Ref
+	return err
+}
+
+// GetIgnoredErrors provides a function to get the ignored errors of the
+// worksheet by given worksheet name. Each item of the result specifies the
+// suppressed error indicators of the cells in the range reference of the
+// SQRef field.
+func (f *File) GetIgnoredErrors(sheet string) ([]IgnoredErrorOptions, error) {
+	var ignoredErrors []IgnoredErrorOptions
+	ws, err := f.workSheetReader(sheet)
+	if err != nil || ws.IgnoredErrors == nil {
+		return ignoredErrors, err
+	}
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	if ws.mergeIgnoredErrors(); ws.IgnoredErrors == nil {
+		return ignoredErrors, err
+	}
+	for _, item := range ws.IgnoredErrors.IgnoredError {
+		ignoredErrors = append(ignoredErrors, IgnoredErrorOptions{
+			SQRef:              item.Sqref,
+			CalculatedColumn:   item.CalculatedColumn,
+			EmptyCellReference: item.EmptyCellReference,
+			EvalError:          item.EvalError,
+			Formula:            item.Formula,
+			FormulaRange:       item.FormulaRange,
+			ListDataValidation: item.ListDataValidation,
+			NumberStoredAsText: item.NumberStoredAsText,
+			TwoDigitTextYear:   item.TwoDigitTextYear,
+			UnlockedFormula:    item.UnlockedFormula,
+		})
 	}
-	return ref, err
+	return ignoredErrors, err
 }
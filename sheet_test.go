@@ -95,6 +95,20 @@ func TestPanes(t *testing.T) {
 		},
 	))
 	assert.EqualError(t, f.SetPanes("Panes 4", nil), ErrParameterInvalid.Error())
+	// Test set frozen panes without top left cell
+	assert.NoError(t, f.SetPanes("Panes 4", &Panes{Freeze: true, XSplit: 2, YSplit: 3}))
+	panes, err = f.GetPanes("Panes 4")
+	assert.NoError(t, err)
+	assert.Equal(t, "C4", panes.TopLeftCell)
+	// Test set frozen panes with top left cell inside of the frozen region
+	assert.Equal(t, newPanesTopLeftCellError("B10"), f.SetPanes("Panes 4", &Panes{Freeze: true, XSplit: 2, YSplit: 3, TopLeftCell: "B10"}))
+	assert.Equal(t, newPanesTopLeftCellError("C3"), f.SetPanes("Panes 4", &Panes{Freeze: true, XSplit: 2, YSplit: 3, TopLeftCell: "C3"}))
+	// Test set frozen panes with invalid top left cell
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), f.SetPanes("Panes 4", &Panes{Freeze: true, YSplit: 1, TopLeftCell: "A"}))
+	// Test set frozen panes with negative split position
+	assert.Equal(t, ErrParameterInvalid, f.SetPanes("Panes 4", &Panes{Freeze: true, XSplit: -1}))
+	// Test set frozen panes with split position exceeds maximum limit
+	assert.Equal(t, ErrColumnNumber, f.SetPanes("Panes 4", &Panes{Freeze: true, XSplit: MaxColumns}))
 	assert.EqualError(t, f.SetPanes("SheetN", nil), "sheet SheetN does not exist")
 	// Test set panes with invalid sheet name
 	assert.EqualError(t, f.SetPanes("Sheet:1", &Panes{Freeze: false, Split: false}), ErrSheetNameInvalid.Error())
@@ -139,6 +153,112 @@ func TestPanes(t *testing.T) {
 	))
 }
 
+func TestFreezeFirstRowAndColumn(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.FreezeFirstRow("Sheet1"))
+	panes, err := f.GetPanes("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, Panes{
+		Freeze: true, YSplit: 1, TopLeftCell: "A2", ActivePane: "bottomLeft",
+		Selection: []Selection{{SQRef: "A2", ActiveCell: "A2", Pane: "bottomLeft"}},
+	}, panes)
+	assert.NoError(t, f.FreezeFirstColumn("Sheet1"))
+	panes, err = f.GetPanes("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, Panes{
+		Freeze: true, XSplit: 1, TopLeftCell: "B1", ActivePane: "topRight",
+		Selection: []Selection{{SQRef: "B1", ActiveCell: "B1", Pane: "topRight"}},
+	}, panes)
+	// Test freeze panes on not exists worksheet
+	assert.EqualError(t, f.FreezeFirstRow("SheetN"), "sheet SheetN does not exist")
+	assert.EqualError(t, f.FreezeFirstColumn("SheetN"), "sheet SheetN does not exist")
+	assert.NoError(t, f.Close())
+}
+
+func TestActiveCellAndSelection(t *testing.T) {
+	f := NewFile()
+	cell, err := f.GetActiveCell("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "A1", cell)
+	ref, err := f.GetSelection("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "A1", ref)
+	assert.NoError(t, f.SetActiveCell("Sheet1", "b5"))
+	cell, err = f.GetActiveCell("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "B5", cell)
+	ref, err = f.GetSelection("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "B5", ref)
+	// Test set selection which contains the active cell
+	assert.NoError(t, f.SetSelection("Sheet1", "D10:A2 F1"))
+	cell, err = f.GetActiveCell("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "B5", cell)
+	ref, err = f.GetSelection("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "A2:D10 F1", ref)
+	// Test set active cell within the selection
+	assert.NoError(t, f.SetActiveCell("Sheet1", "F1"))
+	ref, err = f.GetSelection("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "A2:D10 F1", ref)
+	// Test set selection which doesn't contain the active cell
+	assert.NoError(t, f.SetSelection("Sheet1", "C3:E4"))
+	cell, err = f.GetActiveCell("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "C3", cell)
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestActiveCellAndSelection.xlsx")))
+	assert.NoError(t, f.Close())
+
+	f, err = OpenFile(filepath.Join("test", "TestActiveCellAndSelection.xlsx"))
+	assert.NoError(t, err)
+	cell, err = f.GetActiveCell("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "C3", cell)
+	ref, err = f.GetSelection("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "C3:E4", ref)
+	// Test set active cell and selection in the active pane of the frozen panes
+	assert.NoError(t, f.FreezeFirstRow("Sheet1"))
+	assert.NoError(t, f.SetActiveCell("Sheet1", "C8"))
+	assert.NoError(t, f.SetSelection("Sheet1", "C8:C20"))
+	panes, err := f.GetPanes("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, []Selection{{SQRef: "C8:C20", ActiveCell: "C8", Pane: "bottomLeft"}}, panes.Selection)
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	ws.(*xlsxWorksheet).SheetViews.SheetView[0].Selection = []*xlsxSelection{nil}
+	assert.NoError(t, f.SetSelection("Sheet1", "B2"))
+	panes, err = f.GetPanes("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, []Selection{{SQRef: "B2", ActiveCell: "B2", Pane: "bottomLeft"}}, panes.Selection)
+	// Test set active cell and selection with invalid parameters
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), f.SetActiveCell("Sheet1", "A"))
+	assert.Equal(t, ErrParameterInvalid, f.SetSelection("Sheet1", ""))
+	assert.Equal(t, ErrParameterInvalid, f.SetSelection("Sheet1", "A1:B2:C3"))
+	assert.Equal(t, newCellNameToCoordinatesError("B", newInvalidCellNameError("B")), f.SetSelection("Sheet1", "A1:B"))
+	// Test set and get active cell and selection on not exists worksheet
+	assert.EqualError(t, f.SetActiveCell("SheetN", "A1"), "sheet SheetN does not exist")
+	assert.EqualError(t, f.SetSelection("SheetN", "A1"), "sheet SheetN does not exist")
+	_, err = f.GetActiveCell("SheetN")
+	assert.EqualError(t, err, "sheet SheetN does not exist")
+	_, err = f.GetSelection("SheetN")
+	assert.EqualError(t, err, "sheet SheetN does not exist")
+	assert.NoError(t, f.Close())
+	// Test get selection without the selection range reference
+	f = NewFile()
+	ws, ok = f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	ws.(*xlsxWorksheet).SheetViews.SheetView[0].Selection = []*xlsxSelection{{ActiveCell: "B2"}}
+	ref, err = f.GetSelection("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "B2", ref)
+	assert.NoError(t, f.Close())
+	// Test check cell in the selection with invalid range reference
+	assert.False(t, inSelectionRef("A:B1 C1", 1, 1))
+}
+
 func TestSearchSheet(t *testing.T) {
 	f, err := OpenFile(filepath.Join("test", "SharedStrings.xlsx"))
 	if !assert.NoError(t, err) {
@@ -215,6 +335,32 @@ func TestSetPageLayout(t *testing.T) {
 	opts, err := f.GetPageLayout("Sheet1")
 	assert.NoError(t, err)
 	assert.Equal(t, expected, opts)
+	props, err := f.GetSheetProps("Sheet1")
+	assert.NoError(t, err)
+	assert.True(t, *props.FitToPage)
+	// Test set page layout on A4 paper in landscape orientation fit to one page
+	assert.NoError(t, f.SetPageLayout("Sheet1", &PageLayoutOptions{
+		Size: intPtr(9), FitToHeight: intPtr(1), FitToWidth: intPtr(1),
+	}))
+	opts, err = f.GetPageLayout("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, 9, *opts.Size)
+	assert.Equal(t, "landscape", *opts.Orientation)
+	assert.Equal(t, 1, *opts.FitToHeight)
+	assert.Equal(t, 1, *opts.FitToWidth)
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestSetPageLayout.xlsx")))
+	// Test set page layout with invalid paper size
+	for _, size := range []int{-1, 48, 49, 119} {
+		assert.Equal(t, ErrParameterInvalid, f.SetPageLayout("Sheet1", &PageLayoutOptions{Size: intPtr(size)}))
+	}
+	opts, err = f.GetPageLayout("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, 9, *opts.Size)
+	// Test remove paper size setting
+	assert.NoError(t, f.SetPageLayout("Sheet1", &PageLayoutOptions{Size: intPtr(0)}))
+	opts, err = f.GetPageLayout("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, *opts.Size)
 	// Test set page layout on not exists worksheet
 	assert.EqualError(t, f.SetPageLayout("SheetN", nil), "sheet SheetN does not exist")
 	// Test set page layout with invalid sheet name
@@ -250,6 +396,25 @@ func TestHeaderFooter(t *testing.T) {
 	assert.EqualError(t, f.SetHeaderFooter("Sheet1", &HeaderFooterOptions{
 		OddHeader: strings.Repeat("c", MaxFieldLength+1),
 	}), newFieldLengthError("OddHeader").Error())
+	assert.EqualError(t, f.SetHeaderFooter("Sheet1", &HeaderFooterOptions{
+		FirstFooter: strings.Repeat("c", MaxFieldLength+1),
+	}), newFieldLengthError("FirstFooter").Error())
+	// Test set header and footer with invalid formatting codes
+	for _, c := range []struct {
+		field, code string
+		opts        *HeaderFooterOptions
+	}{
+		{"OddHeader", "&", &HeaderFooterOptions{OddHeader: "&CPage&"}},
+		{"OddFooter", "&Q", &HeaderFooterOptions{OddFooter: "&C&Q"}},
+		{"EvenHeader", "&p", &HeaderFooterOptions{EvenHeader: "&p"}},
+		{"EvenFooter", "&页", &HeaderFooterOptions{EvenFooter: "&L&页"}},
+		{"FirstHeader", `&"Arial,Bold`, &HeaderFooterOptions{FirstHeader: `&C&"Arial,Bold`}},
+		{"FirstFooter", "&KFF00", &HeaderFooterOptions{FirstFooter: "&KFF00"}},
+		{"OddHeader", "&K01*033", &HeaderFooterOptions{OddHeader: "&K01*033Text"}},
+		{"OddHeader", "&KGG0000", &HeaderFooterOptions{OddHeader: "&KGG0000Text"}},
+	} {
+		assert.Equal(t, newInvalidHeaderFooterFormatCodeError(c.field, c.code), f.SetHeaderFooter("Sheet1", c.opts))
+	}
 
 	assert.NoError(t, f.SetHeaderFooter("Sheet1", nil))
 	text := strings.Repeat("一", MaxFieldLength)
@@ -273,9 +438,67 @@ func TestHeaderFooter(t *testing.T) {
 	opts, err = f.GetHeaderFooter("Sheet1")
 	assert.NoError(t, err)
 	assert.Equal(t, expected, opts)
+	// Test set header and footer with formatting codes
+	expected = &HeaderFooterOptions{
+		DifferentFirst:   true,
+		DifferentOddEven: true,
+		OddHeader:        `&L&"Arial,Bold Italic"&14&KFF0000Tom && Jerry&R&P+1 of &N`,
+		OddFooter:        "&C&K01+033&A&P-2",
+		EvenHeader:       "&L&B&I&U&E&S&X&Y&O&H&G",
+		EvenFooter:       "&L&D&R&T&Z&F&P+",
+		FirstHeader:      "&C&14",
+		FirstFooter:      "&C&KFFFFFF页脚",
+	}
+	assert.NoError(t, f.SetHeaderFooter("Sheet1", expected))
+	opts, err = f.GetHeaderFooter("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, opts)
 	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestSetHeaderFooter.xlsx")))
 }
 
+func TestResolveDefinedName(t *testing.T) {
+	f := NewFile()
+	_, err := f.NewSheet("Sheet2")
+	assert.NoError(t, err)
+	for _, definedName := range []DefinedName{
+		{Name: "Amount", RefersTo: "Sheet1!$A$1:$A$10"},
+		{Name: "Amount", RefersTo: "Sheet2!$B$1:$B$10", Scope: "Sheet2"},
+		{Name: "Rate", RefersTo: "Sheet2!$C$1", Scope: "Sheet2"},
+	} {
+		assert.NoError(t, f.SetDefinedName(&definedName))
+	}
+	for _, c := range []struct {
+		name, sheet, expected string
+	}{
+		{name: "Amount", expected: "Sheet1!$A$1:$A$10"},
+		{name: "Amount", sheet: "Sheet1", expected: "Sheet1!$A$1:$A$10"},
+		{name: "Amount", sheet: "Sheet2", expected: "Sheet2!$B$1:$B$10"},
+		{name: "amount", sheet: "sheet2", expected: "Sheet2!$B$1:$B$10"},
+		{name: "Rate", sheet: "Sheet2", expected: "Sheet2!$C$1"},
+	} {
+		refersTo, err := f.ResolveDefinedName(c.name, c.sheet)
+		assert.NoError(t, err)
+		assert.Equal(t, c.expected, refersTo)
+	}
+	// Test resolve defined name on the scope without the defined name
+	_, err = f.ResolveDefinedName("Rate", "Sheet1")
+	assert.Equal(t, ErrDefinedNameScope, err)
+	_, err = f.ResolveDefinedName("Rate", "")
+	assert.Equal(t, ErrDefinedNameScope, err)
+	// Test resolve defined name with invalid sheet name
+	_, err = f.ResolveDefinedName("Amount", "Sheet:1")
+	assert.Equal(t, ErrSheetNameInvalid, err)
+	// Test resolve defined name on not exists worksheet
+	_, err = f.ResolveDefinedName("Amount", "SheetN")
+	assert.EqualError(t, err, "sheet SheetN does not exist")
+	// Test resolve defined name with unsupported charset workbook
+	f.WorkBook = nil
+	f.Pkg.Store(defaultXMLPathWorkbook, MacintoshCyrillicCharset)
+	_, err = f.ResolveDefinedName("Amount", "")
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+	assert.NoError(t, f.Close())
+}
+
 func TestDefinedName(t *testing.T) {
 	f := NewFile()
 	assert.NoError(t, f.SetDefinedName(&DefinedName{
@@ -433,6 +656,49 @@ func TestGetSheetMap(t *testing.T) {
 	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
 }
 
+func TestGetSheetType(t *testing.T) {
+	f := NewFile()
+	f.Pkg.Store("xl/dialogsheets/sheet1.xml", []byte(`<dialogsheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData/></dialogsheet>`))
+	f.Pkg.Store("xl/macrosheets/sheet1.xml", []byte(`<xm:macrosheet xmlns:xm="http://schemas.microsoft.com/office/excel/2006/main" xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData><row r="1"><c r="A1"><f>RETURN()</f></c></row></sheetData></xm:macrosheet>`))
+	f.setWorkbook("Dialog1", 2, f.addRels(f.getWorkbookRelsPath(), SourceRelationshipDialogsheet, "dialogsheets/sheet1.xml", ""))
+	f.setWorkbook("Macro1", 3, f.addRels(f.getWorkbookRelsPath(), "http://schemas.microsoft.com/office/2006/relationships/xlMacrosheet", "macrosheets/sheet1.xml", ""))
+	buf, err := f.WriteToBuffer()
+	assert.NoError(t, err)
+	f, err = OpenReader(buf)
+	assert.NoError(t, err)
+	assert.NoError(t, f.AddChartSheet("Chart1", &Chart{Type: Col, Series: []ChartSeries{{Values: "Sheet1!$A$1:$A$2"}}}))
+	for sheet, expected := range map[string]SheetType{
+		"Sheet1": SheetTypeWorksheet, "Dialog1": SheetTypeDialogsheet, "Macro1": SheetTypeMacrosheet, "Chart1": SheetTypeChartsheet,
+	} {
+		sheetType, err := f.GetSheetType(sheet)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sheetType, sheet)
+	}
+	// Test the dialog sheet and macro sheet are preserved on saving the workbook
+	assert.Equal(t, newNotWorksheetError("Macro1"), f.SetCellValue("Macro1", "A1", 1))
+	assert.NoError(t, f.SetCellFormula("Sheet1", "A1", "Macro1!A1"))
+	assert.NoError(t, f.InsertRows("Sheet1", 1, 1))
+	_, err = f.NewSheet("Sheet2")
+	assert.NoError(t, err)
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestGetSheetType.xlsm")))
+	assert.NoError(t, f.Close())
+	f, err = OpenFile(filepath.Join("test", "TestGetSheetType.xlsm"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Sheet1", "Dialog1", "Macro1", "Chart1", "Sheet2"}, f.GetSheetList())
+	assert.Contains(t, string(f.readXML("xl/macrosheets/sheet1.xml")), "<f>RETURN()</f>")
+	assert.Contains(t, string(f.readXML("xl/dialogsheets/sheet1.xml")), "<dialogsheet")
+	formula, err := f.GetCellFormula("Sheet1", "A2")
+	assert.NoError(t, err)
+	assert.Equal(t, "Macro1!A1", formula)
+	// Test get sheet type with invalid sheet name
+	_, err = f.GetSheetType("Sheet:1")
+	assert.Equal(t, ErrSheetNameInvalid, err)
+	// Test get sheet type on not exists worksheet
+	_, err = f.GetSheetType("SheetN")
+	assert.Equal(t, ErrSheetNotExist{"SheetN"}, err)
+	assert.NoError(t, f.Close())
+}
+
 func TestSetActiveSheet(t *testing.T) {
 	f := NewFile()
 	f.WorkBook.BookViews = nil
@@ -460,6 +726,97 @@ func TestSetActiveSheet(t *testing.T) {
 	f.SetActiveSheet(idx)
 }
 
+func TestSetActiveSheetByName(t *testing.T) {
+	f := NewFile()
+	assert.Equal(t, "Sheet1", f.GetActiveSheetName())
+	assert.NoError(t, f.AddChartSheet("Chart1", &Chart{
+		Type:   Col,
+		Series: []ChartSeries{{Name: "Sheet1!$A$1", Categories: "Sheet1!$B$1:$D$1", Values: "Sheet1!$B$2:$D$2"}},
+	}))
+	for _, sheet := range []string{"Sheet2", "Sheet3"} {
+		_, err := f.NewSheet(sheet)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, f.SetActiveSheetByName("sheet3"))
+	assert.Equal(t, "Sheet3", f.GetActiveSheetName())
+	assert.Equal(t, 3, f.GetActiveSheetIndex())
+	for sheet, selected := range map[string]bool{"Sheet1": false, "Sheet2": false, "Sheet3": true} {
+		ws, err := f.workSheetReader(sheet)
+		assert.NoError(t, err)
+		assert.Equal(t, selected, ws.SheetViews.SheetView[0].TabSelected, sheet)
+	}
+	assert.NoError(t, f.SetActiveSheetByName("Sheet2"))
+	assert.Equal(t, "Sheet2", f.GetActiveSheetName())
+	// Test set active sheet with invalid sheet name
+	assert.Equal(t, ErrSheetNameInvalid, f.SetActiveSheetByName("Sheet:1"))
+	// Test set active sheet on not exists worksheet
+	assert.Equal(t, ErrSheetNotExist{"SheetN"}, f.SetActiveSheetByName("SheetN"))
+	assert.Equal(t, "Sheet2", f.GetActiveSheetName())
+}
+
+func TestSetSelectedSheets(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.AddChartSheet("Chart1", &Chart{
+		Type:   Col,
+		Series: []ChartSeries{{Name: "Sheet1!$A$1", Categories: "Sheet1!$B$1:$D$1", Values: "Sheet1!$B$2:$D$2"}},
+	}))
+	for _, sheet := range []string{"Sheet2", "Sheet3", "Sheet4"} {
+		_, err := f.NewSheet(sheet)
+		assert.NoError(t, err)
+	}
+	selected, err := f.GetSelectedSheets()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Sheet1"}, selected)
+	// Test select a group of sheets without the active sheet
+	assert.NoError(t, f.SetSelectedSheets([]string{"Sheet3", "Chart1", "Sheet2"}))
+	assert.Equal(t, "Sheet3", f.GetActiveSheetName())
+	selected, err = f.GetSelectedSheets()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Sheet2", "Sheet3"}, selected)
+	// Test select a group of sheets with the active sheet
+	assert.NoError(t, f.SetSelectedSheets([]string{"Sheet1", "Sheet3"}))
+	assert.Equal(t, "Sheet3", f.GetActiveSheetName())
+	selected, err = f.GetSelectedSheets()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Sheet1", "Sheet3"}, selected)
+	// Test select an empty group of sheets
+	assert.NoError(t, f.SetSelectedSheets(nil))
+	selected, err = f.GetSelectedSheets()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Sheet3"}, selected)
+	// Test select sheets without sheet views
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet4.xml")
+	assert.True(t, ok)
+	ws.(*xlsxWorksheet).SheetViews = &xlsxSheetViews{}
+	assert.NoError(t, f.SetSelectedSheets([]string{"Sheet3", "Sheet4"}))
+	selected, err = f.GetSelectedSheets()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Sheet3", "Sheet4"}, selected)
+	// Test select a group of sheets starts with the chartsheet
+	f.SetActiveSheet(0)
+	assert.NoError(t, f.SetSelectedSheets([]string{"Chart1", "Sheet4"}))
+	assert.Equal(t, "Sheet4", f.GetActiveSheetName())
+	selected, err = f.GetSelectedSheets()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Sheet4"}, selected)
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestSetSelectedSheets.xlsx")))
+	// Test select sheets with invalid sheet name
+	assert.Equal(t, ErrSheetNameInvalid, f.SetSelectedSheets([]string{"Sheet:1"}))
+	// Test select sheets on not exists worksheet
+	assert.Equal(t, ErrSheetNotExist{"SheetN"}, f.SetSelectedSheets([]string{"Sheet1", "SheetN"}))
+	assert.NoError(t, f.Close())
+
+	// Test get selected sheets with unsupported charset worksheet
+	f = NewFile()
+	f.Sheet.Delete("xl/worksheets/sheet1.xml")
+	f.Pkg.Store("xl/worksheets/sheet1.xml", MacintoshCyrillicCharset)
+	f.checked = sync.Map{}
+	_, err = f.GetSelectedSheets()
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+	// Test select sheets with unsupported charset worksheet
+	assert.EqualError(t, f.SetSelectedSheets([]string{"Sheet1"}), "XML syntax error on line 1: invalid UTF-8")
+}
+
 func TestSetSheetName(t *testing.T) {
 	f := NewFile()
 	// Test set worksheet with the same name
@@ -580,6 +937,34 @@ func TestGetSheetVisible(t *testing.T) {
 	assert.EqualError(t, err, ErrSheetNameInvalid.Error())
 }
 
+func TestGetSheetVisibility(t *testing.T) {
+	f := NewFile()
+	for _, sheet := range []string{"Sheet2", "Sheet3"} {
+		_, err := f.NewSheet(sheet)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, f.SetSheetVisible("Sheet2", false))
+	assert.NoError(t, f.SetSheetVisible("Sheet3", false, true))
+	for sheet, expected := range map[string]SheetVisibility{
+		"Sheet1": SheetVisible, "Sheet2": SheetHidden, "Sheet3": SheetVeryHidden,
+	} {
+		state, err := f.GetSheetVisibility(sheet)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, state)
+	}
+	// Test get sheet visibility on not exists worksheet
+	_, err := f.GetSheetVisibility("SheetN")
+	assert.EqualError(t, err, "sheet SheetN does not exist")
+	// Test get sheet visibility with invalid sheet name
+	_, err = f.GetSheetVisibility("Sheet:1")
+	assert.Equal(t, ErrSheetNameInvalid, err)
+	// Test get sheet visibility with unsupported charset workbook
+	f.WorkBook = nil
+	f.Pkg.Store(defaultXMLPathWorkbook, MacintoshCyrillicCharset)
+	_, err = f.GetSheetVisibility("Sheet1")
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+}
+
 func TestGetSheetIndex(t *testing.T) {
 	f := NewFile()
 	// Test get sheet index with invalid sheet name
@@ -727,6 +1112,14 @@ func TestSheetDimension(t *testing.T) {
 	dimension, err = f.GetSheetDimension(sheetName)
 	assert.NoError(t, err)
 	assert.Equal(t, "", dimension)
+	assert.NoError(t, f.SetCellValue(sheetName, "C3", 1))
+	dimension, err = f.GetSheetDimension(sheetName)
+	assert.NoError(t, err)
+	assert.Equal(t, "C3", dimension)
+	assert.NoError(t, f.SetCellValue(sheetName, "D4", 1))
+	dimension, err = f.GetSheetDimension(sheetName)
+	assert.NoError(t, err)
+	assert.Equal(t, "C3:D4", dimension)
 	// Test set the worksheet dimension
 	for _, excepted := range []string{"A1", "A1:D5", "A1:XFD1048576", "a1", "A1:d5"} {
 		err = f.SetSheetDimension(sheetName, excepted)
@@ -760,4 +1153,167 @@ func TestSheetDimension(t *testing.T) {
 	dimension, err = f.GetSheetDimension("SheetN")
 	assert.Empty(t, dimension)
 	assert.EqualError(t, err, "sheet SheetN does not exist")
+	// Test get the worksheet dimension with invalid sheet name
+	_, err = f.GetSheetDimension("Sheet:1")
+	assert.Equal(t, ErrSheetNameInvalid, err)
+}
+
+func TestGetSheetDimensionStreaming(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetCellValue("Sheet1", "B2", 1))
+	assert.NoError(t, f.SetCellValue("Sheet1", "D5", "text"))
+	assert.NoError(t, f.SetSheetDimension("Sheet1", "A1:F10"))
+	_, err := f.NewSheet("Sheet2")
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetCellValue("Sheet2", "C3", 1))
+	assert.NoError(t, f.SetCellValue("Sheet2", "E4", "text"))
+	assert.NoError(t, f.SetSheetDimension("Sheet2", ""))
+	buf, err := f.WriteToBuffer()
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	f, err = OpenReader(buf)
+	assert.NoError(t, err)
+	// Test get the worksheet dimension from the dimension element
+	dimension, err := f.GetSheetDimension("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "A1:F10", dimension)
+	// Test get the used range without the dimension element
+	dimension, err = f.GetSheetDimension("Sheet2")
+	assert.NoError(t, err)
+	assert.Equal(t, "C3:E4", dimension)
+	// Test the worksheets have not been loaded
+	for _, name := range []string{"xl/worksheets/sheet1.xml", "xl/worksheets/sheet2.xml"} {
+		_, ok := f.Sheet.Load(name)
+		assert.False(t, ok)
+	}
+	// Test get the used range of the worksheet without cells
+	f.Pkg.Store("xl/worksheets/sheet2.xml", []byte(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData><row><c/></row></sheetData></worksheet>`))
+	dimension, err = f.GetSheetDimension("Sheet2")
+	assert.NoError(t, err)
+	assert.Equal(t, "", dimension)
+	// Test get the worksheet dimension without range reference
+	f.Pkg.Store("xl/worksheets/sheet2.xml", []byte(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><dimension/><sheetData><row><c r="B2"><v>1</v></c></row></sheetData></worksheet>`))
+	dimension, err = f.GetSheetDimension("Sheet2")
+	assert.NoError(t, err)
+	assert.Equal(t, "", dimension)
+	// Test get the used range with the cells without references
+	f.Pkg.Store("xl/worksheets/sheet2.xml", []byte(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData><row><c/><c><v>1</v></c></row><row r="3"><c t="s"><v>0</v></c></row></sheetData></worksheet>`))
+	dimension, err = f.GetSheetDimension("Sheet2")
+	assert.NoError(t, err)
+	assert.Equal(t, "A1:B3", dimension)
+	// Test get the used range with invalid cell reference
+	f.Pkg.Store("xl/worksheets/sheet2.xml", []byte(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData><row><c r="A"><v>1</v></c></row></sheetData></worksheet>`))
+	_, err = f.GetSheetDimension("Sheet2")
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), err)
+	// Test get the used range with unsupported charset
+	f.Pkg.Store("xl/worksheets/sheet2.xml", MacintoshCyrillicCharset)
+	_, err = f.GetSheetDimension("Sheet2")
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+	assert.NoError(t, f.Close())
+
+	// Test get the worksheet dimension on the chart sheet
+	f = NewFile()
+	assert.NoError(t, f.AddChartSheet("Chart1", &Chart{Type: Col, Series: []ChartSeries{{Name: "Sheet1!$A$1", Categories: "Sheet1!$B$1:$D$1", Values: "Sheet1!$B$2:$D$2"}}}))
+	_, err = f.GetSheetDimension("Chart1")
+	assert.EqualError(t, err, "sheet Chart1 is not a worksheet")
+	assert.NoError(t, f.Close())
+}
+
+func TestAutoDimension(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetSheetDimension("Sheet1", "A1:B2"))
+	assert.NoError(t, f.SetCellValue("Sheet1", "C3", 1))
+	assert.NoError(t, f.SetCellValue("Sheet1", "E8", "text"))
+	// Test save the spreadsheet without recalculating the dimension
+	buf, err := f.WriteToBuffer()
+	assert.NoError(t, err)
+	f, err = OpenReader(buf)
+	assert.NoError(t, err)
+	dimension, err := f.GetSheetDimension("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "A1:B2", dimension)
+	// Test save the spreadsheet with recalculating the dimension
+	assert.NoError(t, f.SetCellValue("Sheet1", "B10", true))
+	assert.NoError(t, f.SetCellValue("Sheet1", "F1", nil))
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestAutoDimension.xlsx"), Options{AutoDimension: true}))
+	assert.NoError(t, f.Close())
+	f, err = OpenFile(filepath.Join("test", "TestAutoDimension.xlsx"))
+	assert.NoError(t, err)
+	dimension, err = f.GetSheetDimension("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "B3:E10", dimension)
+	assert.NoError(t, f.Close())
+	// Test recalculate the dimension of the worksheet without used cells
+	f = NewFile()
+	assert.NoError(t, f.SetSheetDimension("Sheet1", "A1:B2"))
+	_, err = f.WriteTo(buf, Options{AutoDimension: true})
+	assert.NoError(t, err)
+	dimension, err = f.GetSheetDimension("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "A1", dimension)
+	// Test recalculate the dimension with invalid cell reference
+	ws := &xlsxWorksheet{SheetData: xlsxSheetData{Row: []xlsxRow{{C: []xlsxC{{R: "A", V: "1"}, {R: "C2", V: "1"}}}}}}
+	assert.Equal(t, "C2", ws.getUsedRange())
+}
+
+func TestIgnoredErrors(t *testing.T) {
+	f := NewFile()
+	ignoredErrors, err := f.GetIgnoredErrors("Sheet1")
+	assert.NoError(t, err)
+	assert.Empty(t, ignoredErrors)
+	assert.NoError(t, f.SetIgnoredErrors("Sheet1", "A10:A1", IgnoredErrorOptions{NumberStoredAsText: true}))
+	assert.NoError(t, f.SetIgnoredErrors("Sheet1", "C1 d2:E3", IgnoredErrorOptions{EvalError: true, Formula: true}))
+	// Test merge the range reference into the ignored error with the same error types
+	assert.NoError(t, f.SetIgnoredErrors("Sheet1", "B1:B5 A1:A10", IgnoredErrorOptions{SQRef: "Z1", NumberStoredAsText: true}))
+	expected := []IgnoredErrorOptions{
+		{SQRef: "A1:A10 B1:B5", NumberStoredAsText: true},
+		{SQRef: "C1 D2:E3", EvalError: true, Formula: true},
+	}
+	ignoredErrors, err = f.GetIgnoredErrors("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, ignoredErrors)
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestIgnoredErrors.xlsx")))
+	assert.NoError(t, f.Close())
+
+	f, err = OpenFile(filepath.Join("test", "TestIgnoredErrors.xlsx"))
+	assert.NoError(t, err)
+	ignoredErrors, err = f.GetIgnoredErrors("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, ignoredErrors)
+	// Test set ignored errors with invalid parameters
+	assert.Equal(t, ErrParameterInvalid, f.SetIgnoredErrors("Sheet1", "A1", IgnoredErrorOptions{}))
+	assert.Equal(t, ErrParameterInvalid, f.SetIgnoredErrors("Sheet1", " ", IgnoredErrorOptions{EvalError: true}))
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), f.SetIgnoredErrors("Sheet1", "A", IgnoredErrorOptions{EvalError: true}))
+	assert.Equal(t, newCellNameToCoordinatesError("B", newInvalidCellNameError("B")), f.SetIgnoredErrors("Sheet1", "A1:B", IgnoredErrorOptions{EvalError: true}))
+	// Test set and get ignored errors on not exists worksheet
+	assert.EqualError(t, f.SetIgnoredErrors("SheetN", "A1", IgnoredErrorOptions{EvalError: true}), "sheet SheetN does not exist")
+	_, err = f.GetIgnoredErrors("SheetN")
+	assert.EqualError(t, err, "sheet SheetN does not exist")
+	assert.NoError(t, f.Close())
+}
+
+func TestRenameTableReferences(t *testing.T) {
+	names := map[string]string{"Table1": "Table1_2"}
+	for formula, expected := range map[string]string{
+		"":                            "",
+		"SUM(A1:A2)":                  "SUM(A1:A2)",
+		"Table1[Amount]*2":            "Table1_2[Amount]*2",
+		"TABLE1[@Amount]+MyTable1[A]": "Table1_2[@Amount]+MyTable1[A]",
+		"\"Table1[A]\"&Table1[[A]]":   "\"Table1[A]\"&Table1_2[[A]]",
+	} {
+		assert.Equal(t, expected, renameTableReferences(formula, names))
+	}
+	assert.Equal(t, "Table1[A]", renameTableReferences("Table1[A]", nil))
+	// Test copy table with the total row formula
+	f := NewFile()
+	f.Pkg.Store("xl/tables/table1.xml", []byte(`<table id="1" name="Table1" ref="A1:A3" totalsRowCount="1"><tableColumns count="1"><tableColumn id="1" name="A" totalsRowFunction="custom"><totalsRowFormula>SUM(Table1[A])</totalsRowFormula></tableColumn></tableColumns></table>`))
+	tableNames := map[string]string{}
+	target, err := f.copyTable("xl/tables/table1.xml", tableNames)
+	assert.NoError(t, err)
+	assert.Equal(t, "../tables/table2.xml", target)
+	assert.Equal(t, map[string]string{"Table1": "Table1_2"}, tableNames)
+	content, ok := f.Pkg.Load("xl/tables/table2.xml")
+	assert.True(t, ok)
+	assert.Contains(t, string(content.([]byte)), "SUM(Table1_2[A])")
 }
@@ -11,7 +11,11 @@
 
 package excelize
 
-import "reflect"
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
 
 // SetPageMargins provides a function to set worksheet page margins.
 func (f *File) SetPageMargins(sheet string, opts *PageLayoutMarginsOptions) error {
@@ -178,6 +182,33 @@ func (f *File) SetSheetProps(sheet string, opts *SheetPropsOptions) error {
 	return err
 }
 
+// SetSheetTabColor provides a function to set the tab color of the worksheet
+// by given worksheet name and hex color code in RRGGBB format, passing an empty
+// color will remove the tab color. Set the TabColorTheme and TabColorTint
+// fields of the SheetPropsOptions by the SetSheetProps function to use the
+// theme color with tint. For example, set the tab color of Sheet1 to red:
+//
+//	err := f.SetSheetTabColor("Sheet1", "#FF0000")
+func (f *File) SetSheetTabColor(sheet, color string) error {
+	ws, err := f.workSheetReader(sheet)
+	if err != nil {
+		return err
+	}
+	if color == "" {
+		if ws.SheetPr != nil {
+			ws.SheetPr.TabColor = nil
+		}
+		return err
+	}
+	hexColor := strings.TrimPrefix(color, "#")
+	if _, err = strconv.ParseUint(hexColor, 16, 32); err != nil || len(hexColor) != 6 {
+		return ErrParameterInvalid
+	}
+	ws.prepareSheetPr()
+	ws.SheetPr.TabColor = &xlsxColor{RGB: getPaletteColor(hexColor)}
+	return nil
+}
+
 // GetSheetProps provides a function to get worksheet properties.
 func (f *File) GetSheetProps(sheet string) (SheetPropsOptions, error) {
 	baseColWidth := uint8(8)
@@ -226,3 +257,73 @@ func (f *File) GetSheetProps(sheet string) (SheetPropsOptions, error) {
 	}
 	return opts, err
 }
+
+// SetSheetFormatPr provides a function to set the sheet format properties by
+// given worksheet name and sheet format properties options, which specifies
+// the default column width and row height of the worksheet. For example, set
+// the default column width to 12 characters and the default row height to 20
+// points of the worksheet named Sheet1:
+//
+//	width, height := 12.0, 20.0
+//	err := f.SetSheetFormatPr("Sheet1", &excelize.SheetFormatPrOptions{
+//	    DefaultColWidth:  &width,
+//	    DefaultRowHeight: &height,
+//	})
+func (f *File) SetSheetFormatPr(sheet string, opts *SheetFormatPrOptions) error {
+	if opts == nil {
+		_, err := f.workSheetReader(sheet)
+		return err
+	}
+	if opts.DefaultColWidth != nil && (*opts.DefaultColWidth < 0 || *opts.DefaultColWidth > MaxColumnWidth) {
+		return ErrColumnWidth
+	}
+	if opts.DefaultRowHeight != nil && (*opts.DefaultRowHeight < 0 || *opts.DefaultRowHeight > MaxRowHeight) {
+		return ErrMaxRowHeight
+	}
+	customHeight := opts.CustomHeight
+	if customHeight == nil && opts.DefaultRowHeight != nil {
+		customHeight = boolPtr(true)
+	}
+	return f.SetSheetProps(sheet, &SheetPropsOptions{
+		BaseColWidth:     opts.BaseColWidth,
+		DefaultColWidth:  opts.DefaultColWidth,
+		DefaultRowHeight: opts.DefaultRowHeight,
+		CustomHeight:     customHeight,
+		ZeroHeight:       opts.ZeroHeight,
+		ThickTop:         opts.ThickTop,
+		ThickBottom:      opts.ThickBottom,
+	})
+}
+
+// GetSheetFormatPr provides a function to get the sheet format properties by
+// given worksheet name, which returns the default column width and row
+// height of the worksheet. The default values will be returned if the
+// worksheet doesn't specify them.
+func (f *File) GetSheetFormatPr(sheet string) (SheetFormatPrOptions, error) {
+	props, err := f.GetSheetProps(sheet)
+	opts := SheetFormatPrOptions{
+		BaseColWidth:     props.BaseColWidth,
+		DefaultColWidth:  props.DefaultColWidth,
+		DefaultRowHeight: props.DefaultRowHeight,
+		CustomHeight:     props.CustomHeight,
+		ZeroHeight:       props.ZeroHeight,
+		ThickTop:         props.ThickTop,
+		ThickBottom:      props.ThickBottom,
+	}
+	if opts.BaseColWidth == nil || *opts.BaseColWidth == 0 {
+		baseColWidth := uint8(8)
+		opts.BaseColWidth = &baseColWidth
+	}
+	if opts.DefaultColWidth == nil || *opts.DefaultColWidth == 0 {
+		opts.DefaultColWidth = float64Ptr(defaultColWidth)
+	}
+	if opts.DefaultRowHeight == nil || *opts.DefaultRowHeight == 0 {
+		opts.DefaultRowHeight = float64Ptr(defaultRowHeight)
+	}
+	for _, field := range []**bool{&opts.CustomHeight, &opts.ZeroHeight, &opts.ThickTop, &opts.ThickBottom} {
+		if *field == nil {
+			*field = boolPtr(false)
+		}
+	}
+	return opts, err
+}
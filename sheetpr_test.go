@@ -91,6 +91,28 @@ func TestSetSheetProps(t *testing.T) {
 	assert.Equal(t, ErrSheetNameInvalid, f.SetSheetProps("Sheet:1", nil))
 }
 
+func TestSetSheetTabColor(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetSheetTabColor("Sheet1", "#4472c4"))
+	opts, err := f.GetSheetProps("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "FF4472C4", *opts.TabColorRGB)
+	// Test remove the tab color
+	assert.NoError(t, f.SetSheetTabColor("Sheet1", ""))
+	opts, err = f.GetSheetProps("Sheet1")
+	assert.NoError(t, err)
+	assert.Nil(t, opts.TabColorRGB)
+	// Test set tab color with invalid color
+	for _, color := range []string{"#FFF", "GGGGGG", "#FF000000"} {
+		assert.Equal(t, ErrParameterInvalid, f.SetSheetTabColor("Sheet1", color))
+	}
+	// Test set tab color on not exists worksheet
+	assert.EqualError(t, f.SetSheetTabColor("SheetN", "FF0000"), "sheet SheetN does not exist")
+	// Test remove the tab color without worksheet properties
+	f = NewFile()
+	assert.NoError(t, f.SetSheetTabColor("Sheet1", ""))
+}
+
 func TestGetSheetProps(t *testing.T) {
 	f := NewFile()
 	// Test get worksheet properties on not exists worksheet
@@ -100,3 +122,54 @@ func TestGetSheetProps(t *testing.T) {
 	_, err = f.GetSheetProps("Sheet:1")
 	assert.Equal(t, ErrSheetNameInvalid, err)
 }
+
+func TestSheetFormatPr(t *testing.T) {
+	f := NewFile()
+	defaultBaseColWidth, baseColWidth := uint8(8), uint8(10)
+	opts, err := f.GetSheetFormatPr("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, SheetFormatPrOptions{
+		BaseColWidth:     &defaultBaseColWidth,
+		DefaultColWidth:  float64Ptr(defaultColWidth),
+		DefaultRowHeight: float64Ptr(defaultRowHeight),
+		CustomHeight:     boolPtr(false),
+		ZeroHeight:       boolPtr(false),
+		ThickTop:         boolPtr(false),
+		ThickBottom:      boolPtr(false),
+	}, opts)
+	assert.NoError(t, f.SetSheetFormatPr("Sheet1", nil))
+	expected := SheetFormatPrOptions{
+		BaseColWidth:     &baseColWidth,
+		DefaultColWidth:  float64Ptr(12),
+		DefaultRowHeight: float64Ptr(20),
+		CustomHeight:     boolPtr(true),
+		ZeroHeight:       boolPtr(true),
+		ThickTop:         boolPtr(true),
+		ThickBottom:      boolPtr(true),
+	}
+	assert.NoError(t, f.SetSheetFormatPr("Sheet1", &SheetFormatPrOptions{
+		BaseColWidth:     expected.BaseColWidth,
+		DefaultColWidth:  expected.DefaultColWidth,
+		DefaultRowHeight: expected.DefaultRowHeight,
+		ZeroHeight:       expected.ZeroHeight,
+		ThickTop:         expected.ThickTop,
+		ThickBottom:      expected.ThickBottom,
+	}))
+	opts, err = f.GetSheetFormatPr("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, opts)
+	width, err := f.GetColWidth("Sheet1", "A")
+	assert.NoError(t, err)
+	assert.Equal(t, 12.0, width)
+	height, err := f.GetRowHeight("Sheet1", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 20.0, height)
+	// Test set sheet format properties with invalid options
+	assert.Equal(t, ErrColumnWidth, f.SetSheetFormatPr("Sheet1", &SheetFormatPrOptions{DefaultColWidth: float64Ptr(MaxColumnWidth + 1)}))
+	assert.Equal(t, ErrColumnWidth, f.SetSheetFormatPr("Sheet1", &SheetFormatPrOptions{DefaultColWidth: float64Ptr(-1)}))
+	assert.Equal(t, ErrMaxRowHeight, f.SetSheetFormatPr("Sheet1", &SheetFormatPrOptions{DefaultRowHeight: float64Ptr(MaxRowHeight + 1)}))
+	// Test set and get sheet format properties on not exists worksheet
+	assert.EqualError(t, f.SetSheetFormatPr("SheetN", nil), "sheet SheetN does not exist")
+	_, err = f.GetSheetFormatPr("SheetN")
+	assert.EqualError(t, err, "sheet SheetN does not exist")
+}
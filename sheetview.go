@@ -11,6 +11,11 @@
 
 package excelize
 
+import (
+	"strconv"
+	"strings"
+)
+
 // getSheetView returns the SheetView object
 func (f *File) getSheetView(sheet string, viewIndex int) (*xlsxSheetView, error) {
 	ws, err := f.workSheetReader(sheet)
@@ -34,6 +39,12 @@ func (f *File) getSheetView(sheet string, viewIndex int) (*xlsxSheetView, error)
 	return &(ws.SheetViews.SheetView[viewIndex]), err
 }
 
+// isRightToLeft returns whether the first sheet view of the worksheet displays
+// from right to left.
+func (ws *xlsxWorksheet) isRightToLeft() bool {
+	return ws.SheetViews != nil && len(ws.SheetViews.SheetView) > 0 && ws.SheetViews.SheetView[0].RightToLeft
+}
+
 // setSheetView set sheet view by given options.
 func (view *xlsxSheetView) setSheetView(opts *ViewOptions) {
 	if opts.DefaultGridColor != nil {
@@ -61,17 +72,47 @@ func (view *xlsxSheetView) setSheetView(opts *ViewOptions) {
 		view.TopLeftCell = *opts.TopLeftCell
 	}
 	if opts.View != nil {
-		if inStrSlice([]string{"normal", "pageLayout", "pageBreakPreview"}, *opts.View, true) != -1 {
-			view.View = *opts.View
-		}
+		view.View = *opts.View
 	}
-	if opts.ZoomScale != nil && *opts.ZoomScale >= 10 && *opts.ZoomScale <= 400 {
+	if opts.ZoomScale != nil {
 		view.ZoomScale = *opts.ZoomScale
 	}
 }
 
+// checkViewOptions provides a function to check the sheet view options, the
+// view type should be one of normal, pageLayout and pageBreakPreview, and the
+// zoom scale should be ranging from 10 to 400.
+func checkViewOptions(opts *ViewOptions) error {
+	if opts.View != nil && *opts.View != "" &&
+		inStrSlice([]string{"normal", "pageLayout", "pageBreakPreview"}, *opts.View, true) == -1 {
+		return ErrParameterInvalid
+	}
+	if opts.ZoomScale != nil && (*opts.ZoomScale < 10 || *opts.ZoomScale > 400) {
+		return ErrParameterInvalid
+	}
+	if opts.TopLeftCell != nil && *opts.TopLeftCell != "" {
+		if _, _, err := CellNameToCoordinates(*opts.TopLeftCell); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // SetSheetView sets sheet view options. The viewIndex may be negative and if
-// so is counted backward (-1 is the last view).
+// so is counted backward (-1 is the last view). For example, display Sheet1 in
+// right to left mode with 150 percent zoom, and hide the grid lines and zero
+// values:
+//
+//	enable, disable, zoomScale := true, false, 150.0
+//	err := f.SetSheetView("Sheet1", -1, &excelize.ViewOptions{
+//	    RightToLeft:   &enable,
+//	    ShowGridLines: &disable,
+//	    ShowZeros:     &disable,
+//	    ZoomScale:     &zoomScale,
+//	})
+//
+// An error will be returned if the zoom scale isn't ranging from 10 to 400,
+// or the view type isn't one of normal, pageLayout and pageBreakPreview.
 func (f *File) SetSheetView(sheet string, viewIndex int, opts *ViewOptions) error {
 	view, err := f.getSheetView(sheet, viewIndex)
 	if err != nil {
@@ -80,6 +121,9 @@ func (f *File) SetSheetView(sheet string, viewIndex int, opts *ViewOptions) erro
 	if opts == nil {
 		return err
 	}
+	if err = checkViewOptions(opts); err != nil {
+		return err
+	}
 	view.setSheetView(opts)
 	return nil
 }
@@ -89,7 +133,7 @@ func (f *File) SetSheetView(sheet string, viewIndex int, opts *ViewOptions) erro
 func (f *File) GetSheetView(sheet string, viewIndex int) (ViewOptions, error) {
 	opts := ViewOptions{
 		DefaultGridColor:  boolPtr(true),
-		ShowFormulas:      boolPtr(true),
+		ShowFormulas:      boolPtr(false),
 		ShowGridLines:     boolPtr(true),
 		ShowRowColHeaders: boolPtr(true),
 		ShowRuler:         boolPtr(true),
@@ -127,3 +171,85 @@ func (f *File) GetSheetView(sheet string, viewIndex int) (ViewOptions, error) {
 	}
 	return opts, err
 }
+
+// SetGridlineColor provides a function to set the gridline color of all views
+// of the worksheet by given worksheet name and hex color code in RRGGBB
+// format. The gridline color is stored as an index of the legacy indexed
+// color palette, so the given color will be resolved to the nearest color in
+// the color palette of the workbook, use the SetColorPalette function to
+// customize the palette for an exact color. Passing an empty color will
+// restore the default gridline color. For example, set the gridline color of
+// Sheet1 to light gray:
+//
+//	err := f.SetGridlineColor("Sheet1", "#C0C0C0")
+func (f *File) SetGridlineColor(sheet, color string) error {
+	colorID := 0
+	if color != "" {
+		hexColor := strings.TrimPrefix(color, "#")
+		RGB, err := strconv.ParseUint(hexColor, 16, 32)
+		if err != nil || len(hexColor) != 6 {
+			return ErrParameterInvalid
+		}
+		palette, err := f.GetColorPalette()
+		if err != nil {
+			return err
+		}
+		colorID = getNearestPaletteColor(palette, RGB) + 8
+	}
+	ws, err := f.workSheetReader(sheet)
+	if err != nil {
+		return err
+	}
+	if _, err = f.getSheetView(sheet, 0); err != nil {
+		return err
+	}
+	for i := range ws.SheetViews.SheetView {
+		view := &ws.SheetViews.SheetView[i]
+		if view.ColorID = colorID; colorID == 0 {
+			view.DefaultGridColor = nil
+			continue
+		}
+		view.DefaultGridColor = boolPtr(false)
+	}
+	return err
+}
+
+// GetGridlineColor provides a function to get the gridline color of the first
+// view of the worksheet by given worksheet name. The return value is the hex
+// color code in RRGGBB format resolved by the color palette of the workbook,
+// and an empty string will be returned if the worksheet uses the default
+// gridline color.
+func (f *File) GetGridlineColor(sheet string) (string, error) {
+	view, err := f.getSheetView(sheet, 0)
+	if err != nil {
+		return "", err
+	}
+	if view.DefaultGridColor == nil || *view.DefaultGridColor ||
+		view.ColorID < 8 || view.ColorID > 63 {
+		return "", err
+	}
+	palette, err := f.GetColorPalette()
+	if err != nil {
+		return "", err
+	}
+	return palette[view.ColorID-8], err
+}
+
+// getNearestPaletteColor provides a function to get the index of the color in
+// the given palette which has the minimum Euclidean distance to the given RGB
+// color.
+func getNearestPaletteColor(palette []string, RGB uint64) int {
+	idx, minDistance := 0, int64(-1)
+	r, g, b := int64(RGB>>16&0xFF), int64(RGB>>8&0xFF), int64(RGB&0xFF)
+	for i, color := range palette {
+		val, err := strconv.ParseUint(color, 16, 32)
+		if err != nil {
+			continue
+		}
+		dr, dg, db := int64(val>>16&0xFF)-r, int64(val>>8&0xFF)-g, int64(val&0xFF)-b
+		if distance := dr*dr + dg*dg + db*db; minDistance == -1 || distance < minDistance {
+			idx, minDistance = i, distance
+		}
+	}
+	return idx
+}
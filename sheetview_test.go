@@ -1,6 +1,8 @@
 package excelize
 
 import (
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -28,6 +30,20 @@ func TestSetView(t *testing.T) {
 	opts, err := f.GetSheetView("Sheet1", 0)
 	assert.NoError(t, err)
 	assert.Equal(t, expected, opts)
+	// Test set sheet view options with invalid zoom scale, view type and top
+	// left cell
+	for _, opts := range []*ViewOptions{
+		{ZoomScale: float64Ptr(9)},
+		{ZoomScale: float64Ptr(401)},
+		{View: stringPtr("unknown")},
+	} {
+		assert.Equal(t, ErrParameterInvalid, f.SetSheetView("Sheet1", 0, opts))
+	}
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")),
+		f.SetSheetView("Sheet1", 0, &ViewOptions{TopLeftCell: stringPtr("A")}))
+	opts, err = f.GetSheetView("Sheet1", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, opts)
 	// Test set sheet view options with invalid view index
 	assert.EqualError(t, f.SetSheetView("Sheet1", 1, nil), "view index 1 out of range")
 	assert.EqualError(t, f.SetSheetView("Sheet1", -2, nil), "view index -2 out of range")
@@ -48,3 +64,104 @@ func TestGetView(t *testing.T) {
 	_, err = f.GetSheetView("SheetN", 0)
 	assert.EqualError(t, err, "sheet SheetN does not exist")
 }
+
+func TestRightToLeft(t *testing.T) {
+	f := NewFile(Options{RightToLeft: true})
+	_, err := f.NewSheet("Sheet2")
+	assert.NoError(t, err)
+	for _, sheet := range []string{"Sheet1", "Sheet2"} {
+		opts, err := f.GetSheetView(sheet, 0)
+		assert.NoError(t, err)
+		assert.True(t, *opts.RightToLeft)
+	}
+	// Test add shape on the right-to-left worksheet
+	assert.NoError(t, f.AddShape("Sheet1", &Shape{Cell: "A1", Type: "rect", Paragraph: []RichTextRun{{Text: "مرحبا"}}}))
+	assert.NoError(t, f.SetSheetView("Sheet2", 0, &ViewOptions{RightToLeft: boolPtr(false)}))
+	assert.NoError(t, f.AddShape("Sheet2", &Shape{Cell: "A1", Type: "rect", Paragraph: []RichTextRun{{Text: "Hello"}}}))
+	drawing, ok := f.Drawings.Load("xl/drawings/drawing1.xml")
+	assert.True(t, ok)
+	sp := drawing.(*xlsxWsDr).TwoCellAnchor[0].Sp
+	assert.True(t, sp.TxBody.BodyPr.RtlCol)
+	assert.Equal(t, "r", sp.TxBody.P[0].PPr.Algn)
+	assert.True(t, sp.TxBody.P[0].PPr.Rtl)
+	drawing, ok = f.Drawings.Load("xl/drawings/drawing2.xml")
+	assert.True(t, ok)
+	sp = drawing.(*xlsxWsDr).TwoCellAnchor[0].Sp
+	assert.False(t, sp.TxBody.BodyPr.RtlCol)
+	assert.Nil(t, sp.TxBody.P[0].PPr)
+	// Test add charts on the right-to-left worksheet and chartsheet
+	chart := &Chart{
+		Type:   Col,
+		Series: []ChartSeries{{Name: "Sheet1!$A$1", Categories: "Sheet1!$B$1:$D$1", Values: "Sheet1!$B$2:$D$2"}},
+	}
+	assert.NoError(t, f.AddChart("Sheet1", "E1", chart))
+	assert.NoError(t, f.AddChart("Sheet2", "E1", chart))
+	assert.NoError(t, f.AddChartSheet("Chart1", chart))
+	for chartXML, rtl := range map[string]bool{
+		"xl/charts/chart1.xml": true, "xl/charts/chart2.xml": false, "xl/charts/chart3.xml": true,
+	} {
+		content, ok := f.Pkg.Load(chartXML)
+		assert.True(t, ok)
+		assert.Equal(t, rtl, strings.Contains(string(content.([]byte)), `<a:pPr rtl="true">`), chartXML)
+	}
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestRightToLeft.xlsx")))
+	assert.NoError(t, f.Close())
+
+	f = NewFile()
+	opts, err := f.GetSheetView("Sheet1", 0)
+	assert.NoError(t, err)
+	assert.False(t, *opts.RightToLeft)
+}
+
+func TestGridlineColor(t *testing.T) {
+	f := NewFile()
+	color, err := f.GetGridlineColor("Sheet1")
+	assert.NoError(t, err)
+	assert.Empty(t, color)
+	assert.NoError(t, f.SetGridlineColor("Sheet1", "#C0C0C0"))
+	color, err = f.GetGridlineColor("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "C0C0C0", color)
+	view, err := f.getSheetView("Sheet1", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 22, view.ColorID)
+	assert.False(t, *view.DefaultGridColor)
+	// Test set gridline color which will be resolved to the nearest palette color
+	assert.NoError(t, f.SetGridlineColor("Sheet1", "D0D0D0"))
+	color, err = f.GetGridlineColor("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "C0C0C0", color)
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestGridlineColor.xlsx")))
+	// Test restore the default gridline color
+	assert.NoError(t, f.SetGridlineColor("Sheet1", ""))
+	color, err = f.GetGridlineColor("Sheet1")
+	assert.NoError(t, err)
+	assert.Empty(t, color)
+	// Test set gridline color with invalid color
+	for _, color := range []string{"#FFF", "GGGGGG", "#FF000000"} {
+		assert.Equal(t, ErrParameterInvalid, f.SetGridlineColor("Sheet1", color))
+	}
+	// Test set and get gridline color on not exists worksheet
+	assert.EqualError(t, f.SetGridlineColor("SheetN", "C0C0C0"), "sheet SheetN does not exist")
+	_, err = f.GetGridlineColor("SheetN")
+	assert.EqualError(t, err, "sheet SheetN does not exist")
+	// Test set gridline color without sheet views
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	ws.(*xlsxWorksheet).SheetViews = &xlsxSheetViews{}
+	assert.EqualError(t, f.SetGridlineColor("Sheet1", "C0C0C0"), newViewIdxError(0).Error())
+	assert.NoError(t, f.Close())
+
+	// Test set and get gridline color with unsupported charset style sheet
+	f = NewFile()
+	assert.NoError(t, f.SetGridlineColor("Sheet1", "C0C0C0"))
+	f.Styles = nil
+	f.Pkg.Store(defaultXMLPathStyles, MacintoshCyrillicCharset)
+	assert.EqualError(t, f.SetGridlineColor("Sheet1", "C0C0C0"), "XML syntax error on line 1: invalid UTF-8")
+	f.Styles = nil
+	_, err = f.GetGridlineColor("Sheet1")
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+	assert.NoError(t, f.Close())
+	// Test get nearest palette color with invalid palette color
+	assert.Equal(t, 1, getNearestPaletteColor([]string{"XYZ", "000000"}, 0x101010))
+}
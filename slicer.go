@@ -19,6 +19,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 	"unicode"
 )
 
@@ -51,6 +52,12 @@ import (
 // ItemDesc specifies descending (Z-A) item sorting, this setting is optional,
 // and the default setting is false (represents ascending).
 //
+// ColumnCount specifies the number of columns the slicer items are arranged
+// in, this setting is optional, and the default setting is 1.
+//
+// Style specifies the built-in or custom slicer style name, such as
+// "SlicerStyleLight1", this setting is optional.
+//
 // Format specifies the format of the slicer, this setting is optional.
 type SlicerOptions struct {
 	Name          string
@@ -63,6 +70,8 @@ type SlicerOptions struct {
 	Height        uint
 	DisplayHeader *bool
 	ItemDesc      bool
+	ColumnCount   uint
+	Style         string
 	Format        GraphicOptions
 }
 
@@ -80,6 +89,7 @@ type SlicerOptions struct {
 //	    Caption:    "Column1",
 //	    Width:      200,
 //	    Height:     200,
+//	    Style:      "SlicerStyleLight1",
 //	})
 func (f *File) AddSlicer(sheet string, opts *SlicerOptions) error {
 	opts, err := parseSlicerOptions(opts)
@@ -107,13 +117,18 @@ func (f *File) AddSlicer(sheet string, opts *SlicerOptions) error {
 	if err := f.addDrawingSlicer(sheet, slicerName, ns, opts); err != nil {
 		return err
 	}
-	return f.addSlicer(slicerID, xlsxSlicer{
+	slicer := xlsxSlicer{
 		Name:        slicerName,
 		Cache:       slicerCacheName,
 		Caption:     opts.Caption,
 		ShowCaption: opts.DisplayHeader,
+		Style:       opts.Style,
 		RowHeight:   251883,
-	})
+	}
+	if opts.ColumnCount > 0 {
+		slicer.ColumnCount = intPtr(int(opts.ColumnCount))
+	}
+	return f.addSlicer(slicerID, slicer)
 }
 
 // parseSlicerOptions provides a function to parse the format settings of the
@@ -146,26 +161,30 @@ func parseSlicerOptions(opts *SlicerOptions) (*SlicerOptions, error) {
 	return opts, nil
 }
 
-// countSlicers provides a function to get slicer files count storage in the
-// folder xl/slicers.
+// countSlicers provides a function to get the largest slicer file index
+// storage in the folder xl/slicers.
 func (f *File) countSlicers() int {
 	count := 0
 	f.Pkg.Range(func(k, v interface{}) bool {
 		if strings.Contains(k.(string), "xl/slicers/slicer") {
-			count++
+			if ID, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(k.(string), "xl/slicers/slicer"), ".xml")); err == nil && ID > count {
+				count = ID
+			}
 		}
 		return true
 	})
 	return count
 }
 
-// countSlicerCache provides a function to get slicer cache files count storage
-// in the folder xl/SlicerCaches.
+// countSlicerCache provides a function to get the largest slicer cache file
+// index storage in the folder xl/SlicerCaches.
 func (f *File) countSlicerCache() int {
 	count := 0
 	f.Pkg.Range(func(k, v interface{}) bool {
 		if strings.Contains(k.(string), "xl/slicerCaches/slicerCache") {
-			count++
+			if ID, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(k.(string), "xl/slicerCaches/slicerCache"), ".xml")); err == nil && ID > count {
+				count = ID
+			}
 		}
 		return true
 	})
@@ -351,6 +370,18 @@ func (f *File) genSlicerName(name string) string {
 
 // genSlicerCacheName generates a unique slicer cache name by giving the slicer name.
 func (f *File) genSlicerCacheName(name string) string {
+	return f.genCacheName("Slicer_", name)
+}
+
+// genTimelineCacheName generates a unique timeline cache name by giving the
+// timeline name.
+func (f *File) genTimelineCacheName(name string) string {
+	return f.genCacheName("NativeTimeline_", name)
+}
+
+// genCacheName generates a unique slicer or timeline cache name which could be
+// used as a workbook scope defined name by giving the prefix and source name.
+func (f *File) genCacheName(prefix, name string) string {
 	var (
 		cnt             int
 		definedNames    []string
@@ -372,7 +403,7 @@ func (f *File) genSlicerCacheName(name string) string {
 		}
 		slicerCacheName += "_"
 	}
-	slicerCacheName = fmt.Sprintf("Slicer_%s", slicerCacheName)
+	slicerCacheName = prefix + slicerCacheName
 	for {
 		tmp := slicerCacheName
 		if cnt > 0 {
@@ -571,8 +602,8 @@ func (f *File) addPivotCacheSlicer(opts *PivotTableOptions) (int, error) {
 	return pivotCacheID, err
 }
 
-// addDrawingSlicer adds a slicer shape and fallback shape by giving the
-// worksheet name, slicer name, and slicer options.
+// addDrawingSlicer adds a slicer or timeline shape and fallback shape by giving
+// the worksheet name, slicer name, and slicer options.
 func (f *File) addDrawingSlicer(sheet, slicerName string, ns xml.Attr, opts *SlicerOptions) error {
 	drawingID := f.countDrawings() + 1
 	drawingXML := "xl/drawings/drawing" + strconv.Itoa(drawingID) + ".xml"
@@ -600,6 +631,17 @@ func (f *File) addDrawingSlicer(sheet, slicerName string, ns xml.Attr, opts *Sli
 			},
 		},
 	}
+	fallbackText := []*aP{
+		{R: &aR{T: "This shape represents a table slicer. Table slicers are not supported in this version of Excel."}},
+		{R: &aR{T: "If the shape was modified in an earlier version of Excel, or if the workbook was saved in Excel 2007 or earlier, the slicer can't be used."}},
+	}
+	if ns.Value == NameSpaceDrawingMLTimeslicer.Value { // timeline
+		graphicFrame.Graphic.GraphicData = &xlsxGraphicData{
+			URI:  NameSpaceDrawingMLTimeslicer.Value,
+			Tsle: &xlsxTsle{XMLNS: NameSpaceDrawingMLTimeslicer.Value, Name: slicerName},
+		}
+		fallbackText = []*aP{{R: &aR{T: "Timeline: Works in Excel 2013 or higher. Do not move or resize."}}}
+	}
 	graphic, _ := xml.Marshal(graphicFrame)
 	sp := xdrSp{
 		Macro: opts.Macro,
@@ -621,10 +663,7 @@ func (f *File) addDrawingSlicer(sheet, slicerName string, ns xml.Attr, opts *Sli
 		},
 		TxBody: &xdrTxBody{
 			BodyPr: &aBodyPr{VertOverflow: "clip", HorzOverflow: "clip"},
-			P: []*aP{
-				{R: &aR{T: "This shape represents a table slicer. Table slicers are not supported in this version of Excel."}},
-				{R: &aR{T: "If the shape was modified in an earlier version of Excel, or if the workbook was saved in Excel 2007 or earlier, the slicer can't be used."}},
-			},
+			P:      fallbackText,
 		},
 	}
 	shape, _ := xml.Marshal(sp)
@@ -639,6 +678,9 @@ func (f *File) addDrawingSlicer(sheet, slicerName string, ns xml.Attr, opts *Sli
 	if ns.Value == NameSpaceDrawingMLSlicerX15.Value { // table slicer
 		choice.XMLNSSle15 = ns.Value
 	}
+	if ns.Value == NameSpaceDrawingMLTimeslicer.Value { // timeline
+		choice.XMLNSTsle = ns.Value
+	}
 	fallback := xlsxFallback{Content: string(shape)}
 	choiceBytes, _ := xml.Marshal(choice)
 	shapeBytes, _ := xml.Marshal(fallback)
@@ -725,3 +767,695 @@ func (f *File) addWorkbookSlicerCache(slicerCacheID int, URI string) error {
 	wb.ExtLst = &xlsxExtLst{Ext: strings.TrimSuffix(strings.TrimPrefix(string(extLstBytes), "<extLst>"), "</extLst>")}
 	return err
 }
+
+// DeleteSlicer provides the method to delete a slicer by given slicer name.
+// The slicer view, the drawing shape of the slicer, and the slicer part will
+// be removed, the slicer cache and its defined name will also be removed when
+// there are no other slicers that use it.
+func (f *File) DeleteSlicer(name string) error {
+	for _, sheet := range f.GetSheetList() {
+		ws, err := f.workSheetReader(sheet)
+		if err != nil {
+			return err
+		}
+		if ws.ExtLst == nil {
+			continue
+		}
+		decodeExtLst := new(decodeExtLst)
+		if err = f.xmlNewDecoder(strings.NewReader("<extLst>" + ws.ExtLst.Ext + "</extLst>")).
+			Decode(decodeExtLst); err != nil && err != io.EOF {
+			return err
+		}
+		for _, ext := range decodeExtLst.Ext {
+			if ext.URI != ExtURISlicerListX14 && ext.URI != ExtURISlicerListX15 {
+				continue
+			}
+			slicerList := new(decodeSlicerList)
+			_ = f.xmlNewDecoder(strings.NewReader(ext.Content)).Decode(slicerList)
+			for _, sheetSlicer := range slicerList.Slicer {
+				slicerXML := strings.ReplaceAll(f.getSheetRelationshipsTargetByID(sheet, sheetSlicer.RID), "..", "xl")
+				slicers, err := f.slicerReader(slicerXML)
+				if err != nil {
+					return err
+				}
+				for idx, slicer := range slicers.Slicer {
+					if slicer.Name != name {
+						continue
+					}
+					slicers.Slicer = append(slicers.Slicer[:idx], slicers.Slicer[idx+1:]...)
+					if err = f.deleteDrawingSlicer(ws, sheet, name); err != nil {
+						return err
+					}
+					if len(slicers.Slicer) == 0 {
+						if err = f.deleteSheetSlicer(ws, sheet, ext.URI, sheetSlicer.RID); err != nil {
+							return err
+						}
+						f.Pkg.Delete(slicerXML)
+						_ = f.removeContentTypesPart(ContentTypeSlicer, "/"+slicerXML)
+						return f.deleteSlicerCache(slicer.Cache)
+					}
+					output, _ := xml.Marshal(slicers)
+					f.saveFileList(slicerXML, output)
+					return f.deleteSlicerCache(slicer.Cache)
+				}
+			}
+		}
+	}
+	return newNoExistSlicerError(name)
+}
+
+// deleteDrawingSlicer removes the slicer shape in the worksheet drawing by
+// given worksheet name and slicer name.
+func (f *File) deleteDrawingSlicer(ws *xlsxWorksheet, sheet, name string) error {
+	if ws.Drawing == nil {
+		return nil
+	}
+	drawingXML := strings.ReplaceAll(f.getSheetRelationshipsTargetByID(sheet, ws.Drawing.RID), "..", "xl")
+	wsDr, _, err := f.drawingParser(drawingXML)
+	if err != nil {
+		return err
+	}
+	for idx := 0; idx < len(wsDr.TwoCellAnchor); idx++ {
+		content := wsDr.TwoCellAnchor[idx].GraphicFrame
+		for _, alternateContent := range wsDr.TwoCellAnchor[idx].AlternateContent {
+			content += alternateContent.Content
+		}
+		if inStrSlice(extractSlicerNames(content), name, true) != -1 {
+			wsDr.TwoCellAnchor = append(wsDr.TwoCellAnchor[:idx], wsDr.TwoCellAnchor[idx+1:]...)
+			idx--
+		}
+	}
+	f.Drawings.Store(drawingXML, wsDr)
+	return err
+}
+
+// extractSlicerNames returns the slicer names referenced by the slicer
+// graphic frame in the given drawing anchor content.
+func extractSlicerNames(content string) []string {
+	var names []string
+	decoder := xml.NewDecoder(strings.NewReader("<anchor>" + content + "</anchor>"))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return names
+		}
+		if element, ok := token.(xml.StartElement); ok && element.Name.Local == "slicer" {
+			for _, attr := range element.Attr {
+				if attr.Name.Local == "name" {
+					names = append(names, attr.Value)
+				}
+			}
+		}
+	}
+}
+
+// deleteSheetSlicer removes the slicer part relationship and its reference in
+// the worksheet extension list by given worksheet name, extension URI, and
+// relationship ID.
+func (f *File) deleteSheetSlicer(ws *xlsxWorksheet, sheet, extURI, rID string) error {
+	var (
+		decodeExtLst                 = new(decodeExtLst)
+		err                          error
+		slicerListBytes, extLstBytes []byte
+	)
+	if err = f.xmlNewDecoder(strings.NewReader("<extLst>" + ws.ExtLst.Ext + "</extLst>")).
+		Decode(decodeExtLst); err != nil && err != io.EOF {
+		return err
+	}
+	for idx := 0; idx < len(decodeExtLst.Ext); idx++ {
+		if decodeExtLst.Ext[idx].URI != extURI {
+			continue
+		}
+		slicerList, x14SlicerList := new(decodeSlicerList), new(xlsxX14SlicerList)
+		_ = f.xmlNewDecoder(strings.NewReader(decodeExtLst.Ext[idx].Content)).Decode(slicerList)
+		for _, slicer := range slicerList.Slicer {
+			if slicer.RID != rID {
+				x14SlicerList.Slicer = append(x14SlicerList.Slicer, &xlsxX14Slicer{RID: slicer.RID})
+			}
+		}
+		if len(x14SlicerList.Slicer) == 0 {
+			decodeExtLst.Ext = append(decodeExtLst.Ext[:idx], decodeExtLst.Ext[idx+1:]...)
+			idx--
+			continue
+		}
+		slicerListBytes, _ = xml.Marshal(x14SlicerList)
+		decodeExtLst.Ext[idx].Content = string(slicerListBytes)
+	}
+	f.deleteSheetRelationships(sheet, rID)
+	if len(decodeExtLst.Ext) == 0 {
+		ws.ExtLst = nil
+		return err
+	}
+	extLstBytes, err = xml.Marshal(decodeExtLst)
+	ws.ExtLst = &xlsxExtLst{Ext: strings.TrimSuffix(strings.TrimPrefix(string(extLstBytes), "<extLst>"), "</extLst>")}
+	return err
+}
+
+// deleteSlicerCache removes the slicer cache part, the workbook relationships,
+// the workbook extension list reference, and the defined name of the slicer
+// cache by given slicer cache name, if there are no slicers use it.
+func (f *File) deleteSlicerCache(slicerCacheName string) error {
+	var (
+		inUse          bool
+		slicerCacheXML string
+	)
+	f.Pkg.Range(func(k, v interface{}) bool {
+		if strings.Contains(k.(string), "xl/slicers/slicer") {
+			slicers, err := f.slicerReader(k.(string))
+			if err != nil {
+				return true
+			}
+			for _, slicer := range slicers.Slicer {
+				if slicer.Cache == slicerCacheName {
+					inUse = true
+					return false
+				}
+			}
+		}
+		if strings.Contains(k.(string), "xl/slicerCaches/slicerCache") {
+			slicerCache := &xlsxSlicerCacheDefinition{}
+			if err := f.xmlNewDecoder(bytes.NewReader(namespaceStrictToTransitional(v.([]byte)))).
+				Decode(slicerCache); err != nil && err != io.EOF {
+				return true
+			}
+			if slicerCache.Name == slicerCacheName {
+				slicerCacheXML = k.(string)
+			}
+		}
+		return true
+	})
+	if inUse || slicerCacheXML == "" {
+		return nil
+	}
+	f.Pkg.Delete(slicerCacheXML)
+	_ = f.removeContentTypesPart(ContentTypeSlicerCache, "/"+slicerCacheXML)
+	rID, err := f.deleteWorkbookRels(SourceRelationshipSlicerCache, "/"+slicerCacheXML)
+	if err != nil {
+		return err
+	}
+	if rID == "" {
+		if rID, err = f.deleteWorkbookRels(SourceRelationshipSlicerCache, strings.TrimPrefix(slicerCacheXML, "xl/")); err != nil {
+			return err
+		}
+	}
+	if err = f.deleteWorkbookSlicerCache(rID); err != nil {
+		return err
+	}
+	_ = f.DeleteDefinedName(&DefinedName{Name: slicerCacheName})
+	return err
+}
+
+// deleteWorkbookSlicerCache removes the association ID of the slicer cache in
+// workbook.xml by given relationship ID.
+func (f *File) deleteWorkbookSlicerCache(rID string) error {
+	var (
+		wb                                               *xlsxWorkbook
+		err                                              error
+		decodeExtLst                                     = new(decodeExtLst)
+		slicerCacheBytes, slicerCachesBytes, extLstBytes []byte
+	)
+	if wb, err = f.workbookReader(); err != nil {
+		return err
+	}
+	if wb.ExtLst == nil {
+		return err
+	}
+	if err = f.xmlNewDecoder(strings.NewReader("<extLst>" + wb.ExtLst.Ext + "</extLst>")).
+		Decode(decodeExtLst); err != nil && err != io.EOF {
+		return err
+	}
+	for idx := 0; idx < len(decodeExtLst.Ext); idx++ {
+		URI := decodeExtLst.Ext[idx].URI
+		if URI != ExtURISlicerCachesX14 && URI != ExtURISlicerCachesX15 {
+			continue
+		}
+		decodeSlicerCaches, content := new(decodeSlicerCaches), ""
+		_ = f.xmlNewDecoder(strings.NewReader(decodeExtLst.Ext[idx].Content)).Decode(decodeSlicerCaches)
+		slicerCacheList := new(decodeSlicerCacheList)
+		_ = f.xmlNewDecoder(strings.NewReader("<slicerCaches>" + decodeSlicerCaches.Content + "</slicerCaches>")).Decode(slicerCacheList)
+		for _, slicerCache := range slicerCacheList.SlicerCache {
+			if slicerCache.RID != rID {
+				slicerCacheBytes, _ = xml.Marshal(xlsxX14SlicerCache{RID: slicerCache.RID})
+				content += string(slicerCacheBytes)
+			}
+		}
+		if content == "" {
+			decodeExtLst.Ext = append(decodeExtLst.Ext[:idx], decodeExtLst.Ext[idx+1:]...)
+			idx--
+			continue
+		}
+		if URI == ExtURISlicerCachesX14 {
+			slicerCachesBytes, _ = xml.Marshal(xlsxX14SlicerCaches{XMLNS: NameSpaceSpreadSheetX14.Value, Content: content})
+		}
+		if URI == ExtURISlicerCachesX15 {
+			slicerCachesBytes, _ = xml.Marshal(xlsxX15SlicerCaches{XMLNS: NameSpaceSpreadSheetX14.Value, Content: content})
+		}
+		decodeExtLst.Ext[idx].Content = string(slicerCachesBytes)
+	}
+	if len(decodeExtLst.Ext) == 0 {
+		wb.ExtLst = nil
+		return err
+	}
+	extLstBytes, err = xml.Marshal(decodeExtLst)
+	wb.ExtLst = &xlsxExtLst{Ext: strings.TrimSuffix(strings.TrimPrefix(string(extLstBytes), "<extLst>"), "</extLst>")}
+	return err
+}
+
+// TimelineOptions represents the settings of the timeline.
+//
+// Name specifies the timeline name, should be an existing date field name of
+// the given pivot table, this setting is required.
+//
+// Cell specifies the left top cell coordinates the position for inserting the
+// timeline, this setting is required.
+//
+// TableSheet specifies the worksheet name of the pivot table, this setting is
+// required.
+//
+// TableName specifies the name of the pivot table, this setting is required.
+//
+// Caption specifies the caption of the timeline, this setting is optional.
+//
+// Level specifies the time level of the timeline, the optional values are
+// "Years", "Quarters", "Months" and "Days", this setting is optional, and the
+// default setting is "Months".
+//
+// Macro used for set macro for the timeline, the workbook extension should be
+// XLSM or XLTM.
+//
+// Width specifies the width of the timeline, this setting is optional.
+//
+// Height specifies the height of the timeline, this setting is optional.
+//
+// DisplayHeader specifies if display header of the timeline, this setting is
+// optional, the default setting is display.
+//
+// Style specifies the built-in or custom timeline style name, such as
+// "TimeSlicerStyleLight1", this setting is optional.
+//
+// Format specifies the format of the timeline, this setting is optional.
+type TimelineOptions struct {
+	Name          string
+	Cell          string
+	TableSheet    string
+	TableName     string
+	Caption       string
+	Level         string
+	Macro         string
+	Width         uint
+	Height        uint
+	DisplayHeader *bool
+	Style         string
+	Format        GraphicOptions
+}
+
+// timelineLevels defined the list of supported timeline time levels.
+var timelineLevels = []string{"Years", "Quarters", "Months", "Days"}
+
+// AddTimeline function inserts a timeline by giving the worksheet name and
+// timeline settings. The timeline is a date filter control bound to a date
+// field of the pivot table.
+//
+// For example, insert a timeline on the Sheet1!G20 with the date field Date
+// for the pivot table named PivotTable1:
+//
+//	err := f.AddTimeline("Sheet1", &excelize.TimelineOptions{
+//	    Name:       "Date",
+//	    Cell:       "G20",
+//	    TableSheet: "Sheet1",
+//	    TableName:  "PivotTable1",
+//	    Caption:    "Date",
+//	    Level:      "Quarters",
+//	})
+func (f *File) AddTimeline(sheet string, opts *TimelineOptions) error {
+	opts, err := parseTimelineOptions(opts)
+	if err != nil {
+		return err
+	}
+	pivotTable, colIdx, err := f.getTimelineSource(opts)
+	if err != nil {
+		return err
+	}
+	bounds, err := f.getTimelineBounds(opts, pivotTable, colIdx)
+	if err != nil {
+		return err
+	}
+	timelineID, err := f.addSheetTimeline(sheet)
+	if err != nil {
+		return err
+	}
+	timelineCacheName, err := f.setTimelineCache(opts, pivotTable, bounds)
+	if err != nil {
+		return err
+	}
+	timelineName := f.genSlicerName(opts.Name)
+	if err = f.addDrawingSlicer(sheet, timelineName, NameSpaceDrawingMLTimeslicer, &SlicerOptions{
+		Cell:   opts.Cell,
+		Macro:  opts.Macro,
+		Width:  opts.Width,
+		Height: opts.Height,
+		Format: opts.Format,
+	}); err != nil {
+		return err
+	}
+	level := inStrSlice(timelineLevels, opts.Level, false)
+	return f.addTimeline(timelineID, xlsxTimeline{
+		Name:           timelineName,
+		Cache:          timelineCacheName,
+		Caption:        opts.Caption,
+		ShowHeader:     opts.DisplayHeader,
+		Level:          level,
+		SelectionLevel: level,
+		ScrollPosition: bounds.StartDate,
+		Style:          opts.Style,
+	})
+}
+
+// parseTimelineOptions provides a function to parse the format settings of
+// the timeline with default value.
+func parseTimelineOptions(opts *TimelineOptions) (*TimelineOptions, error) {
+	if opts == nil {
+		return nil, ErrParameterRequired
+	}
+	if opts.Name == "" || opts.Cell == "" || opts.TableSheet == "" || opts.TableName == "" {
+		return nil, ErrParameterInvalid
+	}
+	if opts.Level == "" {
+		opts.Level = "Months"
+	}
+	if inStrSlice(timelineLevels, opts.Level, false) == -1 {
+		return nil, ErrParameterInvalid
+	}
+	if opts.Width == 0 {
+		opts.Width = defaultTimelineWidth
+	}
+	if opts.Height == 0 {
+		opts.Height = defaultTimelineHeight
+	}
+	if opts.Format.PrintObject == nil {
+		opts.Format.PrintObject = boolPtr(true)
+	}
+	if opts.Format.Locked == nil {
+		opts.Format.Locked = boolPtr(false)
+	}
+	if opts.Format.ScaleX == 0 {
+		opts.Format.ScaleX = defaultDrawingScale
+	}
+	if opts.Format.ScaleY == 0 {
+		opts.Format.ScaleY = defaultDrawingScale
+	}
+	return opts, nil
+}
+
+// getTimelineSource returns the timeline data source pivot table settings and
+// the index of the given timeline field in the pivot table data range.
+func (f *File) getTimelineSource(opts *TimelineOptions) (*PivotTableOptions, int, error) {
+	pivotTables, err := f.GetPivotTables(opts.TableSheet)
+	if err != nil {
+		return nil, -1, err
+	}
+	for _, tbl := range pivotTables {
+		if tbl.Name == opts.TableName {
+			order, _ := f.getTableFieldsOrder(&PivotTableOptions{DataRange: tbl.DataRange})
+			colIdx := inStrSlice(order, opts.Name, true)
+			if colIdx == -1 {
+				return &tbl, colIdx, newInvalidTimelineNameError(opts.Name)
+			}
+			return &tbl, colIdx, err
+		}
+	}
+	return nil, -1, newNoExistTableError(opts.TableName)
+}
+
+// getTimelineBounds returns the date range of the timeline by giving the
+// timeline options, pivot table options, and the index of the timeline field
+// in the pivot table data range. The bounds start at the first day of the year
+// of the earliest date and end at the first day of the year after the latest
+// date.
+func (f *File) getTimelineBounds(opts *TimelineOptions, pivotTable *PivotTableOptions, colIdx int) (*xlsxTimelineRange, error) {
+	var (
+		found            bool
+		minDate, maxDate float64
+	)
+	if err := f.getPivotTableDataRange(pivotTable); err != nil {
+		return nil, err
+	}
+	dataSheet, coordinates, err := f.adjustRange(pivotTable.pivotDataRange)
+	if err != nil {
+		return nil, newPivotTableDataRangeError(err.Error())
+	}
+	date1904, err := f.isDate1904()
+	if err != nil {
+		return nil, err
+	}
+	for row := coordinates[1] + 1; row <= coordinates[3]; row++ {
+		cell, _ := CoordinatesToCellName(coordinates[0]+colIdx, row)
+		val, err := f.GetCellValue(dataSheet, cell, Options{RawCellValue: true})
+		if err != nil {
+			return nil, err
+		}
+		num, err := strconv.ParseFloat(val, 64)
+		if err != nil || num < 0 {
+			continue
+		}
+		if !found || num < minDate {
+			minDate = num
+		}
+		if !found || num > maxDate {
+			maxDate = num
+		}
+		found = true
+	}
+	if !found {
+		return nil, newInvalidTimelineNameError(opts.Name)
+	}
+	startDate, endDate := timeFromExcelTime(minDate, date1904), timeFromExcelTime(maxDate, date1904)
+	layout := "2006-01-02T15:04:05"
+	return &xlsxTimelineRange{
+		StartDate: time.Date(startDate.Year(), 1, 1, 0, 0, 0, 0, time.UTC).Format(layout),
+		EndDate:   time.Date(endDate.Year()+1, 1, 1, 0, 0, 0, 0, time.UTC).Format(layout),
+	}, err
+}
+
+// countTimelines provides a function to get the largest timeline file index
+// storage in the folder xl/timelines.
+func (f *File) countTimelines() int {
+	count := 0
+	f.Pkg.Range(func(k, v interface{}) bool {
+		if strings.Contains(k.(string), "xl/timelines/timeline") {
+			if ID, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(k.(string), "xl/timelines/timeline"), ".xml")); err == nil && ID > count {
+				count = ID
+			}
+		}
+		return true
+	})
+	return count
+}
+
+// countTimelineCache provides a function to get the largest timeline cache
+// file index storage in the folder xl/timelineCaches.
+func (f *File) countTimelineCache() int {
+	count := 0
+	f.Pkg.Range(func(k, v interface{}) bool {
+		if strings.Contains(k.(string), "xl/timelineCaches/timelineCache") {
+			if ID, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(k.(string), "xl/timelineCaches/timelineCache"), ".xml")); err == nil && ID > count {
+				count = ID
+			}
+		}
+		return true
+	})
+	return count
+}
+
+// addSheetTimeline adds a new timeline part or returns the existing timeline
+// part ID of the worksheet, and updates the relationships and extension list
+// of the worksheet by giving the worksheet name.
+func (f *File) addSheetTimeline(sheet string) (int, error) {
+	var (
+		timelineID                     = f.countTimelines() + 1
+		ws, err                        = f.workSheetReader(sheet)
+		decodeExtLst                   = new(decodeExtLst)
+		timelineRefsBytes, extLstBytes []byte
+	)
+	if err != nil {
+		return timelineID, err
+	}
+	if ws.ExtLst != nil {
+		if err = f.xmlNewDecoder(strings.NewReader("<extLst>" + ws.ExtLst.Ext + "</extLst>")).
+			Decode(decodeExtLst); err != nil && err != io.EOF {
+			return timelineID, err
+		}
+		for _, ext := range decodeExtLst.Ext {
+			if ext.URI == ExtURITimelineRefs {
+				timelineRefs := new(decodeTimelineRefs)
+				_ = f.xmlNewDecoder(strings.NewReader(ext.Content)).Decode(timelineRefs)
+				for _, timelineRef := range timelineRefs.TimelineRef {
+					if timelineRef.RID != "" {
+						target := f.getSheetRelationshipsTargetByID(sheet, timelineRef.RID)
+						timelineID, _ = strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(target, "../timelines/timeline"), ".xml"))
+						return timelineID, err
+					}
+				}
+			}
+		}
+	}
+	sheetXMLPath, _ := f.getSheetXMLPath(sheet)
+	sheetRels := "xl/worksheets/_rels/" + strings.TrimPrefix(sheetXMLPath, "xl/worksheets/") + ".rels"
+	rID := f.addRels(sheetRels, SourceRelationshipTimeline, "../timelines/timeline"+strconv.Itoa(timelineID)+".xml", "")
+	f.addSheetNameSpace(sheet, SourceRelationship)
+	timelineRefsBytes, _ = xml.Marshal(&xlsxX15TimelineRefs{
+		TimelineRef: []*xlsxX15TimelineRef{{RID: "rId" + strconv.Itoa(rID)}},
+	})
+	decodeExtLst.Ext = append(decodeExtLst.Ext, &xlsxExt{
+		xmlns: []xml.Attr{{Name: xml.Name{Local: "xmlns:" + NameSpaceSpreadSheetX15.Name.Local}, Value: NameSpaceSpreadSheetX15.Value}},
+		URI:   ExtURITimelineRefs, Content: string(timelineRefsBytes),
+	})
+	sort.Slice(decodeExtLst.Ext, func(i, j int) bool {
+		return inStrSlice(worksheetExtURIPriority, decodeExtLst.Ext[i].URI, false) <
+			inStrSlice(worksheetExtURIPriority, decodeExtLst.Ext[j].URI, false)
+	})
+	extLstBytes, err = xml.Marshal(decodeExtLst)
+	ws.ExtLst = &xlsxExtLst{Ext: strings.TrimSuffix(strings.TrimPrefix(string(extLstBytes), "<extLst>"), "</extLst>")}
+	return timelineID, err
+}
+
+// addTimeline adds a new timeline to the workbook by giving the timeline ID
+// and settings.
+func (f *File) addTimeline(timelineID int, timeline xlsxTimeline) error {
+	timelineXML := "xl/timelines/timeline" + strconv.Itoa(timelineID) + ".xml"
+	timelines, err := f.timelineReader(timelineXML)
+	if err != nil {
+		return err
+	}
+	if err := f.addContentTypePart(timelineID, "timeline"); err != nil {
+		return err
+	}
+	timelines.Timeline = append(timelines.Timeline, timeline)
+	output, err := xml.Marshal(timelines)
+	f.saveFileList(timelineXML, output)
+	return err
+}
+
+// setTimelineCache check if a timeline cache already exists or add a new
+// timeline cache by giving the timeline options, pivot table options and the
+// date range of the timeline, and returns the timeline cache name.
+func (f *File) setTimelineCache(opts *TimelineOptions, pivotTable *PivotTableOptions, bounds *xlsxTimelineRange) (string, error) {
+	var (
+		ok                bool
+		timelineCacheName string
+	)
+	f.Pkg.Range(func(k, v interface{}) bool {
+		if strings.Contains(k.(string), "xl/timelineCaches/timelineCache") {
+			timelineCache := &xlsxTimelineCacheDefinition{}
+			if err := f.xmlNewDecoder(bytes.NewReader(namespaceStrictToTransitional(v.([]byte)))).
+				Decode(timelineCache); err != nil && err != io.EOF {
+				return true
+			}
+			if timelineCache.SourceName != opts.Name || timelineCache.PivotTables == nil {
+				return true
+			}
+			for _, tbl := range timelineCache.PivotTables.PivotTable {
+				if tbl.Name == pivotTable.Name {
+					ok, timelineCacheName = true, timelineCache.Name
+					return false
+				}
+			}
+		}
+		return true
+	})
+	if ok {
+		return timelineCacheName, nil
+	}
+	timelineCacheName = f.genTimelineCacheName(opts.Name)
+	return timelineCacheName, f.addTimelineCache(timelineCacheName, opts, pivotTable, bounds)
+}
+
+// addTimelineCache adds a new timeline cache by giving the timeline cache
+// name, timeline options, pivot table options and the date range of the
+// timeline.
+func (f *File) addTimelineCache(timelineCacheName string, opts *TimelineOptions, pivotTable *PivotTableOptions, bounds *xlsxTimelineRange) error {
+	pivotCacheID, err := f.addPivotCacheSlicer(pivotTable)
+	if err != nil {
+		return err
+	}
+	timelineCacheID := f.countTimelineCache() + 1
+	timelineCacheXML := "xl/timelineCaches/timelineCache" + strconv.Itoa(timelineCacheID) + ".xml"
+	timelineCacheBytes, _ := xml.Marshal(xlsxTimelineCacheDefinition{
+		XMLNSXMC:   SourceRelationshipCompatibility.Value,
+		XMLNSX:     NameSpaceSpreadSheet.Value,
+		XMLNSXR10:  NameSpaceSpreadSheetXR10.Value,
+		Name:       timelineCacheName,
+		SourceName: opts.Name,
+		PivotTables: &xlsxTimelineCachePivotTables{
+			PivotTable: []xlsxSlicerCachePivotTable{
+				{TabID: f.getSheetID(opts.TableSheet), Name: pivotTable.Name},
+			},
+		},
+		State: &xlsxTimelineState{
+			MinimalRefreshVersion: 6,
+			LastRefreshVersion:    6,
+			PivotCacheID:          pivotCacheID,
+			FilterType:            "unknown",
+			Selection:             bounds,
+			Bounds:                bounds,
+		},
+	})
+	f.saveFileList(timelineCacheXML, timelineCacheBytes)
+	if err = f.addContentTypePart(timelineCacheID, "timelineCache"); err != nil {
+		return err
+	}
+	if err = f.addWorkbookTimelineCache(timelineCacheID); err != nil {
+		return err
+	}
+	return f.SetDefinedName(&DefinedName{Name: timelineCacheName, RefersTo: formulaErrorNA})
+}
+
+// addWorkbookTimelineCache add the association ID of the timeline cache in
+// workbook.xml.
+func (f *File) addWorkbookTimelineCache(timelineCacheID int) error {
+	var (
+		wb                                  *xlsxWorkbook
+		err                                 error
+		appendMode                          bool
+		decodeExtLst                        = new(decodeExtLst)
+		timelineCacheRefs                   = new(xlsxX15TimelineCacheRefs)
+		timelineCacheRefsBytes, extLstBytes []byte
+	)
+	if wb, err = f.workbookReader(); err != nil {
+		return err
+	}
+	rID := f.addRels(f.getWorkbookRelsPath(), SourceRelationshipTimelineCache, fmt.Sprintf("/xl/timelineCaches/timelineCache%d.xml", timelineCacheID), "")
+	if wb.ExtLst != nil {
+		if err = f.xmlNewDecoder(strings.NewReader("<extLst>" + wb.ExtLst.Ext + "</extLst>")).
+			Decode(decodeExtLst); err != nil && err != io.EOF {
+			return err
+		}
+	}
+	for _, ext := range decodeExtLst.Ext {
+		if ext.URI == ExtURITimelineCacheRefs {
+			decodeTimelineCacheRefs := new(decodeTimelineCacheRefs)
+			_ = f.xmlNewDecoder(strings.NewReader(ext.Content)).Decode(decodeTimelineCacheRefs)
+			for _, timelineCacheRef := range decodeTimelineCacheRefs.TimelineCacheRef {
+				timelineCacheRefs.TimelineCacheRef = append(timelineCacheRefs.TimelineCacheRef, &xlsxX15TimelineCacheRef{RID: timelineCacheRef.RID})
+			}
+			timelineCacheRefs.TimelineCacheRef = append(timelineCacheRefs.TimelineCacheRef, &xlsxX15TimelineCacheRef{RID: fmt.Sprintf("rId%d", rID)})
+			timelineCacheRefsBytes, _ = xml.Marshal(timelineCacheRefs)
+			ext.Content, appendMode = string(timelineCacheRefsBytes), true
+		}
+	}
+	if !appendMode {
+		timelineCacheRefs.TimelineCacheRef = []*xlsxX15TimelineCacheRef{{RID: fmt.Sprintf("rId%d", rID)}}
+		timelineCacheRefsBytes, _ = xml.Marshal(timelineCacheRefs)
+		decodeExtLst.Ext = append(decodeExtLst.Ext, &xlsxExt{
+			xmlns: []xml.Attr{{Name: xml.Name{Local: "xmlns:" + NameSpaceSpreadSheetX15.Name.Local}, Value: NameSpaceSpreadSheetX15.Value}},
+			URI:   ExtURITimelineCacheRefs, Content: string(timelineCacheRefsBytes),
+		})
+	}
+	sort.Slice(decodeExtLst.Ext, func(i, j int) bool {
+		return inStrSlice(workbookExtURIPriority, decodeExtLst.Ext[i].URI, false) <
+			inStrSlice(workbookExtURIPriority, decodeExtLst.Ext[j].URI, false)
+	})
+	extLstBytes, err = xml.Marshal(decodeExtLst)
+	wb.ExtLst = &xlsxExtLst{Ext: strings.TrimSuffix(strings.TrimPrefix(string(extLstBytes), "<extLst>"), "</extLst>")}
+	return err
+}
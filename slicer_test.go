@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -216,7 +217,13 @@ func TestAddSlicer(t *testing.T) {
 		Name:  "Table1",
 		Range: "A1:D5",
 	}))
-	f.Pkg.Store("xl/slicers/slicer2.xml", MacintoshCyrillicCharset)
+	assert.NoError(t, f.AddSlicer("Sheet1", &SlicerOptions{
+		Name:       "Column1",
+		Cell:       "E1",
+		TableName:  "Table1",
+		TableSheet: "Sheet1",
+	}))
+	f.Pkg.Store("xl/slicers/slicer1.xml", MacintoshCyrillicCharset)
 	assert.EqualError(t, f.AddSlicer("Sheet1", &SlicerOptions{
 		Name:       "Column1",
 		Cell:       "E1",
@@ -384,3 +391,224 @@ func TestAddPivotCacheSlicer(t *testing.T) {
 	})
 	assert.NoError(t, err)
 }
+
+func TestDeleteSlicer(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.AddTable("Sheet1", &Table{
+		Name:  "Table1",
+		Range: "A1:D5",
+	}))
+	for _, opts := range []*SlicerOptions{
+		{Name: "Column1", Cell: "E1", TableSheet: "Sheet1", TableName: "Table1", Caption: "Column1"},
+		{Name: "Column1", Cell: "I1", TableSheet: "Sheet1", TableName: "Table1", Caption: "Column1"},
+		{Name: "Column2", Cell: "M1", TableSheet: "Sheet1", TableName: "Table1", Caption: "Column2", ColumnCount: 2, Style: "SlicerStyleLight2"},
+	} {
+		assert.NoError(t, f.AddSlicer("Sheet1", opts))
+	}
+	slicers, err := f.slicerReader("xl/slicers/slicer1.xml")
+	assert.NoError(t, err)
+	assert.Len(t, slicers.Slicer, 3)
+	assert.Equal(t, 2, *slicers.Slicer[2].ColumnCount)
+	assert.Equal(t, "SlicerStyleLight2", slicers.Slicer[2].Style)
+	// Test delete a slicer which slicer cache used by another slicer
+	assert.NoError(t, f.DeleteSlicer("Column1"))
+	_, ok := f.Pkg.Load("xl/slicerCaches/slicerCache1.xml")
+	assert.True(t, ok)
+	// Test delete the last slicer which uses the slicer cache
+	assert.NoError(t, f.DeleteSlicer("Column1 1"))
+	_, ok = f.Pkg.Load("xl/slicerCaches/slicerCache1.xml")
+	assert.False(t, ok)
+	for _, dn := range f.GetDefinedName() {
+		assert.NotEqual(t, "Slicer_Column1", dn.Name)
+	}
+	// Test delete the last slicer in the worksheet
+	assert.NoError(t, f.DeleteSlicer("Column2"))
+	_, ok = f.Pkg.Load("xl/slicers/slicer1.xml")
+	assert.False(t, ok)
+	ws, err := f.workSheetReader("Sheet1")
+	assert.NoError(t, err)
+	assert.Nil(t, ws.ExtLst)
+	assert.Nil(t, f.WorkBook.ExtLst)
+	wsDr, _, err := f.drawingParser("xl/drawings/drawing1.xml")
+	assert.NoError(t, err)
+	assert.Empty(t, wsDr.TwoCellAnchor)
+	// Test delete a not exist slicer
+	assert.Equal(t, newNoExistSlicerError("Column1"), f.DeleteSlicer("Column1"))
+	// Test add slicer after delete slicers
+	assert.NoError(t, f.AddSlicer("Sheet1", &SlicerOptions{
+		Name: "Column3", Cell: "E1", TableSheet: "Sheet1", TableName: "Table1",
+	}))
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestDeleteSlicer.xlsx")))
+	assert.NoError(t, f.Close())
+
+	// Test delete slicer with opened workbook
+	f, err = OpenFile(filepath.Join("test", "TestDeleteSlicer.xlsx"))
+	assert.NoError(t, err)
+	assert.NoError(t, f.DeleteSlicer("Column3"))
+	_, ok = f.Pkg.Load("xl/slicerCaches/slicerCache1.xml")
+	assert.False(t, ok)
+	wsDr, _, err = f.drawingParser("xl/drawings/drawing1.xml")
+	assert.NoError(t, err)
+	assert.Empty(t, wsDr.TwoCellAnchor)
+	assert.NoError(t, f.Close())
+
+	// Test delete slicer with not exist worksheet
+	f = NewFile()
+	f.WorkBook.Sheets.Sheet = append(f.WorkBook.Sheets.Sheet, xlsxSheet{Name: "SheetN"})
+	assert.EqualError(t, f.DeleteSlicer("Column1"), "sheet SheetN does not exist")
+	assert.NoError(t, f.Close())
+
+	// Test delete slicer with invalid worksheet extension list
+	f = NewFile()
+	ws, err = f.workSheetReader("Sheet1")
+	assert.NoError(t, err)
+	ws.ExtLst = &xlsxExtLst{Ext: "<>"}
+	assert.Error(t, f.DeleteSlicer("Column1"))
+	assert.NoError(t, f.Close())
+}
+
+func TestDeleteWorkbookSlicerCache(t *testing.T) {
+	// Test delete a workbook slicer cache with unsupported charset workbook
+	f := NewFile()
+	f.WorkBook = nil
+	f.Pkg.Store("xl/workbook.xml", MacintoshCyrillicCharset)
+	assert.EqualError(t, f.deleteWorkbookSlicerCache("rId1"), "XML syntax error on line 1: invalid UTF-8")
+	assert.NoError(t, f.Close())
+	// Test delete a workbook slicer cache with invalid workbook extension list
+	f = NewFile()
+	f.WorkBook.ExtLst = &xlsxExtLst{Ext: "<>"}
+	assert.Error(t, f.deleteWorkbookSlicerCache("rId1"))
+	assert.NoError(t, f.Close())
+}
+
+func TestAddTimeline(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetSheetRow("Sheet1", "A1", &[]string{"Date", "Type", "Sales"}))
+	types := []string{"Meat", "Dairy", "Beverages", "Produce"}
+	for row := 2; row < 32; row++ {
+		assert.NoError(t, f.SetCellValue("Sheet1", fmt.Sprintf("A%d", row), time.Date(2017+row%3, time.Month(row%12+1), row%28+1, 0, 0, 0, 0, time.UTC)))
+		assert.NoError(t, f.SetCellValue("Sheet1", fmt.Sprintf("B%d", row), types[rand.Intn(4)]))
+		assert.NoError(t, f.SetCellValue("Sheet1", fmt.Sprintf("C%d", row), rand.Intn(5000)))
+	}
+	assert.NoError(t, f.AddPivotTable(&PivotTableOptions{
+		DataRange:       "Sheet1!A1:C31",
+		PivotTableRange: "Sheet1!E2:H10",
+		Name:            "PivotTable1",
+		Rows:            []PivotTableField{{Data: "Type"}},
+		Data:            []PivotTableField{{Data: "Sales", Subtotal: "Sum"}},
+	}))
+	assert.NoError(t, f.AddTimeline("Sheet1", &TimelineOptions{
+		Name:       "Date",
+		Cell:       "J2",
+		TableSheet: "Sheet1",
+		TableName:  "PivotTable1",
+		Caption:    "Date",
+		Level:      "Quarters",
+		Style:      "TimeSlicerStyleLight2",
+	}))
+	// Test add another timeline for the same field which share the timeline cache
+	assert.NoError(t, f.AddTimeline("Sheet1", &TimelineOptions{
+		Name:       "Date",
+		Cell:       "J12",
+		TableSheet: "Sheet1",
+		TableName:  "PivotTable1",
+	}))
+	timelines, err := f.timelineReader("xl/timelines/timeline1.xml")
+	assert.NoError(t, err)
+	assert.Len(t, timelines.Timeline, 2)
+	assert.Equal(t, "Date", timelines.Timeline[0].Name)
+	assert.Equal(t, "Date 1", timelines.Timeline[1].Name)
+	assert.Equal(t, "NativeTimeline_Date", timelines.Timeline[1].Cache)
+	assert.Equal(t, 1, timelines.Timeline[0].Level)
+	assert.Equal(t, 2, timelines.Timeline[1].Level)
+	assert.Equal(t, "2017-01-01T00:00:00", timelines.Timeline[0].ScrollPosition)
+	assert.Equal(t, 1, f.countTimelineCache())
+	// Test add timeline with invalid options
+	assert.Equal(t, ErrParameterRequired, f.AddTimeline("Sheet1", nil))
+	for _, opts := range []*TimelineOptions{
+		{Cell: "J2", TableSheet: "Sheet1", TableName: "PivotTable1"},
+		{Name: "Date", Cell: "J2", TableSheet: "Sheet1", TableName: "PivotTable1", Level: "Weeks"},
+	} {
+		assert.Equal(t, ErrParameterInvalid, f.AddTimeline("Sheet1", opts))
+	}
+	// Test add timeline with not exist pivot table
+	assert.Equal(t, newNoExistTableError("PivotTable2"), f.AddTimeline("Sheet1", &TimelineOptions{
+		Name: "Date", Cell: "J2", TableSheet: "Sheet1", TableName: "PivotTable2",
+	}))
+	// Test add timeline with invalid field name
+	assert.Equal(t, newInvalidTimelineNameError("Month"), f.AddTimeline("Sheet1", &TimelineOptions{
+		Name: "Month", Cell: "J2", TableSheet: "Sheet1", TableName: "PivotTable1",
+	}))
+	// Test add timeline with the field which does not contain date values
+	assert.Equal(t, newInvalidTimelineNameError("Type"), f.AddTimeline("Sheet1", &TimelineOptions{
+		Name: "Type", Cell: "J2", TableSheet: "Sheet1", TableName: "PivotTable1",
+	}))
+	// Test add timeline with not exist worksheet
+	assert.EqualError(t, f.AddTimeline("SheetN", &TimelineOptions{
+		Name: "Date", Cell: "J2", TableSheet: "Sheet1", TableName: "PivotTable1",
+	}), "sheet SheetN does not exist")
+	assert.EqualError(t, f.AddTimeline("Sheet1", &TimelineOptions{
+		Name: "Date", Cell: "J2", TableSheet: "SheetN", TableName: "PivotTable1",
+	}), "sheet SheetN does not exist")
+	// Test add timeline with invalid cell reference
+	assert.EqualError(t, f.AddTimeline("Sheet1", &TimelineOptions{
+		Name: "Date", Cell: "J", TableSheet: "Sheet1", TableName: "PivotTable1",
+	}), "cannot convert cell \"J\" to coordinates: invalid cell name \"J\"")
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestAddTimeline.xlsx")))
+	assert.NoError(t, f.Close())
+
+	// Test add timeline to an opened workbook with existing timelines
+	f, err = OpenFile(filepath.Join("test", "TestAddTimeline.xlsx"))
+	assert.NoError(t, err)
+	_, err = f.NewSheet("Sheet2")
+	assert.NoError(t, err)
+	assert.NoError(t, f.AddTimeline("Sheet2", &TimelineOptions{
+		Name: "Date", Cell: "A1", TableSheet: "Sheet1", TableName: "PivotTable1", Level: "days",
+	}))
+	timelines, err = f.timelineReader("xl/timelines/timeline2.xml")
+	assert.NoError(t, err)
+	assert.Len(t, timelines.Timeline, 1)
+	assert.Equal(t, 3, timelines.Timeline[0].Level)
+	assert.NoError(t, f.Close())
+
+	// Test add timeline with invalid worksheet extension list
+	f, err = OpenFile(filepath.Join("test", "TestAddTimeline.xlsx"))
+	assert.NoError(t, err)
+	ws, err := f.workSheetReader("Sheet1")
+	assert.NoError(t, err)
+	ws.ExtLst = &xlsxExtLst{Ext: "<>"}
+	assert.Error(t, f.AddTimeline("Sheet1", &TimelineOptions{
+		Name: "Date", Cell: "J2", TableSheet: "Sheet1", TableName: "PivotTable1",
+	}))
+	assert.NoError(t, f.Close())
+}
+
+func TestAddWorkbookTimelineCache(t *testing.T) {
+	// Test add a workbook timeline cache with unsupported charset workbook
+	f := NewFile()
+	f.WorkBook = nil
+	f.Pkg.Store("xl/workbook.xml", MacintoshCyrillicCharset)
+	assert.EqualError(t, f.addWorkbookTimelineCache(1), "XML syntax error on line 1: invalid UTF-8")
+	assert.NoError(t, f.Close())
+	// Test add a workbook timeline cache with invalid workbook extension list
+	f = NewFile()
+	f.WorkBook.ExtLst = &xlsxExtLst{Ext: "<>"}
+	assert.Error(t, f.addWorkbookTimelineCache(1))
+	assert.NoError(t, f.Close())
+}
+
+func TestAddTimelineCache(t *testing.T) {
+	// Test add a timeline cache with unsupported charset content types
+	f := NewFile()
+	pivotCacheXML := "xl/pivotCache/pivotCacheDefinition1.xml"
+	f.Pkg.Store(pivotCacheXML, []byte(fmt.Sprintf(`<pivotCacheDefinition xmlns="%s"/>`, NameSpaceSpreadSheet.Value)))
+	f.ContentTypes = nil
+	f.Pkg.Store(defaultXMLPathContentTypes, MacintoshCyrillicCharset)
+	assert.EqualError(t, f.addTimelineCache("NativeTimeline_Date", &TimelineOptions{TableSheet: "Sheet1"},
+		&PivotTableOptions{pivotCacheXML: pivotCacheXML}, &xlsxTimelineRange{}), "XML syntax error on line 1: invalid UTF-8")
+	// Test add a timeline cache with unsupported charset pivot cache
+	f.Pkg.Store(pivotCacheXML, MacintoshCyrillicCharset)
+	assert.EqualError(t, f.addTimelineCache("NativeTimeline_Date", &TimelineOptions{TableSheet: "Sheet1"},
+		&PivotTableOptions{pivotCacheXML: pivotCacheXML}, &xlsxTimelineRange{}), "XML syntax error on line 1: invalid UTF-8")
+	assert.NoError(t, f.Close())
+}
@@ -315,11 +315,14 @@ func getRowElement(token xml.Token, hRow int) (startElement xml.StartElement, ok
 }
 
 // Cell can be used directly in StreamWriter.SetRow to specify a style and
-// a value.
+// a value. It also be returned by the Rows.Cells function, the Type field
+// specifies the data type of the cell read from the worksheet, and will be
+// ignored by the stream writer.
 type Cell struct {
 	StyleID int
 	Formula string
 	Value   interface{}
+	Type    CellType
 }
 
 // RowOpts define the options for the set row, it can be used directly in
@@ -507,15 +510,11 @@ func setCellFormula(c *xlsxC, formula string) {
 
 // setCellTime provides a function to set number of a cell with a time.
 func (sw *StreamWriter) setCellTime(c *xlsxC, val time.Time) error {
-	var date1904, isNum bool
-	wb, err := sw.file.workbookReader()
+	date1904, err := sw.file.isDate1904()
 	if err != nil {
 		return err
 	}
-	if wb != nil && wb.WorkbookPr != nil {
-		date1904 = wb.WorkbookPr.Date1904
-	}
-	if isNum, err = c.setCellTime(val, date1904); err == nil && isNum && c.S == 0 {
+	if isNum, err := c.setCellTime(val, date1904); err == nil && isNum && c.S == 0 {
 		style, _ := sw.file.NewStyle(&Style{NumFmt: 22})
 		c.S = style
 	}
@@ -529,9 +528,9 @@ func (sw *StreamWriter) setCellValFunc(c *xlsxC, val interface{}) error {
 	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
 		setCellIntFunc(c, val)
 	case float32:
-		c.T, c.V = setCellFloat(float64(val), -1, 32)
+		c.T, c.V = sw.file.formatCellFloat(float64(val), -1, 32)
 	case float64:
-		c.T, c.V = setCellFloat(val, -1, 64)
+		c.T, c.V = sw.file.formatCellFloat(val, -1, 64)
 	case string:
 		c.setCellValue(val)
 	case []byte:
@@ -39,7 +39,7 @@ func (f *File) stylesReader() (*xlsxStyleSheet, error) {
 // styleSheetWriter provides a function to save xl/styles.xml after serialize
 // structure.
 func (f *File) styleSheetWriter() {
-	if f.Styles != nil {
+	if f.Styles != nil && f.isPartTouched(defaultXMLPathStyles) {
 		output, _ := xml.Marshal(f.Styles)
 		f.saveFileList(defaultXMLPathStyles, f.replaceNameSpaceBytes(defaultXMLPathStyles, output))
 	}
@@ -67,7 +67,7 @@ func (f *File) themeWriter() {
 			ExtLst: c.ExtLst,
 		}
 	}
-	if f.Theme != nil {
+	if f.Theme != nil && f.isPartTouched(defaultXMLPathTheme) {
 		output, _ := xml.Marshal(xlsxTheme{
 			XMLNSa: NameSpaceDrawingML.Value,
 			XMLNSr: SourceRelationship.Value,
@@ -116,7 +116,7 @@ func (f *File) themeWriter() {
 // sharedStringsWriter provides a function to save xl/sharedStrings.xml after
 // serialize structure.
 func (f *File) sharedStringsWriter() {
-	if f.SharedStrings != nil {
+	if f.SharedStrings != nil && f.isPartTouched(defaultXMLPathSharedStrings) {
 		output, _ := xml.Marshal(f.SharedStrings)
 		f.saveFileList(defaultXMLPathSharedStrings, f.replaceNameSpaceBytes(defaultXMLPathSharedStrings, output))
 	}
@@ -134,6 +134,24 @@ func parseFormatStyleSet(style *Style) (*Style, error) {
 			return style, ErrFontSize
 		}
 	}
+	if style.Alignment != nil {
+		if style.Alignment.Indent < 0 || style.Alignment.Indent > MaxIndent {
+			return style, ErrIndent
+		}
+		if rotation := style.Alignment.TextRotation; (rotation < -90 || rotation > 180) && rotation != 255 {
+			return style, ErrTextRotation
+		}
+	}
+	if style.Fill.Type == "gradient" && len(style.Fill.Stops) > 0 {
+		if len(style.Fill.Stops) < 2 || len(style.Fill.Stops) != len(style.Fill.Color) {
+			return style, ErrParameterInvalid
+		}
+		for i, position := range style.Fill.Stops {
+			if position < 0 || position > 1 || (i > 0 && position < style.Fill.Stops[i-1]) {
+				return style, ErrParameterInvalid
+			}
+		}
+	}
 	if style.CustomNumFmt != nil && len(*style.CustomNumFmt) == 0 {
 		err = ErrCustomNumFmt
 	}
@@ -216,6 +234,35 @@ func parseFormatStyleSet(style *Style) (*Style, error) {
 //	 8     | darkUp          | 18    | gray0625
 //	 9     | darkGrid        |       |
 //
+// For the pattern fill, the first color in 'Fill.Color' specifies the
+// foreground color of the pattern, and the optional second color specifies
+// the background color of the pattern. For example, create a style with red
+// dark grid pattern on yellow background:
+//
+//	style, err := f.NewStyle(&excelize.Style{
+//	    Fill: excelize.Fill{Type: "pattern", Color: []string{"FF0000", "FFFF00"}, Pattern: 9},
+//	})
+//
+// For the gradient fill, the two colors in 'Fill.Color' specify the colors of
+// the shading styles, and the optional third color specifies the end color of
+// the shading styles with three gradient stops. Set 'Fill.Stops' to create a
+// linear gradient fill with custom gradient stops instead of the shading
+// styles, the 'Fill.Stops' specifies the positions of the gradient stops in the
+// range of 0 to 1 in ascending order, and the number of the stops must be the
+// same as the number of colors, otherwise an error will be returned. The
+// 'Fill.Degree' specifies the angle of the linear gradient fill with custom
+// gradient stops. For example, create a style with three colors gradient fill
+// in 45 degrees:
+//
+//	style, err := f.NewStyle(&excelize.Style{
+//	    Fill: excelize.Fill{
+//	        Type:   "gradient",
+//	        Color:  []string{"FFFFFF", "4E71BE", "FF0000"},
+//	        Stops:  []float64{0, 0.3, 1},
+//	        Degree: 45,
+//	    },
+//	})
+//
 // The 'Alignment.Indent' is an integer value, where an increment of 1
 // represents 3 spaces. Indicates the number of spaces (of the normal style
 // font) of indentation for text in a cell. The number of spaces to indent is
@@ -226,7 +273,7 @@ func parseFormatStyleSet(style *Style) (*Style, error) {
 // For example, an indent value of 1 means that the text begins 3 space widths
 // (of the normal style font) from the edge of the cell. Note: The width of one
 // space character is defined by the font. Only left, right, and distributed
-// horizontal alignments are supported.
+// horizontal alignments are supported. The maximum indent value is 250.
 //
 // The following table shows the type of cells' horizontal alignment used
 // in 'Alignment.Horizontal':
@@ -269,6 +316,14 @@ func parseFormatStyleSet(style *Style) (*Style, error) {
 // The 'Alignment.RelativeIndent' is an integer value to indicate the additional
 // number of spaces of indentation to adjust for text in a cell.
 //
+// The 'Alignment.TextRotation' is an integer value to set the rotation of the
+// text in the cell in degrees. The valid value of this field is between -90
+// and 90 degrees, and 255 for the vertical stacked text. The value between 91
+// and 180 is also accepted for the text rotated downward, which is equal to
+// the negative rotation degrees, for example, 135 was the same as -45. When
+// you get the style definition by the GetStyle function, the negative rotation
+// degrees will be returned in this form.
+//
 // The following table shows the type of font underline style used in
 // 'Font.Underline':
 //
@@ -987,6 +1042,7 @@ func (f *File) NewStyle(style *Style) (int, error) {
 	if cellXfsID, err = f.getStyleID(s, fs); err != nil || cellXfsID != -1 {
 		return cellXfsID, err
 	}
+	f.touchPart(defaultXMLPathStyles)
 
 	numFmtID := newNumFmt(s, fs)
 
@@ -1456,6 +1512,31 @@ func (f *File) extractBorders(bdr *xlsxBorder, s *xlsxStyleSheet, style *Style)
 	}
 }
 
+// getGradientFillShading provides a function to get the index of the shading
+// styles by given gradient fill definition, it returns -1 if the gradient fill
+// doesn't match any of the shading styles.
+func getGradientFillShading(gradient *xlsxGradientFill) int {
+	for shading, variants := range styleFillVariants() {
+		if gradient.Bottom != variants.Bottom || gradient.Degree != variants.Degree ||
+			gradient.Left != variants.Left || gradient.Right != variants.Right ||
+			gradient.Top != variants.Top || gradient.Type != variants.Type ||
+			len(gradient.Stop) != len(variants.Stop) {
+			continue
+		}
+		matched := true
+		for i, stop := range gradient.Stop {
+			if stop.Position != variants.Stop[i].Position {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return shading
+		}
+	}
+	return -1
+}
+
 // extractFills provides a function to extract fill styles settings by
 // given fill styles definition.
 func (f *File) extractFills(fl *xlsxFill, s *xlsxStyleSheet, style *Style) {
@@ -1463,19 +1544,15 @@ func (f *File) extractFills(fl *xlsxFill, s *xlsxStyleSheet, style *Style) {
 		var fill Fill
 		if fl.GradientFill != nil {
 			fill.Type = "gradient"
-			for shading, variants := range styleFillVariants() {
-				if fl.GradientFill.Bottom == variants.Bottom &&
-					fl.GradientFill.Degree == variants.Degree &&
-					fl.GradientFill.Left == variants.Left &&
-					fl.GradientFill.Right == variants.Right &&
-					fl.GradientFill.Top == variants.Top &&
-					fl.GradientFill.Type == variants.Type {
-					fill.Shading = shading
-					break
-				}
-			}
+			shading := getGradientFillShading(fl.GradientFill)
 			for _, stop := range fl.GradientFill.Stop {
 				fill.Color = append(fill.Color, f.getThemeColor(&stop.Color))
+				if shading == -1 {
+					fill.Stops = append(fill.Stops, stop.Position)
+				}
+			}
+			if fill.Shading = shading; shading == -1 {
+				fill.Shading, fill.Degree = 0, fl.GradientFill.Degree
 			}
 		}
 		if fl.PatternFill != nil {
@@ -1486,6 +1563,11 @@ func (f *File) extractFills(fl *xlsxFill, s *xlsxStyleSheet, style *Style) {
 			}
 			if fl.PatternFill.FgColor != nil {
 				fill.Color = []string{f.getThemeColor(fl.PatternFill.FgColor)}
+				if fill.Pattern > 1 && fl.PatternFill.BgColor != nil {
+					if bgColor := f.getThemeColor(fl.PatternFill.BgColor); bgColor != "" {
+						fill.Color = append(fill.Color, bgColor)
+					}
+				}
 			}
 		}
 		style.Fill = fill
@@ -1517,6 +1599,9 @@ func (f *File) extractFont(fnt *xlsxFont, s *xlsxStyleSheet, style *Style) {
 		if fnt.Strike != nil {
 			font.Strike = fnt.Strike.Value()
 		}
+		if fnt.VertAlign != nil {
+			font.VertAlign = fnt.VertAlign.Value()
+		}
 		if fnt.Color != nil {
 			font.Color = strings.TrimPrefix(fnt.Color.RGB, "FF")
 			font.ColorIndexed = fnt.Color.Indexed
@@ -1629,6 +1714,135 @@ func (f *File) GetStyle(idx int) (*Style, error) {
 	return style, nil
 }
 
+// MergeStyles provides a function to create a new style by given base style
+// index and the style overrides, the settings of the base style will be kept
+// except the non-zero fields of the overrides. The fields of the font and
+// alignment will be merged one by one, the borders will be replaced by the
+// border type, and the fill and protection settings will be replaced
+// entirely. Note that the zero value fields of the overrides will be treated
+// as unset, specify the field paths of the overrides by the optional fields
+// parameter to set them even if they are zero values, such as "Font.Bold",
+// "Alignment.WrapText" or "NegRed", so that a field could be changed from
+// true to false. For example, create a style based on the style of cell A1
+// with bold font:
+//
+//	styleID, err := f.GetCellStyle("Sheet1", "A1")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	style, err := f.MergeStyles(styleID, &excelize.Style{
+//	    Font: &excelize.Font{Bold: true},
+//	})
+//
+// Create a style based on the style of cell A1 with the italic font removed
+// and wrap text disabled:
+//
+//	style, err := f.MergeStyles(styleID, &excelize.Style{},
+//	    "Font.Italic", "Alignment.WrapText")
+func (f *File) MergeStyles(base int, overrides *Style, fields ...string) (int, error) {
+	if overrides == nil {
+		return 0, ErrParameterInvalid
+	}
+	style, err := f.GetStyle(base)
+	if err != nil {
+		return 0, err
+	}
+	for _, border := range overrides.Border {
+		idx := -1
+		for i, b := range style.Border {
+			if strings.EqualFold(b.Type, border.Type) {
+				idx = i
+			}
+		}
+		if idx == -1 {
+			style.Border = append(style.Border, border)
+			continue
+		}
+		style.Border[idx] = border
+	}
+	if overrides.Fill.Type != "" {
+		style.Fill = overrides.Fill
+	}
+	if overrides.Font != nil {
+		if style.Font == nil {
+			style.Font = &Font{}
+		}
+		if overrides.Font.Color != "" {
+			style.Font.ColorIndexed, style.Font.ColorTheme, style.Font.ColorTint = 0, nil, 0
+		}
+		if overrides.Font.ColorTheme != nil {
+			style.Font.Color = ""
+		}
+		mergeNonZeroFields(reflect.ValueOf(style.Font).Elem(), reflect.ValueOf(overrides.Font).Elem())
+	}
+	if overrides.Alignment != nil {
+		if style.Alignment == nil {
+			style.Alignment = &Alignment{}
+		}
+		mergeNonZeroFields(reflect.ValueOf(style.Alignment).Elem(), reflect.ValueOf(overrides.Alignment).Elem())
+	}
+	if overrides.Protection != nil {
+		style.Protection = overrides.Protection
+	}
+	if overrides.NumFmt != 0 {
+		style.NumFmt, style.CustomNumFmt = overrides.NumFmt, nil
+	}
+	if overrides.CustomNumFmt != nil {
+		style.CustomNumFmt = overrides.CustomNumFmt
+	}
+	if overrides.DecimalPlaces != nil {
+		style.DecimalPlaces = overrides.DecimalPlaces
+	}
+	style.NegRed = style.NegRed || overrides.NegRed
+	for _, field := range fields {
+		if err = setStyleField(reflect.ValueOf(style).Elem(), reflect.ValueOf(overrides).Elem(), strings.Split(field, ".")); err != nil {
+			return 0, err
+		}
+	}
+	return f.NewStyle(style)
+}
+
+// setStyleField provides a function to set the field of the destination
+// struct by the field with the same path of the source struct, the value of
+// the field will be set even if it is the zero value. The nil struct pointer
+// on the path of the destination will be allocated, and the nil struct
+// pointer on the path of the source will be treated as the zero value.
+func setStyleField(dst, src reflect.Value, path []string) error {
+	if dst.Kind() != reflect.Struct || path[0] == "" {
+		return ErrParameterInvalid
+	}
+	field, ok := dst.Type().FieldByName(path[0])
+	if !ok || !field.IsExported() {
+		return ErrParameterInvalid
+	}
+	dstField, srcField := dst.FieldByIndex(field.Index), reflect.Zero(field.Type)
+	if src.IsValid() {
+		srcField = src.FieldByIndex(field.Index)
+	}
+	if len(path) == 1 {
+		dstField.Set(srcField)
+		return nil
+	}
+	if dstField.Kind() == reflect.Ptr && dstField.Type().Elem().Kind() == reflect.Struct {
+		if dstField.IsNil() {
+			dstField.Set(reflect.New(dstField.Type().Elem()))
+		}
+		dstField, srcField = dstField.Elem(), srcField.Elem()
+	}
+	return setStyleField(dstField, srcField, path[1:])
+}
+
+// mergeNonZeroFields provides a function to set the fields of the destination
+// struct by the non-zero value fields of the source struct with the same type.
+func mergeNonZeroFields(dst, src reflect.Value) {
+	for i := 0; i < src.NumField(); i++ {
+		if !src.Field(i).IsZero() {
+			dst.Field(i).Set(src.Field(i))
+		}
+	}
+}
+
 // getStyleID provides a function to get styleID by given style. If given
 // style does not exist, will return -1.
 func (f *File) getStyleID(ss *xlsxStyleSheet, style *Style) (int, error) {
@@ -1661,9 +1875,32 @@ func (f *File) getStyleID(ss *xlsxStyleSheet, style *Style) (int, error) {
 	return styleID, err
 }
 
-// NewConditionalStyle provides a function to create style for conditional
-// format by given style format. The parameters are the same with the NewStyle
-// function.
+// NewConditionalStyle provides a function to create differential style (dxf)
+// for conditional format by given style format, and returns the index of the
+// differential style which could be used as the Format field of the
+// conditional format options. The parameters are the same with the NewStyle
+// function. Note that the differential style is different from the cell style
+// created by the NewStyle function, it only stores the properties specified in
+// the style format, other properties will be inherited from the cell style
+// when the conditional format rule is applied. For example, create a
+// differential style with bold red font and pink fill, and apply it on the
+// cells which value are greater than 6 in the range A1:A10 on Sheet1:
+//
+//	format, err := f.NewConditionalStyle(&excelize.Style{
+//	    Font: &excelize.Font{Bold: true, Color: "9A0511"},
+//	    Fill: excelize.Fill{
+//	        Type: "pattern", Color: []string{"FEC7CE"}, Pattern: 1,
+//	    },
+//	})
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	err = f.SetConditionalFormat("Sheet1", "A1:A10",
+//	    []excelize.ConditionalFormatOptions{
+//	        {Type: "cell", Criteria: ">", Format: &format, Value: "6"},
+//	    },
+//	)
 func (f *File) NewConditionalStyle(style *Style) (int, error) {
 	f.mu.Lock()
 	s, err := f.stylesReader()
@@ -1689,17 +1926,18 @@ func (f *File) NewConditionalStyle(style *Style) (int, error) {
 		dxf.Border = newBorders(fs)
 	}
 	if fs.Font != nil {
-		dxf.Font, _ = f.newFont(fs)
+		dxf.Font = newDxfFont(fs)
 	}
 	if fs.Protection != nil {
 		dxf.Protection = newProtection(fs)
 	}
+	f.touchPart(defaultXMLPathStyles)
 	dxf.NumFmt = newDxfNumFmt(s, style, &dxf)
 	if s.Dxfs == nil {
 		s.Dxfs = &xlsxDxfs{}
 	}
-	s.Dxfs.Count++
 	s.Dxfs.Dxfs = append(s.Dxfs.Dxfs, &dxf)
+	s.Dxfs.Count = len(s.Dxfs.Dxfs)
 	return s.Dxfs.Count - 1, nil
 }
 
@@ -1710,6 +1948,7 @@ func (f *File) GetConditionalStyle(idx int) (*Style, error) {
 	f.mu.Lock()
 	s, err := f.stylesReader()
 	if err != nil {
+		f.mu.Unlock()
 		return style, err
 	}
 	f.mu.Unlock()
@@ -1793,6 +2032,7 @@ func (f *File) SetDefaultFont(fontName string) error {
 	s.Fonts.Font[0] = font
 	custom := true
 	s.CellStyles.CellStyle[0].CustomBuiltIn = &custom
+	f.touchPart(defaultXMLPathStyles)
 	return err
 }
 
@@ -1807,6 +2047,80 @@ func (f *File) readDefaultFont() (*xlsxFont, error) {
 	return s.Fonts.Font[0], err
 }
 
+// SetColorPalette provides a function to set the legacy indexed color palette
+// of the workbook by given 56 colors in hex format, which correspond to the
+// indexed colors from 8 to 63. The indexed colors used in the styles,
+// conditional formats and charts will be resolved against this palette. Pass
+// an empty slice to restore the default palette. For example, change the
+// indexed color 10 to orange:
+//
+//	palette, err := f.GetColorPalette()
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	palette[2] = "FFA500"
+//	err = f.SetColorPalette(palette)
+func (f *File) SetColorPalette(colors []string) error {
+	if len(colors) != 0 && len(colors) != 56 {
+		return ErrParameterInvalid
+	}
+	palette := make([]xlsxColor, 0, 64)
+	for i := 0; i < 8; i++ {
+		palette = append(palette, xlsxColor{RGB: "FF" + IndexedColorMapping[i]})
+	}
+	for _, color := range colors {
+		hexColor := strings.TrimPrefix(color, "#")
+		if _, err := strconv.ParseUint(hexColor, 16, 32); err != nil || len(hexColor) != 6 {
+			return ErrParameterInvalid
+		}
+		palette = append(palette, xlsxColor{RGB: getPaletteColor(hexColor)})
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	s, err := f.stylesReader()
+	if err != nil {
+		return err
+	}
+	f.touchPart(defaultXMLPathStyles)
+	if len(colors) == 0 {
+		if s.Colors != nil {
+			if s.Colors.IndexedColors = nil; s.Colors.MruColors == nil {
+				s.Colors = nil
+			}
+		}
+		return err
+	}
+	if s.Colors == nil {
+		s.Colors = &xlsxStyleColors{}
+	}
+	s.Colors.IndexedColors = &xlsxIndexedColors{RgbColor: palette}
+	return err
+}
+
+// GetColorPalette provides a function to get the legacy indexed color palette
+// of the workbook, which returns 56 colors in hex format correspond to the
+// indexed colors from 8 to 63. The default palette will be returned if the
+// workbook doesn't specify a custom palette.
+func (f *File) GetColorPalette() ([]string, error) {
+	palette := make([]string, 56)
+	copy(palette, IndexedColorMapping[8:64])
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	s, err := f.stylesReader()
+	if err != nil {
+		return palette, err
+	}
+	if s.Colors != nil && s.Colors.IndexedColors != nil {
+		for i, color := range s.Colors.IndexedColors.RgbColor {
+			if i >= 8 && i < 64 && len(color.RGB) == 8 {
+				palette[i-8] = strings.ToUpper(color.RGB[2:])
+			}
+		}
+	}
+	return palette, err
+}
+
 // getFontID provides a function to get font ID.
 // If given font does not exist, will return -1.
 func (f *File) getFontID(styleSheet *xlsxStyleSheet, style *Style) (int, error) {
@@ -1886,9 +2200,42 @@ func (f *File) newFont(style *Style) (*xlsxFont, error) {
 	if idx := inStrSlice(supportedUnderlineTypes, style.Font.Underline, true); idx != -1 {
 		fnt.U = &attrValString{Val: stringPtr(supportedUnderlineTypes[idx])}
 	}
+	if inStrSlice([]string{"baseline", "superscript", "subscript"}, style.Font.VertAlign, true) != -1 {
+		fnt.VertAlign = &attrValString{Val: stringPtr(style.Font.VertAlign)}
+	}
 	return &fnt, err
 }
 
+// newDxfFont provides a function to create font for the differential style,
+// only the font properties specified in the style format will be stored, so
+// that other properties will be inherited from the cell style.
+func newDxfFont(style *Style) *xlsxFont {
+	var fnt xlsxFont
+	if style.Font.Size >= MinFontSize {
+		fnt.Sz = &attrValFloat{Val: float64Ptr(style.Font.Size)}
+	}
+	if style.Font.Family != "" {
+		fnt.Name = &attrValString{Val: stringPtr(style.Font.Family)}
+	}
+	fnt.Color = newFontColor(style.Font)
+	if style.Font.Bold {
+		fnt.B = &attrValBool{Val: boolPtr(true)}
+	}
+	if style.Font.Italic {
+		fnt.I = &attrValBool{Val: boolPtr(true)}
+	}
+	if style.Font.Strike {
+		fnt.Strike = &attrValBool{Val: boolPtr(true)}
+	}
+	if idx := inStrSlice(supportedUnderlineTypes, style.Font.Underline, true); idx != -1 {
+		fnt.U = &attrValString{Val: stringPtr(supportedUnderlineTypes[idx])}
+	}
+	if inStrSlice([]string{"baseline", "superscript", "subscript"}, style.Font.VertAlign, true) != -1 {
+		fnt.VertAlign = &attrValString{Val: stringPtr(style.Font.VertAlign)}
+	}
+	return &fnt
+}
+
 // getNumFmtID provides a function to get number format code ID.
 // If given number format code does not exist, will return -1.
 func getNumFmtID(styleSheet *xlsxStyleSheet, style *Style) (numFmtID int) {
@@ -2028,16 +2375,7 @@ func newFills(style *Style, fg bool) *xlsxFill {
 	var fill xlsxFill
 	switch style.Fill.Type {
 	case "gradient":
-		if len(style.Fill.Color) != 2 || style.Fill.Shading < 0 || style.Fill.Shading > 16 {
-			break
-		}
-		gradient := styleFillVariants()[style.Fill.Shading]
-		gradient.Stop[0].Color.RGB = getPaletteColor(style.Fill.Color[0])
-		gradient.Stop[1].Color.RGB = getPaletteColor(style.Fill.Color[1])
-		if len(gradient.Stop) == 3 {
-			gradient.Stop[2].Color.RGB = getPaletteColor(style.Fill.Color[0])
-		}
-		fill.GradientFill = &gradient
+		fill.GradientFill = newGradientFill(style)
 	case "pattern":
 		if style.Fill.Pattern > 18 || style.Fill.Pattern < 0 {
 			break
@@ -2047,16 +2385,13 @@ func newFills(style *Style, fg bool) *xlsxFill {
 		}
 		var pattern xlsxPatternFill
 		pattern.PatternType = styleFillPatterns[style.Fill.Pattern]
-		if fg {
-			if pattern.FgColor == nil {
-				pattern.FgColor = new(xlsxColor)
+		if twoColors := style.Fill.Pattern > 1 && len(style.Fill.Color) > 1; fg || twoColors {
+			pattern.FgColor = &xlsxColor{RGB: getPaletteColor(style.Fill.Color[0])}
+			if twoColors {
+				pattern.BgColor = &xlsxColor{RGB: getPaletteColor(style.Fill.Color[1])}
 			}
-			pattern.FgColor.RGB = getPaletteColor(style.Fill.Color[0])
 		} else {
-			if pattern.BgColor == nil {
-				pattern.BgColor = new(xlsxColor)
-			}
-			pattern.BgColor.RGB = getPaletteColor(style.Fill.Color[0])
+			pattern.BgColor = &xlsxColor{RGB: getPaletteColor(style.Fill.Color[0])}
 		}
 		fill.PatternFill = &pattern
 	default:
@@ -2065,6 +2400,32 @@ func newFills(style *Style, fg bool) *xlsxFill {
 	return &fill
 }
 
+// newGradientFill provides a function to create the gradient fill by given
+// cell format settings, it returns nil if the gradient fill settings are
+// invalid.
+func newGradientFill(style *Style) *xlsxGradientFill {
+	if len(style.Fill.Stops) > 0 {
+		gradient := xlsxGradientFill{Degree: style.Fill.Degree}
+		for i, position := range style.Fill.Stops {
+			gradient.Stop = append(gradient.Stop, &xlsxGradientFillStop{
+				Position: position, Color: xlsxColor{RGB: getPaletteColor(style.Fill.Color[i])},
+			})
+		}
+		return &gradient
+	}
+	if style.Fill.Shading < 0 || style.Fill.Shading > 16 {
+		return nil
+	}
+	gradient := styleFillVariants()[style.Fill.Shading]
+	if len(style.Fill.Color) != 2 && len(style.Fill.Color) != len(gradient.Stop) {
+		return nil
+	}
+	for i, stop := range gradient.Stop {
+		stop.Color.RGB = getPaletteColor(style.Fill.Color[i%len(style.Fill.Color)])
+	}
+	return &gradient
+}
+
 // newAlignment provides a function to formatting information pertaining to
 // text alignment in cells. There are a variety of choices for how text is
 // aligned both horizontally and vertically, as well as indentation settings,
@@ -2079,6 +2440,9 @@ func newAlignment(style *Style) *xlsxAlignment {
 		alignment.RelativeIndent = style.Alignment.RelativeIndent
 		alignment.ShrinkToFit = style.Alignment.ShrinkToFit
 		alignment.TextRotation = style.Alignment.TextRotation
+		if alignment.TextRotation < 0 {
+			alignment.TextRotation = 90 - alignment.TextRotation
+		}
 		alignment.Vertical = style.Alignment.Vertical
 		alignment.WrapText = style.Alignment.WrapText
 	}
@@ -2205,6 +2569,125 @@ func (f *File) GetCellStyle(sheet, cell string) (int, error) {
 	return ws.prepareCellStyle(col, row, ws.SheetData.Row[row-1].C[col-1].S), err
 }
 
+// GetCellStyleDetails provides a function to get the style definition of a
+// cell by given worksheet name and cell reference, the style of the cell will
+// be reconstructed from the styles part of the workbook, and the theme colors
+// and indexed colors of the font, fill and border will be resolved to the hex
+// color code in RRGGBB format. The definition of the default style will be
+// returned if the cell doesn't specify the style. The returned style could be
+// used to create the same style in another workbook by the NewStyle function.
+// For example, get the style definition of cell A1 on Sheet1:
+//
+//	style, err := f.GetCellStyleDetails("Sheet1", "A1")
+func (f *File) GetCellStyleDetails(sheet, cell string) (*Style, error) {
+	styleID, err := f.GetCellStyle(sheet, cell)
+	if err != nil {
+		return nil, err
+	}
+	style, err := f.GetStyle(styleID)
+	if err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	s, err := f.stylesReader()
+	f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if xf := s.CellXfs.Xf[styleID]; extractStyleCondFuncs["font"](xf, s) {
+		if clr := s.Fonts.Font[*xf.FontID].Color; clr != nil && style.Font != nil {
+			style.Font.Color = f.getFillColor(clr)
+			style.Font.ColorIndexed, style.Font.ColorTheme, style.Font.ColorTint = 0, nil, 0
+		}
+	}
+	return style, err
+}
+
+// GetCellFillColor provides a function to get the displayed fill color of a
+// cell by given worksheet name and cell reference. The return value is the
+// resolved hex color code in RRGGBB format, the indexed colors, theme colors
+// with tint and automatic colors will be converted to RGB color. It will
+// return empty string if the cell has no fill. For the pattern fill, the
+// foreground color of the pattern will be returned, and for the gradient fill,
+// the color of the first gradient stop will be returned. For example, get the
+// fill color of cell A1 on Sheet1:
+//
+//	color, err := f.GetCellFillColor("Sheet1", "A1")
+func (f *File) GetCellFillColor(sheet, cell string) (string, error) {
+	styleID, err := f.GetCellStyle(sheet, cell)
+	if err != nil {
+		return "", err
+	}
+	f.mu.Lock()
+	s, err := f.stylesReader()
+	f.mu.Unlock()
+	if err != nil {
+		return "", err
+	}
+	if s.CellXfs == nil || len(s.CellXfs.Xf) <= styleID || !extractStyleCondFuncs["fill"](s.CellXfs.Xf[styleID], s) {
+		return "", err
+	}
+	fl := s.Fills.Fill[*s.CellXfs.Xf[styleID].FillID]
+	if fl.GradientFill != nil && len(fl.GradientFill.Stop) > 0 {
+		return f.getFillColor(&fl.GradientFill.Stop[0].Color), err
+	}
+	if fl.PatternFill == nil || fl.PatternFill.PatternType == "" || fl.PatternFill.PatternType == "none" {
+		return "", err
+	}
+	if fl.PatternFill.FgColor == nil {
+		return IndexedColorMapping[64], err
+	}
+	return f.getFillColor(fl.PatternFill.FgColor), err
+}
+
+// GetCellProtection provides a function to get the protection settings of a
+// cell by given worksheet name and cell reference. The locked and hidden
+// settings only take effect after the worksheet was protected, and all cells
+// are locked and not hidden by default. For example, get the protection
+// settings of cell A1 on Sheet1:
+//
+//	protection, err := f.GetCellProtection("Sheet1", "A1")
+func (f *File) GetCellProtection(sheet, cell string) (Protection, error) {
+	protection := Protection{Locked: true}
+	styleID, err := f.GetCellStyle(sheet, cell)
+	if err != nil {
+		return protection, err
+	}
+	f.mu.Lock()
+	s, err := f.stylesReader()
+	f.mu.Unlock()
+	if err != nil {
+		return protection, err
+	}
+	if s.CellXfs == nil || len(s.CellXfs.Xf) <= styleID || s.CellXfs.Xf[styleID].Protection == nil {
+		return protection, err
+	}
+	if p := s.CellXfs.Xf[styleID].Protection; p.Locked != nil {
+		protection.Locked = *p.Locked
+	}
+	if p := s.CellXfs.Xf[styleID].Protection; p.Hidden != nil {
+		protection.Hidden = *p.Hidden
+	}
+	return protection, err
+}
+
+// getFillColor provides a function to resolve the color of fill to RGB color
+// by given color settings.
+func (f *File) getFillColor(clr *xlsxColor) string {
+	if clr.Auto {
+		return IndexedColorMapping[64]
+	}
+	RGB := f.GetBaseColor(clr.RGB, clr.Indexed, clr.Theme)
+	// Strip the alpha channel of the ARGB color
+	if len(RGB) == 8 {
+		RGB = RGB[2:]
+	}
+	if len(RGB) != 6 {
+		return RGB
+	}
+	return ThemeColor(RGB, clr.Tint)[2:]
+}
+
 // SetCellStyle provides a function to add style attribute for cells by given
 // worksheet name, range reference and style ID. This function is concurrency
 // safe. Note that diagonalDown and diagonalUp type border should be use same
@@ -2308,6 +2791,32 @@ func (f *File) GetCellStyle(sheet, cell string) (int, error) {
 //	    fmt.Println(err)
 //	}
 //	err = f.SetCellStyle("Sheet1", "H9", "H9", style)
+//
+// When the range covers entire columns, such as A1:C1048576, the style will
+// be set to the columns style attribute and the existing cells in the
+// columns, instead of creating every cell in the range. When the range covers
+// entire rows, such as A1:XFD10, the style will be set to the rows style
+// attribute and the existing cells in the rows. For example, set style for
+// columns A:C on Sheet1:
+//
+//	err = f.SetCellStyle("Sheet1", "A1", "C1048576", style)
+//
+// Unlock the input cells A1:B10 on Sheet1 to keep them editable after the
+// worksheet was protected:
+//
+//	style, err := f.NewStyle(&excelize.Style{
+//	    Protection: &excelize.Protection{Locked: false},
+//	})
+//	if err != nil {
+//	    fmt.Println(err)
+//	}
+//	err = f.SetCellStyle("Sheet1", "A1", "B10", style)
+//	if err != nil {
+//	    fmt.Println(err)
+//	}
+//	err = f.ProtectSheet("Sheet1", &excelize.SheetProtectionOptions{
+//	    Password: "password",
+//	})
 func (f *File) SetCellStyle(sheet, topLeftCell, bottomRightCell string, styleID int) error {
 	hCol, hRow, err := CellNameToCoordinates(topLeftCell)
 	if err != nil {
@@ -2349,12 +2858,31 @@ func (f *File) SetCellStyle(sheet, topLeftCell, bottomRightCell string, styleID
 	ws.mu.Lock()
 	defer ws.mu.Unlock()
 
-	ws.prepareSheetXML(vCol, vRow)
-	ws.makeContiguousColumns(hRow, vRow, vCol)
-
+	s.mu.Lock()
 	if styleID < 0 || s.CellXfs == nil || len(s.CellXfs.Xf) <= styleID {
+		s.mu.Unlock()
 		return newInvalidStyleID(styleID)
 	}
+	s.mu.Unlock()
+
+	if hRow == 1 && vRow == TotalRows {
+		ws.setColStyle(hCol, vCol, styleID)
+		for r := range ws.SheetData.Row {
+			for k := range ws.SheetData.Row[r].C {
+				if col, _, err := CellNameToCoordinates(ws.SheetData.Row[r].C[k].R); err == nil && col >= hCol && col <= vCol {
+					ws.SheetData.Row[r].C[k].S = styleID
+				}
+			}
+		}
+		return err
+	}
+	if hCol == 1 && vCol == MaxColumns {
+		ws.setRowStyle(hRow, vRow, styleID)
+		return err
+	}
+
+	ws.prepareSheetXML(vCol, vRow)
+	ws.makeContiguousColumns(hRow, vRow, vCol)
 
 	for r := hRowIdx; r <= vRowIdx; r++ {
 		for k := hColIdx; k <= vColIdx; k++ {
@@ -2367,7 +2895,10 @@ func (f *File) SetCellStyle(sheet, topLeftCell, bottomRightCell string, styleID
 // SetConditionalFormat provides a function to create conditional formatting
 // rule for cell value. Conditional formatting is a feature of Excel which
 // allows you to apply a format to a cell or a range of cells based on certain
-// criteria.
+// criteria. The range reference can be a cell reference, a range reference,
+// an entire columns reference (e.g. A:A) or an entire rows reference (e.g.
+// 1:1), multiple references are separated by commas. The entire columns and
+// rows references are stored in compact form.
 //
 // The type option is a required parameter and it has no default value.
 // Allowable type values and their associated parameters are:
@@ -2548,6 +3079,24 @@ func (f *File) SetCellStyle(sheet, topLeftCell, bottomRightCell string, styleID
 // value when the criteria is either "between" or "not between". See the
 // previous example.
 //
+// type: text - The text type is used to specify Excel's "Text that Contains"
+// style conditional format, the criteria could be "containing", "not
+// containing", "begins with" and "ends with", and the 'Value' parameter
+// specifies the text to be matched case-insensitively. The formula of the rule
+// will be generated by the top-left cell of the range:
+//
+//	// Highlight cells rules: Text that Contains...
+//	err := f.SetConditionalFormat("Sheet1", "A1:A10",
+//	    []excelize.ConditionalFormatOptions{
+//	        {
+//	            Type:     "text",
+//	            Criteria: "containing",
+//	            Format:   &format,
+//	            Value:    "foo",
+//	        },
+//	    },
+//	)
+//
 // type: average - The average type is used to specify Excel's "Average" style
 // conditional format:
 //
@@ -2575,6 +3124,24 @@ func (f *File) SetCellStyle(sheet, topLeftCell, bottomRightCell string, styleID
 //	    },
 //	)
 //
+// The 'EqualAverage' parameter is used to include the cells which equal to the
+// average in the range, and the 'StdDev' parameter is used to specify the
+// number of standard deviations above or below the average, the valid range
+// is 0 to 3:
+//
+//	// Top/Bottom rules: 1 Std Dev Above...
+//	err := f.SetConditionalFormat("Sheet1", "C1:C10",
+//	    []excelize.ConditionalFormatOptions{
+//	        {
+//	            Type:         "average",
+//	            Criteria:     "=",
+//	            Format:       &format3,
+//	            AboveAverage: true,
+//	            StdDev:       1,
+//	        },
+//	    },
+//	)
+//
 // type: duplicate - The duplicate type is used to highlight duplicate cells in
 // a range:
 //
@@ -2609,7 +3176,9 @@ func (f *File) SetCellStyle(sheet, topLeftCell, bottomRightCell string, styleID
 //	    },
 //	)
 //
-// The criteria can be used to indicate that a percentage condition is required:
+// The criteria can be used to indicate that a percentage condition is required,
+// the valid range of the value is 1 to 1000 for the number of items, and 1 to
+// 100 for the percentage:
 //
 //	err := f.SetConditionalFormat("Sheet1", "A1:A10",
 //	    []excelize.ConditionalFormatOptions{
@@ -2641,7 +3210,9 @@ func (f *File) SetCellStyle(sheet, topLeftCell, bottomRightCell string, styleID
 //	)
 //
 // This conditional type can be modified with MinType, MaxType, MinValue,
-// MaxValue, MinColor and MaxColor, see below.
+// MaxValue, MinColor and MaxColor, see below. The MidType, MidValue and
+// MidColor are not available for the 2_color_scale type, and an error will be
+// returned if any of them was specified.
 //
 // type: 3_color_scale - The 3_color_scale type is used to specify Excel's "3
 // Color Scale" style conditional format:
@@ -2696,7 +3267,12 @@ func (f *File) SetCellStyle(sheet, topLeftCell, bottomRightCell string, styleID
 //	formula
 //	max        (for MaxType only)
 //
-// MidType - Used for 3_color_scale. Same as MinType, see above.
+// The MinType and MaxType of the color scales are default to min and max if
+// they are omitted.
+//
+// MidType - Used for 3_color_scale. Same as MinType, see above. Which is one of
+// num, percent, percentile and formula, and default to percentile if it is
+// omitted.
 //
 // MaxType - Same as MinType, see above.
 //
@@ -2776,6 +3352,35 @@ func (f *File) SetCellStyle(sheet, topLeftCell, bottomRightCell string, styleID
 // formatting rule when more than one rule is applied to a cell or a range of
 // cells. When this parameter is set then subsequent rules are not evaluated
 // if the current rule is true.
+//
+// Priority - used to set the priority of a conditional formatting rule, the
+// rule with the lower value has the higher priority and will be evaluated
+// first. The priority value must be a positive integer which is not used by
+// the other rules in the worksheet, when it is omitted, the rules will be
+// assigned with the unused priorities after the existing rules in the
+// worksheet by the order of the given options. For example, highlight the
+// cells that greater than 90 with the format ID 1, and stop evaluating the
+// rule which highlight the cells greater than 60 with the format ID 2:
+//
+//	err := f.SetConditionalFormat("Sheet1", "A1:A10",
+//	    []excelize.ConditionalFormatOptions{
+//	        {
+//	            Type:       "cell",
+//	            Criteria:   ">",
+//	            Format:     &format1,
+//	            Value:      "90",
+//	            StopIfTrue: true,
+//	            Priority:   1,
+//	        },
+//	        {
+//	            Type:     "cell",
+//	            Criteria: ">",
+//	            Format:   &format2,
+//	            Value:    "60",
+//	            Priority: 2,
+//	        },
+//	    },
+//	)
 func (f *File) SetConditionalFormat(sheet, rangeRef string, opts []ConditionalFormatOptions) error {
 	ws, err := f.workSheetReader(sheet)
 	if err != nil {
@@ -2785,10 +3390,9 @@ func (f *File) SetConditionalFormat(sheet, rangeRef string, opts []ConditionalFo
 	if err != nil {
 		return err
 	}
-	// Create a pseudo GUID for each unique rule.
-	var rules int
-	for _, cf := range ws.ConditionalFormatting {
-		rules += len(cf.CfRule)
+	priorities, err := f.prepareCondFmtPriorities(ws, opts)
+	if err != nil {
+		return err
 	}
 	var (
 		cfRule          []*xlsxCfRule
@@ -2812,7 +3416,8 @@ func (f *File) SetConditionalFormat(sheet, rangeRef string, opts []ConditionalFo
 			if ok || inStrSlice(noCriteriaTypes, vt, true) != -1 {
 				drawFunc, ok := drawContFmtFunc[vt]
 				if ok {
-					priority := rules + i
+					// Create a pseudo GUID for each unique rule.
+					priority := priorities[i] - 1
 					rule, x14rule := drawFunc(priority, ct, mastCell,
 						fmt.Sprintf("{00000000-0000-0000-%04X-%012X}", f.getSheetID(sheet), priority), &opt)
 					if rule == nil {
@@ -2840,6 +3445,46 @@ func (f *File) SetConditionalFormat(sheet, rangeRef string, opts []ConditionalFo
 	return err
 }
 
+// prepareCondFmtPriorities provides a function to get the priorities of the
+// given conditional formatting rules. The specified priority should be a
+// positive integer which is not used by the other rules in the worksheet, and
+// the rules without specified priority will be assigned with the unused
+// priorities after the existing rules in the worksheet.
+func (f *File) prepareCondFmtPriorities(ws *xlsxWorksheet, opts []ConditionalFormatOptions) ([]int, error) {
+	var rules int
+	priorities, used := make([]int, len(opts)), make(map[int]bool)
+	for _, cf := range ws.ConditionalFormatting {
+		rules += len(cf.CfRule)
+		for _, rule := range cf.CfRule {
+			if rule != nil {
+				used[rule.Priority] = true
+			}
+		}
+	}
+	for _, x14Rules := range f.getCondFmtX14Rules(ws) {
+		for _, rule := range x14Rules {
+			used[rule.Priority] = true
+		}
+	}
+	for i, opt := range opts {
+		if opt.Priority < 0 || opt.Priority > 0 && used[opt.Priority] {
+			return priorities, ErrParameterInvalid
+		}
+		priorities[i], used[opt.Priority] = opt.Priority, true
+	}
+	for i := range priorities {
+		if priorities[i] > 0 {
+			continue
+		}
+		rules++
+		for used[rules] {
+			rules++
+		}
+		priorities[i], used[rules] = rules, true
+	}
+	return priorities, nil
+}
+
 // prepareConditionalFormatRange returns checked cell range and master cell
 // reference by giving conditional formatting range reference.
 func prepareConditionalFormatRange(rangeRef string) (string, string, error) {
@@ -2849,6 +3494,14 @@ func prepareConditionalFormatRange(rangeRef string) (string, string, error) {
 	}
 	rangeRef = strings.ReplaceAll(rangeRef, ",", " ")
 	for i, cellRange := range strings.Split(rangeRef, " ") {
+		// Keep the entire columns or rows range reference as compact form
+		if entireRef, cellName, ok := parseEntireRangeRef(cellRange); ok {
+			if i == 0 {
+				mastCell = cellName
+			}
+			SQRef += entireRef + " "
+			continue
+		}
 		var cellNames []string
 		for j, ref := range strings.Split(cellRange, ":") {
 			if j > 1 {
@@ -2881,6 +3534,33 @@ func prepareConditionalFormatRange(rangeRef string) (string, string, error) {
 	return strings.TrimSuffix(SQRef, " "), mastCell, nil
 }
 
+// parseEntireRangeRef provides a function to parse the entire columns range
+// reference (e.g. A:B) or entire rows range reference (e.g. 1:2), returns the
+// normalized range reference and the top left cell of the range.
+func parseEntireRangeRef(rangeRef string) (string, string, bool) {
+	refs := strings.Split(rangeRef, ":")
+	if len(refs) != 2 {
+		return "", "", false
+	}
+	from, fromCol, fromRow, err := parseRef(refs[0])
+	if err != nil || from.Sheet != "" {
+		return "", "", false
+	}
+	to, toCol, toRow, err := parseRef(refs[1])
+	if err != nil || to.Sheet != "" {
+		return "", "", false
+	}
+	if fromCol && toCol && from.Col <= MaxColumns && to.Col <= MaxColumns {
+		fromName, _ := ColumnNumberToName(from.Col)
+		toName, _ := ColumnNumberToName(to.Col)
+		return fromName + ":" + toName, fromName + "1", true
+	}
+	if fromRow && toRow && from.Row > 0 && from.Row <= TotalRows && to.Row > 0 && to.Row <= TotalRows {
+		return strconv.Itoa(from.Row) + ":" + strconv.Itoa(to.Row), "A" + strconv.Itoa(from.Row), true
+	}
+	return "", "", false
+}
+
 // appendCfRule provides a function to append rules to conditional formatting.
 func (f *File) appendCfRule(ws *xlsxWorksheet, rule *xlsxX14CfRule) error {
 	var (
@@ -2978,10 +3658,12 @@ func (f *File) extractCondFmtTop10(c *xlsxCfRule, extLst *xlsxExtLst) Conditiona
 // rule.
 func (f *File) extractCondFmtAboveAverage(c *xlsxCfRule, extLst *xlsxExtLst) ConditionalFormatOptions {
 	format := ConditionalFormatOptions{
-		Format:     c.DxfID,
-		StopIfTrue: c.StopIfTrue,
-		Type:       "average",
-		Criteria:   "=",
+		Format:       c.DxfID,
+		StopIfTrue:   c.StopIfTrue,
+		Type:         "average",
+		Criteria:     "=",
+		EqualAverage: c.EqualAverage,
+		StdDev:       c.StdDev,
 	}
 	if c.AboveAverage != nil {
 		format.AboveAverage = *c.AboveAverage
@@ -3071,7 +3753,7 @@ func (f *File) extractCondFmtColorScale(c *xlsxCfRule, extLst *xlsxExtLst) Condi
 			format.MidValue = c.ColorScale.Cfvo[1].Val
 		}
 		format.MidColor = "#" + f.getThemeColor(c.ColorScale.Color[1])
-		format.MaxType = c.ColorScale.Cfvo[2].Type
+		format.MaxType, format.MaxValue = c.ColorScale.Cfvo[2].Type, ""
 		if c.ColorScale.Cfvo[2].Val != "0" {
 			format.MaxValue = c.ColorScale.Cfvo[2].Val
 		}
@@ -3159,8 +3841,96 @@ func (f *File) extractCondFmtIconSet(c *xlsxCfRule, extLst *xlsxExtLst) Conditio
 	return format
 }
 
+// extractCondFmtX14Rule provides a function to extract conditional format
+// settings for the data bar or icon set rule which only defined in the
+// conditional formatting extension.
+func (f *File) extractCondFmtX14Rule(rule *decodeX14CfRule) (ConditionalFormatOptions, bool) {
+	format := ConditionalFormatOptions{Priority: rule.Priority, StopIfTrue: rule.StopIfTrue}
+	cfvoType := func(cfvo *decodeX14Cfvo) string {
+		if typ, ok := map[string]string{"autoMin": "min", "autoMax": "max"}[cfvo.Type]; ok {
+			return typ
+		}
+		return cfvo.Type
+	}
+	if rule.DataBar != nil {
+		format.Type, format.Criteria = "data_bar", "="
+		if len(rule.DataBar.Cfvo) > 1 {
+			format.MinType, format.MinValue = cfvoType(rule.DataBar.Cfvo[0]), rule.DataBar.Cfvo[0].F
+			format.MaxType, format.MaxValue = cfvoType(rule.DataBar.Cfvo[1]), rule.DataBar.Cfvo[1].F
+		}
+		if rule.DataBar.FillColor != nil {
+			format.BarColor = "#" + f.getThemeColor(rule.DataBar.FillColor)
+		}
+		if rule.DataBar.BorderColor != nil {
+			format.BarBorderColor = "#" + f.getThemeColor(rule.DataBar.BorderColor)
+		}
+		if rule.DataBar.ShowValue != nil {
+			format.BarOnly = !*rule.DataBar.ShowValue
+		}
+		format.BarDirection = rule.DataBar.Direction
+		format.BarSolid = rule.DataBar.Gradient != nil && !*rule.DataBar.Gradient
+		return format, true
+	}
+	if rule.IconSet != nil {
+		format.Type, format.IconStyle, format.ReverseIcons = "icon_set", rule.IconSet.IconSet, rule.IconSet.Reverse
+		if rule.IconSet.ShowValue != nil {
+			format.IconsOnly = !*rule.IconSet.ShowValue
+		}
+		return format, true
+	}
+	return format, false
+}
+
+// getCondFmtX14Rules provides a function to get the conditional formatting
+// rules which only defined in the worksheet extension list, the rules
+// referenced by the conditional formatting rules in the worksheet will be
+// skipped.
+func (f *File) getCondFmtX14Rules(ws *xlsxWorksheet) map[string][]ConditionalFormatOptions {
+	rules, referenced := make(map[string][]ConditionalFormatOptions), make(map[string]bool)
+	if ws.ExtLst == nil {
+		return rules
+	}
+	for _, cf := range ws.ConditionalFormatting {
+		for _, cr := range cf.CfRule {
+			if cr.ExtLst != nil {
+				ext := decodeX14ConditionalFormattingExt{}
+				if err := xml.Unmarshal([]byte(cr.ExtLst.Ext), &ext); err == nil {
+					referenced[ext.ID] = true
+				}
+			}
+		}
+	}
+	decodeExtLst := new(decodeExtLst)
+	if err := xml.Unmarshal([]byte("<extLst>"+ws.ExtLst.Ext+"</extLst>"), decodeExtLst); err != nil {
+		return rules
+	}
+	for _, ext := range decodeExtLst.Ext {
+		if ext.URI != ExtURIConditionalFormattings {
+			continue
+		}
+		decodeCondFmts := new(decodeX14ConditionalFormattingRules)
+		if err := xml.Unmarshal([]byte(ext.Content), &decodeCondFmts); err != nil {
+			continue
+		}
+		for _, condFmt := range decodeCondFmts.CondFmt {
+			for _, rule := range condFmt.CfRule {
+				if referenced[rule.ID] {
+					continue
+				}
+				if opt, ok := f.extractCondFmtX14Rule(rule); ok {
+					rules[condFmt.Sqref] = append(rules[condFmt.Sqref], opt)
+				}
+			}
+		}
+	}
+	return rules
+}
+
 // GetConditionalFormats returns conditional format settings by given worksheet
-// name.
+// name. The conditional formats are keyed by the range reference, and the
+// rules of each range reference are sorted in priority order with the
+// Priority field of each rule, includes the data bar and icon set rules which
+// only defined in the conditional formatting extension.
 func (f *File) GetConditionalFormats(sheet string) (map[string][]ConditionalFormatOptions, error) {
 	conditionalFormats := make(map[string][]ConditionalFormatOptions)
 	ws, err := f.workSheetReader(sheet)
@@ -3168,14 +3938,24 @@ func (f *File) GetConditionalFormats(sheet string) (map[string][]ConditionalForm
 		return conditionalFormats, err
 	}
 	for _, cf := range ws.ConditionalFormatting {
-		var opts []ConditionalFormatOptions
+		opts := conditionalFormats[cf.SQRef]
 		for _, cr := range cf.CfRule {
 			if extractFunc, ok := extractContFmtFunc[cr.Type]; ok {
-				opts = append(opts, extractFunc(f, cr, ws.ExtLst))
+				opt := extractFunc(f, cr, ws.ExtLst)
+				opt.Priority = cr.Priority
+				opts = append(opts, opt)
 			}
 		}
 		conditionalFormats[cf.SQRef] = opts
 	}
+	for sqref, opts := range f.getCondFmtX14Rules(ws) {
+		conditionalFormats[sqref] = append(conditionalFormats[sqref], opts...)
+	}
+	for _, opts := range conditionalFormats {
+		sort.SliceStable(opts, func(i, j int) bool {
+			return opts[i].Priority < opts[j].Priority
+		})
+	}
 	return conditionalFormats, err
 }
 
@@ -3288,6 +4068,9 @@ func drawCondFmtTop10(p int, ct, ref, GUID string, format *ConditionalFormatOpti
 	if rank, err := strconv.Atoi(format.Value); err == nil {
 		c.Rank = rank
 	}
+	if c.Rank < 1 || c.Rank > 1000 || (c.Percent && c.Rank > 100) {
+		return nil, nil
+	}
 	return c, nil
 }
 
@@ -3295,11 +4078,16 @@ func drawCondFmtTop10(p int, ct, ref, GUID string, format *ConditionalFormatOpti
 // formatting rule for above average and below average by given priority,
 // criteria type and format settings.
 func drawCondFmtAboveAverage(p int, ct, ref, GUID string, format *ConditionalFormatOptions) (*xlsxCfRule, *xlsxX14CfRule) {
+	if format.StdDev < 0 || format.StdDev > 3 {
+		return nil, nil
+	}
 	return &xlsxCfRule{
 		Priority:     p + 1,
 		StopIfTrue:   format.StopIfTrue,
 		Type:         validType[format.Type],
 		AboveAverage: boolPtr(format.AboveAverage),
+		EqualAverage: format.EqualAverage,
+		StdDev:       format.StdDev,
 		DxfID:        format.Format,
 	}, nil
 }
@@ -3320,6 +4108,29 @@ func drawCondFmtDuplicateUniqueValues(p int, ct, ref, GUID string, format *Condi
 // rule for color scale (include 2 color scale and 3 color scale) by given
 // priority, criteria type and format settings.
 func drawCondFmtColorScale(p int, ct, ref, GUID string, format *ConditionalFormatOptions) (*xlsxCfRule, *xlsxX14CfRule) {
+	valueTypes := []string{"num", "percent", "percentile", "formula"}
+	minType, midType, maxType := format.MinType, format.MidType, format.MaxType
+	if minType == "" {
+		minType = "min"
+	}
+	if maxType == "" {
+		maxType = "max"
+	}
+	if validType[format.Type] == "3_color_scale" {
+		if midType == "" {
+			midType = "percentile"
+		}
+		if inStrSlice(valueTypes, midType, true) == -1 {
+			return nil, nil
+		}
+	} else if midType != "" || format.MidValue != "" || format.MidColor != "" {
+		// The midpoint is not available for the 2 color scale.
+		return nil, nil
+	}
+	if (minType != "min" && inStrSlice(valueTypes, minType, true) == -1) ||
+		(maxType != "max" && inStrSlice(valueTypes, maxType, true) == -1) {
+		return nil, nil
+	}
 	minValue := format.MinValue
 	if minValue == "" {
 		minValue = "0"
@@ -3339,7 +4150,7 @@ func drawCondFmtColorScale(p int, ct, ref, GUID string, format *ConditionalForma
 		Type:       "colorScale",
 		ColorScale: &xlsxColorScale{
 			Cfvo: []*xlsxCfvo{
-				{Type: format.MinType, Val: minValue},
+				{Type: minType, Val: minValue},
 			},
 			Color: []*xlsxColor{
 				{RGB: getPaletteColor(format.MinColor)},
@@ -3347,10 +4158,10 @@ func drawCondFmtColorScale(p int, ct, ref, GUID string, format *ConditionalForma
 		},
 	}
 	if validType[format.Type] == "3_color_scale" {
-		c.ColorScale.Cfvo = append(c.ColorScale.Cfvo, &xlsxCfvo{Type: format.MidType, Val: midValue})
+		c.ColorScale.Cfvo = append(c.ColorScale.Cfvo, &xlsxCfvo{Type: midType, Val: midValue})
 		c.ColorScale.Color = append(c.ColorScale.Color, &xlsxColor{RGB: getPaletteColor(format.MidColor)})
 	}
-	c.ColorScale.Cfvo = append(c.ColorScale.Cfvo, &xlsxCfvo{Type: format.MaxType, Val: maxValue})
+	c.ColorScale.Cfvo = append(c.ColorScale.Cfvo, &xlsxCfvo{Type: maxType, Val: maxValue})
 	c.ColorScale.Color = append(c.ColorScale.Color, &xlsxColor{RGB: getPaletteColor(format.MaxColor)})
 	return c, nil
 }
@@ -197,6 +197,39 @@ func TestSetConditionalFormat(t *testing.T) {
 	assert.Equal(t, ErrParameterInvalid, f.SetConditionalFormat("Sheet1", "A1:A2", []ConditionalFormatOptions{{Type: "icon_set", IconStyle: "unknown"}}))
 	// Test unsupported conditional formatting rule types
 	assert.Equal(t, ErrParameterInvalid, f.SetConditionalFormat("Sheet1", "A1", []ConditionalFormatOptions{{Type: "unsupported"}}))
+	// Test creating color scales with invalid min, mid or max type
+	for _, opts := range []ConditionalFormatOptions{
+		{Type: "2_color_scale", Criteria: "=", MidType: "num"},
+		{Type: "2_color_scale", Criteria: "=", MidValue: "50"},
+		{Type: "2_color_scale", Criteria: "=", MidColor: "#FFEB84"},
+		{Type: "2_color_scale", Criteria: "=", MinType: "max"},
+		{Type: "3_color_scale", Criteria: "=", MidType: "min"},
+		{Type: "3_color_scale", Criteria: "=", MaxType: "unknown"},
+	} {
+		assert.Equal(t, ErrParameterInvalid, f.SetConditionalFormat("Sheet1", "A1", []ConditionalFormatOptions{opts}))
+	}
+	// Test creating top N and above average rules with invalid rank or standard deviation
+	for _, opts := range []ConditionalFormatOptions{
+		{Type: "top", Criteria: "=", Value: "0"},
+		{Type: "bottom", Criteria: "=", Value: "1001"},
+		{Type: "top", Criteria: "=", Value: "101", Percent: true},
+		{Type: "average", Criteria: "=", StdDev: -1},
+		{Type: "average", Criteria: "=", StdDev: 4},
+	} {
+		assert.Equal(t, ErrParameterInvalid, f.SetConditionalFormat("Sheet1", "A1", []ConditionalFormatOptions{opts}))
+	}
+	// Test creating color scales with default min, mid and max type
+	f = NewFile()
+	assert.NoError(t, f.SetConditionalFormat("Sheet1", "A1:A10", []ConditionalFormatOptions{
+		{Type: "3_color_scale", Criteria: "=", MidValue: "40", MinColor: "#F8696B", MidColor: "#FFEB84", MaxColor: "#63BE7B"},
+		{Type: "2_color_scale", Criteria: "=", MinType: "percent", MinValue: "10", MinColor: "#F8696B", MaxColor: "#63BE7B"},
+	}))
+	condFmtsMap, err := f.GetConditionalFormats("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, []ConditionalFormatOptions{
+		{Type: "3_color_scale", Criteria: "=", MinType: "min", MidType: "percentile", MaxType: "max", MidValue: "40", MinColor: "#F8696B", MidColor: "#FFEB84", MaxColor: "#63BE7B", Priority: 1},
+		{Type: "2_color_scale", Criteria: "=", MinType: "percent", MaxType: "max", MinValue: "10", MinColor: "#F8696B", MaxColor: "#63BE7B", Priority: 2},
+	}, condFmtsMap["A1:A10"])
 
 	t.Run("multi_conditional_formatting_rules_priority", func(t *testing.T) {
 		f := NewFile()
@@ -239,6 +272,82 @@ func TestSetConditionalFormat(t *testing.T) {
 		assert.Equal(t, expected, priorities)
 		assert.NoError(t, f.Close())
 	})
+
+	t.Run("stop_if_true_with_explicit_priority", func(t *testing.T) {
+		f := NewFile()
+		format1, err := f.NewConditionalStyle(&Style{Font: &Font{Color: "9A0511"}})
+		assert.NoError(t, err)
+		format2, err := f.NewConditionalStyle(&Style{Font: &Font{Color: "09600B"}})
+		assert.NoError(t, err)
+		// Test the rule with the lower priority value is evaluated first, and
+		// stop evaluating the overlapping rule when it is true
+		assert.NoError(t, f.SetConditionalFormat("Sheet1", "A1:A10", []ConditionalFormatOptions{
+			{Type: "cell", Criteria: ">", Format: &format2, Value: "60", Priority: 3},
+			{Type: "cell", Criteria: ">", Format: &format1, Value: "90", StopIfTrue: true, Priority: 2},
+		}))
+		ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+		assert.True(t, ok)
+		rules := ws.(*xlsxWorksheet).ConditionalFormatting[0].CfRule
+		assert.Equal(t, 3, rules[0].Priority)
+		assert.False(t, rules[0].StopIfTrue)
+		assert.Equal(t, 2, rules[1].Priority)
+		assert.True(t, rules[1].StopIfTrue)
+		// Test the priorities of new rules are assigned after the existing rules
+		assert.NoError(t, f.SetConditionalFormat("Sheet1", "B1:B10", []ConditionalFormatOptions{
+			{Type: "cell", Criteria: ">", Format: &format1, Value: "90"},
+		}))
+		opts, err := f.GetConditionalFormats("Sheet1")
+		assert.NoError(t, err)
+		assert.Equal(t, []ConditionalFormatOptions{
+			{Type: "cell", Criteria: "greater than", Format: &format1, Value: "90", StopIfTrue: true, Priority: 2},
+			{Type: "cell", Criteria: "greater than", Format: &format2, Value: "60", Priority: 3},
+		}, opts["A1:A10"])
+		assert.Equal(t, 4, opts["B1:B10"][0].Priority)
+		// Test the priorities of new rules skip the used priorities
+		assert.NoError(t, f.SetConditionalFormat("Sheet1", "C1:C10", []ConditionalFormatOptions{
+			{Type: "cell", Criteria: ">", Format: &format1, Value: "90"},
+			{Type: "cell", Criteria: ">", Format: &format1, Value: "80", Priority: 5},
+			{Type: "cell", Criteria: ">", Format: &format1, Value: "70"},
+		}))
+		var priorities []int
+		for _, rule := range ws.(*xlsxWorksheet).ConditionalFormatting[2].CfRule {
+			priorities = append(priorities, rule.Priority)
+		}
+		assert.Equal(t, []int{6, 5, 7}, priorities)
+		// Test set conditional format with invalid or used priority
+		for _, opts := range [][]ConditionalFormatOptions{
+			{{Type: "cell", Criteria: ">", Format: &format1, Value: "90", Priority: -1}},
+			{{Type: "cell", Criteria: ">", Format: &format1, Value: "90", Priority: 2}},
+			{
+				{Type: "cell", Criteria: ">", Format: &format1, Value: "90", Priority: 7},
+				{Type: "cell", Criteria: ">", Format: &format1, Value: "80", Priority: 7},
+			},
+		} {
+			assert.Equal(t, ErrParameterInvalid, f.SetConditionalFormat("Sheet1", "D1:D10", opts))
+		}
+		assert.NoError(t, f.Close())
+	})
+}
+
+func TestSetConditionalFormatText(t *testing.T) {
+	for criteria, expected := range map[string]xlsxCfRule{
+		"containing":     {Type: "containsText", Operator: "containsText", Formula: []string{`NOT(ISERROR(SEARCH("fo""o",C3)))`}},
+		"not containing": {Type: "notContainsText", Operator: "notContains", Formula: []string{`ISERROR(SEARCH("fo""o",C3))`}},
+		"begins with":    {Type: "beginsWith", Operator: "beginsWith", Formula: []string{`LEFT(C3,LEN("fo""o"))="fo""o"`}},
+		"ends with":      {Type: "endsWith", Operator: "endsWith", Formula: []string{`RIGHT(C3,LEN("fo""o"))="fo""o"`}},
+	} {
+		f := NewFile()
+		assert.NoError(t, f.SetConditionalFormat("Sheet1", "C3:D10", []ConditionalFormatOptions{
+			{Type: "text", Criteria: criteria, Value: `fo"o`, Format: intPtr(1)},
+		}))
+		ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+		assert.True(t, ok)
+		cfRules := ws.(*xlsxWorksheet).ConditionalFormatting[0].CfRule
+		assert.Len(t, cfRules, 1)
+		expected.Priority, expected.Text, expected.DxfID = 1, `fo"o`, intPtr(1)
+		assert.Equal(t, &expected, cfRules[0], criteria)
+		assert.NoError(t, f.Close())
+	}
 }
 
 func TestGetConditionalFormats(t *testing.T) {
@@ -262,6 +371,8 @@ func TestGetConditionalFormats(t *testing.T) {
 		{{Type: "top", Format: intPtr(1), Criteria: "=", Value: "6"}},
 		{{Type: "bottom", Format: intPtr(1), Criteria: "=", Value: "6"}},
 		{{Type: "average", AboveAverage: true, Format: intPtr(1), Criteria: "="}},
+		{{Type: "average", AboveAverage: false, EqualAverage: true, StdDev: 2, Format: intPtr(1), Criteria: "="}},
+		{{Type: "top", Format: intPtr(1), Criteria: "=", Value: "20", Percent: true}},
 		{{Type: "duplicate", Format: intPtr(1), Criteria: "="}},
 		{{Type: "unique", Format: intPtr(1), Criteria: "="}},
 		{{Type: "3_color_scale", Criteria: "=", MinType: "num", MidType: "num", MaxType: "num", MinValue: "-10", MidValue: "50", MaxValue: "10", MinColor: "#FF0000", MidColor: "#00FF00", MaxColor: "#0000FF"}},
@@ -280,7 +391,20 @@ func TestGetConditionalFormats(t *testing.T) {
 		assert.NoError(t, err)
 		opts, err := f.GetConditionalFormats("Sheet1")
 		assert.NoError(t, err)
-		assert.Equal(t, format, opts["A2:A1 B1:B1048576 A2:XFD2"])
+		for i := range format {
+			format[i].Priority = i + 1
+		}
+		assert.Equal(t, format, opts["A2:A1 B:B 2:2"])
+	}
+	// Test set conditional format with entire columns or rows range reference
+	for rangeRef, expected := range map[string]string{
+		"A:A": "A:A", "b:c": "B:C", "1:1": "1:1", "A:A,3:5": "A:A 3:5", "A:1": "A1:XFD1",
+	} {
+		f := NewFile()
+		assert.NoError(t, f.SetConditionalFormat("Sheet1", rangeRef, []ConditionalFormatOptions{{Type: "blanks", Format: intPtr(1)}}))
+		opts, err := f.GetConditionalFormats("Sheet1")
+		assert.NoError(t, err)
+		assert.Contains(t, opts, expected)
 	}
 	// Test get multiple conditional formats
 	f := NewFile()
@@ -292,8 +416,50 @@ func TestGetConditionalFormats(t *testing.T) {
 	assert.NoError(t, err)
 	opts, err := f.GetConditionalFormats("Sheet1")
 	assert.NoError(t, err)
+	for i := range expected {
+		expected[i].Priority = i + 1
+	}
+	assert.Equal(t, expected, opts["A1:A2"])
+	// Test set the conditional formats with the priorities returned by the
+	// GetConditionalFormats function into another worksheet
+	_, err = f.NewSheet("Sheet2")
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetConditionalFormat("Sheet2", "A1:A2", opts["A1:A2"]))
+	opts, err = f.GetConditionalFormats("Sheet2")
+	assert.NoError(t, err)
 	assert.Equal(t, expected, opts["A1:A2"])
 
+	// Test get conditional formats in priority order with extension rules
+	f = NewFile()
+	f.Sheet.Delete("xl/worksheets/sheet1.xml")
+	f.Pkg.Store("xl/worksheets/sheet1.xml", []byte(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData/>`+
+		`<conditionalFormatting sqref="A1:A5"><cfRule type="containsBlanks" dxfId="0" priority="5"><formula>LEN(TRIM(A1))=0</formula></cfRule><cfRule type="expression" dxfId="0" priority="1"><formula>A1>1</formula></cfRule></conditionalFormatting>`+
+		`<conditionalFormatting sqref="A1:A5"><cfRule type="expression" dxfId="0" priority="3"><formula>A1>3</formula></cfRule></conditionalFormatting>`+
+		`<extLst><ext uri="{78C0D931-6437-407d-A8EE-F0AAD7539E65}" xmlns:x14="http://schemas.microsoft.com/office/spreadsheetml/2009/9/main"><x14:conditionalFormattings>`+
+		`<x14:conditionalFormatting xmlns:xm="http://schemas.microsoft.com/office/excel/2006/main"><x14:cfRule type="dataBar" priority="4" id="{00000000-0000-0000-0000-000000000001}"><x14:dataBar minLength="0" maxLength="100" gradient="0" showValue="0" direction="rightToLeft"><x14:cfvo type="autoMin"/><x14:cfvo type="num"><xm:f>10</xm:f></x14:cfvo><x14:fillColor rgb="FF638EC6"/><x14:borderColor rgb="FF0000FF"/></x14:dataBar></x14:cfRule><xm:sqref>A1:A5</xm:sqref></x14:conditionalFormatting>`+
+		`<x14:conditionalFormatting xmlns:xm="http://schemas.microsoft.com/office/excel/2006/main"><x14:cfRule type="iconSet" priority="2" id="{00000000-0000-0000-0000-000000000002}"><x14:iconSet iconSet="3Stars" showValue="0" reverse="1"><x14:cfvo type="percent"><xm:f>0</xm:f></x14:cfvo></x14:iconSet></x14:cfRule><x14:cfRule type="expression" priority="6" id="{00000000-0000-0000-0000-000000000003}"/><xm:sqref>A1:A5</xm:sqref></x14:conditionalFormatting>`+
+		`</x14:conditionalFormattings></ext></extLst></worksheet>`))
+	opts, err = f.GetConditionalFormats("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, []ConditionalFormatOptions{
+		{Type: "formula", Format: intPtr(0), Criteria: "A1>1", Priority: 1},
+		{Type: "icon_set", IconStyle: "3Stars", ReverseIcons: true, IconsOnly: true, Priority: 2},
+		{Type: "formula", Format: intPtr(0), Criteria: "A1>3", Priority: 3},
+		{Type: "data_bar", Criteria: "=", MinType: "min", MaxType: "num", MaxValue: "10", BarColor: "#638EC6", BarBorderColor: "#0000FF", BarDirection: "rightToLeft", BarOnly: true, BarSolid: true, Priority: 4},
+		{Type: "blanks", Format: intPtr(0), Priority: 5},
+	}, opts["A1:A5"])
+	// Test get conditional formats with invalid extension list
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	ws.(*xlsxWorksheet).ExtLst.Ext = "<ext"
+	opts, err = f.GetConditionalFormats("Sheet1")
+	assert.NoError(t, err)
+	assert.Len(t, opts["A1:A5"], 3)
+	ws.(*xlsxWorksheet).ExtLst.Ext = fmt.Sprintf(`<ext uri="%s"><conditionalFormattings><conditionalFormatting></ext>`, ExtURIConditionalFormattings)
+	opts, err = f.GetConditionalFormats("Sheet1")
+	assert.NoError(t, err)
+	assert.Len(t, opts["A1:A5"], 3)
+
 	// Test get conditional formats on no exists worksheet
 	f = NewFile()
 	_, err = f.GetConditionalFormats("SheetN")
@@ -348,6 +514,48 @@ func TestNewStyle(t *testing.T) {
 	styleID2, err := f.NewStyle(&Style{Fill: Fill{Type: "gradient", Color: []string{"FF0000", "4E71BE"}, Shading: 1, Pattern: 1}})
 	assert.NoError(t, err)
 	assert.NotEqual(t, styleID1, styleID2)
+	// Test gradient fills with custom stops and pattern fills with background color
+	for _, fill := range []Fill{
+		{Type: "gradient", Color: []string{"FFFFFF", "4E71BE", "FF0000"}, Stops: []float64{0, 0.3, 1}, Degree: 45},
+		{Type: "gradient", Color: []string{"FFFFFF", "4E71BE", "FF0000"}, Shading: 2},
+		{Type: "pattern", Color: []string{"FF0000", "FFFF00"}, Pattern: 9},
+		{Type: "pattern", Color: []string{"FF0000"}, Pattern: 14},
+	} {
+		styleID, err := f.NewStyle(&Style{Fill: fill})
+		assert.NoError(t, err)
+		style, err := f.GetStyle(styleID)
+		assert.NoError(t, err)
+		assert.Equal(t, fill, style.Fill)
+	}
+	styles, err = f.stylesReader()
+	assert.NoError(t, err)
+	fill := styles.Fills.Fill[len(styles.Fills.Fill)-2]
+	assert.Equal(t, "darkGrid", fill.PatternFill.PatternType)
+	assert.Equal(t, "FFFF0000", fill.PatternFill.FgColor.RGB)
+	assert.Equal(t, "FFFFFF00", fill.PatternFill.BgColor.RGB)
+	// Test create style with invalid gradient fills
+	for _, fill := range []Fill{
+		{Type: "gradient", Color: []string{"FFFFFF", "4E71BE"}, Stops: []float64{0}},
+		{Type: "gradient", Color: []string{"FFFFFF"}, Stops: []float64{0, 1}},
+		{Type: "gradient", Color: []string{"FFFFFF", "4E71BE"}, Stops: []float64{0, 1.5}},
+		{Type: "gradient", Color: []string{"FFFFFF", "4E71BE"}, Stops: []float64{0.5, 0}},
+	} {
+		_, err := f.NewStyle(&Style{Fill: fill})
+		assert.Equal(t, ErrParameterInvalid, err)
+		_, err = f.NewConditionalStyle(&Style{Fill: fill})
+		assert.Equal(t, ErrParameterInvalid, err)
+	}
+	styleID, err = f.NewStyle(&Style{Fill: Fill{Type: "gradient", Color: []string{"FFFFFF", "4E71BE", "FF0000"}, Shading: 1}})
+	assert.NoError(t, err)
+	style, err := f.GetStyle(styleID)
+	assert.NoError(t, err)
+	assert.Empty(t, style.Fill.Type)
+	// Test conditional style with pattern fill with background color
+	format, err := f.NewConditionalStyle(&Style{Fill: Fill{Type: "pattern", Color: []string{"FF0000", "FFFF00"}, Pattern: 9}})
+	assert.NoError(t, err)
+	style, err = f.GetConditionalStyle(format)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"FF0000", "FFFF00"}, style.Fill.Color)
 
 	var exp string
 	f = NewFile()
@@ -357,6 +565,29 @@ func TestNewStyle(t *testing.T) {
 	assert.Equal(t, ErrFontLength, err)
 	_, err = f.NewStyle(&Style{Font: &Font{Size: MaxFontSize + 1}})
 	assert.Equal(t, ErrFontSize, err)
+	// Test create style with alignment indent and text rotation
+	for _, c := range []struct {
+		alignment *Alignment
+		expected  int
+		err       error
+	}{
+		{&Alignment{Horizontal: "left", Indent: 2, TextRotation: 45}, 45, nil},
+		{&Alignment{TextRotation: -45}, 135, nil},
+		{&Alignment{TextRotation: -90}, 180, nil},
+		{&Alignment{TextRotation: 255}, 255, nil},
+		{&Alignment{TextRotation: -91}, 0, ErrTextRotation},
+		{&Alignment{TextRotation: 181}, 0, ErrTextRotation},
+		{&Alignment{Indent: -1}, 0, ErrIndent},
+		{&Alignment{Indent: MaxIndent + 1}, 0, ErrIndent},
+	} {
+		f := NewFile()
+		styleID, err := f.NewStyle(&Style{Alignment: c.alignment})
+		assert.Equal(t, c.err, err)
+		if err == nil {
+			assert.Equal(t, c.expected, f.Styles.CellXfs.Xf[styleID].Alignment.TextRotation)
+			assert.Equal(t, c.alignment.Indent, f.Styles.CellXfs.Xf[styleID].Alignment.Indent)
+		}
+	}
 
 	// Test create numeric custom style
 	numFmt := "####;####"
@@ -491,6 +722,30 @@ func TestConditionalStyle(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "pattern", style.Fill.Type)
 	assert.Equal(t, []string{"A5A5A5"}, style.Fill.Color)
+	// Test the differential style only stores the specified font properties
+	expected = &Style{Font: &Font{Bold: true, Italic: true, Strike: true, Underline: "double", Color: "9A0511", VertAlign: "superscript"}}
+	idx, err = f.NewConditionalStyle(expected)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, idx)
+	assert.Equal(t, 2, f.Styles.Dxfs.Count)
+	dxf := f.Styles.Dxfs.Dxfs[idx]
+	assert.Nil(t, dxf.Font.Name)
+	assert.Nil(t, dxf.Font.Sz)
+	assert.Nil(t, dxf.Font.Family)
+	style, err = f.GetConditionalStyle(idx)
+	assert.NoError(t, err)
+	assert.Equal(t, expected.Font, style.Font)
+	idx, err = f.NewConditionalStyle(&Style{Font: &Font{Family: "Arial", Size: 14}})
+	assert.NoError(t, err)
+	style, err = f.GetConditionalStyle(idx)
+	assert.NoError(t, err)
+	assert.Equal(t, &Font{Family: "Arial", Size: 14}, style.Font)
+	// Test the cell style stores the vertical alignment of the font
+	idx, err = f.NewStyle(&Style{Font: &Font{VertAlign: "subscript"}})
+	assert.NoError(t, err)
+	style, err = f.GetStyle(idx)
+	assert.NoError(t, err)
+	assert.Equal(t, "subscript", style.Font.VertAlign)
 }
 
 func TestGetDefaultFont(t *testing.T) {
@@ -505,6 +760,53 @@ func TestGetDefaultFont(t *testing.T) {
 	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
 }
 
+func TestColorPalette(t *testing.T) {
+	f := NewFile()
+	palette, err := f.GetColorPalette()
+	assert.NoError(t, err)
+	assert.Equal(t, IndexedColorMapping[8:64], palette)
+	palette[2] = "#ffa500"
+	assert.NoError(t, f.SetColorPalette(palette))
+	assert.Equal(t, "FFA500", f.getThemeColor(&xlsxColor{Indexed: 10}))
+	assert.Equal(t, IndexedColorMapping[2], f.getThemeColor(&xlsxColor{Indexed: 2}))
+	style, err := f.NewStyle(&Style{Font: &Font{ColorIndexed: 10}})
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetCellStyle("Sheet1", "A1", "A1", style))
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestColorPalette.xlsx")))
+	assert.NoError(t, f.Close())
+
+	f, err = OpenFile(filepath.Join("test", "TestColorPalette.xlsx"))
+	assert.NoError(t, err)
+	palette, err = f.GetColorPalette()
+	assert.NoError(t, err)
+	assert.Equal(t, "FFA500", palette[2])
+	assert.Len(t, palette, 56)
+	// Test restore the default palette
+	assert.NoError(t, f.SetColorPalette(nil))
+	palette, err = f.GetColorPalette()
+	assert.NoError(t, err)
+	assert.Equal(t, IndexedColorMapping[8:64], palette)
+	assert.Nil(t, f.Styles.Colors)
+	assert.NoError(t, f.SetColorPalette(nil))
+	f.Styles.Colors = &xlsxStyleColors{IndexedColors: &xlsxIndexedColors{}, MruColors: &xlsxInnerXML{}}
+	assert.NoError(t, f.SetColorPalette(nil))
+	assert.Nil(t, f.Styles.Colors.IndexedColors)
+	assert.NoError(t, f.Close())
+
+	// Test set color palette with invalid colors
+	f = NewFile()
+	assert.Equal(t, ErrParameterInvalid, f.SetColorPalette([]string{"FFFFFF"}))
+	palette[0] = "FFFFFG"
+	assert.Equal(t, ErrParameterInvalid, f.SetColorPalette(palette))
+	// Test set and get color palette with unsupported charset style sheet
+	f.Styles = nil
+	f.Pkg.Store(defaultXMLPathStyles, MacintoshCyrillicCharset)
+	assert.EqualError(t, f.SetColorPalette(IndexedColorMapping[8:64]), "XML syntax error on line 1: invalid UTF-8")
+	f.Styles = nil
+	_, err = f.GetColorPalette()
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+}
+
 func TestSetDefaultFont(t *testing.T) {
 	f := NewFile()
 	assert.NoError(t, f.SetDefaultFont("Arial"))
@@ -547,12 +849,219 @@ func TestSetCellStyle(t *testing.T) {
 	assert.Equal(t, newInvalidStyleID(-1), f.SetCellStyle("Sheet1", "A1", "A2", -1))
 	// Test set cell style with not exists style ID
 	assert.Equal(t, newInvalidStyleID(10), f.SetCellStyle("Sheet1", "A1", "A2", 10))
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	assert.Empty(t, ws.(*xlsxWorksheet).SheetData.Row)
+	// Test set cell style for entire columns
+	styleID, err := f.NewStyle(&Style{Fill: Fill{Type: "pattern", Color: []string{"E0EBF5"}, Pattern: 1}})
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetCellValue("Sheet1", "B3", "B3"))
+	assert.NoError(t, f.SetCellValue("Sheet1", "D3", "D3"))
+	assert.NoError(t, f.SetCellStyle("Sheet1", "A1", "C1048576", styleID))
+	assert.Len(t, ws.(*xlsxWorksheet).SheetData.Row, 3)
+	for _, col := range ws.(*xlsxWorksheet).Cols.Col {
+		assert.Equal(t, styleID, col.Style)
+	}
+	for cell, expected := range map[string]int{"A1": styleID, "B3": styleID, "C2": styleID, "D3": 0} {
+		style, err := f.GetCellStyle("Sheet1", cell)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, style, cell)
+	}
+	// Test set cell style for entire rows
+	assert.NoError(t, f.SetCellStyle("Sheet1", "XFD5", "A4", styleID))
+	assert.Len(t, ws.(*xlsxWorksheet).SheetData.Row, 5)
+	for _, row := range ws.(*xlsxWorksheet).SheetData.Row[3:] {
+		assert.Equal(t, styleID, row.S)
+		assert.True(t, row.CustomFormat)
+		assert.Empty(t, row.C)
+	}
+	style, err := f.GetCellStyle("Sheet1", "E5")
+	assert.NoError(t, err)
+	assert.Equal(t, styleID, style)
 	// Test set cell style with unsupported charset style sheet
 	f.Styles = nil
 	f.Pkg.Store(defaultXMLPathStyles, MacintoshCyrillicCharset)
 	assert.EqualError(t, f.SetCellStyle("Sheet1", "A1", "A2", 1), "XML syntax error on line 1: invalid UTF-8")
 }
 
+func BenchmarkSetCellStyle(b *testing.B) {
+	f := NewFile()
+	styleID, err := f.NewStyle(&Style{Fill: Fill{Type: "pattern", Color: []string{"E0EBF5"}, Pattern: 1}})
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := f.SetCellStyle("Sheet1", "A1", "J1048576", styleID); err != nil {
+			b.Error(err)
+		}
+	}
+}
+
+func TestGetCellFillColor(t *testing.T) {
+	f := NewFile()
+	// Test get fill color of the cell without fill
+	color, err := f.GetCellFillColor("Sheet1", "A1")
+	assert.NoError(t, err)
+	assert.Empty(t, color)
+	// Test get fill color of the cell with pattern fill
+	styleID, err := f.NewStyle(&Style{Fill: Fill{Type: "pattern", Color: []string{"E0EBF5"}, Pattern: 1}})
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetCellStyle("Sheet1", "A1", "A1", styleID))
+	color, err = f.GetCellFillColor("Sheet1", "A1")
+	assert.NoError(t, err)
+	assert.Equal(t, "E0EBF5", color)
+	// Test get fill color of the cell with gradient fill
+	styleID, err = f.NewStyle(&Style{Fill: Fill{Type: "gradient", Color: []string{"FFFFFF", "4E71BE"}, Shading: 1}})
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetCellStyle("Sheet1", "A2", "A2", styleID))
+	color, err = f.GetCellFillColor("Sheet1", "A2")
+	assert.NoError(t, err)
+	assert.Equal(t, "FFFFFF", color)
+	// Test get fill color with indexed, theme with tint and automatic colors
+	s, err := f.stylesReader()
+	assert.NoError(t, err)
+	for _, c := range []struct {
+		fill     *xlsxFill
+		expected string
+	}{
+		{&xlsxFill{PatternFill: &xlsxPatternFill{PatternType: "solid", FgColor: &xlsxColor{Indexed: 10}}}, "FF0000"},
+		{&xlsxFill{PatternFill: &xlsxPatternFill{PatternType: "solid", FgColor: &xlsxColor{Theme: intPtr(4)}}}, "5B9BD5"},
+		{&xlsxFill{PatternFill: &xlsxPatternFill{PatternType: "solid", FgColor: &xlsxColor{Theme: intPtr(4), Tint: 0.7999816888943144}}}, "DEEBF7"},
+		{&xlsxFill{PatternFill: &xlsxPatternFill{PatternType: "solid", FgColor: &xlsxColor{Auto: true}}}, "000000"},
+		{&xlsxFill{PatternFill: &xlsxPatternFill{PatternType: "solid", FgColor: &xlsxColor{RGB: "00FF0000"}}}, "FF0000"},
+		{&xlsxFill{PatternFill: &xlsxPatternFill{PatternType: "solid", FgColor: &xlsxColor{RGB: "80FFFFFF", Tint: -0.5}}}, "808080"},
+		{&xlsxFill{PatternFill: &xlsxPatternFill{PatternType: "gray125"}}, "000000"},
+		{&xlsxFill{PatternFill: &xlsxPatternFill{PatternType: "none"}}, ""},
+	} {
+		s.Fills.Fill = append(s.Fills.Fill, c.fill)
+		s.Fills.Count = len(s.Fills.Fill)
+		s.CellXfs.Xf = append(s.CellXfs.Xf, xlsxXf{FillID: intPtr(s.Fills.Count - 1)})
+		s.CellXfs.Count = len(s.CellXfs.Xf)
+		assert.NoError(t, f.SetCellStyle("Sheet1", "A3", "A3", s.CellXfs.Count-1))
+		color, err = f.GetCellFillColor("Sheet1", "A3")
+		assert.NoError(t, err)
+		assert.Equal(t, c.expected, color)
+	}
+	// Test get fill color with the style ID which not exists
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	ws.(*xlsxWorksheet).SheetData.Row[2].C[0].S = 100
+	color, err = f.GetCellFillColor("Sheet1", "A3")
+	assert.NoError(t, err)
+	assert.Empty(t, color)
+	// Test get fill color on not exists worksheet
+	_, err = f.GetCellFillColor("SheetN", "A1")
+	assert.EqualError(t, err, "sheet SheetN does not exist")
+	// Test get fill color with invalid cell reference
+	_, err = f.GetCellFillColor("Sheet1", "A")
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), err)
+	// Test get fill color with unsupported charset style sheet
+	f.Styles = nil
+	f.Pkg.Store(defaultXMLPathStyles, MacintoshCyrillicCharset)
+	_, err = f.GetCellFillColor("Sheet1", "A1")
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+}
+
+func TestGetCellStyleDetails(t *testing.T) {
+	f := NewFile()
+	// Test get style details of the cell with default style
+	style, err := f.GetCellStyleDetails("Sheet1", "A1")
+	assert.NoError(t, err)
+	assert.Equal(t, "Calibri", style.Font.Family)
+	assert.Equal(t, "000000", style.Font.Color)
+	assert.Nil(t, style.Font.ColorTheme)
+	// Test get style details of the cell with theme and indexed colors
+	expected := &Style{
+		Border:    []Border{{Type: "left", Color: "FF0000", Style: 1}},
+		Fill:      Fill{Type: "pattern", Color: []string{"E0EBF5"}, Pattern: 1},
+		Font:      &Font{Bold: true, Family: "Arial", Size: 12, ColorTheme: intPtr(4)},
+		Alignment: &Alignment{Horizontal: "center"},
+		NumFmt:    14,
+	}
+	styleID, err := f.NewStyle(expected)
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetCellStyle("Sheet1", "A1", "A1", styleID))
+	style, err = f.GetCellStyleDetails("Sheet1", "A1")
+	assert.NoError(t, err)
+	assert.Equal(t, "5B9BD5", style.Font.Color)
+	assert.Nil(t, style.Font.ColorTheme)
+	assert.True(t, style.Font.Bold)
+	assert.Equal(t, "Arial", style.Font.Family)
+	assert.Equal(t, 12.0, style.Font.Size)
+	assert.Equal(t, expected.Border, style.Border)
+	assert.Equal(t, expected.Fill, style.Fill)
+	assert.Equal(t, "center", style.Alignment.Horizontal)
+	assert.Equal(t, 14, style.NumFmt)
+	styleID, err = f.NewStyle(&Style{Font: &Font{ColorIndexed: 10}})
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetCellStyle("Sheet1", "A2", "A2", styleID))
+	style, err = f.GetCellStyleDetails("Sheet1", "A2")
+	assert.NoError(t, err)
+	assert.Equal(t, "FF0000", style.Font.Color)
+	assert.Zero(t, style.Font.ColorIndexed)
+	// Test the style details could be used to create the same style
+	styleID, err = f.NewStyle(style)
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetCellStyle("Sheet1", "A3", "A3", styleID))
+	details, err := f.GetCellStyleDetails("Sheet1", "A3")
+	assert.NoError(t, err)
+	assert.Equal(t, style.Font, details.Font)
+	// Test get style details with the style ID which not exists
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	ws.(*xlsxWorksheet).SheetData.Row[2].C[0].S = 100
+	_, err = f.GetCellStyleDetails("Sheet1", "A3")
+	assert.Equal(t, newInvalidStyleID(100), err)
+	// Test get style details on not exists worksheet
+	_, err = f.GetCellStyleDetails("SheetN", "A1")
+	assert.EqualError(t, err, "sheet SheetN does not exist")
+	// Test get style details with invalid cell reference
+	_, err = f.GetCellStyleDetails("Sheet1", "A")
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), err)
+	// Test get style details with unsupported charset style sheet
+	f.Styles = nil
+	f.Pkg.Store(defaultXMLPathStyles, MacintoshCyrillicCharset)
+	_, err = f.GetCellStyleDetails("Sheet1", "A1")
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+}
+
+func TestGetCellProtection(t *testing.T) {
+	f := NewFile()
+	// Test get protection of the cell with default style
+	protection, err := f.GetCellProtection("Sheet1", "A1")
+	assert.NoError(t, err)
+	assert.Equal(t, Protection{Locked: true}, protection)
+	// Test get protection of the unlocked and hidden cell
+	styleID, err := f.NewStyle(&Style{Protection: &Protection{Hidden: true, Locked: false}})
+	assert.NoError(t, err)
+	assert.NoError(t, f.SetCellStyle("Sheet1", "A1", "B2", styleID))
+	assert.NoError(t, f.ProtectSheet("Sheet1", &SheetProtectionOptions{Password: "password"}))
+	protection, err = f.GetCellProtection("Sheet1", "B2")
+	assert.NoError(t, err)
+	assert.Equal(t, Protection{Hidden: true}, protection)
+	// Test get protection of the cell with partial protection settings
+	f.Styles.CellXfs.Xf[styleID].Protection = &xlsxProtection{Hidden: boolPtr(true)}
+	protection, err = f.GetCellProtection("Sheet1", "B2")
+	assert.NoError(t, err)
+	assert.Equal(t, Protection{Hidden: true, Locked: true}, protection)
+	// Test get protection with the style ID which not exists
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	ws.(*xlsxWorksheet).SheetData.Row[0].C[0].S = 100
+	protection, err = f.GetCellProtection("Sheet1", "A1")
+	assert.NoError(t, err)
+	assert.Equal(t, Protection{Locked: true}, protection)
+	// Test get protection on not exists worksheet
+	_, err = f.GetCellProtection("SheetN", "A1")
+	assert.EqualError(t, err, "sheet SheetN does not exist")
+	// Test get protection with unsupported charset style sheet
+	f.Styles = nil
+	f.Pkg.Store(defaultXMLPathStyles, MacintoshCyrillicCharset)
+	_, err = f.GetCellProtection("Sheet1", "A1")
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+}
+
 func TestGetStyleID(t *testing.T) {
 	f := NewFile()
 	styleID, err := f.getStyleID(&xlsxStyleSheet{}, nil)
@@ -615,6 +1124,91 @@ func TestGetThemeColor(t *testing.T) {
 	assert.Empty(t, f.getThemeColor(&xlsxColor{Indexed: len(IndexedColorMapping), Tint: 0.5}))
 }
 
+func TestMergeStyles(t *testing.T) {
+	f := NewFile()
+	base, err := f.NewStyle(&Style{
+		Border:    []Border{{Type: "left", Color: "0000FF", Style: 1}},
+		Fill:      Fill{Type: "pattern", Color: []string{"E0EBF5"}, Pattern: 1},
+		Font:      &Font{Family: "Arial", Size: 10, ColorTheme: intPtr(1)},
+		Alignment: &Alignment{Horizontal: "center"},
+		NumFmt:    2,
+	})
+	assert.NoError(t, err)
+	styleID, err := f.MergeStyles(base, &Style{
+		Border:    []Border{{Type: "left", Color: "FF0000", Style: 2}, {Type: "top", Color: "00FF00", Style: 1}},
+		Font:      &Font{Bold: true, Color: "777777"},
+		Alignment: &Alignment{WrapText: true},
+		NegRed:    true,
+	})
+	assert.NoError(t, err)
+	assert.NotEqual(t, base, styleID)
+	style, err := f.GetStyle(styleID)
+	assert.NoError(t, err)
+	assert.Equal(t, []Border{{Type: "left", Color: "FF0000", Style: 2}, {Type: "top", Color: "00FF00", Style: 1}}, style.Border)
+	assert.Equal(t, Fill{Type: "pattern", Color: []string{"E0EBF5"}, Pattern: 1}, style.Fill)
+	assert.Equal(t, &Font{Bold: true, Family: "Arial", Size: 10, Color: "777777"}, style.Font)
+	assert.Equal(t, &Alignment{Horizontal: "center", WrapText: true}, style.Alignment)
+	assert.Equal(t, 2, style.NumFmt)
+	// Test merge the fill, protection and number format
+	customNumFmt := "0.00%"
+	styleID, err = f.MergeStyles(styleID, &Style{
+		Fill:         Fill{Type: "pattern", Color: []string{"FF0000"}, Pattern: 1},
+		Font:         &Font{ColorTheme: intPtr(4)},
+		Protection:   &Protection{Hidden: true, Locked: true},
+		CustomNumFmt: &customNumFmt,
+	})
+	assert.NoError(t, err)
+	style, err = f.GetStyle(styleID)
+	assert.NoError(t, err)
+	assert.Equal(t, Fill{Type: "pattern", Color: []string{"FF0000"}, Pattern: 1}, style.Fill)
+	assert.Equal(t, &Font{Bold: true, Family: "Arial", Size: 10, ColorTheme: intPtr(4)}, style.Font)
+	assert.Equal(t, &Protection{Hidden: true, Locked: true}, style.Protection)
+	assert.Equal(t, customNumFmt, *style.CustomNumFmt)
+	styleID, err = f.MergeStyles(styleID, &Style{NumFmt: 14, Alignment: &Alignment{Vertical: "top"}})
+	assert.NoError(t, err)
+	style, err = f.GetStyle(styleID)
+	assert.NoError(t, err)
+	assert.Equal(t, 14, style.NumFmt)
+	assert.Nil(t, style.CustomNumFmt)
+	// Test merge styles with the same settings of the base style
+	styleID, err = f.MergeStyles(styleID, &Style{})
+	assert.NoError(t, err)
+	mergedID, err := f.MergeStyles(styleID, &Style{Font: &Font{Family: "Arial"}})
+	assert.NoError(t, err)
+	assert.Equal(t, styleID, mergedID)
+	// Test merge styles based on the default style without alignment
+	styleID, err = f.MergeStyles(0, &Style{Font: &Font{Italic: true}, Alignment: &Alignment{Indent: 1}})
+	assert.NoError(t, err)
+	style, err = f.GetStyle(styleID)
+	assert.NoError(t, err)
+	assert.True(t, style.Font.Italic)
+	assert.Equal(t, 1, style.Alignment.Indent)
+	// Test merge styles with the zero value fields specified
+	styleID, err = f.MergeStyles(styleID, &Style{Font: &Font{Size: 12}}, "Font.Italic", "Font.Size", "Alignment.Indent", "Fill", "NegRed")
+	assert.NoError(t, err)
+	style, err = f.GetStyle(styleID)
+	assert.NoError(t, err)
+	assert.False(t, style.Font.Italic)
+	assert.Equal(t, 12.0, style.Font.Size)
+	assert.Equal(t, &Alignment{}, style.Alignment)
+	assert.Equal(t, Fill{Type: "pattern"}, style.Fill)
+	styleID, err = f.MergeStyles(0, &Style{}, "Alignment.WrapText", "Protection.Locked")
+	assert.NoError(t, err)
+	style, err = f.GetStyle(styleID)
+	assert.NoError(t, err)
+	assert.Equal(t, &Protection{}, style.Protection)
+	// Test merge styles with invalid parameters
+	for _, field := range []string{"", "Font.", "Fonts", "Font.Bold.Val", "Border.Type", "Font.family"} {
+		_, err = f.MergeStyles(base, &Style{}, field)
+		assert.Equal(t, ErrParameterInvalid, err, field)
+	}
+	_, err = f.MergeStyles(base, nil)
+	assert.Equal(t, ErrParameterInvalid, err)
+	_, err = f.MergeStyles(-1, &Style{})
+	assert.Equal(t, newInvalidStyleID(-1), err)
+	assert.NoError(t, f.Close())
+}
+
 func TestGetStyle(t *testing.T) {
 	f := NewFile()
 	expected := &Style{
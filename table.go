@@ -27,6 +27,30 @@ var (
 	conditionFormat  = regexp.MustCompile(`(or|\|\|)`)
 	blankFormat      = regexp.MustCompile("blanks|nonblanks")
 	matchFormat      = regexp.MustCompile("[*?]")
+	// dynamicFilterTypes defined the list of valid dynamic filter types.
+	dynamicFilterTypes = []string{
+		"aboveAverage", "belowAverage", "tomorrow", "today", "yesterday",
+		"nextWeek", "thisWeek", "lastWeek", "nextMonth", "thisMonth",
+		"lastMonth", "nextQuarter", "thisQuarter", "lastQuarter", "nextYear",
+		"thisYear", "lastYear", "yearToDate", "Q1", "Q2", "Q3", "Q4", "M1",
+		"M2", "M3", "M4", "M5", "M6", "M7", "M8", "M9", "M10", "M11", "M12",
+	}
+	// dateTimeGroupings defined the list of valid date group item groupings.
+	dateTimeGroupings = []string{"year", "month", "day", "hour", "minute", "second"}
+	// totalsRowFunctions defined the list of table total row functions and
+	// the function number of the SUBTOTAL function for each of them.
+	totalsRowFunctions = map[string]int{
+		"average":   101,
+		"count":     103,
+		"countNums": 102,
+		"custom":    0,
+		"max":       104,
+		"min":       105,
+		"none":      0,
+		"stdDev":    107,
+		"sum":       109,
+		"var":       110,
+	}
 )
 
 // parseTableOptions provides a function to parse the format settings of the
@@ -42,6 +66,17 @@ func parseTableOptions(opts *Table) (*Table, error) {
 	if err = checkDefinedName(opts.Name); err != nil {
 		return opts, err
 	}
+	for _, totalRow := range opts.TotalRow {
+		if _, ok := totalsRowFunctions[totalRow.Function]; !ok && totalRow.Function != "" {
+			return opts, ErrParameterInvalid
+		}
+		if totalRow.Function == "custom" && totalRow.Formula == "" {
+			return opts, ErrParameterInvalid
+		}
+		if _, err = ColumnNameToNumber(totalRow.Column); err != nil {
+			return opts, err
+		}
+	}
 	return opts, err
 }
 
@@ -78,6 +113,46 @@ func parseTableOptions(opts *Table) (*Table, error) {
 //	TableStyleLight1 - TableStyleLight21
 //	TableStyleMedium1 - TableStyleMedium28
 //	TableStyleDark1 - TableStyleDark11
+//
+// TotalRow: The total row settings of the table columns, the last row of the
+// table range will be used as the total row if this option is specified. The
+// table range with a total row must be at least three lines including the
+// header row, a data row and the total row, or at least two lines if the header
+// row is hidden, otherwise an error will be returned. For example, create a
+// table of A1:C6 on Sheet1 with a total row, which labeled "Total" in the
+// column A, the sum of the column B and a custom formula in the column C:
+//
+//	err := f.AddTable("Sheet1", &excelize.Table{
+//	    Range: "A1:C6",
+//	    TotalRow: []excelize.TableTotalRowOptions{
+//	        {Column: "A", Label: "Total"},
+//	        {Column: "B", Function: "sum"},
+//	        {Column: "C", Function: "custom", Formula: "SUM(Table1[Amount])*2"},
+//	    },
+//	})
+//
+// Column specifies the column name of the total row cell, which should be
+// inside the table range.
+//
+// Function specifies the aggregation function of the total row cell, the
+// following functions are available:
+//
+//	average
+//	count
+//	countNums
+//	custom
+//	max
+//	min
+//	none
+//	stdDev
+//	sum
+//	var
+//
+// Formula specifies the formula of the total row cell, which is required when
+// the function is custom.
+//
+// Label specifies the text of the total row cell, which would be ignored when
+// the function is specified.
 func (f *File) AddTable(sheet string, table *Table) error {
 	options, err := parseTableOptions(table)
 	if err != nil {
@@ -107,6 +182,22 @@ func (f *File) AddTable(sheet string, table *Table) error {
 	}
 	// Correct table reference range, such correct C1:B3 to B1:C3.
 	_ = sortCoordinates(coordinates)
+	for _, totalRow := range options.TotalRow {
+		if col, _ := ColumnNameToNumber(totalRow.Column); col < coordinates[0] || col > coordinates[2] {
+			return ErrParameterInvalid
+		}
+	}
+	if len(options.TotalRow) > 0 {
+		// The header row, a data row and the total row are required, and the
+		// header row is not required if it was hidden.
+		minRows := 3
+		if options.ShowHeaderRow != nil && !*options.ShowHeaderRow {
+			minRows = 2
+		}
+		if coordinates[3]-coordinates[1]+1 < minRows {
+			return ErrTableTotalRowRange
+		}
+	}
 	tableID := f.countTables() + 1
 	sheetRelationshipsTableXML := "../tables/table" + strconv.Itoa(tableID) + ".xml"
 	tableXML := strings.ReplaceAll(sheetRelationshipsTableXML, "..", "xl")
@@ -125,7 +216,22 @@ func (f *File) AddTable(sheet string, table *Table) error {
 }
 
 // GetTables provides the method to get all tables in a worksheet by given
-// worksheet name.
+// worksheet name. The tables will be returned in the order of definition, and
+// the Headers field of each table will be the column names of the table. The
+// TotalRow field will contain the settings of each table column if the table
+// has a total row, otherwise it will be empty. For example, find the range of
+// the table named "Sales" in Sheet1:
+//
+//	tables, err := f.GetTables("Sheet1")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	for _, table := range tables {
+//	    if table.Name == "Sales" {
+//	        fmt.Println(table.Range, table.Headers)
+//	    }
+//	}
 func (f *File) GetTables(sheet string) ([]Table, error) {
 	var tables []Table
 	ws, err := f.workSheetReader(sheet)
@@ -162,12 +268,51 @@ func (f *File) GetTables(sheet string) ([]Table, error) {
 				table.ShowLastColumn = t.TableStyleInfo.ShowLastColumn
 				table.ShowRowStripes = &t.TableStyleInfo.ShowRowStripes
 			}
+			if t.HeaderRowCount != nil {
+				table.ShowHeaderRow = boolPtr(*t.HeaderRowCount != 0)
+			}
+			if t.TableColumns != nil {
+				for _, column := range t.TableColumns.TableColumn {
+					table.Headers = append(table.Headers, column.Name)
+				}
+			}
+			if table.TotalRow, err = getTableTotalRow(&t); err != nil {
+				return tables, err
+			}
 			tables = append(tables, table)
 		}
 	}
 	return tables, err
 }
 
+// getTableTotalRow provides a function to get the total row settings of the
+// table columns, the settings of all table columns will be returned if the
+// table has a total row.
+func getTableTotalRow(t *xlsxTable) ([]TableTotalRowOptions, error) {
+	var totalRow []TableTotalRowOptions
+	if t.TotalsRowCount == 0 || t.TableColumns == nil {
+		return totalRow, nil
+	}
+	coordinates, err := rangeRefToCoordinates(t.Ref)
+	if err != nil {
+		return totalRow, err
+	}
+	_ = sortCoordinates(coordinates)
+	for i, column := range t.TableColumns.TableColumn {
+		col, err := ColumnNumberToName(coordinates[0] + i)
+		if err != nil {
+			return totalRow, err
+		}
+		totalRow = append(totalRow, TableTotalRowOptions{
+			Column:   col,
+			Function: column.TotalsRowFunction,
+			Formula:  column.TotalsRowFormula,
+			Label:    column.TotalsRowLabel,
+		})
+	}
+	return totalRow, err
+}
+
 // DeleteTable provides the method to delete table by given table name.
 func (f *File) DeleteTable(name string) error {
 	if err := checkDefinedName(name); err != nil {
@@ -307,6 +452,42 @@ func (f *File) setTableColumns(sheet string, showHeaderRow bool, x1, y1, x2 int,
 	return nil
 }
 
+// setTableTotalRow provides a function to set the total row of the table
+// columns and the cells value or formula in the total row.
+func (f *File) setTableTotalRow(sheet string, x1, y2 int, tbl *xlsxTable, totalRow []TableTotalRowOptions) error {
+	if len(totalRow) == 0 {
+		return nil
+	}
+	escape := strings.NewReplacer("'", "''", "[", "'[", "]", "']", "#", "'#")
+	for _, opt := range totalRow {
+		col, _ := ColumnNameToNumber(opt.Column)
+		cell, err := CoordinatesToCellName(col, y2)
+		if err != nil {
+			return err
+		}
+		column := tbl.TableColumns.TableColumn[col-x1]
+		column.TotalsRowFunction, column.TotalsRowFormula, column.TotalsRowLabel = "", "", ""
+		switch opt.Function {
+		case "", "none":
+			column.TotalsRowLabel = opt.Label
+			if opt.Label != "" {
+				err = f.SetCellStr(sheet, cell, opt.Label)
+			}
+		case "custom":
+			column.TotalsRowFunction, column.TotalsRowFormula = opt.Function, opt.Formula
+			err = f.SetCellFormula(sheet, cell, opt.Formula)
+		default:
+			column.TotalsRowFunction = opt.Function
+			err = f.SetCellFormula(sheet, cell, fmt.Sprintf("SUBTOTAL(%d,%s[%s])",
+				totalsRowFunctions[opt.Function], tbl.Name, escape.Replace(column.Name)))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // checkDefinedName check whether there are illegal characters in the defined
 // name or table name. Verify that the name:
 // 1. Starts with a letter or underscore (_)
@@ -346,7 +527,8 @@ func (f *File) addTable(sheet, tableXML string, x1, y1, x2, y2, i int, opts *Tab
 		y2++
 	}
 	hideHeaderRow := opts != nil && opts.ShowHeaderRow != nil && !*opts.ShowHeaderRow
-	if hideHeaderRow {
+	// Keep the data row beside the total row if the header row is hidden.
+	if hideHeaderRow && (len(opts.TotalRow) == 0 || y2-y1 > 1) {
 		y1++
 	}
 	// Correct table range reference, such correct C1:B3 to B1:C3.
@@ -354,6 +536,10 @@ func (f *File) addTable(sheet, tableXML string, x1, y1, x2, y2, i int, opts *Tab
 	if err != nil {
 		return err
 	}
+	filterRef := ref
+	if len(opts.TotalRow) > 0 {
+		filterRef, _ = coordinatesToRangeRef([]int{x1, y1, x2, y2 - 1})
+	}
 	name := opts.Name
 	if name == "" {
 		name = "Table" + strconv.Itoa(i)
@@ -365,7 +551,7 @@ func (f *File) addTable(sheet, tableXML string, x1, y1, x2, y2, i int, opts *Tab
 		DisplayName: name,
 		Ref:         ref,
 		AutoFilter: &xlsxAutoFilter{
-			Ref: ref,
+			Ref: filterRef,
 		},
 		TableStyleInfo: &xlsxTableStyleInfo{
 			Name:              opts.StyleName,
@@ -380,6 +566,12 @@ func (f *File) addTable(sheet, tableXML string, x1, y1, x2, y2, i int, opts *Tab
 		t.AutoFilter = nil
 		t.HeaderRowCount = intPtr(0)
 	}
+	if len(opts.TotalRow) > 0 {
+		t.TotalsRowCount = 1
+	}
+	if err = f.setTableTotalRow(sheet, x1, y2, &t, opts.TotalRow); err != nil {
+		return err
+	}
 	table, err := xml.Marshal(t)
 	f.saveFileList(tableXML, table)
 	return err
@@ -456,6 +648,58 @@ func (f *File) addTable(sheet, tableXML string, x1, y1, x2, y2, i int, opts *Tab
 //	x     < 2000
 //	col   < 2000
 //	Price < 2000
+//
+// Each column in the range could be filtered by a separate criteria, and only
+// one of the Expression, Top10, DynamicFilter and DateGroups can be set for
+// each column, otherwise an error will be returned. For example, filter the top
+// 10 percent values in column B, the values above average in column C and the
+// dates in January 2024 or in the year 2023 in column D:
+//
+//	err := f.AutoFilter("Sheet1", "A1:D100", []excelize.AutoFilterOptions{
+//	    {Column: "B", Top10: &excelize.AutoFilterTop10Options{Percent: true, Value: 10}},
+//	    {Column: "C", DynamicFilter: "aboveAverage"},
+//	    {Column: "D", DateGroups: []excelize.AutoFilterDateGroupOptions{
+//	        {Grouping: "month", Year: 2024, Month: 1},
+//	        {Grouping: "year", Year: 2023},
+//	    }},
+//	})
+//
+// Top10 specifies the top or bottom N items or percent to filter by. Set the
+// Bottom to true to filter the smallest values, and set the Percent to true to
+// filter by percent. The Value specifies the number of items between 1 and
+// 500, or the percent between 1 and 100.
+//
+// DynamicFilter specifies the dynamic filter criteria, the following types are
+// available:
+//
+//	aboveAverage
+//	belowAverage
+//	tomorrow
+//	today
+//	yesterday
+//	nextWeek
+//	thisWeek
+//	lastWeek
+//	nextMonth
+//	thisMonth
+//	lastMonth
+//	nextQuarter
+//	thisQuarter
+//	lastQuarter
+//	nextYear
+//	thisYear
+//	lastYear
+//	yearToDate
+//	Q1 - Q4
+//	M1 - M12
+//
+// DateGroups specifies the group of dates or times to filter by. The Grouping
+// defines the level of the date group item, the following values are
+// available: year, month, day, hour, minute and second. The Year is required,
+// and the other date and time parts down to the grouping level are required.
+// The Year should be between 1 and 9999, the Month between 1 and 12, the Day
+// within the days of the month, the Hour between 0 and 23, and the Minute and
+// Second between 0 and 59.
 func (f *File) AutoFilter(sheet, rangeRef string, opts []AutoFilterOptions) error {
 	coordinates, err := rangeRefToCoordinates(rangeRef)
 	if err != nil {
@@ -519,7 +763,8 @@ func (f *File) autoFilter(sheet, ref string, columns, col int, opts []AutoFilter
 	}
 	ws.AutoFilter = filter
 	for _, opt := range opts {
-		if opt.Column == "" || opt.Expression == "" {
+		if opt.Column == "" || (opt.Expression == "" && opt.Top10 == nil &&
+			opt.DynamicFilter == "" && len(opt.DateGroups) == 0) {
 			continue
 		}
 		fsCol, err := ColumnNameToNumber(opt.Column)
@@ -531,6 +776,32 @@ func (f *File) autoFilter(sheet, ref string, columns, col int, opts []AutoFilter
 			return newInvalidAutoFilterColumnError(opt.Column)
 		}
 		fc := &xlsxFilterColumn{ColID: offset}
+		if err = f.writeFilterColumn(fc, opt); err != nil {
+			return err
+		}
+		filter.FilterColumn = append(filter.FilterColumn, fc)
+	}
+	ws.AutoFilter = filter
+	return nil
+}
+
+// writeFilterColumn provides a function to write the filter criteria of the
+// auto filter column by given auto filter settings.
+func (f *File) writeFilterColumn(fc *xlsxFilterColumn, opt AutoFilterOptions) error {
+	var criteria int
+	for _, set := range []bool{
+		opt.Expression != "", opt.Top10 != nil,
+		opt.DynamicFilter != "", len(opt.DateGroups) > 0,
+	} {
+		if set {
+			criteria++
+		}
+	}
+	if criteria > 1 {
+		return ErrParameterInvalid
+	}
+	switch {
+	case opt.Expression != "":
 		token := expressionFormat.FindAllString(opt.Expression, -1)
 		if len(token) != 3 && len(token) != 7 {
 			return newInvalidAutoFilterExpError(opt.Expression)
@@ -540,12 +811,151 @@ func (f *File) autoFilter(sheet, ref string, columns, col int, opts []AutoFilter
 			return err
 		}
 		f.writeAutoFilter(fc, expressions, tokens)
-		filter.FilterColumn = append(filter.FilterColumn, fc)
+	case opt.Top10 != nil:
+		if opt.Top10.Value < 1 || opt.Top10.Value > 500 ||
+			(opt.Top10.Percent && opt.Top10.Value > 100) {
+			return ErrParameterInvalid
+		}
+		fc.Top10 = &xlsxTop10{
+			Percent: opt.Top10.Percent,
+			Top:     !opt.Top10.Bottom,
+			Val:     opt.Top10.Value,
+		}
+	case opt.DynamicFilter != "":
+		if inStrSlice(dynamicFilterTypes, opt.DynamicFilter, true) == -1 {
+			return ErrParameterInvalid
+		}
+		fc.DynamicFilter = &xlsxDynamicFilter{Type: opt.DynamicFilter}
+	default:
+		filters := &xlsxFilters{}
+		for _, group := range opt.DateGroups {
+			level := inStrSlice(dateTimeGroupings, group.Grouping, true)
+			if level == -1 || group.Year < 1 || group.Year > 9999 {
+				return ErrParameterInvalid
+			}
+			maxDay := 31
+			if group.Month >= 1 && group.Month <= 12 {
+				maxDay = getDaysInMonth(group.Year, group.Month)
+			}
+			item := &xlsxDateGroupItem{DateTimeGrouping: group.Grouping, Year: group.Year}
+			// Keep the date and time parts down to the grouping level only.
+			for _, part := range []struct {
+				val, min, max int
+				ptr           *int
+			}{
+				{group.Month, 1, 12, &item.Month},
+				{group.Day, 1, maxDay, &item.Day},
+				{group.Hour, 0, 23, &item.Hour},
+				{group.Minute, 0, 59, &item.Minute},
+				{group.Second, 0, 59, &item.Second},
+			}[:level] {
+				if part.val < part.min || part.val > part.max {
+					return ErrParameterInvalid
+				}
+				*part.ptr = part.val
+			}
+			filters.DateGroupItem = append(filters.DateGroupItem, item)
+		}
+		fc.Filters = filters
 	}
-	ws.AutoFilter = filter
 	return nil
 }
 
+// GetAutoFilter provides the method to get the auto filter range reference and
+// the filter criteria of the columns in a worksheet by given worksheet name.
+// The range reference will be empty if there is no auto filter in the
+// worksheet. The filter criteria of a column will be returned by the
+// Expression, Top10, DynamicFilter or DateGroups fields of the auto filter
+// settings. For example, get the auto filter in the Sheet1:
+//
+//	ref, opts, err := f.GetAutoFilter("Sheet1")
+func (f *File) GetAutoFilter(sheet string) (string, []AutoFilterOptions, error) {
+	var opts []AutoFilterOptions
+	ws, err := f.workSheetReader(sheet)
+	if err != nil || ws.AutoFilter == nil {
+		return "", opts, err
+	}
+	ref := strings.ReplaceAll(ws.AutoFilter.Ref, "$", "")
+	coordinates, err := rangeRefToCoordinates(ref)
+	if err != nil {
+		return ref, opts, err
+	}
+	_ = sortCoordinates(coordinates)
+	for _, fc := range ws.AutoFilter.FilterColumn {
+		col, err := ColumnNumberToName(coordinates[0] + fc.ColID)
+		if err != nil {
+			return ref, opts, err
+		}
+		opt := AutoFilterOptions{Column: col}
+		if fc.CustomFilters != nil {
+			opt.Expression = extractCustomFilters(fc.CustomFilters)
+		}
+		if fc.Filters != nil {
+			var exps []string
+			for _, filter := range fc.Filters.Filter {
+				exps = append(exps, "x == "+filter.Val)
+			}
+			if fc.Filters.Blank {
+				exps = append(exps, "x == Blanks")
+			}
+			opt.Expression = strings.Join(exps, " or ")
+			for _, item := range fc.Filters.DateGroupItem {
+				opt.DateGroups = append(opt.DateGroups, AutoFilterDateGroupOptions{
+					Grouping: item.DateTimeGrouping,
+					Year:     item.Year,
+					Month:    item.Month,
+					Day:      item.Day,
+					Hour:     item.Hour,
+					Minute:   item.Minute,
+					Second:   item.Second,
+				})
+			}
+		}
+		if fc.Top10 != nil {
+			opt.Top10 = &AutoFilterTop10Options{
+				Bottom:  !fc.Top10.Top,
+				Percent: fc.Top10.Percent,
+				Value:   fc.Top10.Val,
+			}
+		}
+		if fc.DynamicFilter != nil {
+			opt.DynamicFilter = fc.DynamicFilter.Type
+		}
+		opts = append(opts, opt)
+	}
+	return ref, opts, err
+}
+
+// extractCustomFilters provides a function to convert the custom filters of
+// the auto filter column to the filter expression.
+func extractCustomFilters(cfs *xlsxCustomFilters) string {
+	operators := map[string]string{
+		"lessThan":           "<",
+		"equal":              "==",
+		"lessThanOrEqual":    "<=",
+		"greaterThan":        ">",
+		"notEqual":           "!=",
+		"greaterThanOrEqual": ">=",
+	}
+	var exps []string
+	for _, cf := range cfs.CustomFilter {
+		operator, ok := operators[cf.Operator]
+		if !ok {
+			operator = "=="
+		}
+		if cf.Val == " " && operator == "!=" {
+			exps = append(exps, "x == NonBlanks")
+			continue
+		}
+		exps = append(exps, fmt.Sprintf("x %s %s", operator, cf.Val))
+	}
+	conditional := " or "
+	if cfs.And {
+		conditional = " and "
+	}
+	return strings.Join(exps, conditional)
+}
+
 // writeAutoFilter provides a function to check for single or double custom
 // filters as default filters and handle them accordingly.
 func (f *File) writeAutoFilter(fc *xlsxFilterColumn, exp []int, tokens []string) {
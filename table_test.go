@@ -31,6 +31,14 @@ func TestAddTable(t *testing.T) {
 	tables, err := f.GetTables("Sheet2")
 	assert.Len(t, tables, 3)
 	assert.NoError(t, err)
+	assert.Equal(t, []string{"table", "Table4", "Table5"}, []string{tables[0].Name, tables[1].Name, tables[2].Name})
+	assert.Equal(t, "A2:B5", tables[0].Range)
+	assert.Equal(t, "TableStyleMedium2", tables[0].StyleName)
+	assert.Len(t, tables[0].Headers, 2)
+	assert.Nil(t, tables[0].ShowHeaderRow)
+	assert.Empty(t, tables[0].TotalRow)
+	assert.Equal(t, boolPtr(false), tables[1].ShowHeaderRow)
+	assert.Equal(t, []string{"Column1"}, tables[2].Headers)
 
 	// Test add table with already exist table name
 	assert.Equal(t, f.AddTable("Sheet2", &Table{Name: "Table1"}), ErrExistsTableName)
@@ -137,6 +145,121 @@ func TestDeleteTable(t *testing.T) {
 	assert.Equal(t, "Values", val)
 }
 
+func TestAddTableTotalRow(t *testing.T) {
+	f := NewFile()
+	for idx, row := range [][]interface{}{{"Name", "Amount", "Price[USD]"}, {"A", 1, 2}, {"B", 3, 4}} {
+		cell, err := CoordinatesToCellName(1, idx+1)
+		assert.NoError(t, err)
+		assert.NoError(t, f.SetSheetRow("Sheet1", cell, &row))
+	}
+	totalRow := []TableTotalRowOptions{
+		{Column: "A", Label: "Total"},
+		{Column: "B", Function: "sum"},
+		{Column: "C", Function: "custom", Formula: "SUM(Table1[Amount])*2"},
+	}
+	assert.NoError(t, f.AddTable("Sheet1", &Table{
+		Range:     "A1:C4",
+		Name:      "Table1",
+		StyleName: "TableStyleMedium2",
+		TotalRow:  totalRow,
+	}))
+	for cell, expected := range map[string]string{
+		"A4": "", "B4": "SUBTOTAL(109,Table1[Amount])", "C4": "SUM(Table1[Amount])*2",
+	} {
+		formula, err := f.GetCellFormula("Sheet1", cell)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, formula)
+	}
+	val, err := f.GetCellValue("Sheet1", "A4")
+	assert.NoError(t, err)
+	assert.Equal(t, "Total", val)
+	// Test get tables with total row
+	tables, err := f.GetTables("Sheet1")
+	assert.NoError(t, err)
+	assert.Len(t, tables, 1)
+	assert.Equal(t, "A1:C4", tables[0].Range)
+	assert.Equal(t, totalRow, tables[0].TotalRow)
+	// Test add table with total row without enough rows
+	for _, ref := range []string{"E1:E1", "E1:E2"} {
+		assert.Equal(t, ErrTableTotalRowRange, f.AddTable("Sheet1", &Table{
+			Range:    ref,
+			Name:     "Table2",
+			TotalRow: []TableTotalRowOptions{{Column: "E", Function: "average"}},
+		}))
+	}
+	// Test add table with total row and escape the column name
+	assert.NoError(t, f.AddTable("Sheet1", &Table{
+		Range:    "E1:E3",
+		Name:     "Table2",
+		TotalRow: []TableTotalRowOptions{{Column: "E", Function: "average"}},
+	}))
+	assert.NoError(t, f.SetCellStr("Sheet1", "G1", "Price[USD]"))
+	assert.NoError(t, f.AddTable("Sheet1", &Table{
+		Range:    "G1:G3",
+		Name:     "Table3",
+		TotalRow: []TableTotalRowOptions{{Column: "G", Function: "max"}},
+	}))
+	formula, err := f.GetCellFormula("Sheet1", "G3")
+	assert.NoError(t, err)
+	assert.Equal(t, "SUBTOTAL(104,Table3[Price'[USD']])", formula)
+	tables, err = f.GetTables("Sheet1")
+	assert.NoError(t, err)
+	assert.Len(t, tables, 3)
+	assert.Equal(t, "E1:E3", tables[1].Range)
+	// Test get tables with total row without settings of some columns
+	assert.NoError(t, f.AddTable("Sheet1", &Table{
+		Range:    "I1:J3",
+		Name:     "Table4",
+		TotalRow: []TableTotalRowOptions{{Column: "J", Function: "sum"}},
+	}))
+	tables, err = f.GetTables("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, []TableTotalRowOptions{{Column: "I"}, {Column: "J", Function: "sum"}}, tables[3].TotalRow)
+	// Test add table with total row and hidden header row
+	assert.Equal(t, ErrTableTotalRowRange, f.AddTable("Sheet1", &Table{
+		Range:         "L1:L1",
+		ShowHeaderRow: boolPtr(false),
+		TotalRow:      []TableTotalRowOptions{{Column: "L", Function: "sum"}},
+	}))
+	for _, ref := range []string{"L1:L2", "N1:N3"} {
+		assert.NoError(t, f.AddTable("Sheet1", &Table{
+			Range:         ref,
+			ShowHeaderRow: boolPtr(false),
+			TotalRow:      []TableTotalRowOptions{{Column: ref[:1], Function: "sum"}},
+		}))
+	}
+	tables, err = f.GetTables("Sheet1")
+	assert.NoError(t, err)
+	assert.Len(t, tables, 6)
+	assert.Equal(t, "L1:L2", tables[4].Range)
+	assert.Equal(t, "N2:N3", tables[5].Range)
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestAddTableTotalRow.xlsx")))
+	// Test add table with invalid total row settings
+	for _, totalRow := range [][]TableTotalRowOptions{
+		{{Column: "A", Function: "unknown"}},
+		{{Column: "A", Function: "custom"}},
+		{{Column: "D", Function: "sum"}},
+	} {
+		assert.Equal(t, ErrParameterInvalid, f.AddTable("Sheet1", &Table{Range: "A10:C12", TotalRow: totalRow}))
+	}
+	assert.Equal(t, newInvalidColumnNameError("-"), f.AddTable("Sheet1", &Table{
+		Range: "A10:C12", TotalRow: []TableTotalRowOptions{{Column: "-"}},
+	}))
+	// Test set table total row with invalid total row cell formula
+	assert.Equal(t, ErrSheetNotExist{"SheetN"}, f.setTableTotalRow("SheetN", 1, 1, &xlsxTable{
+		TableColumns: &xlsxTableColumns{TableColumn: []*xlsxTableColumn{{}}},
+	}, []TableTotalRowOptions{{Column: "A", Function: "sum"}}))
+	assert.Equal(t, newCoordinatesToCellNameError(1, 0), f.setTableTotalRow("Sheet1", 1, 0, &xlsxTable{},
+		[]TableTotalRowOptions{{Column: "A", Function: "sum"}}))
+	// Test get table total row with invalid range reference
+	_, err = getTableTotalRow(&xlsxTable{Ref: "A", TotalsRowCount: 1, TableColumns: &xlsxTableColumns{}})
+	assert.Equal(t, ErrParameterInvalid, err)
+	_, err = getTableTotalRow(&xlsxTable{Ref: "XFD1:XFD2", TotalsRowCount: 1, TableColumns: &xlsxTableColumns{
+		TableColumn: []*xlsxTableColumn{{}, {TotalsRowFunction: "sum"}},
+	}})
+	assert.Equal(t, ErrColumnNumber, err)
+}
+
 func TestSetTableColumns(t *testing.T) {
 	f := NewFile()
 	assert.Equal(t, newCoordinatesToCellNameError(1, 0), f.setTableColumns("Sheet1", true, 1, 0, 1, nil))
@@ -178,6 +301,64 @@ func TestAutoFilter(t *testing.T) {
 	assert.NoError(t, f.AutoFilter("Sheet1", "A1:B1", nil))
 }
 
+func TestGetAutoFilter(t *testing.T) {
+	f := NewFile()
+	// Test get auto filter without auto filter
+	ref, opts, err := f.GetAutoFilter("Sheet1")
+	assert.NoError(t, err)
+	assert.Empty(t, ref)
+	assert.Nil(t, opts)
+	expected := []AutoFilterOptions{
+		{Column: "A", Expression: "x == 1 or x == 2"},
+		{Column: "B", Expression: "x > 1 and x <= 5"},
+		{Column: "C", Expression: "x == NonBlanks"},
+		{Column: "D", Top10: &AutoFilterTop10Options{Bottom: true, Percent: true, Value: 10}},
+		{Column: "E", DynamicFilter: "aboveAverage"},
+		{Column: "F", DateGroups: []AutoFilterDateGroupOptions{
+			{Grouping: "year", Year: 2023},
+			{Grouping: "month", Year: 2024, Month: 1},
+			{Grouping: "day", Year: 2024, Month: 2, Day: 29},
+			{Grouping: "second", Year: 2024, Month: 2, Day: 3, Hour: 4, Minute: 5, Second: 6},
+		}},
+	}
+	assert.NoError(t, f.AutoFilter("Sheet1", "F10:A1", expected))
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestGetAutoFilter.xlsx")))
+	assert.NoError(t, f.Close())
+
+	f, err = OpenFile(filepath.Join("test", "TestGetAutoFilter.xlsx"))
+	assert.NoError(t, err)
+	ref, opts, err = f.GetAutoFilter("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "A1:F10", ref)
+	assert.Equal(t, expected, opts)
+	// Test get auto filter with blank and unknown custom filter operator
+	ws, ok := f.Sheet.Load("xl/worksheets/sheet1.xml")
+	assert.True(t, ok)
+	ws.(*xlsxWorksheet).AutoFilter.FilterColumn = []*xlsxFilterColumn{
+		{ColID: 1, Filters: &xlsxFilters{Blank: true}},
+		{ColID: 2, CustomFilters: &xlsxCustomFilters{CustomFilter: []*xlsxCustomFilter{{Val: "a*"}}}},
+	}
+	_, opts, err = f.GetAutoFilter("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, []AutoFilterOptions{
+		{Column: "B", Expression: "x == Blanks"},
+		{Column: "C", Expression: "x == a*"},
+	}, opts)
+	// Test get auto filter with invalid range reference
+	ws.(*xlsxWorksheet).AutoFilter.Ref = "A"
+	_, _, err = f.GetAutoFilter("Sheet1")
+	assert.Equal(t, ErrParameterInvalid, err)
+	// Test get auto filter with invalid column ID
+	ws.(*xlsxWorksheet).AutoFilter.Ref = "A1:B2"
+	ws.(*xlsxWorksheet).AutoFilter.FilterColumn = []*xlsxFilterColumn{{ColID: -1}}
+	_, _, err = f.GetAutoFilter("Sheet1")
+	assert.Equal(t, ErrColumnNumber, err)
+	// Test get auto filter on not exists worksheet
+	_, _, err = f.GetAutoFilter("SheetN")
+	assert.EqualError(t, err, "sheet SheetN does not exist")
+	assert.NoError(t, f.Close())
+}
+
 func TestAutoFilterError(t *testing.T) {
 	outFile := filepath.Join("test", "TestAutoFilterError%d.xlsx")
 	f, err := prepareTestBook1()
@@ -213,6 +394,27 @@ func TestAutoFilterError(t *testing.T) {
 		Column:     "A",
 		Expression: "-",
 	}}))
+	// Test add auto filter with invalid top 10, dynamic and date group filters
+	for _, opt := range []AutoFilterOptions{
+		{Column: "A", Top10: &AutoFilterTop10Options{}},
+		{Column: "A", Top10: &AutoFilterTop10Options{Value: 501}},
+		{Column: "A", Top10: &AutoFilterTop10Options{Percent: true, Value: 101}},
+		{Column: "A", DynamicFilter: "unknown"},
+		{Column: "A", DateGroups: []AutoFilterDateGroupOptions{{Grouping: "week", Year: 2024}}},
+		{Column: "A", DateGroups: []AutoFilterDateGroupOptions{{Grouping: "year"}}},
+		{Column: "A", DateGroups: []AutoFilterDateGroupOptions{{Grouping: "month", Year: 2024}}},
+		{Column: "A", DateGroups: []AutoFilterDateGroupOptions{{Grouping: "hour", Year: 2024, Month: 1, Day: 1, Hour: -1}}},
+		{Column: "A", DateGroups: []AutoFilterDateGroupOptions{{Grouping: "year", Year: 10000}}},
+		{Column: "A", DateGroups: []AutoFilterDateGroupOptions{{Grouping: "month", Year: 2024, Month: 13}}},
+		{Column: "A", DateGroups: []AutoFilterDateGroupOptions{{Grouping: "day", Year: 2024, Month: 1, Day: 32}}},
+		{Column: "A", DateGroups: []AutoFilterDateGroupOptions{{Grouping: "day", Year: 2023, Month: 2, Day: 29}}},
+		{Column: "A", DateGroups: []AutoFilterDateGroupOptions{{Grouping: "hour", Year: 2024, Month: 1, Day: 1, Hour: 24}}},
+		{Column: "A", DateGroups: []AutoFilterDateGroupOptions{{Grouping: "second", Year: 2024, Month: 1, Day: 1, Second: 60}}},
+		{Column: "A", Expression: "x == 1", DynamicFilter: "today"},
+		{Column: "A", Top10: &AutoFilterTop10Options{Value: 10}, DateGroups: []AutoFilterDateGroupOptions{{Grouping: "year", Year: 2024}}},
+	} {
+		assert.Equal(t, ErrParameterInvalid, f.AutoFilter("Sheet1", "A1:B2", []AutoFilterOptions{opt}))
+	}
 }
 
 func TestParseFilterTokens(t *testing.T) {
@@ -26,6 +26,7 @@ var (
 	NameSpaceDrawingMLChart                 = xml.Attr{Name: xml.Name{Local: "c", Space: "xmlns"}, Value: "http://schemas.openxmlformats.org/drawingml/2006/chart"}
 	NameSpaceDrawingMLSlicer                = xml.Attr{Name: xml.Name{Local: "sle", Space: "xmlns"}, Value: "http://schemas.microsoft.com/office/drawing/2010/slicer"}
 	NameSpaceDrawingMLSlicerX15             = xml.Attr{Name: xml.Name{Local: "sle15", Space: "xmlns"}, Value: "http://schemas.microsoft.com/office/drawing/2012/slicer"}
+	NameSpaceDrawingMLTimeslicer            = xml.Attr{Name: xml.Name{Local: "tsle", Space: "xmlns"}, Value: "http://schemas.microsoft.com/office/drawing/2012/timeslicer"}
 	NameSpaceDrawingMLSpreadSheet           = xml.Attr{Name: xml.Name{Local: "xdr", Space: "xmlns"}, Value: "http://schemas.openxmlformats.org/drawingml/2006/spreadsheetDrawing"}
 	NameSpaceMacExcel2008Main               = xml.Attr{Name: xml.Name{Local: "mx", Space: "xmlns"}, Value: "http://schemas.microsoft.com/office/mac/excel/2008/main"}
 	NameSpaceSpreadSheet                    = xml.Attr{Name: xml.Name{Local: "xmlns"}, Value: "http://schemas.openxmlformats.org/spreadsheetml/2006/main"}
@@ -43,10 +44,17 @@ var (
 // Source relationship and namespace.
 const (
 	ContentTypeAddinMacro                         = "application/vnd.ms-excel.addin.macroEnabled.main+xml"
+	ContentTypeCustomXMLProperties                = "application/vnd.openxmlformats-officedocument.customXmlProperties+xml"
 	ContentTypeDrawing                            = "application/vnd.openxmlformats-officedocument.drawing+xml"
 	ContentTypeDrawingML                          = "application/vnd.openxmlformats-officedocument.drawingml.chart+xml"
 	ContentTypeMacro                              = "application/vnd.ms-excel.sheet.macroEnabled.main+xml"
+	ContentTypeOLEObject                          = "application/vnd.openxmlformats-officedocument.oleObject"
+	ContentTypePerson                             = "application/vnd.ms-excel.person+xml"
+	ContentTypeRdRichValue                        = "application/vnd.ms-excel.rdrichvalue+xml"
+	ContentTypeRdRichValueStructure               = "application/vnd.ms-excel.rdrichvaluestructure+xml"
 	ContentTypeRelationships                      = "application/vnd.openxmlformats-package.relationships+xml"
+	ContentTypeRichValueRel                       = "application/vnd.ms-excel.richvaluerel+xml"
+	ContentTypeSheetMetadata                      = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheetMetadata+xml"
 	ContentTypeSheetML                            = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"
 	ContentTypeSlicer                             = "application/vnd.ms-excel.slicer+xml"
 	ContentTypeSlicerCache                        = "application/vnd.ms-excel.slicerCache+xml"
@@ -57,33 +65,54 @@ const (
 	ContentTypeSpreadSheetMLSharedStrings         = "application/vnd.openxmlformats-officedocument.spreadsheetml.sharedStrings+xml"
 	ContentTypeSpreadSheetMLTable                 = "application/vnd.openxmlformats-officedocument.spreadsheetml.table+xml"
 	ContentTypeSpreadSheetMLWorksheet             = "application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"
+	ContentTypeTimeline                           = "application/vnd.ms-excel.timeline+xml"
+	ContentTypeTimelineCache                      = "application/vnd.ms-excel.timelineCache+xml"
 	ContentTypeTemplate                           = "application/vnd.openxmlformats-officedocument.spreadsheetml.template.main+xml"
 	ContentTypeTemplateMacro                      = "application/vnd.ms-excel.template.macroEnabled.main+xml"
+	ContentTypeThreadedComment                    = "application/vnd.ms-excel.threadedcomments+xml"
 	ContentTypeVBA                                = "application/vnd.ms-office.vbaProject"
 	ContentTypeVML                                = "application/vnd.openxmlformats-officedocument.vmlDrawing"
+	NameSpaceCustomXML                            = "http://schemas.openxmlformats.org/officeDocument/2006/customXml"
 	NameSpaceDrawingMLMain                        = "http://schemas.openxmlformats.org/drawingml/2006/main"
 	NameSpaceDublinCore                           = "http://purl.org/dc/elements/1.1/"
 	NameSpaceDublinCoreMetadataInitiative         = "http://purl.org/dc/dcmitype/"
 	NameSpaceDublinCoreTerms                      = "http://purl.org/dc/terms/"
 	NameSpaceExtendedProperties                   = "http://schemas.openxmlformats.org/officeDocument/2006/extended-properties"
+	NameSpaceSpreadSheetDynamicArray              = "http://schemas.microsoft.com/office/spreadsheetml/2017/dynamicarray"
+	NameSpaceSpreadSheetRichData                  = "http://schemas.microsoft.com/office/spreadsheetml/2017/richdata"
+	NameSpaceSpreadSheetRichValueRel              = "http://schemas.microsoft.com/office/spreadsheetml/2022/richvaluerel"
+	NameSpaceSpreadSheetThreadedComments          = "http://schemas.microsoft.com/office/spreadsheetml/2018/threadedcomments"
 	NameSpaceXML                                  = "http://www.w3.org/XML/1998/namespace"
 	NameSpaceXMLSchemaInstance                    = "http://www.w3.org/2001/XMLSchema-instance"
+	SourceRelationshipCalcChain                   = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/calcChain"
 	SourceRelationshipChart                       = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/chart"
 	SourceRelationshipChartsheet                  = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/chartsheet"
 	SourceRelationshipComments                    = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/comments"
+	SourceRelationshipCustomXML                   = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/customXml"
+	SourceRelationshipCustomXMLProps              = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/customXmlProps"
 	SourceRelationshipDialogsheet                 = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/dialogsheet"
 	SourceRelationshipDrawingML                   = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/drawing"
 	SourceRelationshipDrawingVML                  = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/vmlDrawing"
 	SourceRelationshipExtendProperties            = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/extended-properties"
 	SourceRelationshipHyperLink                   = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/hyperlink"
 	SourceRelationshipImage                       = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/image"
+	SourceRelationshipOLEObject                   = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/oleObject"
 	SourceRelationshipOfficeDocument              = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument"
+	SourceRelationshipPerson                      = "http://schemas.microsoft.com/office/2017/10/relationships/person"
 	SourceRelationshipPivotCache                  = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/pivotCacheDefinition"
 	SourceRelationshipPivotTable                  = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/pivotTable"
+	SourceRelationshipPrinterSettings             = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/printerSettings"
+	SourceRelationshipRdRichValue                 = "http://schemas.microsoft.com/office/2017/06/relationships/rdRichValue"
+	SourceRelationshipRdRichValueStructure        = "http://schemas.microsoft.com/office/2017/06/relationships/rdRichValueStructure"
+	SourceRelationshipRichValueRel                = "http://schemas.microsoft.com/office/2022/10/relationships/richValueRel"
 	SourceRelationshipSharedStrings               = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/sharedStrings"
+	SourceRelationshipSheetMetadata               = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/sheetMetadata"
 	SourceRelationshipSlicer                      = "http://schemas.microsoft.com/office/2007/relationships/slicer"
 	SourceRelationshipSlicerCache                 = "http://schemas.microsoft.com/office/2007/relationships/slicerCache"
 	SourceRelationshipTable                       = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/table"
+	SourceRelationshipThreadedComment             = "http://schemas.microsoft.com/office/2017/10/relationships/threadedComment"
+	SourceRelationshipTimeline                    = "http://schemas.microsoft.com/office/2011/relationships/timeline"
+	SourceRelationshipTimelineCache               = "http://schemas.microsoft.com/office/2011/relationships/timelineCache"
 	SourceRelationshipVBAProject                  = "http://schemas.microsoft.com/office/2006/relationships/vbaProject"
 	SourceRelationshipWorkSheet                   = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet"
 	StrictNameSpaceDocumentPropertiesVariantTypes = "http://purl.oclc.org/ooxml/officeDocument/docPropsVTypes"
@@ -114,6 +143,7 @@ const (
 	ExtURIPivotCachesX15                 = "{841E416B-1EF1-43b6-AB56-02D37102CBD5}"
 	ExtURIPivotTableReferences           = "{983426D0-5260-488c-9760-48F4B6AC55F4}"
 	ExtURIProtectedRanges                = "{FC87AEE6-9EDD-4A0A-B7FB-166176984837}"
+	ExtURIRichValueBlock                 = "{3e2802c4-a4d2-4d8b-9148-e3be6c30e623}"
 	ExtURISlicerCacheDefinition          = "{2F2917AC-EB37-4324-AD4E-5DD8C200BD13}"
 	ExtURISlicerCacheHideItemsWithNoData = "{470722E0-AACD-4C17-9CDC-17EF765DBC7E}"
 	ExtURISlicerCachesX14                = "{BBE1A952-AA13-448e-AADC-164F8A28A991}"
@@ -163,23 +193,25 @@ var worksheetExtURIPriority = []string{
 
 // Excel specifications and limits
 const (
-	MaxCellStyles        = 65430
-	MaxColumns           = 16384
-	MaxColumnWidth       = 255
-	MaxFieldLength       = 255
-	MaxFilePathLength    = 207
-	MaxFormControlValue  = 30000
-	MaxFontFamilyLength  = 31
-	MaxFontSize          = 409
-	MaxRowHeight         = 409
-	MaxSheetNameLength   = 31
-	MinColumns           = 1
-	MinFontSize          = 1
-	StreamChunkSize      = 1 << 24
-	TotalCellChars       = 32767
-	TotalRows            = 1048576
-	TotalSheetHyperlinks = 65529
-	UnzipSizeLimit       = 1000 << 24
+	MaxCellStyles                = 65430
+	MaxColumns                   = 16384
+	MaxColumnWidth               = 255
+	MaxDataValidationTitleLength = 32
+	MaxFieldLength               = 255
+	MaxFilePathLength            = 207
+	MaxFormControlValue          = 30000
+	MaxFontFamilyLength          = 31
+	MaxFontSize                  = 409
+	MaxIndent                    = 250
+	MaxRowHeight                 = 409
+	MaxSheetNameLength           = 31
+	MinColumns                   = 1
+	MinFontSize                  = 1
+	StreamChunkSize              = 1 << 24
+	TotalCellChars               = 32767
+	TotalRows                    = 1048576
+	TotalSheetHyperlinks         = 65529
+	UnzipSizeLimit               = 1000 << 24
 	// pivotTableVersion should be greater than 3. One or more of the
 	// PivotTables chosen are created in a version of Excel earlier than
 	// Excel 2007 or in compatibility mode. Slicer can only be used with
@@ -191,6 +223,8 @@ const (
 	defaultChartDimensionHeight = 260
 	defaultSlicerWidth          = 200
 	defaultSlicerHeight         = 200
+	defaultTimelineWidth        = 335
+	defaultTimelineHeight       = 140
 	defaultChartLegendPosition  = "bottom"
 	defaultChartShowBlanksAs    = "gap"
 	defaultShapeSize            = 160
@@ -248,6 +282,13 @@ var chartDataLabelsPositionTypes = map[ChartDataLabelPositionType]string{
 	ChartDataLabelsPositionAbove:      "t",
 }
 
+// supportedChartDisplayUnits defined the built-in display units of the chart
+// value axis.
+var supportedChartDisplayUnits = []string{
+	"hundreds", "thousands", "tenThousands", "hundredThousands", "millions",
+	"tenMillions", "hundredMillions", "billions", "trillions",
+}
+
 // supportedChartDataLabelsPosition defined supported chart data labels position
 // types for each type of chart.
 var supportedChartDataLabelsPosition = map[ChartType][]ChartDataLabelPositionType{
@@ -282,6 +323,7 @@ const (
 	defaultXMLPathWorkbookRels            = "xl/_rels/workbook.xml.rels"
 	defaultXMLRdRichValuePart             = "xl/richData/rdrichvalue.xml"
 	defaultXMLRdRichValueRel              = "xl/richData/richValueRel.xml"
+	defaultXMLRdRichValueStructurePart    = "xl/richData/rdrichvaluestructure.xml"
 	defaultXMLRdRichValueRelRels          = "xl/richData/_rels/richValueRel.xml.rels"
 	defaultXMLRdRichValueWebImagePart     = "xl/richData/rdRichValueWebImage.xml"
 	defaultXMLRdRichValueWebImagePartRels = "xl/richData/_rels/rdRichValueWebImage.xml.rels"
@@ -472,6 +514,26 @@ var supportedImageTypes = map[string]string{
 	".tif": ".tiff", ".tiff": ".tiff", ".wmf": ".wmf", ".wmz": ".wmz",
 }
 
+// defaultOLEObjectIcon defined the default icon image in PNG format of the
+// embedded OLE object, the size of the image is 32 x 40 pixels.
+var defaultOLEObjectIcon = []byte{
+	0x89, 0x50, 0x4E, 0x47, 0x0D, 0x0A, 0x1A, 0x0A, 0x00, 0x00, 0x00, 0x0D, 0x49, 0x48, 0x44, 0x52,
+	0x00, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0x28, 0x02, 0x03, 0x00, 0x00, 0x00, 0xE2, 0x47, 0x10,
+	0x0A, 0x00, 0x00, 0x00, 0x0C, 0x50, 0x4C, 0x54, 0x45, 0x00, 0x00, 0x00, 0xFF, 0xFF, 0xFF, 0x80,
+	0x80, 0x80, 0x44, 0x72, 0xC4, 0x7C, 0x47, 0x5D, 0xBB, 0x00, 0x00, 0x00, 0x01, 0x74, 0x52, 0x4E,
+	0x53, 0x00, 0x40, 0xE6, 0xD8, 0x66, 0x00, 0x00, 0x00, 0x45, 0x49, 0x44, 0x41, 0x54, 0x78, 0xDA,
+	0x62, 0x58, 0x05, 0x02, 0x2B, 0x18, 0x18, 0x18, 0xA6, 0x86, 0x86, 0x86, 0x86, 0x46, 0xC1, 0x18,
+	0x91, 0x0D, 0x30, 0x46, 0x02, 0x8C, 0x11, 0x01, 0x63, 0x84, 0xC1, 0x18, 0xA1, 0x0D, 0x30, 0x46,
+	0x02, 0x8C, 0x11, 0x01, 0x35, 0x67, 0xD5, 0x2A, 0x08, 0x23, 0x34, 0x34, 0x8C, 0x44, 0x46, 0xFC,
+	0xFF, 0xFF, 0xFF, 0xFF, 0x7F, 0x1D, 0x5A, 0x6A, 0x70, 0x33, 0x56, 0x41, 0x01, 0x60, 0x00, 0x65,
+	0xA6, 0x87, 0xB7, 0xEC, 0x54, 0xE9, 0x8E, 0x00, 0x00, 0x00, 0x00, 0x49, 0x45, 0x4E, 0x44, 0xAE,
+	0x42, 0x60, 0x82,
+}
+
+// oleObjectPackageCLSID defined the class identifier of the OLE package object
+// {0003000C-0000-0000-C000-000000000046} in little-endian byte order.
+var oleObjectPackageCLSID = []byte{0x0C, 0x00, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0xC0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}
+
 // supportedContentTypes defined supported file format types.
 var supportedContentTypes = map[string]string{
 	".xlam": ContentTypeAddinMacro,
@@ -491,6 +553,9 @@ var supportedDrawingUnderlineTypes = []string{
 	"wavyDbl",
 }
 
+// supportedLineEndTypes defined supported line end types of the connector.
+var supportedLineEndTypes = []string{"none", "triangle", "stealth", "diamond", "oval", "arrow"}
+
 // supportedPositioning defined supported positioning types.
 var supportedPositioning = []string{"absolute", "oneCell", "twoCell"}
 
@@ -16,9 +16,12 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io"
+	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 )
 
 // FormControlType is the type of supported form controls.
@@ -36,7 +39,18 @@ const (
 	FormControlScrollBar
 )
 
-// GetComments retrieves all comments in a worksheet by given worksheet name.
+// threadedCommentPlaceholder defined the prefix of the text of the note which
+// acts as the placeholder of the threaded comment for the spreadsheet
+// applications that don't support threaded comments.
+const threadedCommentPlaceholder = "[Threaded comment]\n\nYour version of Excel allows you to read this threaded comment; however, any edits to it will get removed if the file is opened in a newer version of Excel. Learn more: https://go.microsoft.com/fwlink/?linkid=870924\n\n"
+
+// GetComments retrieves all notes (legacy comments) in a worksheet by given
+// worksheet name. The cell reference, author, author ID and rich text runs of
+// each comment will be returned, and the width and height of the comment box
+// in pixels will be calculated with the anchor of the comment shape in the
+// VML drawing part. The notes which act as the placeholders of the threaded
+// comments will be skipped, please use GetThreadedComments to get the
+// threaded comments.
 func (f *File) GetComments(sheet string) ([]Comment, error) {
 	var comments []Comment
 	sheetXMLPath, ok := f.getSheetXMLPath(sheet)
@@ -53,13 +67,23 @@ func (f *File) GetComments(sheet string) ([]Comment, error) {
 		return comments, err
 	}
 	if cmts != nil {
+		sizes, err := f.getCommentsSize(sheet)
+		if err != nil {
+			return comments, err
+		}
 		for _, cmt := range cmts.CommentList.Comment {
 			comment := Comment{}
 			if cmt.AuthorID < len(cmts.Authors.Author) {
 				comment.Author = cmts.Authors.Author[cmt.AuthorID]
 			}
+			if strings.HasPrefix(comment.Author, "tc=") {
+				continue
+			}
 			comment.Cell = cmt.Ref
 			comment.AuthorID = cmt.AuthorID
+			if size, ok := sizes[cmt.Ref]; ok {
+				comment.Width, comment.Height = size[0], size[1]
+			}
 			if cmt.Text.T != nil {
 				comment.Text += *cmt.Text.T
 			}
@@ -78,6 +102,69 @@ func (f *File) GetComments(sheet string) ([]Comment, error) {
 	return comments, nil
 }
 
+// getCommentsSize provides a function to get the width and height in pixels
+// of the comment boxes in the VML drawing part by given worksheet name. The
+// key of the returned map is the cell reference of the comment.
+func (f *File) getCommentsSize(sheet string) (map[string][2]uint, error) {
+	sizes := map[string][2]uint{}
+	ws, err := f.workSheetReader(sheet)
+	if err != nil || ws.LegacyDrawing == nil {
+		return sizes, err
+	}
+	drawingVML := strings.ReplaceAll(f.getSheetRelationshipsTargetByID(sheet, ws.LegacyDrawing.RID), "..", "xl")
+	var shapes []string
+	if vml := f.VMLDrawing[drawingVML]; vml != nil {
+		for _, sp := range vml.Shape {
+			shapes = append(shapes, sp.Val)
+		}
+	} else {
+		d, err := f.decodeVMLDrawingReader(drawingVML)
+		if err != nil || d == nil {
+			return sizes, err
+		}
+		for _, sp := range d.Shape {
+			shapes = append(shapes, sp.Val)
+		}
+	}
+	for _, val := range shapes {
+		var shapeVal decodeShapeVal
+		if err = xml.Unmarshal([]byte(fmt.Sprintf("<shape>%s</shape>", val)), &shapeVal); err != nil {
+			return sizes, err
+		}
+		if shapeVal.ClientData.ObjectType != "Note" || shapeVal.ClientData.Row == nil || shapeVal.ClientData.Column == nil {
+			continue
+		}
+		var pos []int
+		for _, v := range strings.Split(shapeVal.ClientData.Anchor, ",") {
+			n, err := strconv.Atoi(strings.TrimSpace(v))
+			if err != nil {
+				break
+			}
+			pos = append(pos, n)
+		}
+		if len(pos) != 8 {
+			continue
+		}
+		cell, err := CoordinatesToCellName(*shapeVal.ClientData.Column+1, *shapeVal.ClientData.Row+1)
+		if err != nil {
+			return sizes, err
+		}
+		// The comment box starts at the 23 pixels left offset of the column,
+		// which isn't excluded from the width on adding the comment
+		width, height := pos[5]-pos[1]+23, pos[7]-pos[3]
+		for col := pos[0]; col < pos[4]; col++ {
+			width += f.getColWidth(sheet, col+1)
+		}
+		for row := pos[2]; row < pos[6]; row++ {
+			height += f.getRowHeight(sheet, row+1)
+		}
+		if width > 0 && height > 0 {
+			sizes[cell] = [2]uint{uint(width), uint(height)}
+		}
+	}
+	return sizes, err
+}
+
 // getSheetComments provides the method to get the target comment reference by
 // given worksheet file path.
 func (f *File) getSheetComments(sheetFile string) string {
@@ -94,8 +181,45 @@ func (f *File) getSheetComments(sheetFile string) string {
 	return ""
 }
 
+// SetDefaultCommentAuthor provides a function to set the default author name
+// of the comments. The default author will be used by the AddComment,
+// AddNote and AddThreadedComment functions when the author isn't specified,
+// and each author will be stored only once in the authors list of the
+// comments. The author name "Author" will be used if the default author name
+// is empty. For example, set "Excelize" as the default author of comments:
+//
+//	f.SetDefaultCommentAuthor("Excelize")
+//	err := f.AddComment("Sheet1", excelize.Comment{
+//	    Cell: "A5",
+//	    Text: "This is a comment.",
+//	})
+func (f *File) SetDefaultCommentAuthor(name string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.commentAuthor = name
+}
+
+// getCommentAuthor returns the author name of the comment by given author
+// name, the default author name will be returned if the given name is empty.
+func (f *File) getCommentAuthor(name string) string {
+	if name == "" {
+		f.mu.Lock()
+		name = f.commentAuthor
+		f.mu.Unlock()
+	}
+	if name == "" {
+		name = "Author"
+	}
+	if utf8.RuneCountInString(name) > MaxFieldLength {
+		name = string([]rune(name)[:MaxFieldLength])
+	}
+	return name
+}
+
 // AddComment provides the method to add comments in a sheet by giving the
 // worksheet name, cell reference, and format set (such as author and text).
+// The comment added by this function is a note, which is also known as the
+// legacy comment, please use AddThreadedComment to add a threaded comment.
 // Note that the maximum author name length is 255 and the max text length is
 // 32512. For example, add a rich-text comment with a specified comments box
 // size in Sheet1!A5:
@@ -124,8 +248,360 @@ func (f *File) AddComment(sheet string, opts Comment) error {
 	})
 }
 
+// AddNote provides the method to add a note, which is also known as the
+// legacy comment, in a worksheet by given worksheet name and note settings.
+// This function is the same as AddComment. For example, add a note in
+// Sheet1!A5:
+//
+//	err := f.AddNote("Sheet1", excelize.Comment{
+//	    Cell:   "A5",
+//	    Author: "Excelize",
+//	    Text:   "This is a note.",
+//	})
+func (f *File) AddNote(sheet string, opts Comment) error {
+	return f.AddComment(sheet, opts)
+}
+
+// AddThreadedComment provides the method to add a threaded comment in a
+// worksheet by given worksheet name and threaded comment settings. The
+// comment will be added as a reply if there is already a thread of comments
+// in the cell. A note with the content of the thread will be created as the
+// placeholder for the spreadsheet applications which don't support threaded
+// comments. Note that the maximum author name length is 255 and the max text
+// length is 32767. For example, add a threaded comment with a reply in
+// Sheet1!A5:
+//
+//	err := f.AddThreadedComment("Sheet1", excelize.ThreadedComment{
+//	    Cell:   "A5",
+//	    Author: "Excelize",
+//	    Text:   "This is a threaded comment.",
+//	})
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	err = f.AddThreadedComment("Sheet1", excelize.ThreadedComment{
+//	    Cell:   "A5",
+//	    Author: "Excelize",
+//	    Text:   "This is a reply.",
+//	})
+//
+// The date of the comment will be the current time if the Date field is not
+// specified, and set the Done field to true to mark the thread as resolved,
+// which will be stored on the top-level comment of the thread.
+func (f *File) AddThreadedComment(sheet string, opts ThreadedComment) error {
+	col, row, err := CellNameToCoordinates(opts.Cell)
+	if err != nil {
+		return err
+	}
+	if _, err = f.workSheetReader(sheet); err != nil {
+		return err
+	}
+	opts.Cell, _ = CoordinatesToCellName(col, row)
+	opts.Author = f.getCommentAuthor(opts.Author)
+	if utf8.RuneCountInString(opts.Text) > TotalCellChars {
+		opts.Text = string([]rune(opts.Text)[:TotalCellChars])
+	}
+	if opts.Date.IsZero() {
+		opts.Date = time.Now()
+	}
+	personID, err := f.addPerson(opts.Author)
+	if err != nil {
+		return err
+	}
+	threadedCommentsXML := f.getSheetThreadedComments(sheet)
+	if threadedCommentsXML == "" {
+		threadedCommentID := f.countThreadedComments() + 1
+		threadedCommentsXML = "xl/threadedComments/threadedComment" + strconv.Itoa(threadedCommentID) + ".xml"
+		sheetXMLPath, _ := f.getSheetXMLPath(sheet)
+		sheetRels := "xl/worksheets/_rels/" + strings.TrimPrefix(sheetXMLPath, "xl/worksheets/") + ".rels"
+		f.addRels(sheetRels, SourceRelationshipThreadedComment, "../threadedComments/threadedComment"+strconv.Itoa(threadedCommentID)+".xml", "")
+		if err = f.addContentTypePart(threadedCommentID, "threadedComment"); err != nil {
+			return err
+		}
+	}
+	tc, err := f.threadedCommentsReader(threadedCommentsXML)
+	if err != nil {
+		return err
+	}
+	ID, err := genGUID()
+	if err != nil {
+		return err
+	}
+	cmt := xlsxThreadedComment{
+		Ref:      opts.Cell,
+		DT:       opts.Date.Format("2006-01-02T15:04:05.00"),
+		PersonID: personID,
+		ID:       ID,
+		Text:     opts.Text,
+	}
+	var texts []string
+	for i, c := range tc.ThreadedComment {
+		if c.Ref != opts.Cell {
+			continue
+		}
+		if c.ParentID == "" && cmt.ParentID == "" {
+			cmt.ParentID = c.ID
+			// The resolved state of the thread is stored on the root comment.
+			if opts.Done {
+				tc.ThreadedComment[i].Done = boolPtr(true)
+			}
+		}
+		texts = append(texts, c.Text)
+	}
+	if opts.Done && cmt.ParentID == "" {
+		cmt.Done = boolPtr(true)
+	}
+	tc.ThreadedComment = append(tc.ThreadedComment, cmt)
+	placeholder := threadedCommentPlaceholderText(append(texts, opts.Text))
+	if cmt.ParentID == "" {
+		return f.AddComment(sheet, Comment{Cell: opts.Cell, Author: "tc=" + cmt.ID, Text: placeholder})
+	}
+	return f.setThreadedCommentPlaceholder(sheet, opts.Cell, placeholder)
+}
+
+// threadedCommentPlaceholderText returns the text of the note which acts as
+// the placeholder of the threaded comment by given texts of the comment and
+// replies in the thread.
+func threadedCommentPlaceholderText(texts []string) string {
+	var buf strings.Builder
+	buf.WriteString(threadedCommentPlaceholder)
+	for i, text := range texts {
+		if i == 0 {
+			buf.WriteString("Comment:\n    ")
+		} else {
+			buf.WriteString("\nReply:\n    ")
+		}
+		buf.WriteString(text)
+	}
+	placeholder := buf.String()
+	if utf8.RuneCountInString(placeholder) > TotalCellChars {
+		placeholder = string([]rune(placeholder)[:TotalCellChars])
+	}
+	return placeholder
+}
+
+// setThreadedCommentPlaceholder provides a function to update the text of
+// the note which acts as the placeholder of the threaded comment by given
+// worksheet name, cell reference and text.
+func (f *File) setThreadedCommentPlaceholder(sheet, cell, text string) error {
+	sheetXMLPath, _ := f.getSheetXMLPath(sheet)
+	commentsXML := f.getSheetComments(filepath.Base(sheetXMLPath))
+	if !strings.HasPrefix(commentsXML, "/") {
+		commentsXML = "xl" + strings.TrimPrefix(commentsXML, "..")
+	}
+	commentsXML = strings.TrimPrefix(commentsXML, "/")
+	cmts, err := f.commentsReader(commentsXML)
+	if err != nil || cmts == nil {
+		return err
+	}
+	for i, cmt := range cmts.CommentList.Comment {
+		if cmt.Ref == cell {
+			cmts.CommentList.Comment[i].Text = xlsxText{T: stringPtr(text)}
+		}
+	}
+	return err
+}
+
+// GetThreadedComments retrieves all threaded comments in a worksheet by given
+// worksheet name. The replies of a thread will be returned with the ID of
+// the top-level comment in the thread as the ParentID. For example, get all
+// threaded comments in Sheet1:
+//
+//	comments, err := f.GetThreadedComments("Sheet1")
+func (f *File) GetThreadedComments(sheet string) ([]ThreadedComment, error) {
+	var comments []ThreadedComment
+	if _, ok := f.getSheetXMLPath(sheet); !ok {
+		return comments, ErrSheetNotExist{sheet}
+	}
+	threadedCommentsXML := f.getSheetThreadedComments(sheet)
+	if threadedCommentsXML == "" {
+		return comments, nil
+	}
+	tc, err := f.threadedCommentsReader(threadedCommentsXML)
+	if err != nil {
+		return comments, err
+	}
+	persons, err := f.personsReader(f.getPersonsPath())
+	if err != nil {
+		return comments, err
+	}
+	authors := make(map[string]string, len(persons.Person))
+	for _, person := range persons.Person {
+		authors[person.ID] = person.DisplayName
+	}
+	for _, c := range tc.ThreadedComment {
+		comment := ThreadedComment{
+			ID:       c.ID,
+			ParentID: c.ParentID,
+			Cell:     c.Ref,
+			Author:   authors[c.PersonID],
+			Text:     c.Text,
+			Done:     c.Done != nil && *c.Done,
+		}
+		if c.DT != "" {
+			comment.Date, _ = time.Parse("2006-01-02T15:04:05", c.DT)
+		}
+		comments = append(comments, comment)
+	}
+	return comments, err
+}
+
+// getSheetThreadedComments provides the method to get the threaded comments
+// part path by given worksheet name.
+func (f *File) getSheetThreadedComments(sheet string) string {
+	sheetXMLPath, _ := f.getSheetXMLPath(sheet)
+	rels, _ := f.relsReader("xl/worksheets/_rels/" + filepath.Base(sheetXMLPath) + ".rels")
+	if rels != nil {
+		rels.mu.Lock()
+		defer rels.mu.Unlock()
+		for _, v := range rels.Relationships {
+			if v.Type == SourceRelationshipThreadedComment {
+				return strings.TrimPrefix(strings.ReplaceAll(v.Target, "..", "xl"), "/")
+			}
+		}
+	}
+	return ""
+}
+
+// getPersonsPath provides the method to get the persons part path of the
+// workbook, which holds the authors of the threaded comments.
+func (f *File) getPersonsPath() string {
+	rels, _ := f.relsReader(f.getWorkbookRelsPath())
+	if rels != nil {
+		rels.mu.Lock()
+		defer rels.mu.Unlock()
+		for _, v := range rels.Relationships {
+			if v.Type == SourceRelationshipPerson {
+				if strings.HasPrefix(v.Target, "/") {
+					return strings.TrimPrefix(v.Target, "/")
+				}
+				return path.Join(path.Dir(f.getWorkbookPath()), v.Target)
+			}
+		}
+	}
+	return ""
+}
+
+// addPerson provides a function to add the author of the threaded comments
+// into the persons part of the workbook, and returns the ID of the person.
+func (f *File) addPerson(name string) (string, error) {
+	personsXML := f.getPersonsPath()
+	if personsXML == "" {
+		personsXML = "xl/persons/person.xml"
+		f.addRels(f.getWorkbookRelsPath(), SourceRelationshipPerson, "persons/person.xml", "")
+		if err := f.addContentTypePart(0, "person"); err != nil {
+			return "", err
+		}
+	}
+	persons, err := f.personsReader(personsXML)
+	if err != nil {
+		return "", err
+	}
+	for _, person := range persons.Person {
+		if person.DisplayName == name {
+			return person.ID, err
+		}
+	}
+	ID, err := genGUID()
+	if err != nil {
+		return "", err
+	}
+	persons.Person = append(persons.Person, xlsxPerson{
+		DisplayName: name, ID: ID, UserID: name, ProviderID: "None",
+	})
+	output, err := xml.Marshal(persons)
+	f.saveFileList(personsXML, output)
+	return ID, err
+}
+
+// countThreadedComments provides a function to get the largest threaded
+// comments file index storage in the folder xl/threadedComments.
+func (f *File) countThreadedComments() int {
+	count := 0
+	countPart := func(path string) {
+		if strings.Contains(path, "xl/threadedComments/threadedComment") {
+			if ID, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(path, "xl/threadedComments/threadedComment"), ".xml")); err == nil && ID > count {
+				count = ID
+			}
+		}
+	}
+	f.Pkg.Range(func(k, v interface{}) bool {
+		countPart(k.(string))
+		return true
+	})
+	for path := range f.ThreadedComments {
+		countPart(path)
+	}
+	return count
+}
+
+// threadedCommentsReader provides a function to get the pointer to the
+// structure after deserialization of xl/threadedComments/threadedComment%d.xml.
+func (f *File) threadedCommentsReader(path string) (*xlsxThreadedComments, error) {
+	if f.ThreadedComments[path] == nil {
+		content, ok := f.Pkg.Load(path)
+		tc := &xlsxThreadedComments{XMLNSX: NameSpaceSpreadSheet.Value}
+		if ok && content != nil {
+			if err := f.xmlNewDecoder(bytes.NewReader(namespaceStrictToTransitional(content.([]byte)))).
+				Decode(tc); err != nil && err != io.EOF {
+				return nil, err
+			}
+		}
+		f.ThreadedComments[path] = tc
+	}
+	return f.ThreadedComments[path], nil
+}
+
+// threadedCommentsWriter provides a function to save
+// xl/threadedComments/threadedComment%d.xml after serialize structure.
+func (f *File) threadedCommentsWriter() {
+	for path, tc := range f.ThreadedComments {
+		if tc != nil {
+			v, _ := xml.Marshal(tc)
+			f.saveFileList(path, v)
+		}
+	}
+}
+
+// personsReader provides a function to get the pointer to the structure
+// after deserialization of xl/persons/person.xml.
+func (f *File) personsReader(path string) (*xlsxPersonList, error) {
+	content, ok := f.Pkg.Load(path)
+	persons := &xlsxPersonList{XMLNSX: NameSpaceSpreadSheet.Value}
+	if ok && content != nil {
+		if err := f.xmlNewDecoder(bytes.NewReader(namespaceStrictToTransitional(content.([]byte)))).
+			Decode(persons); err != nil && err != io.EOF {
+			return nil, err
+		}
+	}
+	return persons, nil
+}
+
+// deleteThreadedComments provides a function to delete the thread of
+// comments by given worksheet name and cell reference.
+func (f *File) deleteThreadedComments(sheet, cell string) error {
+	threadedCommentsXML := f.getSheetThreadedComments(sheet)
+	if threadedCommentsXML == "" {
+		return nil
+	}
+	tc, err := f.threadedCommentsReader(threadedCommentsXML)
+	if err != nil {
+		return err
+	}
+	var threadedComments []xlsxThreadedComment
+	for _, c := range tc.ThreadedComment {
+		if c.Ref != cell {
+			threadedComments = append(threadedComments, c)
+		}
+	}
+	tc.ThreadedComment = threadedComments
+	return err
+}
+
 // DeleteComment provides the method to delete comment in a worksheet by given
-// worksheet name and cell reference. For example, delete the comment in
+// worksheet name and cell reference. The thread of comments in the cell will
+// also be deleted if it exists. For example, delete the comment in
 // Sheet1!$A$30:
 //
 //	err := f.DeleteComment("Sheet1", "A30")
@@ -134,6 +610,9 @@ func (f *File) DeleteComment(sheet, cell string) error {
 	if err != nil {
 		return err
 	}
+	if err = f.deleteThreadedComments(sheet, cell); err != nil {
+		return err
+	}
 	if ws.LegacyDrawing == nil {
 		return err
 	}
@@ -188,10 +667,10 @@ func (f *File) deleteFormControl(sheetRelationshipsDrawingVML, cell string, isCo
 			ShapeLayout: &xlsxShapeLayout{
 				Ext: "edit", IDmap: &xlsxIDmap{Ext: "edit", Data: vmlID},
 			},
-			ShapeType: &xlsxShapeType{
+			ShapeType: []*xlsxShapeType{{
 				Stroke: &xlsxStroke{JoinStyle: "miter"},
 				VPath:  &vPath{GradientShapeOK: "t", ConnectType: "rect"},
-			},
+			}},
 		}
 		// Load exist VML shapes from xl/drawings/vmlDrawing%d.vml
 		d, err := f.decodeVMLDrawingReader(drawingVML)
@@ -199,10 +678,7 @@ func (f *File) deleteFormControl(sheetRelationshipsDrawingVML, cell string, isCo
 			return err
 		}
 		if d != nil {
-			vml.ShapeType.ID = d.ShapeType.ID
-			vml.ShapeType.CoordSize = d.ShapeType.CoordSize
-			vml.ShapeType.Spt = d.ShapeType.Spt
-			vml.ShapeType.Path = d.ShapeType.Path
+			vml.loadShapeTypes(d.ShapeType)
 			for _, v := range d.Shape {
 				s := xlsxShape{
 					ID:          v.ID,
@@ -247,12 +723,7 @@ func (f *File) deleteFormControl(sheetRelationshipsDrawingVML, cell string, isCo
 // addComment provides a function to create chart as xl/comments%d.xml by
 // given cell and format sets.
 func (f *File) addComment(commentsXML string, opts vmlOptions) error {
-	if opts.Author == "" {
-		opts.Author = "Author"
-	}
-	if len(opts.Author) > MaxFieldLength {
-		opts.Author = opts.Author[:MaxFieldLength]
-	}
+	opts.Author = f.getCommentAuthor(opts.Author)
 	cmts, err := f.commentsReader(commentsXML)
 	if err != nil {
 		return err
@@ -261,7 +732,7 @@ func (f *File) addComment(commentsXML string, opts vmlOptions) error {
 	if cmts == nil {
 		cmts = &xlsxComments{Authors: xlsxAuthor{Author: []string{opts.Author}}}
 	}
-	if inStrSlice(cmts.Authors.Author, opts.Author, true) == -1 {
+	if authorID = inStrSlice(cmts.Authors.Author, opts.Author, true); authorID == -1 {
 		cmts.Authors.Author = append(cmts.Authors.Author, opts.Author)
 		authorID = len(cmts.Authors.Author) - 1
 	}
@@ -818,6 +1289,115 @@ func (f *File) addFormCtrlShape(preset formCtrlPreset, col, row int, anchor stri
 	return &sp, sp.addFormCtrl(opts)
 }
 
+// newVMLShapeType provides a function to create the VML shape type by given
+// shape type ID. The picture frame shape type will be created for the shape
+// type ID 75, and the text box shape type will be created for others.
+func newVMLShapeType(shapeTypeID int) *xlsxShapeType {
+	if shapeTypeID != 75 {
+		return &xlsxShapeType{
+			ID:        fmt.Sprintf("_x0000_t%d", shapeTypeID),
+			CoordSize: "21600,21600",
+			Spt:       202,
+			Path:      "m0,0l0,21600,21600,21600,21600,0xe",
+			Stroke:    &xlsxStroke{JoinStyle: "miter"},
+			VPath:     &vPath{GradientShapeOK: "t", ConnectType: "rect"},
+		}
+	}
+	formulas := &vFormulas{}
+	for _, eqn := range []string{
+		"if lineDrawn pixelLineWidth 0", "sum @0 1 0", "sum 0 0 @1", "prod @2 1 2",
+		"prod @3 21600 pixelWidth", "prod @3 21600 pixelHeight", "sum @0 0 1",
+		"prod @6 1 2", "prod @7 21600 pixelWidth", "sum @8 21600 0",
+		"prod @7 21600 pixelHeight", "sum @10 21600 0",
+	} {
+		formulas.F = append(formulas.F, vFormula{Eqn: eqn})
+	}
+	return &xlsxShapeType{
+		ID:             "_x0000_t75",
+		CoordSize:      "21600,21600",
+		Spt:            75,
+		PreferRelative: "t",
+		Path:           "m@4@5l@4@11@9@11@9@5xe",
+		Filled:         "f",
+		Stroked:        "f",
+		Stroke:         &xlsxStroke{JoinStyle: "miter"},
+		Formulas:       formulas,
+		VPath:          &vPath{ExtrusionOK: "f", GradientShapeOK: "t", ConnectType: "rect"},
+		Lock:           &oLock{Ext: "edit", AspectRatio: "t"},
+	}
+}
+
+// loadShapeTypes provides a function to load the shape types in the VML
+// drawing by given shape types in the existing VML drawing part.
+func (vml *vmlDrawing) loadShapeTypes(shapeTypes []decodeShapeType) {
+	if len(shapeTypes) == 0 {
+		return
+	}
+	vml.ShapeType = nil
+	for _, st := range shapeTypes {
+		shapeType := newVMLShapeType(st.Spt)
+		shapeType.ID, shapeType.CoordSize, shapeType.Spt, shapeType.Path = st.ID, st.CoordSize, st.Spt, st.Path
+		vml.ShapeType = append(vml.ShapeType, shapeType)
+	}
+}
+
+// addPictureShapeType provides a function to add the picture frame shape
+// type into the VML drawing if it doesn't exist, and returns the ID of the
+// shape type.
+func (vml *vmlDrawing) addPictureShapeType() string {
+	for _, shapeType := range vml.ShapeType {
+		if shapeType.Spt == 75 {
+			return shapeType.ID
+		}
+	}
+	shapeType := newVMLShapeType(75)
+	vml.ShapeType = append(vml.ShapeType, shapeType)
+	return shapeType.ID
+}
+
+// prepareVMLDrawing provides a function to create the VML drawing by given
+// data ID, shape type ID and VML drawing path if it doesn't exist, and load
+// the existing VML shapes from xl/drawings/vmlDrawing%d.vml.
+func (f *File) prepareVMLDrawing(vml *vmlDrawing, dataID, vmlID int, drawingVML string) (*vmlDrawing, error) {
+	if vml != nil {
+		return vml, nil
+	}
+	vml = &vmlDrawing{
+		XMLNSv:  "urn:schemas-microsoft-com:vml",
+		XMLNSo:  "urn:schemas-microsoft-com:office:office",
+		XMLNSx:  "urn:schemas-microsoft-com:office:excel",
+		XMLNSmv: "http://macVmlSchemaUri",
+		ShapeLayout: &xlsxShapeLayout{
+			Ext: "edit", IDmap: &xlsxIDmap{Ext: "edit", Data: dataID},
+		},
+		ShapeType: []*xlsxShapeType{newVMLShapeType(vmlID)},
+	}
+	// Load exist VML shapes from xl/drawings/vmlDrawing%d.vml
+	d, err := f.decodeVMLDrawingReader(drawingVML)
+	if err != nil {
+		return vml, err
+	}
+	if d != nil {
+		vml.loadShapeTypes(d.ShapeType)
+		for _, v := range d.Shape {
+			s := xlsxShape{
+				ID:          v.ID,
+				Type:        v.Type,
+				Style:       v.Style,
+				Button:      v.Button,
+				Filled:      v.Filled,
+				FillColor:   v.FillColor,
+				InsetMode:   v.InsetMode,
+				Stroked:     v.Stroked,
+				StrokeColor: v.StrokeColor,
+				Val:         v.Val,
+			}
+			vml.Shape = append(vml.Shape, s)
+		}
+	}
+	return vml, err
+}
+
 // addDrawingVML provides a function to create VML drawing XML as
 // xl/drawings/vmlDrawing%d.vml by given data ID, XML path and VML options. The
 // anchor value is a comma-separated list of data written out as: LeftColumn,
@@ -836,50 +1416,8 @@ func (f *File) addDrawingVML(dataID int, drawingVML string, opts *vmlOptions) er
 	}
 	colStart, rowStart, colEnd, rowEnd, x2, y2 := f.positionObjectPixels(opts.sheet, col, row, opts.Format.OffsetX, opts.Format.OffsetY, int(opts.FormControl.Width), int(opts.FormControl.Height))
 	anchor := fmt.Sprintf("%d, %d, %d, 0, %d, %d, %d, %d", colStart, leftOffset, rowStart, colEnd, x2, rowEnd, y2)
-	if vml == nil {
-		vml = &vmlDrawing{
-			XMLNSv:  "urn:schemas-microsoft-com:vml",
-			XMLNSo:  "urn:schemas-microsoft-com:office:office",
-			XMLNSx:  "urn:schemas-microsoft-com:office:excel",
-			XMLNSmv: "http://macVmlSchemaUri",
-			ShapeLayout: &xlsxShapeLayout{
-				Ext: "edit", IDmap: &xlsxIDmap{Ext: "edit", Data: dataID},
-			},
-			ShapeType: &xlsxShapeType{
-				ID:        fmt.Sprintf("_x0000_t%d", vmlID),
-				CoordSize: "21600,21600",
-				Spt:       202,
-				Path:      "m0,0l0,21600,21600,21600,21600,0xe",
-				Stroke:    &xlsxStroke{JoinStyle: "miter"},
-				VPath:     &vPath{GradientShapeOK: "t", ConnectType: "rect"},
-			},
-		}
-		// Load exist VML shapes from xl/drawings/vmlDrawing%d.vml
-		d, err := f.decodeVMLDrawingReader(drawingVML)
-		if err != nil {
-			return err
-		}
-		if d != nil {
-			vml.ShapeType.ID = d.ShapeType.ID
-			vml.ShapeType.CoordSize = d.ShapeType.CoordSize
-			vml.ShapeType.Spt = d.ShapeType.Spt
-			vml.ShapeType.Path = d.ShapeType.Path
-			for _, v := range d.Shape {
-				s := xlsxShape{
-					ID:          v.ID,
-					Type:        v.Type,
-					Style:       v.Style,
-					Button:      v.Button,
-					Filled:      v.Filled,
-					FillColor:   v.FillColor,
-					InsetMode:   v.InsetMode,
-					Stroked:     v.Stroked,
-					StrokeColor: v.StrokeColor,
-					Val:         v.Val,
-				}
-				vml.Shape = append(vml.Shape, s)
-			}
-		}
+	if vml, err = f.prepareVMLDrawing(vml, dataID, vmlID, drawingVML); err != nil {
+		return err
 	}
 	sp, err := f.addFormCtrlShape(preset, col, row, anchor, opts)
 	if err != nil {
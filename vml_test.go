@@ -17,7 +17,9 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -81,6 +83,102 @@ func TestAddComment(t *testing.T) {
 	assert.EqualError(t, err, "sheet SheetN does not exist")
 }
 
+func TestSetDefaultCommentAuthor(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.AddComment("Sheet1", Comment{Cell: "A1", Text: "Comment 1"}))
+	f.SetDefaultCommentAuthor("Excelize")
+	assert.NoError(t, f.AddComment("Sheet1", Comment{Cell: "A2", Text: "Comment 2"}))
+	assert.NoError(t, f.AddComment("Sheet1", Comment{Cell: "A3", Author: "Author", Text: "Comment 3"}))
+	assert.NoError(t, f.AddNote("Sheet1", Comment{Cell: "A4", Text: "Comment 4"}))
+	assert.NoError(t, f.AddThreadedComment("Sheet1", ThreadedComment{Cell: "A5", Text: "Comment 5"}))
+	comments, err := f.GetComments("Sheet1")
+	assert.NoError(t, err)
+	assert.Len(t, comments, 4)
+	for i, expected := range []struct {
+		author   string
+		authorID int
+	}{{"Author", 0}, {"Excelize", 1}, {"Author", 0}, {"Excelize", 1}} {
+		assert.Equal(t, expected.author, comments[i].Author)
+		assert.Equal(t, expected.authorID, comments[i].AuthorID)
+	}
+	// Test the authors of the notes are not duplicated
+	authors := f.Comments["xl/comments1.xml"].Authors.Author
+	assert.Len(t, authors, 3)
+	assert.Equal(t, []string{"Author", "Excelize"}, authors[:2])
+	threadedComments, err := f.GetThreadedComments("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "Excelize", threadedComments[0].Author)
+	// Test concurrency set and get the default author
+	wg := new(sync.WaitGroup)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(val int) {
+			defer wg.Done()
+			f.SetDefaultCommentAuthor(fmt.Sprintf("Author%d", val))
+			assert.NotEmpty(t, f.getCommentAuthor(""))
+		}(i)
+	}
+	wg.Wait()
+	assert.NoError(t, f.Close())
+}
+
+func TestGetComments(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetColWidth("Sheet1", "B", "C", 20))
+	assert.NoError(t, f.AddComment("Sheet1", Comment{Cell: "A1", Author: "Excelize", Text: "Comment 1", Width: 200, Height: 120}))
+	assert.NoError(t, f.AddComment("Sheet1", Comment{
+		Cell: "B3", Author: "Author",
+		Paragraph: []RichTextRun{{Text: "Author: ", Font: &Font{Bold: true}}, {Text: "Comment 2"}},
+	}))
+	expected := []Comment{
+		{Author: "Excelize", AuthorID: 0, Cell: "A1", Text: "Comment 1", Width: 200, Height: 120},
+		{Author: "Author", AuthorID: 1, Cell: "B3", Width: 140, Height: 60, Paragraph: []RichTextRun{{Text: "Author: "}, {Text: "Comment 2"}}},
+	}
+	checkComments := func(comments []Comment) {
+		assert.Len(t, comments, len(expected))
+		for i, comment := range comments {
+			for j := range comment.Paragraph {
+				comment.Paragraph[j].Font = nil
+			}
+			assert.Equal(t, expected[i], comment)
+		}
+	}
+	comments, err := f.GetComments("Sheet1")
+	assert.NoError(t, err)
+	checkComments(comments)
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestGetComments.xlsx")))
+	assert.NoError(t, f.Close())
+
+	// Test get comments with the VML drawing part which loaded from file
+	f, err = OpenFile(filepath.Join("test", "TestGetComments.xlsx"))
+	assert.NoError(t, err)
+	comments, err = f.GetComments("Sheet1")
+	assert.NoError(t, err)
+	checkComments(comments)
+	// Test get comments with invalid anchor in the VML drawing part
+	f.Pkg.Store("xl/drawings/vmlDrawing1.vml", []byte(`<xml xmlns:v="urn:schemas-microsoft-com:vml" xmlns:x="urn:schemas-microsoft-com:office:excel"><v:shape><x:ClientData ObjectType="Note"><x:Anchor>x</x:Anchor><x:Row>0</x:Row><x:Column>0</x:Column></x:ClientData></v:shape></xml>`))
+	f.DecodeVMLDrawing = map[string]*decodeVmlDrawing{}
+	comments, err = f.GetComments("Sheet1")
+	assert.NoError(t, err)
+	assert.Zero(t, comments[0].Width)
+	// Test get comments with invalid cell reference in the VML drawing part
+	f.Pkg.Store("xl/drawings/vmlDrawing1.vml", []byte(`<xml xmlns:v="urn:schemas-microsoft-com:vml" xmlns:x="urn:schemas-microsoft-com:office:excel"><v:shape><x:ClientData ObjectType="Note"><x:Anchor>0,0,0,0,1,0,1,0</x:Anchor><x:Row>0</x:Row><x:Column>-1</x:Column></x:ClientData></v:shape></xml>`))
+	f.DecodeVMLDrawing = map[string]*decodeVmlDrawing{}
+	_, err = f.GetComments("Sheet1")
+	assert.Equal(t, newCoordinatesToCellNameError(0, 1), err)
+	// Test get comments with invalid shape in the VML drawing part
+	f.VMLDrawing["xl/drawings/vmlDrawing1.vml"] = &vmlDrawing{Shape: []xlsxShape{{Val: "<x:ClientData"}}}
+	_, err = f.GetComments("Sheet1")
+	assert.EqualError(t, err, "XML syntax error on line 1: expected attribute name in element")
+	// Test get comments with unsupported charset VML drawing part
+	delete(f.VMLDrawing, "xl/drawings/vmlDrawing1.vml")
+	f.DecodeVMLDrawing = map[string]*decodeVmlDrawing{}
+	f.Pkg.Store("xl/drawings/vmlDrawing1.vml", MacintoshCyrillicCharset)
+	_, err = f.GetComments("Sheet1")
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+	assert.NoError(t, f.Close())
+}
+
 func TestDeleteComment(t *testing.T) {
 	f, err := prepareTestBook1()
 	if !assert.NoError(t, err) {
@@ -128,6 +226,105 @@ func TestDeleteComment(t *testing.T) {
 	assert.NoError(t, f.DeleteComment("Sheet1", "A1"))
 }
 
+func TestThreadedComment(t *testing.T) {
+	f := NewFile()
+	date := time.Date(2024, 3, 1, 8, 30, 0, 0, time.UTC)
+	assert.NoError(t, f.AddNote("Sheet1", Comment{Cell: "A1", Author: "Excelize", Text: "This is a note."}))
+	assert.NoError(t, f.AddThreadedComment("Sheet1", ThreadedComment{Cell: "B2", Author: "Excelize", Text: "This is a threaded comment.", Date: date}))
+	assert.NoError(t, f.AddThreadedComment("Sheet1", ThreadedComment{Cell: "b2", Text: "This is a reply.", Date: date, Done: true}))
+	assert.NoError(t, f.AddThreadedComment("Sheet1", ThreadedComment{Cell: "C3", Author: "Excelize", Text: "This is another threaded comment."}))
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestThreadedComment.xlsx")))
+	assert.NoError(t, f.Close())
+
+	f, err := OpenFile(filepath.Join("test", "TestThreadedComment.xlsx"))
+	assert.NoError(t, err)
+	// Test get the notes without the placeholders of the threaded comments
+	comments, err := f.GetComments("Sheet1")
+	assert.NoError(t, err)
+	assert.Len(t, comments, 1)
+	assert.Equal(t, "A1", comments[0].Cell)
+	assert.Equal(t, "This is a note.", comments[0].Text)
+	// Test get the threaded comments
+	threadedComments, err := f.GetThreadedComments("Sheet1")
+	assert.NoError(t, err)
+	assert.Len(t, threadedComments, 3)
+	assert.Equal(t, ThreadedComment{
+		ID: threadedComments[0].ID, Cell: "B2", Author: "Excelize", Text: "This is a threaded comment.", Date: date, Done: true,
+	}, threadedComments[0])
+	assert.Equal(t, ThreadedComment{
+		ID: threadedComments[1].ID, ParentID: threadedComments[0].ID, Cell: "B2", Author: "Author", Text: "This is a reply.", Date: date,
+	}, threadedComments[1])
+	assert.Empty(t, threadedComments[2].ParentID)
+	assert.Equal(t, "Excelize", threadedComments[2].Author)
+	assert.False(t, threadedComments[2].Date.IsZero())
+	// Test the placeholder of the thread
+	cmts, err := f.commentsReader("xl/comments1.xml")
+	assert.NoError(t, err)
+	assert.Len(t, cmts.CommentList.Comment, 3)
+	assert.Equal(t, "tc="+threadedComments[0].ID, cmts.Authors.Author[cmts.CommentList.Comment[1].AuthorID])
+	assert.Equal(t, threadedCommentPlaceholder+"Comment:\n    This is a threaded comment.\nReply:\n    This is a reply.", *cmts.CommentList.Comment[1].Text.T)
+	persons, err := f.personsReader("xl/persons/person.xml")
+	assert.NoError(t, err)
+	assert.Len(t, persons.Person, 2)
+	// Test delete the thread of comments
+	assert.NoError(t, f.DeleteComment("Sheet1", "B2"))
+	threadedComments, err = f.GetThreadedComments("Sheet1")
+	assert.NoError(t, err)
+	assert.Len(t, threadedComments, 1)
+	assert.Equal(t, "C3", threadedComments[0].Cell)
+	assert.Len(t, cmts.CommentList.Comment, 2)
+	// Test get the threaded comments on not exists worksheet
+	_, err = f.GetThreadedComments("SheetN")
+	assert.EqualError(t, err, "sheet SheetN does not exist")
+	// Test add threaded comment with invalid cell reference
+	assert.Equal(t, newCellNameToCoordinatesError("A", newInvalidCellNameError("A")), f.AddThreadedComment("Sheet1", ThreadedComment{Cell: "A"}))
+	// Test add threaded comment on not exists worksheet
+	assert.EqualError(t, f.AddThreadedComment("SheetN", ThreadedComment{Cell: "A1"}), "sheet SheetN does not exist")
+	// Test get and delete threaded comments with unsupported charset
+	f.ThreadedComments["xl/threadedComments/threadedComment1.xml"] = nil
+	f.Pkg.Store("xl/threadedComments/threadedComment1.xml", MacintoshCyrillicCharset)
+	_, err = f.GetThreadedComments("Sheet1")
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+	assert.EqualError(t, f.DeleteComment("Sheet1", "C3"), "XML syntax error on line 1: invalid UTF-8")
+	assert.EqualError(t, f.AddThreadedComment("Sheet1", ThreadedComment{Cell: "D4"}), "XML syntax error on line 1: invalid UTF-8")
+	f.Pkg.Store("xl/persons/person.xml", MacintoshCyrillicCharset)
+	assert.EqualError(t, f.AddThreadedComment("Sheet1", ThreadedComment{Cell: "D4"}), "XML syntax error on line 1: invalid UTF-8")
+	assert.NoError(t, f.Close())
+
+	f = NewFile()
+	// Test get the threaded comments without threaded comments part
+	threadedComments, err = f.GetThreadedComments("Sheet1")
+	assert.NoError(t, err)
+	assert.Empty(t, threadedComments)
+	assert.NoError(t, f.AddThreadedComment("Sheet1", ThreadedComment{Cell: "A1", Text: "Text"}))
+	// Test add threaded comment with exceeds length limit author and text
+	assert.NoError(t, f.AddThreadedComment("Sheet1", ThreadedComment{
+		Cell: "C3", Author: strings.Repeat("\u00e9", MaxFieldLength+1), Text: strings.Repeat("\u00e9", TotalCellChars+1),
+	}))
+	threadedComments, err = f.GetThreadedComments("Sheet1")
+	assert.NoError(t, err)
+	assert.Len(t, threadedComments, 2)
+	assert.Equal(t, strings.Repeat("\u00e9", MaxFieldLength), threadedComments[1].Author)
+	assert.Equal(t, strings.Repeat("\u00e9", TotalCellChars), threadedComments[1].Text)
+	// Test add threaded comments on multiple worksheets before saving
+	_, err = f.NewSheet("Sheet2")
+	assert.NoError(t, err)
+	assert.NoError(t, f.AddThreadedComment("Sheet2", ThreadedComment{Cell: "B2", Text: "Text2"}))
+	for sheet, text := range map[string]string{"Sheet1": "Text", "Sheet2": "Text2"} {
+		threadedComments, err = f.GetThreadedComments(sheet)
+		assert.NoError(t, err)
+		assert.Equal(t, text, threadedComments[0].Text)
+	}
+	f.Pkg.Store("xl/persons/person.xml", MacintoshCyrillicCharset)
+	_, err = f.GetThreadedComments("Sheet1")
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+	// Test add threaded comment with unsupported charset content types
+	f = NewFile()
+	f.ContentTypes = nil
+	f.Pkg.Store(defaultXMLPathContentTypes, MacintoshCyrillicCharset)
+	assert.EqualError(t, f.AddThreadedComment("Sheet1", ThreadedComment{Cell: "A1"}), "XML syntax error on line 1: invalid UTF-8")
+}
+
 func TestDecodeVMLDrawingReader(t *testing.T) {
 	f := NewFile()
 	path := "xl/drawings/vmlDrawing1.xml"
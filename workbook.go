@@ -14,6 +14,7 @@ package excelize
 import (
 	"bytes"
 	"encoding/xml"
+	"fmt"
 	"io"
 	"path/filepath"
 	"strconv"
@@ -59,6 +60,122 @@ func (f *File) GetWorkbookProps() (WorkbookPropsOptions, error) {
 	return opts, err
 }
 
+// SetWorkbookDate1904 provides a function to set the date system of the
+// workbook. Set the date1904 to true to use the 1904 date system, which is the
+// default date system of the spreadsheet created by the earlier version of
+// spreadsheet application on Macintosh, or false to use the 1900 date system.
+// The date and time values set by the SetCellValue function and the stream
+// writer, and the formatted date and time values returned by the GetCellValue
+// function will be converted based on the date system of the workbook. Note
+// that the existing date serial numbers in the cells will not be changed, so
+// the displayed dates of these cells will be shifted by 1462 days after
+// changing the date system. For example, use the 1904 date system in the
+// workbook:
+//
+//	err := f.SetWorkbookDate1904(true)
+func (f *File) SetWorkbookDate1904(date1904 bool) error {
+	return f.SetWorkbookProps(&WorkbookPropsOptions{Date1904: boolPtr(date1904)})
+}
+
+// isDate1904 provides a function to check if the workbook uses the 1904 date
+// system.
+func (f *File) isDate1904() (bool, error) {
+	wb, err := f.workbookReader()
+	if err != nil {
+		return false, err
+	}
+	return wb != nil && wb.WorkbookPr != nil && wb.WorkbookPr.Date1904, err
+}
+
+// SetWorkbookView provides a function to set the workbook window view
+// settings. The XWindow and YWindow specifies the position of the upper-left
+// corner of the workbook window in twips, the WindowWidth and WindowHeight
+// specifies the size of the workbook window in twips, the FirstSheet
+// specifies the index of the first sheet tab displayed in the sheet tab bar,
+// and the TabRatio specifies the ratio between the sheet tab bar and the
+// horizontal scroll bar in thousandths, the value of it should be between 0
+// and 1000. For example:
+//
+//	x, y, width, height, tabRatio := 240, 105, 14805, 8010, 750.0
+//	err := f.SetWorkbookView(&excelize.WorkbookViewOptions{
+//	    XWindow:      &x,
+//	    YWindow:      &y,
+//	    WindowWidth:  &width,
+//	    WindowHeight: &height,
+//	    TabRatio:     &tabRatio,
+//	})
+func (f *File) SetWorkbookView(opts *WorkbookViewOptions) error {
+	wb, err := f.workbookReader()
+	if err != nil || opts == nil {
+		return err
+	}
+	if (opts.WindowWidth != nil && *opts.WindowWidth < 0) || (opts.WindowHeight != nil && *opts.WindowHeight < 0) ||
+		(opts.TabRatio != nil && (*opts.TabRatio < 0 || *opts.TabRatio > 1000)) {
+		return ErrParameterInvalid
+	}
+	if opts.FirstSheet != nil && (*opts.FirstSheet < 0 || *opts.FirstSheet >= len(wb.Sheets.Sheet)) {
+		return ErrSheetIdx
+	}
+	if wb.BookViews == nil {
+		wb.BookViews = &xlsxBookViews{}
+	}
+	if len(wb.BookViews.WorkBookView) == 0 {
+		wb.BookViews.WorkBookView = append(wb.BookViews.WorkBookView, xlsxWorkBookView{})
+	}
+	view := &wb.BookViews.WorkBookView[0]
+	if opts.XWindow != nil {
+		view.XWindow = strconv.Itoa(*opts.XWindow)
+	}
+	if opts.YWindow != nil {
+		view.YWindow = strconv.Itoa(*opts.YWindow)
+	}
+	if opts.WindowWidth != nil {
+		view.WindowWidth = intPtr(*opts.WindowWidth)
+	}
+	if opts.WindowHeight != nil {
+		view.WindowHeight = intPtr(*opts.WindowHeight)
+	}
+	if opts.FirstSheet != nil {
+		view.FirstSheet = intPtr(*opts.FirstSheet)
+	}
+	if opts.TabRatio != nil {
+		view.TabRatio = float64Ptr(*opts.TabRatio)
+	}
+	return err
+}
+
+// GetWorkbookView provides a function to get the workbook window view
+// settings. The fields of the settings will be nil if the corresponding
+// attributes are absent in the workbook, which means the default values will
+// be used by the spreadsheet application, such as 600 for the TabRatio.
+func (f *File) GetWorkbookView() (WorkbookViewOptions, error) {
+	var opts WorkbookViewOptions
+	wb, err := f.workbookReader()
+	if err != nil || wb.BookViews == nil || len(wb.BookViews.WorkBookView) == 0 {
+		return opts, err
+	}
+	view := wb.BookViews.WorkBookView[0]
+	if x, err := strconv.Atoi(view.XWindow); err == nil {
+		opts.XWindow = intPtr(x)
+	}
+	if y, err := strconv.Atoi(view.YWindow); err == nil {
+		opts.YWindow = intPtr(y)
+	}
+	if view.WindowWidth != nil {
+		opts.WindowWidth = intPtr(*view.WindowWidth)
+	}
+	if view.WindowHeight != nil {
+		opts.WindowHeight = intPtr(*view.WindowHeight)
+	}
+	if view.FirstSheet != nil {
+		opts.FirstSheet = intPtr(*view.FirstSheet)
+	}
+	if view.TabRatio != nil {
+		opts.TabRatio = float64Ptr(*view.TabRatio)
+	}
+	return opts, err
+}
+
 // ProtectWorkbook provides a function to prevent other users from viewing
 // hidden worksheets, adding, moving, deleting, or hiding worksheets, and
 // renaming worksheets in a workbook. The optional field AlgorithmName
@@ -130,6 +247,155 @@ func (f *File) UnprotectWorkbook(password ...string) error {
 	return err
 }
 
+// SetFileSharing provides a function to set the file sharing settings of the
+// workbook, which specifies whether the workbook should be opened as read-only
+// recommended and the password required to modify the workbook. The optional
+// field AlgorithmName specified hash algorithm of the password, support MD4,
+// MD5, SHA-1, SHA-256, SHA-384, and SHA-512 currently, if no hash algorithm
+// specified, will be using the legacy XOR algorithm. The UserName field
+// specifies the name of the user who reserved the workbook. This setting is
+// distinct from the protection of the workbook structure and worksheets, and
+// passing nil options will remove the file sharing settings. For example,
+// require the password "password" to modify the workbook:
+//
+//	err := f.SetFileSharing(&excelize.FileSharingOptions{
+//	    AlgorithmName:       "SHA-512",
+//	    Password:            "password",
+//	    ReadOnlyRecommended: true,
+//	    UserName:            "Excelize",
+//	})
+func (f *File) SetFileSharing(opts *FileSharingOptions) error {
+	wb, err := f.workbookReader()
+	if err != nil {
+		return err
+	}
+	if opts == nil {
+		wb.FileSharing = nil
+		return err
+	}
+	fileSharing := &xlsxFileSharing{
+		ReadOnlyRecommended: opts.ReadOnlyRecommended,
+		UserName:            opts.UserName,
+	}
+	if opts.Password != "" {
+		if opts.AlgorithmName == "" {
+			fileSharing.ReservationPassword = genSheetPasswd(opts.Password)
+		} else {
+			hashValue, saltValue, err := genISOPasswdHash(opts.Password, opts.AlgorithmName, "", int(workbookProtectionSpinCount))
+			if err != nil {
+				return err
+			}
+			fileSharing.AlgorithmName = opts.AlgorithmName
+			fileSharing.HashValue = hashValue
+			fileSharing.SaltValue = saltValue
+			fileSharing.SpinCount = int(workbookProtectionSpinCount)
+		}
+	}
+	wb.FileSharing = fileSharing
+	return err
+}
+
+// GetFileSharing provides a function to get the file sharing settings of the
+// workbook. This function returns nil options if the workbook doesn't specify
+// the file sharing settings. The HasPassword field specifies whether the
+// password is required to modify the workbook, the password or its hash will
+// not be returned.
+func (f *File) GetFileSharing() (*FileSharingOptions, error) {
+	wb, err := f.workbookReader()
+	if err != nil || wb.FileSharing == nil {
+		return nil, err
+	}
+	return &FileSharingOptions{
+		AlgorithmName:       wb.FileSharing.AlgorithmName,
+		HasPassword:         wb.FileSharing.ReservationPassword != "" || wb.FileSharing.HashValue != "",
+		ReadOnlyRecommended: wb.FileSharing.ReadOnlyRecommended,
+		UserName:            wb.FileSharing.UserName,
+	}, err
+}
+
+// AddCustomXMLPart provides a function to add a custom XML part to the
+// workbook by given XML data. The custom XML part is used to store arbitrary
+// XML data in the workbook, such as the schema bindings of the document
+// management systems. The custom XML parts of the workbook will be preserved
+// on saving. For example:
+//
+//	err := f.AddCustomXMLPart([]byte(`<project xmlns="urn:example:project"><id>42</id></project>`))
+func (f *File) AddCustomXMLPart(data []byte) error {
+	if len(data) == 0 {
+		return ErrParameterInvalid
+	}
+	decoder := f.xmlNewDecoder(bytes.NewReader(data))
+	for {
+		if _, err := decoder.Token(); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+	}
+	partID := f.countCustomXMLParts() + 1
+	itemID, err := genGUID()
+	if err != nil {
+		return err
+	}
+	itemProps, err := xml.Marshal(xlsxDatastoreItem{
+		XMLNSds: NameSpaceCustomXML, ItemID: itemID,
+	})
+	if err != nil {
+		return err
+	}
+	f.Pkg.Store(fmt.Sprintf("customXml/item%d.xml", partID), data)
+	f.Pkg.Store(fmt.Sprintf("customXml/itemProps%d.xml", partID), append([]byte(xml.Header), itemProps...))
+	f.addRels(fmt.Sprintf("customXml/_rels/item%d.xml.rels", partID), SourceRelationshipCustomXMLProps, fmt.Sprintf("itemProps%d.xml", partID), "")
+	f.addRels(f.getWorkbookRelsPath(), SourceRelationshipCustomXML, fmt.Sprintf("../customXml/item%d.xml", partID), "")
+	return f.addContentTypePart(partID, "customXmlProps")
+}
+
+// GetCustomXMLParts provides a function to get the data of all custom XML
+// parts in the workbook, in the order of the workbook relationships.
+func (f *File) GetCustomXMLParts() ([][]byte, error) {
+	var parts [][]byte
+	rels, err := f.relsReader(f.getWorkbookRelsPath())
+	if err != nil || rels == nil {
+		return parts, err
+	}
+	rels.mu.Lock()
+	defer rels.mu.Unlock()
+	for _, rel := range rels.Relationships {
+		if rel.Type != SourceRelationshipCustomXML {
+			continue
+		}
+		partPath := strings.TrimPrefix(rel.Target, "/")
+		if !strings.HasPrefix(rel.Target, "/") {
+			partPath = filepath.ToSlash(filepath.Clean("xl/" + rel.Target))
+		}
+		if data := f.readBytes(partPath); len(data) > 0 {
+			parts = append(parts, data)
+		}
+	}
+	return parts, err
+}
+
+// countCustomXMLParts provides a function to get the largest custom XML
+// parts file index storage in the folder customXml, both the custom XML data
+// and properties parts will be counted to avoid overwriting existing parts.
+func (f *File) countCustomXMLParts() int {
+	count := 0
+	f.Pkg.Range(func(k, v interface{}) bool {
+		name := strings.TrimSuffix(k.(string), ".xml")
+		for _, prefix := range []string{"customXml/itemProps", "customXml/item"} {
+			if strings.HasPrefix(name, prefix) {
+				if ID, err := strconv.Atoi(strings.TrimPrefix(name, prefix)); err == nil && ID > count {
+					count = ID
+				}
+				break
+			}
+		}
+		return true
+	})
+	return count
+}
+
 // setWorkbook update workbook property of the spreadsheet. Maximum 31
 // characters are allowed in sheet title.
 func (f *File) setWorkbook(name string, sheetID, rid int) {
@@ -278,6 +544,15 @@ func (f *File) setContentTypePartImageExtensions() error {
 	return err
 }
 
+// setContentTypePartOLEObjectExtensions provides a function to set the content
+// type for the VML drawing and image parts used by the embedded OLE objects.
+func (f *File) setContentTypePartOLEObjectExtensions() error {
+	if err := f.setContentTypePartVMLExtensions(); err != nil {
+		return err
+	}
+	return f.setContentTypePartImageExtensions()
+}
+
 // setContentTypePartVMLExtensions provides a function to set the content type
 // for relationship parts and the Main Document part.
 func (f *File) setContentTypePartVMLExtensions() error {
@@ -306,32 +581,54 @@ func (f *File) setContentTypePartVMLExtensions() error {
 // in the file [Content_Types].xml by given index and content type.
 func (f *File) addContentTypePart(index int, contentType string) error {
 	setContentType := map[string]func() error{
-		"comments": f.setContentTypePartVMLExtensions,
-		"drawings": f.setContentTypePartImageExtensions,
+		"comments":     f.setContentTypePartVMLExtensions,
+		"drawings":     f.setContentTypePartImageExtensions,
+		"oleObject":    f.setContentTypePartOLEObjectExtensions,
+		"richValueRel": f.setContentTypePartImageExtensions,
 	}
 	partNames := map[string]string{
-		"chart":         "/xl/charts/chart" + strconv.Itoa(index) + ".xml",
-		"chartsheet":    "/xl/chartsheets/sheet" + strconv.Itoa(index) + ".xml",
-		"comments":      "/xl/comments" + strconv.Itoa(index) + ".xml",
-		"drawings":      "/xl/drawings/drawing" + strconv.Itoa(index) + ".xml",
-		"table":         "/xl/tables/table" + strconv.Itoa(index) + ".xml",
-		"pivotTable":    "/xl/pivotTables/pivotTable" + strconv.Itoa(index) + ".xml",
-		"pivotCache":    "/xl/pivotCache/pivotCacheDefinition" + strconv.Itoa(index) + ".xml",
-		"sharedStrings": "/xl/sharedStrings.xml",
-		"slicer":        "/xl/slicers/slicer" + strconv.Itoa(index) + ".xml",
-		"slicerCache":   "/xl/slicerCaches/slicerCache" + strconv.Itoa(index) + ".xml",
+		"chart":                "/xl/charts/chart" + strconv.Itoa(index) + ".xml",
+		"chartsheet":           "/xl/chartsheets/sheet" + strconv.Itoa(index) + ".xml",
+		"comments":             "/xl/comments" + strconv.Itoa(index) + ".xml",
+		"customXmlProps":       "/customXml/itemProps" + strconv.Itoa(index) + ".xml",
+		"drawings":             "/xl/drawings/drawing" + strconv.Itoa(index) + ".xml",
+		"metadata":             "/" + defaultXMLMetadata,
+		"oleObject":            "/xl/embeddings/oleObject" + strconv.Itoa(index) + ".bin",
+		"person":               "/xl/persons/person.xml",
+		"table":                "/xl/tables/table" + strconv.Itoa(index) + ".xml",
+		"threadedComment":      "/xl/threadedComments/threadedComment" + strconv.Itoa(index) + ".xml",
+		"pivotTable":           "/xl/pivotTables/pivotTable" + strconv.Itoa(index) + ".xml",
+		"pivotCache":           "/xl/pivotCache/pivotCacheDefinition" + strconv.Itoa(index) + ".xml",
+		"rdRichValue":          "/" + defaultXMLRdRichValuePart,
+		"rdRichValueStructure": "/" + defaultXMLRdRichValueStructurePart,
+		"richValueRel":         "/" + defaultXMLRdRichValueRel,
+		"sharedStrings":        "/xl/sharedStrings.xml",
+		"slicer":               "/xl/slicers/slicer" + strconv.Itoa(index) + ".xml",
+		"slicerCache":          "/xl/slicerCaches/slicerCache" + strconv.Itoa(index) + ".xml",
+		"timeline":             "/xl/timelines/timeline" + strconv.Itoa(index) + ".xml",
+		"timelineCache":        "/xl/timelineCaches/timelineCache" + strconv.Itoa(index) + ".xml",
 	}
 	contentTypes := map[string]string{
-		"chart":         ContentTypeDrawingML,
-		"chartsheet":    ContentTypeSpreadSheetMLChartsheet,
-		"comments":      ContentTypeSpreadSheetMLComments,
-		"drawings":      ContentTypeDrawing,
-		"table":         ContentTypeSpreadSheetMLTable,
-		"pivotTable":    ContentTypeSpreadSheetMLPivotTable,
-		"pivotCache":    ContentTypeSpreadSheetMLPivotCacheDefinition,
-		"sharedStrings": ContentTypeSpreadSheetMLSharedStrings,
-		"slicer":        ContentTypeSlicer,
-		"slicerCache":   ContentTypeSlicerCache,
+		"chart":                ContentTypeDrawingML,
+		"chartsheet":           ContentTypeSpreadSheetMLChartsheet,
+		"comments":             ContentTypeSpreadSheetMLComments,
+		"customXmlProps":       ContentTypeCustomXMLProperties,
+		"drawings":             ContentTypeDrawing,
+		"metadata":             ContentTypeSheetMetadata,
+		"oleObject":            ContentTypeOLEObject,
+		"person":               ContentTypePerson,
+		"table":                ContentTypeSpreadSheetMLTable,
+		"threadedComment":      ContentTypeThreadedComment,
+		"pivotTable":           ContentTypeSpreadSheetMLPivotTable,
+		"pivotCache":           ContentTypeSpreadSheetMLPivotCacheDefinition,
+		"rdRichValue":          ContentTypeRdRichValue,
+		"rdRichValueStructure": ContentTypeRdRichValueStructure,
+		"richValueRel":         ContentTypeRichValueRel,
+		"sharedStrings":        ContentTypeSpreadSheetMLSharedStrings,
+		"slicer":               ContentTypeSlicer,
+		"slicerCache":          ContentTypeSlicerCache,
+		"timeline":             ContentTypeTimeline,
+		"timelineCache":        ContentTypeTimelineCache,
 	}
 	s, ok := setContentType[contentType]
 	if ok {
@@ -1,7 +1,10 @@
 package excelize
 
 import (
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -32,6 +35,105 @@ func TestWorkbookProps(t *testing.T) {
 	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
 }
 
+func TestSetWorkbookDate1904(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetWorkbookDate1904(true))
+	opts, err := f.GetWorkbookProps()
+	assert.NoError(t, err)
+	assert.True(t, *opts.Date1904)
+	// Test set and get cell date value with the 1904 date system
+	assert.NoError(t, f.SetCellValue("Sheet1", "A1", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+	val, err := f.GetCellValue("Sheet1", "A1", Options{RawCellValue: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "43830", val)
+	val, err = f.GetCellValue("Sheet1", "A1")
+	assert.NoError(t, err)
+	assert.Equal(t, "1/1/24 00:00", val)
+	assert.NoError(t, f.SetWorkbookDate1904(false))
+	val, err = f.GetCellValue("Sheet1", "A1")
+	assert.NoError(t, err)
+	assert.Equal(t, "12/31/19 00:00", val)
+	// Test set workbook date system with unsupported charset workbook
+	f.WorkBook = nil
+	f.Pkg.Store(defaultXMLPathWorkbook, MacintoshCyrillicCharset)
+	assert.EqualError(t, f.SetWorkbookDate1904(true), "XML syntax error on line 1: invalid UTF-8")
+	f.WorkBook = nil
+	_, err = f.isDate1904()
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+}
+
+func TestWorkbookView(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetWorkbookView(nil))
+	_, err := f.NewSheet("Sheet2")
+	assert.NoError(t, err)
+	f.WorkBook.BookViews = nil
+	opts, err := f.GetWorkbookView()
+	assert.NoError(t, err)
+	assert.Equal(t, WorkbookViewOptions{}, opts)
+	expected := WorkbookViewOptions{
+		XWindow:      intPtr(-120),
+		YWindow:      intPtr(105),
+		WindowWidth:  intPtr(14805),
+		WindowHeight: intPtr(8010),
+		FirstSheet:   intPtr(1),
+		TabRatio:     float64Ptr(750),
+	}
+	assert.NoError(t, f.SetWorkbookView(&expected))
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestWorkbookView.xlsx")))
+	assert.NoError(t, f.Close())
+
+	f, err = OpenFile(filepath.Join("test", "TestWorkbookView.xlsx"))
+	assert.NoError(t, err)
+	opts, err = f.GetWorkbookView()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, opts)
+	// Test get workbook view with absent attributes
+	f.WorkBook.BookViews.WorkBookView[0] = xlsxWorkBookView{WindowWidth: intPtr(14805)}
+	opts, err = f.GetWorkbookView()
+	assert.NoError(t, err)
+	assert.Equal(t, WorkbookViewOptions{WindowWidth: intPtr(14805)}, opts)
+	// Test set and get workbook view with zero values
+	zero := WorkbookViewOptions{
+		XWindow:      intPtr(0),
+		YWindow:      intPtr(0),
+		WindowWidth:  intPtr(0),
+		WindowHeight: intPtr(0),
+		FirstSheet:   intPtr(0),
+		TabRatio:     float64Ptr(0),
+	}
+	assert.NoError(t, f.SetWorkbookView(&zero))
+	buf, err := f.WriteToBuffer()
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+	f, err = OpenReader(buf)
+	assert.NoError(t, err)
+	opts, err = f.GetWorkbookView()
+	assert.NoError(t, err)
+	assert.Equal(t, zero, opts)
+	// Test set workbook view with invalid settings
+	for _, opts := range []*WorkbookViewOptions{
+		{WindowWidth: intPtr(-1)},
+		{WindowHeight: intPtr(-1)},
+		{TabRatio: float64Ptr(-1)},
+		{TabRatio: float64Ptr(1001)},
+	} {
+		assert.Equal(t, ErrParameterInvalid, f.SetWorkbookView(opts))
+	}
+	assert.Equal(t, ErrSheetIdx, f.SetWorkbookView(&WorkbookViewOptions{FirstSheet: intPtr(2)}))
+	assert.NoError(t, f.Close())
+	// Test set workbook view with unsupported charset workbook
+	f = NewFile()
+	f.WorkBook = nil
+	f.Pkg.Store(defaultXMLPathWorkbook, MacintoshCyrillicCharset)
+	assert.EqualError(t, f.SetWorkbookView(&expected), "XML syntax error on line 1: invalid UTF-8")
+	// Test get workbook view with unsupported charset workbook
+	f.WorkBook = nil
+	f.Pkg.Store(defaultXMLPathWorkbook, MacintoshCyrillicCharset)
+	_, err = f.GetWorkbookView()
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+}
+
 func TestDeleteWorkbookRels(t *testing.T) {
 	f := NewFile()
 	// Test delete pivot table without worksheet relationships
@@ -41,3 +143,108 @@ func TestDeleteWorkbookRels(t *testing.T) {
 	assert.Empty(t, rID)
 	assert.NoError(t, err)
 }
+
+func TestCustomXMLParts(t *testing.T) {
+	f := NewFile()
+	parts, err := f.GetCustomXMLParts()
+	assert.NoError(t, err)
+	assert.Empty(t, parts)
+	expected := [][]byte{
+		[]byte(`<project xmlns="urn:example:project"><id>42</id></project>`),
+		[]byte(`<metadata xmlns="urn:example:metadata"><owner>Sales</owner></metadata>`),
+	}
+	for _, data := range expected {
+		assert.NoError(t, f.AddCustomXMLPart(data))
+	}
+	parts, err = f.GetCustomXMLParts()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, parts)
+	assert.Equal(t, 2, f.countCustomXMLParts())
+	// Test count the custom XML parts with the largest index
+	f.Pkg.Delete("customXml/item1.xml")
+	assert.Equal(t, 2, f.countCustomXMLParts())
+	f.Pkg.Store("customXml/itemProps5.xml", nil)
+	f.Pkg.Store("customXml/item.xml", nil)
+	assert.Equal(t, 5, f.countCustomXMLParts())
+	f.Pkg.Store("customXml/item1.xml", expected[0])
+	f.Pkg.Delete("customXml/itemProps5.xml")
+	f.Pkg.Delete("customXml/item.xml")
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestCustomXMLParts.xlsx")))
+	assert.NoError(t, f.Close())
+
+	f, err = OpenFile(filepath.Join("test", "TestCustomXMLParts.xlsx"))
+	assert.NoError(t, err)
+	parts, err = f.GetCustomXMLParts()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, parts)
+	rels, err := f.relsReader("customXml/_rels/item2.xml.rels")
+	assert.NoError(t, err)
+	assert.Equal(t, SourceRelationshipCustomXMLProps, rels.Relationships[0].Type)
+	assert.Equal(t, "itemProps2.xml", rels.Relationships[0].Target)
+	assert.Contains(t, string(f.readBytes("customXml/itemProps2.xml")), NameSpaceCustomXML)
+	// Test add custom XML part with invalid parameters
+	assert.Equal(t, ErrParameterInvalid, f.AddCustomXMLPart(nil))
+	assert.Error(t, f.AddCustomXMLPart([]byte("<project><x")))
+	assert.NoError(t, f.Close())
+
+	// Test add custom XML part with unsupported charset content types
+	f = NewFile()
+	f.ContentTypes = nil
+	f.Pkg.Store(defaultXMLPathContentTypes, MacintoshCyrillicCharset)
+	assert.EqualError(t, f.AddCustomXMLPart(expected[0]), "XML syntax error on line 1: invalid UTF-8")
+	// Test get custom XML parts with unsupported charset workbook relationships
+	f = NewFile()
+	f.Relationships.Delete(defaultXMLPathWorkbookRels)
+	f.Pkg.Store(defaultXMLPathWorkbookRels, MacintoshCyrillicCharset)
+	_, err = f.GetCustomXMLParts()
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+	assert.NoError(t, f.Close())
+}
+
+func TestFileSharing(t *testing.T) {
+	f := NewFile()
+	opts, err := f.GetFileSharing()
+	assert.NoError(t, err)
+	assert.Nil(t, opts)
+	// Test set file sharing with the legacy password hash algorithm
+	assert.NoError(t, f.SetFileSharing(&FileSharingOptions{Password: "password", ReadOnlyRecommended: true}))
+	wb, err := f.workbookReader()
+	assert.NoError(t, err)
+	assert.Equal(t, &xlsxFileSharing{ReadOnlyRecommended: true, ReservationPassword: "83AF"}, wb.FileSharing)
+	opts, err = f.GetFileSharing()
+	assert.NoError(t, err)
+	assert.Equal(t, &FileSharingOptions{HasPassword: true, ReadOnlyRecommended: true}, opts)
+	// Test set file sharing with the ISO password hash algorithm
+	assert.NoError(t, f.SetFileSharing(&FileSharingOptions{AlgorithmName: "SHA-512", Password: "password", UserName: "Excelize"}))
+	assert.Equal(t, "SHA-512", wb.FileSharing.AlgorithmName)
+	assert.Len(t, wb.FileSharing.SaltValue, 24)
+	assert.Len(t, wb.FileSharing.HashValue, 88)
+	assert.Equal(t, int(workbookProtectionSpinCount), wb.FileSharing.SpinCount)
+	assert.NoError(t, f.SaveAs(filepath.Join("test", "TestFileSharing.xlsx")))
+	assert.NoError(t, f.Close())
+
+	f, err = OpenFile(filepath.Join("test", "TestFileSharing.xlsx"))
+	assert.NoError(t, err)
+	opts, err = f.GetFileSharing()
+	assert.NoError(t, err)
+	assert.Equal(t, &FileSharingOptions{AlgorithmName: "SHA-512", HasPassword: true, UserName: "Excelize"}, opts)
+	// Test set file sharing with invalid password
+	assert.Equal(t, ErrPasswordLengthInvalid, f.SetFileSharing(&FileSharingOptions{AlgorithmName: "MD4", Password: strings.Repeat("s", MaxFieldLength+1)}))
+	assert.Equal(t, ErrUnsupportedHashAlgorithm, f.SetFileSharing(&FileSharingOptions{AlgorithmName: "RIPEMD-160", Password: "password"}))
+	// Test remove file sharing settings
+	assert.NoError(t, f.SetFileSharing(nil))
+	opts, err = f.GetFileSharing()
+	assert.NoError(t, err)
+	assert.Nil(t, opts)
+	assert.NoError(t, f.Close())
+
+	// Test set and get file sharing with unsupported charset workbook
+	f = NewFile()
+	f.WorkBook = nil
+	f.Pkg.Store(defaultXMLPathWorkbook, MacintoshCyrillicCharset)
+	assert.EqualError(t, f.SetFileSharing(nil), "XML syntax error on line 1: invalid UTF-8")
+	f.WorkBook = nil
+	_, err = f.GetFileSharing()
+	assert.EqualError(t, err, "XML syntax error on line 1: invalid UTF-8")
+	assert.NoError(t, f.Close())
+}
@@ -18,12 +18,23 @@ type AppProperties struct {
 	Application       string
 	ScaleCrop         bool
 	DocSecurity       int
+	Manager           string
 	Company           string
 	LinksUpToDate     bool
 	HyperlinksChanged bool
 	AppVersion        string
 }
 
+// This section defines the flags of the document security, the flags can be
+// combined by bitwise OR except that the document can't be both recommended
+// and enforced to be opened as read-only.
+const (
+	DocSecurityPasswordProtected   = 1
+	DocSecurityReadOnlyRecommended = 2
+	DocSecurityReadOnlyEnforced    = 4
+	DocSecurityLockedForAnnotation = 8
+)
+
 // xlsxProperties specifies to an OOXML document properties such as the
 // template used, the number of pages and words, and the application name and
 // version.
@@ -67,6 +67,7 @@ type cTitle struct {
 type cTx struct {
 	StrRef *cStrRef `xml:"strRef"`
 	Rich   *cRich   `xml:"rich,omitempty"`
+	V      *string  `xml:"v"`
 }
 
 // cRich (Rich Text) directly maps the rich element. This element contains a
@@ -116,7 +117,9 @@ type aP struct {
 // formatting, since they are directly applied to the paragraph and supersede
 // any formatting from styles.
 type aPPr struct {
-	DefRPr aRPr `xml:"a:defRPr"`
+	Algn   string `xml:"algn,attr,omitempty"`
+	Rtl    bool   `xml:"rtl,attr,omitempty"`
+	DefRPr aRPr   `xml:"a:defRPr"`
 }
 
 // aSolidFill (Solid Fill) directly maps the solidFill element. This element
@@ -300,26 +303,27 @@ type cView3D struct {
 // cPlotArea directly maps the plotArea element. This element specifies the
 // plot area of the chart.
 type cPlotArea struct {
-	Layout         *string  `xml:"layout"`
-	AreaChart      *cCharts `xml:"areaChart"`
-	Area3DChart    *cCharts `xml:"area3DChart"`
-	BarChart       *cCharts `xml:"barChart"`
-	Bar3DChart     *cCharts `xml:"bar3DChart"`
-	BubbleChart    *cCharts `xml:"bubbleChart"`
-	DoughnutChart  *cCharts `xml:"doughnutChart"`
-	LineChart      *cCharts `xml:"lineChart"`
-	Line3DChart    *cCharts `xml:"line3DChart"`
-	PieChart       *cCharts `xml:"pieChart"`
-	Pie3DChart     *cCharts `xml:"pie3DChart"`
-	OfPieChart     *cCharts `xml:"ofPieChart"`
-	RadarChart     *cCharts `xml:"radarChart"`
-	ScatterChart   *cCharts `xml:"scatterChart"`
-	Surface3DChart *cCharts `xml:"surface3DChart"`
-	SurfaceChart   *cCharts `xml:"surfaceChart"`
-	CatAx          []*cAxs  `xml:"catAx"`
-	ValAx          []*cAxs  `xml:"valAx"`
-	SerAx          []*cAxs  `xml:"serAx"`
-	SpPr           *cSpPr   `xml:"spPr"`
+	Layout         *string    `xml:"layout"`
+	AreaChart      []*cCharts `xml:"areaChart"`
+	Area3DChart    []*cCharts `xml:"area3DChart"`
+	BarChart       []*cCharts `xml:"barChart"`
+	Bar3DChart     []*cCharts `xml:"bar3DChart"`
+	BubbleChart    []*cCharts `xml:"bubbleChart"`
+	DoughnutChart  []*cCharts `xml:"doughnutChart"`
+	LineChart      []*cCharts `xml:"lineChart"`
+	Line3DChart    []*cCharts `xml:"line3DChart"`
+	PieChart       []*cCharts `xml:"pieChart"`
+	Pie3DChart     []*cCharts `xml:"pie3DChart"`
+	OfPieChart     []*cCharts `xml:"ofPieChart"`
+	RadarChart     []*cCharts `xml:"radarChart"`
+	ScatterChart   []*cCharts `xml:"scatterChart"`
+	Surface3DChart []*cCharts `xml:"surface3DChart"`
+	SurfaceChart   []*cCharts `xml:"surfaceChart"`
+	CatAx          []*cAxs    `xml:"catAx"`
+	ValAx          []*cAxs    `xml:"valAx"`
+	DateAx         []*cDateAx `xml:"dateAx"`
+	SerAx          []*cAxs    `xml:"serAx"`
+	SpPr           *cSpPr     `xml:"spPr"`
 }
 
 // cCharts specifies the common element of the chart.
@@ -363,6 +367,7 @@ type cAxs struct {
 	CrossBetween   *attrValString `xml:"crossBetween"`
 	MajorUnit      *attrValFloat  `xml:"majorUnit"`
 	MinorUnit      *attrValFloat  `xml:"minorUnit"`
+	DispUnits      *cDispUnits    `xml:"dispUnits"`
 	Auto           *attrValBool   `xml:"auto"`
 	LblAlgn        *attrValString `xml:"lblAlgn"`
 	LblOffset      *attrValInt    `xml:"lblOffset"`
@@ -371,6 +376,48 @@ type cAxs struct {
 	NoMultiLvlLbl  *attrValBool   `xml:"noMultiLvlLbl"`
 }
 
+// cDispUnits directly maps the dispUnits element. This element specifies the
+// scaling value of the display units for the value axis.
+type cDispUnits struct {
+	CustUnit     *attrValFloat  `xml:"custUnit"`
+	BuiltInUnit  *attrValString `xml:"builtInUnit"`
+	DispUnitsLbl *cDispUnitsLbl `xml:"dispUnitsLbl"`
+}
+
+// cDispUnitsLbl directly maps the dispUnitsLbl element. This element
+// specifies the display units label.
+type cDispUnitsLbl struct {
+	Layout *string `xml:"layout"`
+}
+
+// cDateAx directly maps the dateAx element. This element specifies a date
+// axis, the data points on the date axis are spaced by the actual date
+// intervals.
+type cDateAx struct {
+	AxID           *attrValInt    `xml:"axId"`
+	Scaling        *cScaling      `xml:"scaling"`
+	Delete         *attrValBool   `xml:"delete"`
+	AxPos          *attrValString `xml:"axPos"`
+	MajorGridlines *cChartLines   `xml:"majorGridlines"`
+	MinorGridlines *cChartLines   `xml:"minorGridlines"`
+	Title          *cTitle        `xml:"title"`
+	NumFmt         *cNumFmt       `xml:"numFmt"`
+	MajorTickMark  *attrValString `xml:"majorTickMark"`
+	MinorTickMark  *attrValString `xml:"minorTickMark"`
+	TickLblPos     *attrValString `xml:"tickLblPos"`
+	SpPr           *cSpPr         `xml:"spPr"`
+	TxPr           *cTxPr         `xml:"txPr"`
+	CrossAx        *attrValInt    `xml:"crossAx"`
+	Crosses        *attrValString `xml:"crosses"`
+	Auto           *attrValBool   `xml:"auto"`
+	LblOffset      *attrValInt    `xml:"lblOffset"`
+	BaseTimeUnit   *attrValString `xml:"baseTimeUnit"`
+	MajorUnit      *attrValFloat  `xml:"majorUnit"`
+	MajorTimeUnit  *attrValString `xml:"majorTimeUnit"`
+	MinorUnit      *attrValFloat  `xml:"minorUnit"`
+	MinorTimeUnit  *attrValString `xml:"minorTimeUnit"`
+}
+
 // cChartLines directly maps the chart lines content model.
 type cChartLines struct {
 	SpPr *cSpPr `xml:"spPr"`
@@ -490,6 +537,7 @@ type cDLbls struct {
 	ShowSerName     *attrValBool   `xml:"showSerName"`
 	ShowPercent     *attrValBool   `xml:"showPercent"`
 	ShowBubbleSize  *attrValBool   `xml:"showBubbleSize"`
+	Separator       *string        `xml:"separator"`
 	ShowLeaderLines *attrValBool   `xml:"showLeaderLines"`
 }
 
@@ -530,20 +578,26 @@ type ChartNumFmt struct {
 
 // ChartAxis directly maps the format settings of the chart axis.
 type ChartAxis struct {
-	None           bool
-	MajorGridLines bool
-	MinorGridLines bool
-	MajorUnit      float64
-	TickLabelSkip  int
-	ReverseOrder   bool
-	Secondary      bool
-	Maximum        *float64
-	Minimum        *float64
-	Font           Font
-	LogBase        float64
-	NumFmt         ChartNumFmt
-	Title          []RichTextRun
-	axID           int
+	None             bool
+	MajorGridLines   bool
+	MinorGridLines   bool
+	MajorUnit        float64
+	TickLabelSkip    int
+	ReverseOrder     bool
+	Secondary        bool
+	Maximum          *float64
+	Minimum          *float64
+	Font             Font
+	LogBase          float64
+	NumFmt           ChartNumFmt
+	Title            []RichTextRun
+	Date             bool
+	BaseTimeUnit     string
+	MajorTimeUnit    string
+	MinorTimeUnit    string
+	DisplayUnit      string
+	DisplayUnitLabel bool
+	axID             int
 }
 
 // ChartDimension directly maps the dimension of the chart.
@@ -554,15 +608,16 @@ type ChartDimension struct {
 
 // ChartPlotArea directly maps the format settings of the plot area.
 type ChartPlotArea struct {
-	SecondPlotValues int
-	ShowBubbleSize   bool
-	ShowCatName      bool
-	ShowLeaderLines  bool
-	ShowPercent      bool
-	ShowSerName      bool
-	ShowVal          bool
-	Fill             Fill
-	NumFmt           ChartNumFmt
+	SecondPlotValues   int
+	ShowBubbleSize     bool
+	ShowCatName        bool
+	ShowLeaderLines    bool
+	ShowPercent        bool
+	ShowSerName        bool
+	ShowVal            bool
+	DataLabelSeparator string
+	Fill               Fill
+	NumFmt             ChartNumFmt
 }
 
 // Chart directly maps the format settings of the chart.
@@ -583,6 +638,7 @@ type Chart struct {
 	BubbleSize   int
 	HoleSize     int
 	order        int
+	seriesIdx    []int
 }
 
 // ChartLegend directly maps the format settings of the chart legend.
@@ -607,12 +663,15 @@ type ChartLine struct {
 
 // ChartSeries directly maps the format settings of the chart series.
 type ChartSeries struct {
-	Name              string
-	Categories        string
-	Values            string
-	Sizes             string
-	Fill              Fill
-	Line              ChartLine
-	Marker            ChartMarker
-	DataLabelPosition ChartDataLabelPositionType
+	Name               string
+	NameRef            bool
+	Categories         string
+	Values             string
+	Sizes              string
+	Fill               Fill
+	Line               ChartLine
+	Marker             ChartMarker
+	DataLabelPosition  ChartDataLabelPositionType
+	DataLabelSeparator string
+	Secondary          bool
 }
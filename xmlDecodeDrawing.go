@@ -21,7 +21,9 @@ type decodeCellAnchor struct {
 	EditAs           string                  `xml:"editAs,attr,omitempty"`
 	From             *decodeFrom             `xml:"from"`
 	To               *decodeTo               `xml:"to"`
+	Ext              *decodeAExt             `xml:"ext"`
 	Sp               *decodeSp               `xml:"sp"`
+	CxnSp            *decodeCxnSp            `xml:"cxnSp"`
 	Pic              *decodePic              `xml:"pic"`
 	ClientData       *decodeClientData       `xml:"clientData"`
 	AlternateContent []*xlsxAlternateContent `xml:"mc:AlternateContent"`
@@ -58,6 +60,17 @@ type decodeSp struct {
 	SpPr   *decodeSpPr   `xml:"spPr"`
 }
 
+// decodeCxnSp defines the structure used to deserialize the cxnSp element.
+type decodeCxnSp struct {
+	NvCxnSpPr *decodeNvCxnSpPr `xml:"nvCxnSpPr"`
+}
+
+// decodeNvCxnSpPr directly maps the nvCxnSpPr (Non-Visual Properties for a
+// Connection Shape) element.
+type decodeNvCxnSpPr struct {
+	CNvPr *decodeCNvPr `xml:"cNvPr"`
+}
+
 // decodeSp (Non-Visual Properties for a Shape) directly maps the nvSpPr
 // element. This element specifies all non-visual properties for a shape. This
 // element is a container for the non-visual identification properties, shape
@@ -97,11 +110,18 @@ type decodeWsDr struct {
 // information that does not affect the appearance of the picture to be
 // stored.
 type decodeCNvPr struct {
-	XMLName xml.Name `xml:"cNvPr"`
-	ID      int      `xml:"id,attr"`
-	Name    string   `xml:"name,attr"`
-	Descr   string   `xml:"descr,attr"`
-	Title   string   `xml:"title,attr,omitempty"`
+	XMLName    xml.Name          `xml:"cNvPr"`
+	ID         int               `xml:"id,attr"`
+	Name       string            `xml:"name,attr"`
+	Descr      string            `xml:"descr,attr"`
+	Title      string            `xml:"title,attr,omitempty"`
+	HlinkClick *decodeHlinkClick `xml:"hlinkClick"`
+}
+
+// decodeHlinkClick directly maps the hlinkClick (Click Hyperlink) element of
+// the drawing object.
+type decodeHlinkClick struct {
+	RID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
 }
 
 // decodePicLocks directly maps the picLocks (Picture Locks). This element
@@ -254,3 +274,36 @@ type decodeCellImages struct {
 type decodeCellImage struct {
 	Pic decodePic `xml:"pic"`
 }
+
+// decodeChartAnchor defines the structure used to parse the anchor cell and
+// the relationship ID of the chart in the graphic frame of the cell anchor.
+type decodeChartAnchor struct {
+	From  *decodeFrom `xml:"from"`
+	Chart *struct {
+		RID string `xml:"id,attr"`
+	} `xml:"graphicFrame>graphic>graphicData>chart"`
+}
+
+// decodeChartSpace defines the structure used to parse the axes of the plot
+// area in the chart part.
+type decodeChartSpace struct {
+	PlotArea struct {
+		CatAx  []decodeChartAxis `xml:"catAx"`
+		DateAx []decodeChartAxis `xml:"dateAx"`
+		ValAx  []decodeChartAxis `xml:"valAx"`
+	} `xml:"chart>plotArea"`
+}
+
+// decodeChartAxis defines the structure used to parse the title of the chart
+// axis, which could be the rich text or the cached value of the string
+// reference.
+type decodeChartAxis struct {
+	Title *struct {
+		P []struct {
+			R []struct {
+				T string `xml:"t"`
+			} `xml:"r"`
+		} `xml:"tx>rich>p"`
+		V []string `xml:"tx>strRef>strCache>pt>v"`
+	} `xml:"title"`
+}
@@ -102,8 +102,20 @@ type xlsxPrstGeom struct {
 // frame. This transformation is applied to the graphic frame just as it would
 // be for a shape or group shape.
 type xlsxXfrm struct {
-	Off xlsxOff `xml:"a:off"`
-	Ext aExt    `xml:"a:ext"`
+	FlipH bool    `xml:"flipH,attr,omitempty"`
+	FlipV bool    `xml:"flipV,attr,omitempty"`
+	Off   xlsxOff `xml:"a:off"`
+	Ext   aExt    `xml:"a:ext"`
+}
+
+// xlsxGrpXfrm directly maps the a:xfrm element of the group shape properties.
+// The child offset and extents specify the coordinate space of the shapes
+// inside the group.
+type xlsxGrpXfrm struct {
+	Off   xlsxOff `xml:"a:off"`
+	Ext   aExt    `xml:"a:ext"`
+	ChOff xlsxOff `xml:"a:chOff"`
+	ChExt aExt    `xml:"a:chExt"`
 }
 
 // xlsxCNvPicPr directly maps the cNvPicPr (Non-Visual Picture Drawing
@@ -163,6 +175,14 @@ type xlsxBlipFill struct {
 type xlsxLineProperties struct {
 	W         int           `xml:"w,attr,omitempty"`
 	SolidFill *xlsxInnerXML `xml:"a:solidFill"`
+	HeadEnd   *aLineEnd     `xml:"a:headEnd"`
+	TailEnd   *aLineEnd     `xml:"a:tailEnd"`
+}
+
+// aLineEnd directly maps the a:headEnd and a:tailEnd element. This element
+// specifies decorations which can be added to the head or tail of a line.
+type aLineEnd struct {
+	Type string `xml:"type,attr,omitempty"`
 }
 
 // xlsxSpPr directly maps the spPr (Shape Properties). This element specifies
@@ -224,6 +244,7 @@ type xdrCellAnchor struct {
 	To               *xlsxTo                 `xml:"xdr:to"`
 	Ext              *aExt                   `xml:"xdr:ext"`
 	Sp               *xdrSp                  `xml:"xdr:sp"`
+	CxnSp            *xdrCxnSp               `xml:"xdr:cxnSp"`
 	Pic              *xlsxPic                `xml:"xdr:pic,omitempty"`
 	GraphicFrame     string                  `xml:",innerxml"`
 	AlternateContent []*xlsxAlternateContent `xml:"mc:AlternateContent"`
@@ -290,8 +311,26 @@ type xlsxGraphicFrame struct {
 // information that does not affect the appearance of the graphic frame to be
 // stored.
 type xlsxNvGraphicFramePr struct {
-	CNvPr                *xlsxCNvPr `xml:"xdr:cNvPr"`
-	ChicNvGraphicFramePr string     `xml:"xdr:cNvGraphicFramePr"`
+	CNvPr             *xlsxCNvPr            `xml:"xdr:cNvPr"`
+	CNvGraphicFramePr xlsxCNvGraphicFramePr `xml:"xdr:cNvGraphicFramePr"`
+}
+
+// xlsxCNvGraphicFramePr directly maps the cNvGraphicFramePr (Non-Visual
+// Graphic Frame Drawing Properties). This element specifies the non-visual
+// drawing properties for a graphic frame.
+type xlsxCNvGraphicFramePr struct {
+	GraphicFrameLocks *xlsxGraphicFrameLocks `xml:"a:graphicFrameLocks"`
+}
+
+// xlsxGraphicFrameLocks directly maps the graphicFrameLocks (Graphic Frame
+// Locks). This element specifies all locking properties for a graphic frame.
+type xlsxGraphicFrameLocks struct {
+	NoGrp          bool `xml:"noGrp,attr,omitempty"`
+	NoDrilldown    bool `xml:"noDrilldown,attr,omitempty"`
+	NoSelect       bool `xml:"noSelect,attr,omitempty"`
+	NoChangeAspect bool `xml:"noChangeAspect,attr,omitempty"`
+	NoMove         bool `xml:"noMove,attr,omitempty"`
+	NoResize       bool `xml:"noResize,attr,omitempty"`
 }
 
 // xlsxGraphic (Graphic Object) directly maps the a:graphic element. This
@@ -311,6 +350,7 @@ type xlsxGraphicData struct {
 	URI   string     `xml:"uri,attr"`
 	Chart *xlsxChart `xml:"c:chart,omitempty"`
 	Sle   *xlsxSle   `xml:"sle:slicer"`
+	Tsle  *xlsxTsle  `xml:"tsle:timeslicer"`
 }
 
 type xlsxSle struct {
@@ -318,6 +358,12 @@ type xlsxSle struct {
 	Name  string `xml:"name,attr"`
 }
 
+// xlsxTsle directly maps the tsle:timeslicer element.
+type xlsxTsle struct {
+	XMLNS string `xml:"xmlns:tsle,attr"`
+	Name  string `xml:"name,attr"`
+}
+
 // xlsxChart (Chart) directly maps the c:chart element.
 type xlsxChart struct {
 	C   string `xml:"xmlns:c,attr"`
@@ -361,6 +407,57 @@ type xdrCNvSpPr struct {
 	TxBox bool `xml:"txBox,attr"`
 }
 
+// xdrCxnSp (Connection Shape) directly maps the xdr:cxnSp element. This
+// element specifies a connection shape that is used to connect two sp
+// elements.
+type xdrCxnSp struct {
+	XMLName   xml.Name      `xml:"xdr:cxnSp"`
+	Macro     string        `xml:"macro,attr"`
+	NvCxnSpPr *xdrNvCxnSpPr `xml:"xdr:nvCxnSpPr"`
+	SpPr      *xlsxSpPr     `xml:"xdr:spPr"`
+	Style     *xdrStyle     `xml:"xdr:style"`
+}
+
+// xdrNvCxnSpPr (Non-Visual Properties for a Connection Shape) directly maps
+// the xdr:nvCxnSpPr element. This element specifies all non-visual properties
+// for a connection shape.
+type xdrNvCxnSpPr struct {
+	CNvPr      *xlsxCNvPr     `xml:"xdr:cNvPr"`
+	CNvCxnSpPr *xdrCNvCxnSpPr `xml:"xdr:cNvCxnSpPr"`
+}
+
+// xdrCNvCxnSpPr (Non-Visual Connector Shape Drawing Properties) directly maps
+// the xdr:cNvCxnSpPr element. This element specifies the shapes to which the
+// connection shape is connected.
+type xdrCNvCxnSpPr struct {
+	StCxn  *aCxn `xml:"a:stCxn"`
+	EndCxn *aCxn `xml:"a:endCxn"`
+}
+
+// aCxn directly maps the a:stCxn and a:endCxn element. This element specifies
+// the shape ID and the index of the connection site of the connected shape.
+type aCxn struct {
+	ID  int `xml:"id,attr"`
+	Idx int `xml:"idx,attr"`
+}
+
+// xdrNvGrpSpPr (Non-Visual Properties for a Group Shape) directly maps the
+// xdr:nvGrpSpPr element. This element specifies all non-visual properties for
+// a group shape.
+type xdrNvGrpSpPr struct {
+	XMLName    xml.Name      `xml:"xdr:nvGrpSpPr"`
+	CNvPr      *xlsxCNvPr    `xml:"xdr:cNvPr"`
+	CNvGrpSpPr *xlsxInnerXML `xml:"xdr:cNvGrpSpPr"`
+}
+
+// xdrGrpSpPr (Group Shape Properties) directly maps the xdr:grpSpPr element.
+// This element specifies the properties that are to be common across all of
+// the shapes within the corresponding group.
+type xdrGrpSpPr struct {
+	XMLName xml.Name     `xml:"xdr:grpSpPr"`
+	Xfrm    *xlsxGrpXfrm `xml:"a:xfrm"`
+}
+
 // xdrStyle (Shape Style) directly maps the xdr:style element. The element
 // specifies the style that is applied to a shape and the corresponding
 // references for each of the style components such as lines and fills.
@@ -415,12 +512,27 @@ type Picture struct {
 	InsertType PictureInsertType
 }
 
+// PictureInfo directly maps the anchor, size and format settings of the
+// picture in the worksheet.
+type PictureInfo struct {
+	Cell       string
+	Name       string
+	Extension  string
+	File       []byte
+	Width      int
+	Height     int
+	Format     *GraphicOptions
+	InsertType PictureInsertType
+}
+
 // GraphicOptions directly maps the format settings of the picture.
 type GraphicOptions struct {
 	AltText             string
+	AltTextTitle        string
 	PrintObject         *bool
 	Locked              *bool
 	LockAspectRatio     bool
+	DisableResize       bool
 	AutoFit             bool
 	AutoFitIgnoreAspect bool
 	OffsetX             int
@@ -443,6 +555,20 @@ type Shape struct {
 	Fill      Fill
 	Line      ShapeLine
 	Paragraph []RichTextRun
+	Name      string
+}
+
+// ShapeConnector directly maps the settings of the connector shape.
+type ShapeConnector struct {
+	Name       string
+	Type       string
+	StartShape string
+	EndShape   string
+	BeginArrow string
+	EndArrow   string
+	Macro      string
+	Line       ShapeLine
+	Format     GraphicOptions
 }
 
 // ShapeLine directly maps the line settings of the shape.
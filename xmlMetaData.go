@@ -24,7 +24,10 @@ import "encoding/xml"
 // can be propagated along with the value as it is referenced in formulas.
 type xlsxMetadata struct {
 	XMLName         xml.Name             `xml:"metadata"`
-	MetadataTypes   *xlsxInnerXML        `xml:"metadataTypes"`
+	XMLNS           string               `xml:"xmlns,attr"`
+	XMLNSXda        string               `xml:"xmlns:xda,attr,omitempty"`
+	XMLNSXlrd       string               `xml:"xmlns:xlrd,attr,omitempty"`
+	MetadataTypes   *xlsxMetadataTypes   `xml:"metadataTypes"`
 	MetadataStrings *xlsxInnerXML        `xml:"metadataStrings"`
 	MdxMetadata     *xlsxInnerXML        `xml:"mdxMetadata"`
 	FutureMetadata  []xlsxFutureMetadata `xml:"futureMetadata"`
@@ -33,9 +36,52 @@ type xlsxMetadata struct {
 	ExtLst          *xlsxInnerXML        `xml:"extLst"`
 }
 
+// xlsxMetadataTypes directly maps the metadataTypes element. This element
+// represents the types of metadata in the workbook.
+type xlsxMetadataTypes struct {
+	Count        int                `xml:"count,attr,omitempty"`
+	MetadataType []xlsxMetadataType `xml:"metadataType"`
+}
+
+// xlsxMetadataType directly maps the metadataType element. This element
+// represents a single metadata type and the behavior of the metadata when the
+// cells are edited.
+type xlsxMetadataType struct {
+	Name                string `xml:"name,attr"`
+	MinSupportedVersion int    `xml:"minSupportedVersion,attr"`
+	GhostRow            bool   `xml:"ghostRow,attr,omitempty"`
+	GhostCol            bool   `xml:"ghostCol,attr,omitempty"`
+	Edit                bool   `xml:"edit,attr,omitempty"`
+	Delete              bool   `xml:"delete,attr,omitempty"`
+	Copy                bool   `xml:"copy,attr,omitempty"`
+	PasteAll            bool   `xml:"pasteAll,attr,omitempty"`
+	PasteFormulas       bool   `xml:"pasteFormulas,attr,omitempty"`
+	PasteValues         bool   `xml:"pasteValues,attr,omitempty"`
+	PasteFormats        bool   `xml:"pasteFormats,attr,omitempty"`
+	PasteComments       bool   `xml:"pasteComments,attr,omitempty"`
+	PasteDataValidation bool   `xml:"pasteDataValidation,attr,omitempty"`
+	PasteBorders        bool   `xml:"pasteBorders,attr,omitempty"`
+	PasteColWidths      bool   `xml:"pasteColWidths,attr,omitempty"`
+	PasteNumberFormats  bool   `xml:"pasteNumberFormats,attr,omitempty"`
+	Merge               bool   `xml:"merge,attr,omitempty"`
+	SplitFirst          bool   `xml:"splitFirst,attr,omitempty"`
+	SplitAll            bool   `xml:"splitAll,attr,omitempty"`
+	RowColShift         bool   `xml:"rowColShift,attr,omitempty"`
+	ClearAll            bool   `xml:"clearAll,attr,omitempty"`
+	ClearFormats        bool   `xml:"clearFormats,attr,omitempty"`
+	ClearContents       bool   `xml:"clearContents,attr,omitempty"`
+	ClearComments       bool   `xml:"clearComments,attr,omitempty"`
+	Assign              bool   `xml:"assign,attr,omitempty"`
+	Coerce              bool   `xml:"coerce,attr,omitempty"`
+	Adjust              bool   `xml:"adjust,attr,omitempty"`
+	CellMeta            bool   `xml:"cellMeta,attr,omitempty"`
+}
+
 // xlsxFutureMetadata directly maps the futureMetadata element. This element
 // represents future metadata information.
 type xlsxFutureMetadata struct {
+	Name   string                    `xml:"name,attr"`
+	Count  int                       `xml:"count,attr,omitempty"`
 	Bk     []xlsxFutureMetadataBlock `xml:"bk"`
 	ExtLst *xlsxInnerXML             `xml:"extLst"`
 }
@@ -72,6 +118,7 @@ type xlsxMetadataRecord struct {
 // data.
 type xlsxRichValueData struct {
 	XMLName xml.Name        `xml:"rvData"`
+	XMLNS   string          `xml:"xmlns,attr"`
 	Count   int             `xml:"count,attr,omitempty"`
 	Rv      []xlsxRichValue `xml:"rv"`
 	ExtLst  *xlsxInnerXML   `xml:"extLst"`
@@ -89,6 +136,8 @@ type xlsxRichValue struct {
 // specifies a list of rich value relationships.
 type xlsxRichValueRels struct {
 	XMLName xml.Name                       `xml:"richValueRels"`
+	XMLNS   string                         `xml:"xmlns,attr"`
+	XMLNSR  string                         `xml:"xmlns:r,attr"`
 	Rels    []xlsxRichValueRelRelationship `xml:"rel"`
 	ExtLst  *xlsxInnerXML                  `xml:"extLst"`
 }
@@ -96,9 +145,47 @@ type xlsxRichValueRels struct {
 // xlsxRichValueRelRelationship directly maps the rel element. This element
 // specifies a relationship for a rich value property.
 type xlsxRichValueRelRelationship struct {
+	ID string `xml:"r:id,attr"`
+}
+
+// decodeRichValueRels defines the structure used to parse the richValueRels
+// element in the file xl/richData/richValueRel.xml.
+type decodeRichValueRels struct {
+	XMLName xml.Name                         `xml:"richValueRels"`
+	Rels    []decodeRichValueRelRelationship `xml:"rel"`
+	ExtLst  *xlsxInnerXML                    `xml:"extLst"`
+}
+
+// decodeRichValueRelRelationship defines the structure used to parse the rel
+// element in the file xl/richData/richValueRel.xml.
+type decodeRichValueRelRelationship struct {
 	ID string `xml:"id,attr"`
 }
 
+// xlsxRichValueStructures directly maps the rvStructures element. This element
+// specifies a list of rich value structures.
+type xlsxRichValueStructures struct {
+	XMLName xml.Name                 `xml:"rvStructures"`
+	XMLNS   string                   `xml:"xmlns,attr"`
+	Count   int                      `xml:"count,attr,omitempty"`
+	S       []xlsxRichValueStructure `xml:"s"`
+	ExtLst  *xlsxInnerXML            `xml:"extLst"`
+}
+
+// xlsxRichValueStructure directly maps the s element. This element specifies
+// a rich value structure, which is a collection of keys.
+type xlsxRichValueStructure struct {
+	T string             `xml:"t,attr"`
+	K []xlsxRichValueKey `xml:"k"`
+}
+
+// xlsxRichValueKey directly maps the k element. This element specifies a key
+// of a rich value structure.
+type xlsxRichValueKey struct {
+	N string `xml:"n,attr"`
+	T string `xml:"t,attr,omitempty"`
+}
+
 // xlsxWebImagesSupportingRichData directly maps the webImagesSrd element. This
 // element specifies a list of sets of properties associated with web image rich
 // values.
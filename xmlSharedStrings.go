@@ -85,8 +85,13 @@ type xlsxRPr struct {
 	Scheme    *attrValString `xml:"scheme"`
 }
 
-// RichTextRun directly maps the settings of the rich text run.
+// RichTextRun directly maps the settings of the rich text run. The Lang field
+// specifies the language tag of the run for spell checking, such as "fr-FR",
+// which only applies to the text of the shapes, because the run properties of
+// the cell rich text doesn't support the language attribute in the file
+// format, and it will be ignored for the cells, comments and form controls.
 type RichTextRun struct {
 	Font *Font
+	Lang string
 	Text string
 }
@@ -174,6 +174,14 @@ type decodeSlicerCaches struct {
 	Content string   `xml:",innerxml"`
 }
 
+// decodeSlicerCacheList defines the structure used to parse the list of
+// x14:slicerCache element in the x14:slicerCaches and x15:slicerCaches
+// element.
+type decodeSlicerCacheList struct {
+	XMLName     xml.Name       `xml:"slicerCaches"`
+	SlicerCache []decodeSlicer `xml:"slicerCache"`
+}
+
 // xlsxTimelines is a mechanism for filtering data in pivot table views, cube
 // functions and charts based on non-worksheet pivot tables. In the case of
 // using OLAP Timeline source data, a Timeline is based on a key attribute of
@@ -203,3 +211,82 @@ type xlsxTimeline struct {
 	ScrollPosition          string `xml:"scrollPosition,attr,omitempty"`
 	Style                   string `xml:"style,attr,omitempty"`
 }
+
+// xlsxTimelineCacheDefinition directly maps the timelineCacheDefinition
+// element that specifies a timeline cache.
+type xlsxTimelineCacheDefinition struct {
+	XMLName     xml.Name                      `xml:"http://schemas.microsoft.com/office/spreadsheetml/2010/11/main timelineCacheDefinition"`
+	XMLNSXMC    string                        `xml:"xmlns:mc,attr"`
+	XMLNSX      string                        `xml:"xmlns:x,attr"`
+	XMLNSXR10   string                        `xml:"xmlns:xr10,attr"`
+	Name        string                        `xml:"name,attr"`
+	XR10UID     string                        `xml:"xr10:uid,attr,omitempty"`
+	SourceName  string                        `xml:"sourceName,attr"`
+	PivotTables *xlsxTimelineCachePivotTables `xml:"pivotTables"`
+	State       *xlsxTimelineState            `xml:"state"`
+	ExtLst      *xlsxExtLst                   `xml:"extLst"`
+}
+
+// xlsxTimelineCachePivotTables is a complex type that specifies a group of
+// pivotTable elements that specify the PivotTable views that are filtered by
+// the timeline cache.
+type xlsxTimelineCachePivotTables struct {
+	PivotTable []xlsxSlicerCachePivotTable `xml:"pivotTable"`
+}
+
+// xlsxTimelineState is a complex type that specifies the current state of the
+// timeline cache.
+type xlsxTimelineState struct {
+	SingleRangeFilterState bool               `xml:"singleRangeFilterState,attr,omitempty"`
+	MinimalRefreshVersion  int                `xml:"minimalRefreshVersion,attr"`
+	LastRefreshVersion     int                `xml:"lastRefreshVersion,attr"`
+	PivotCacheID           int                `xml:"pivotCacheId,attr"`
+	FilterType             string             `xml:"filterType,attr"`
+	Selection              *xlsxTimelineRange `xml:"selection"`
+	Bounds                 *xlsxTimelineRange `xml:"bounds"`
+}
+
+// xlsxTimelineRange is a complex type that specifies a date range in the
+// timeline cache.
+type xlsxTimelineRange struct {
+	StartDate string `xml:"startDate,attr"`
+	EndDate   string `xml:"endDate,attr"`
+}
+
+// xlsxX15TimelineRefs specifies a list of timeline.
+type xlsxX15TimelineRefs struct {
+	XMLName     xml.Name              `xml:"x15:timelineRefs"`
+	TimelineRef []*xlsxX15TimelineRef `xml:"x15:timelineRef"`
+}
+
+// xlsxX15TimelineRef specifies a timeline part reference.
+type xlsxX15TimelineRef struct {
+	XMLName xml.Name `xml:"x15:timelineRef"`
+	RID     string   `xml:"r:id,attr"`
+}
+
+// xlsxX15TimelineCacheRefs specifies a list of timeline cache.
+type xlsxX15TimelineCacheRefs struct {
+	XMLName          xml.Name                   `xml:"x15:timelineCacheRefs"`
+	TimelineCacheRef []*xlsxX15TimelineCacheRef `xml:"x15:timelineCacheRef"`
+}
+
+// xlsxX15TimelineCacheRef specifies a timeline cache part reference.
+type xlsxX15TimelineCacheRef struct {
+	XMLName xml.Name `xml:"x15:timelineCacheRef"`
+	RID     string   `xml:"r:id,attr"`
+}
+
+// decodeTimelineRefs defines the structure used to parse the
+// x15:timelineRefs element of a list of timeline.
+type decodeTimelineRefs struct {
+	XMLName     xml.Name        `xml:"timelineRefs"`
+	TimelineRef []*decodeSlicer `xml:"timelineRef"`
+}
+
+// decodeTimelineCacheRefs defines the structure used to parse the
+// x15:timelineCacheRefs element of a list of timeline cache.
+type decodeTimelineCacheRefs struct {
+	XMLName          xml.Name        `xml:"timelineCacheRefs"`
+	TimelineCacheRef []*decodeSlicer `xml:"timelineCacheRef"`
+}
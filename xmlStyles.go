@@ -87,20 +87,21 @@ type xlsxFonts struct {
 // xlsxFont directly maps the font element. This element defines the
 // properties for one of the fonts used in this workbook.
 type xlsxFont struct {
-	B        *attrValBool   `xml:"b"`
-	I        *attrValBool   `xml:"i"`
-	Strike   *attrValBool   `xml:"strike"`
-	Outline  *attrValBool   `xml:"outline"`
-	Shadow   *attrValBool   `xml:"shadow"`
-	Condense *attrValBool   `xml:"condense"`
-	Extend   *attrValBool   `xml:"extend"`
-	U        *attrValString `xml:"u"`
-	Sz       *attrValFloat  `xml:"sz"`
-	Color    *xlsxColor     `xml:"color"`
-	Name     *attrValString `xml:"name"`
-	Family   *attrValInt    `xml:"family"`
-	Charset  *attrValInt    `xml:"charset"`
-	Scheme   *attrValString `xml:"scheme"`
+	B         *attrValBool   `xml:"b"`
+	I         *attrValBool   `xml:"i"`
+	Strike    *attrValBool   `xml:"strike"`
+	Outline   *attrValBool   `xml:"outline"`
+	Shadow    *attrValBool   `xml:"shadow"`
+	Condense  *attrValBool   `xml:"condense"`
+	Extend    *attrValBool   `xml:"extend"`
+	U         *attrValString `xml:"u"`
+	VertAlign *attrValString `xml:"vertAlign"`
+	Sz        *attrValFloat  `xml:"sz"`
+	Color     *xlsxColor     `xml:"color"`
+	Name      *attrValString `xml:"name"`
+	Family    *attrValInt    `xml:"family"`
+	Charset   *attrValInt    `xml:"charset"`
+	Scheme    *attrValString `xml:"scheme"`
 }
 
 // xlsxFills directly maps the fills' element. This element defines the cell
@@ -356,6 +357,8 @@ type Fill struct {
 	Pattern int
 	Color   []string
 	Shading int
+	Degree  float64
+	Stops   []float64
 }
 
 // Protection directly maps the protection settings of the cells.
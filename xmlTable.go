@@ -189,6 +189,7 @@ type xlsxTableColumn struct {
 	HeaderRowCellStyle string `xml:"headerRowCellStyle,attr,omitempty"`
 	DataCellStyle      string `xml:"dataCellStyle,attr,omitempty"`
 	TotalsRowCellStyle string `xml:"totalsRowCellStyle,attr,omitempty"`
+	TotalsRowFormula   string `xml:"totalsRowFormula,omitempty"`
 }
 
 // xlsxTableStyleInfo directly maps the tableStyleInfo element. This element
@@ -244,10 +245,43 @@ type Table struct {
 	ShowHeaderRow     *bool
 	ShowLastColumn    bool
 	ShowRowStripes    *bool
+	TotalRow          []TableTotalRowOptions
+	Headers           []string
+}
+
+// TableTotalRowOptions directly maps the total row settings of a table column.
+type TableTotalRowOptions struct {
+	Column   string
+	Function string
+	Formula  string
+	Label    string
 }
 
 // AutoFilterOptions directly maps the auto filter settings.
 type AutoFilterOptions struct {
-	Column     string
-	Expression string
+	Column        string
+	Expression    string
+	Top10         *AutoFilterTop10Options
+	DynamicFilter string
+	DateGroups    []AutoFilterDateGroupOptions
+}
+
+// AutoFilterTop10Options directly maps the top N filter settings of an auto
+// filter column.
+type AutoFilterTop10Options struct {
+	Bottom  bool
+	Percent bool
+	Value   float64
+}
+
+// AutoFilterDateGroupOptions directly maps the date group item settings of an
+// auto filter column.
+type AutoFilterDateGroupOptions struct {
+	Grouping string
+	Year     int
+	Month    int
+	Day      int
+	Hour     int
+	Minute   int
+	Second   int
 }
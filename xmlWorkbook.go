@@ -39,7 +39,7 @@ type xlsxWorkbook struct {
 	XMLName                xml.Name                 `xml:"http://schemas.openxmlformats.org/spreadsheetml/2006/main workbook"`
 	Conformance            string                   `xml:"conformance,attr,omitempty"`
 	FileVersion            *xlsxFileVersion         `xml:"fileVersion"`
-	FileSharing            *xlsxExtLst              `xml:"fileSharing"`
+	FileSharing            *xlsxFileSharing         `xml:"fileSharing"`
 	WorkbookPr             *xlsxWorkbookPr          `xml:"workbookPr"`
 	AlternateContent       *xlsxAlternateContent    `xml:"mc:AlternateContent"`
 	DecodeAlternateContent *xlsxInnerXML            `xml:"http://schemas.openxmlformats.org/markup-compatibility/2006 AlternateContent"`
@@ -95,6 +95,19 @@ type xlsxWorkbookProtection struct {
 	WorkbookSpinCount      int    `xml:"workbookSpinCount,attr,omitempty"`
 }
 
+// xlsxFileSharing directly maps the fileSharing element. This element
+// specifies file sharing settings for the workbook, such as the read-only
+// recommended setting and the password to modify the workbook.
+type xlsxFileSharing struct {
+	ReadOnlyRecommended bool   `xml:"readOnlyRecommended,attr,omitempty"`
+	UserName            string `xml:"userName,attr,omitempty"`
+	ReservationPassword string `xml:"reservationPassword,attr,omitempty"`
+	AlgorithmName       string `xml:"algorithmName,attr,omitempty"`
+	HashValue           string `xml:"hashValue,attr,omitempty"`
+	SaltValue           string `xml:"saltValue,attr,omitempty"`
+	SpinCount           int    `xml:"spinCount,attr,omitempty"`
+}
+
 // xlsxFileVersion directly maps the fileVersion element. This element defines
 // properties that track which version of the application accessed the data and
 // source code contained in the file.
@@ -142,19 +155,19 @@ type xlsxBookViews struct {
 // http://schemas.openxmlformats.org/spreadsheetml/2006/main This element
 // specifies a single Workbook view.
 type xlsxWorkBookView struct {
-	Visibility             string  `xml:"visibility,attr,omitempty"`
-	Minimized              bool    `xml:"minimized,attr,omitempty"`
-	ShowHorizontalScroll   *bool   `xml:"showHorizontalScroll,attr"`
-	ShowVerticalScroll     *bool   `xml:"showVerticalScroll,attr"`
-	ShowSheetTabs          *bool   `xml:"showSheetTabs,attr"`
-	XWindow                string  `xml:"xWindow,attr,omitempty"`
-	YWindow                string  `xml:"yWindow,attr,omitempty"`
-	WindowWidth            int     `xml:"windowWidth,attr,omitempty"`
-	WindowHeight           int     `xml:"windowHeight,attr,omitempty"`
-	TabRatio               float64 `xml:"tabRatio,attr,omitempty"`
-	FirstSheet             int     `xml:"firstSheet,attr,omitempty"`
-	ActiveTab              int     `xml:"activeTab,attr,omitempty"`
-	AutoFilterDateGrouping *bool   `xml:"autoFilterDateGrouping,attr"`
+	Visibility             string   `xml:"visibility,attr,omitempty"`
+	Minimized              bool     `xml:"minimized,attr,omitempty"`
+	ShowHorizontalScroll   *bool    `xml:"showHorizontalScroll,attr"`
+	ShowVerticalScroll     *bool    `xml:"showVerticalScroll,attr"`
+	ShowSheetTabs          *bool    `xml:"showSheetTabs,attr"`
+	XWindow                string   `xml:"xWindow,attr,omitempty"`
+	YWindow                string   `xml:"yWindow,attr,omitempty"`
+	WindowWidth            *int     `xml:"windowWidth,attr"`
+	WindowHeight           *int     `xml:"windowHeight,attr"`
+	TabRatio               *float64 `xml:"tabRatio,attr"`
+	FirstSheet             *int     `xml:"firstSheet,attr"`
+	ActiveTab              int      `xml:"activeTab,attr,omitempty"`
+	AutoFilterDateGrouping *bool    `xml:"autoFilterDateGrouping,attr"`
 }
 
 // xlsxSheets directly maps the sheets element from the namespace
@@ -247,6 +260,7 @@ type xlsxChoice struct {
 	XMLName    xml.Name `xml:"mc:Choice"`
 	XMLNSA14   string   `xml:"xmlns:a14,attr,omitempty"`
 	XMLNSSle15 string   `xml:"xmlns:sle15,attr,omitempty"`
+	XMLNSTsle  string   `xml:"xmlns:tsle,attr,omitempty"`
 	Requires   string   `xml:"Requires,attr,omitempty"`
 	Content    string   `xml:",innerxml"`
 }
@@ -277,6 +291,16 @@ type decodeExt struct {
 	Content string `xml:",innerxml"`
 }
 
+// xlsxDatastoreItem directly maps the datastoreItem element of the custom XML
+// data properties part. This element specifies the unique identifier of the
+// custom XML data and the XML schemas referenced by the custom XML data.
+type xlsxDatastoreItem struct {
+	XMLName    xml.Name `xml:"ds:datastoreItem"`
+	XMLNSds    string   `xml:"xmlns:ds,attr"`
+	ItemID     string   `xml:"ds:itemID,attr"`
+	SchemaRefs string   `xml:"ds:schemaRefs"`
+}
+
 // xlsxDefinedNames directly maps the definedNames element. This element defines
 // the collection of defined names for this workbook. Defined names are
 // descriptive names to represent cells, ranges of cells, formulas, or constant
@@ -391,6 +415,25 @@ type WorkbookPropsOptions struct {
 	CodeName      *string
 }
 
+// WorkbookViewOptions directly maps the settings of workbook window view.
+type WorkbookViewOptions struct {
+	XWindow      *int
+	YWindow      *int
+	WindowWidth  *int
+	WindowHeight *int
+	FirstSheet   *int
+	TabRatio     *float64
+}
+
+// FileSharingOptions directly maps the settings of workbook file sharing.
+type FileSharingOptions struct {
+	AlgorithmName       string
+	HasPassword         bool
+	Password            string
+	ReadOnlyRecommended bool
+	UserName            string
+}
+
 // WorkbookProtectionOptions directly maps the settings of workbook protection.
 type WorkbookProtectionOptions struct {
 	AlgorithmName string
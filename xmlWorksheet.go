@@ -20,6 +20,7 @@ import (
 // http://schemas.openxmlformats.org/spreadsheetml/2006/main.
 type xlsxWorksheet struct {
 	mu                     sync.Mutex
+	ignoredErrors          map[xlsxIgnoredError]map[string]struct{}
 	XMLName                xml.Name                     `xml:"http://schemas.openxmlformats.org/spreadsheetml/2006/main worksheet"`
 	SheetPr                *xlsxSheetPr                 `xml:"sheetPr"`
 	Dimension              *xlsxDimension               `xml:"dimension"`
@@ -48,7 +49,7 @@ type xlsxWorksheet struct {
 	ColBreaks              *xlsxColBreaks               `xml:"colBreaks"`
 	CustomProperties       *xlsxInnerXML                `xml:"customProperties"`
 	CellWatches            *xlsxInnerXML                `xml:"cellWatches"`
-	IgnoredErrors          *xlsxInnerXML                `xml:"ignoredErrors"`
+	IgnoredErrors          *xlsxIgnoredErrors           `xml:"ignoredErrors"`
 	SmartTags              *xlsxInnerXML                `xml:"smartTags"`
 	Drawing                *xlsxDrawing                 `xml:"drawing"`
 	LegacyDrawing          *xlsxLegacyDrawing           `xml:"legacyDrawing"`
@@ -679,6 +680,28 @@ type xlsxPicture struct {
 	RID     string   `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr,omitempty"`
 }
 
+// xlsxIgnoredErrors directly maps the ignoredErrors element. This element
+// specifies the collection of ignored errors, by cell range.
+type xlsxIgnoredErrors struct {
+	IgnoredError []xlsxIgnoredError `xml:"ignoredError"`
+	ExtLst       *xlsxInnerXML      `xml:"extLst"`
+}
+
+// xlsxIgnoredError directly maps the ignoredError element. This element
+// specifies a single ignored error for a range of cells.
+type xlsxIgnoredError struct {
+	Sqref              string `xml:"sqref,attr"`
+	EvalError          bool   `xml:"evalError,attr,omitempty"`
+	TwoDigitTextYear   bool   `xml:"twoDigitTextYear,attr,omitempty"`
+	NumberStoredAsText bool   `xml:"numberStoredAsText,attr,omitempty"`
+	Formula            bool   `xml:"formula,attr,omitempty"`
+	FormulaRange       bool   `xml:"formulaRange,attr,omitempty"`
+	UnlockedFormula    bool   `xml:"unlockedFormula,attr,omitempty"`
+	EmptyCellReference bool   `xml:"emptyCellReference,attr,omitempty"`
+	ListDataValidation bool   `xml:"listDataValidation,attr,omitempty"`
+	CalculatedColumn   bool   `xml:"calculatedColumn,attr,omitempty"`
+}
+
 // xlsxLegacyDrawing directly maps the legacyDrawing element in the namespace
 // http://schemas.openxmlformats.org/spreadsheetml/2006/main - A comment is a
 // rich text note that is attached to, and associated with, a cell, separate
@@ -735,29 +758,54 @@ type decodeX14ConditionalFormattingRules struct {
 type decodeX14ConditionalFormatting struct {
 	XMLName xml.Name           `xml:"conditionalFormatting"`
 	CfRule  []*decodeX14CfRule `xml:"cfRule"`
+	Sqref   string             `xml:"sqref"`
 }
 
 // decodeX14CfRule directly maps the cfRule element.
 type decodeX14CfRule struct {
-	XMLName xml.Name          `xml:"cfRule"`
-	Type    string            `xml:"type,attr,omitempty"`
-	ID      string            `xml:"id,attr,omitempty"`
-	DataBar *decodeX14DataBar `xml:"dataBar"`
+	XMLName    xml.Name          `xml:"cfRule"`
+	Type       string            `xml:"type,attr,omitempty"`
+	Priority   int               `xml:"priority,attr,omitempty"`
+	StopIfTrue bool              `xml:"stopIfTrue,attr,omitempty"`
+	ID         string            `xml:"id,attr,omitempty"`
+	DataBar    *decodeX14DataBar `xml:"dataBar"`
+	IconSet    *decodeX14IconSet `xml:"iconSet"`
+}
+
+// decodeX14Cfvo directly maps the cfvo element in the conditional formatting
+// extension rules.
+type decodeX14Cfvo struct {
+	XMLName xml.Name `xml:"cfvo"`
+	Type    string   `xml:"type,attr,omitempty"`
+	Gte     *bool    `xml:"gte,attr"`
+	F       string   `xml:"f"`
 }
 
 // decodeX14DataBar directly maps the dataBar element.
 type decodeX14DataBar struct {
-	XMLName           xml.Name    `xml:"dataBar"`
-	MaxLength         int         `xml:"maxLength,attr"`
-	MinLength         int         `xml:"minLength,attr"`
-	Border            bool        `xml:"border,attr,omitempty"`
-	Gradient          *bool       `xml:"gradient,attr"`
-	ShowValue         bool        `xml:"showValue,attr,omitempty"`
-	Direction         string      `xml:"direction,attr,omitempty"`
-	Cfvo              []*xlsxCfvo `xml:"cfvo"`
-	BorderColor       *xlsxColor  `xml:"borderColor"`
-	NegativeFillColor *xlsxColor  `xml:"negativeFillColor"`
-	AxisColor         *xlsxColor  `xml:"axisColor"`
+	XMLName           xml.Name         `xml:"dataBar"`
+	MaxLength         int              `xml:"maxLength,attr"`
+	MinLength         int              `xml:"minLength,attr"`
+	Border            bool             `xml:"border,attr,omitempty"`
+	Gradient          *bool            `xml:"gradient,attr"`
+	ShowValue         *bool            `xml:"showValue,attr"`
+	Direction         string           `xml:"direction,attr,omitempty"`
+	Cfvo              []*decodeX14Cfvo `xml:"cfvo"`
+	FillColor         *xlsxColor       `xml:"fillColor"`
+	BorderColor       *xlsxColor       `xml:"borderColor"`
+	NegativeFillColor *xlsxColor       `xml:"negativeFillColor"`
+	AxisColor         *xlsxColor       `xml:"axisColor"`
+}
+
+// decodeX14IconSet directly maps the iconSet element in the conditional
+// formatting extension rules.
+type decodeX14IconSet struct {
+	XMLName   xml.Name         `xml:"iconSet"`
+	IconSet   string           `xml:"iconSet,attr,omitempty"`
+	ShowValue *bool            `xml:"showValue,attr"`
+	Reverse   bool             `xml:"reverse,attr,omitempty"`
+	Custom    bool             `xml:"custom,attr,omitempty"`
+	Cfvo      []*decodeX14Cfvo `xml:"cfvo"`
 }
 
 // xlsxX14ConditionalFormattings directly maps the conditionalFormattings
@@ -912,10 +960,17 @@ type Panes struct {
 	Selection   []Selection
 }
 
+// GroupOptions directly maps the settings of grouping rows or columns.
+type GroupOptions struct {
+	Collapsed bool
+}
+
 // ConditionalFormatOptions directly maps the conditional format settings of the cells.
 type ConditionalFormatOptions struct {
 	Type           string
 	AboveAverage   bool
+	EqualAverage   bool
+	StdDev         int
 	Percent        bool
 	Format         *int
 	Criteria       string
@@ -938,6 +993,7 @@ type ConditionalFormatOptions struct {
 	ReverseIcons   bool
 	IconsOnly      bool
 	StopIfTrue     bool
+	Priority       int
 }
 
 // SheetProtectionOptions directly maps the settings of worksheet protection.
@@ -951,6 +1007,7 @@ type SheetProtectionOptions struct {
 	FormatCells         bool
 	FormatColumns       bool
 	FormatRows          bool
+	HasPassword         bool
 	InsertColumns       bool
 	InsertHyperlinks    bool
 	InsertRows          bool
@@ -961,6 +1018,22 @@ type SheetProtectionOptions struct {
 	Sort                bool
 }
 
+// IgnoredErrorOptions directly maps the settings of the ignored errors of the
+// worksheet. Each boolean field specifies whether to suppress the
+// corresponding error indicator for the cells in the range reference.
+type IgnoredErrorOptions struct {
+	SQRef              string
+	CalculatedColumn   bool
+	EmptyCellReference bool
+	EvalError          bool
+	Formula            bool
+	FormulaRange       bool
+	ListDataValidation bool
+	NumberStoredAsText bool
+	TwoDigitTextYear   bool
+	UnlockedFormula    bool
+}
+
 // HeaderFooterOptions directly maps the settings of header and footer.
 type HeaderFooterOptions struct {
 	AlignWithMargins *bool
@@ -1103,3 +1176,73 @@ type SheetPropsOptions struct {
 	// ThickBottom specifies if rows have a thick bottom border by default.
 	ThickBottom *bool
 }
+
+// SheetFormatPrOptions directly maps the settings of sheet format properties,
+// which specifies the default column width and row height of the worksheet.
+type SheetFormatPrOptions struct {
+	// BaseColWidth specifies the number of characters of the maximum digit
+	// width of the normal style's font. This value does not include margin
+	// padding or extra padding for grid lines. It is only the number of
+	// characters.
+	BaseColWidth *uint8
+	// DefaultColWidth specifies the default column width measured as the
+	// number of characters of the maximum digit width of the normal style's
+	// font.
+	DefaultColWidth *float64
+	// DefaultRowHeight specifies the default row height measured in point
+	// size.
+	DefaultRowHeight *float64
+	// CustomHeight specifies if the default row height has been manually
+	// set, it will be set to true if the default row height was specified
+	// without this field.
+	CustomHeight *bool
+	// ZeroHeight specifies if rows are hidden.
+	ZeroHeight *bool
+	// ThickTop specifies if rows have a thick top border by default.
+	ThickTop *bool
+	// ThickBottom specifies if rows have a thick bottom border by default.
+	ThickBottom *bool
+}
+
+// xlsxOleObject directly maps the oleObject element. This element specifies
+// an embedded or linked OLE object in the worksheet.
+type xlsxOleObject struct {
+	XMLName  xml.Name `xml:"oleObject"`
+	ProgID   string   `xml:"progId,attr,omitempty"`
+	DvAspect string   `xml:"dvAspect,attr,omitempty"`
+	ShapeID  int      `xml:"shapeId,attr"`
+	RID      string   `xml:"r:id,attr,omitempty"`
+}
+
+// decodeOleObject defines the structure used to parse the oleObject element
+// in the worksheet.
+type decodeOleObject struct {
+	ProgID   string             `xml:"progId,attr"`
+	ShapeID  int                `xml:"shapeId,attr"`
+	RID      string             `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	ObjectPr *decodeOleObjectPr `xml:"objectPr"`
+}
+
+// decodeOleObjectPr defines the structure used to parse the objectPr element
+// of the OLE object in the worksheet.
+type decodeOleObjectPr struct {
+	Anchor *struct {
+		From struct {
+			Col int `xml:"col"`
+			Row int `xml:"row"`
+		} `xml:"from"`
+	} `xml:"anchor"`
+}
+
+// OLEObject directly maps the settings of the embedded OLE object. The Cell
+// and ProgID are only used for getting the embedded OLE objects.
+type OLEObject struct {
+	Cell          string
+	ProgID        string
+	FileName      string
+	Data          []byte
+	Icon          []byte
+	IconExtension string
+	Width         uint
+	Height        uint
+}